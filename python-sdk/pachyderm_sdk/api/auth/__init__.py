@@ -3,10 +3,14 @@
 # plugin: python-betterproto
 # This file has been @generated
 from dataclasses import dataclass
-from datetime import datetime
+from datetime import (
+    datetime,
+    timedelta,
+)
 from typing import (
     TYPE_CHECKING,
     Dict,
+    Iterator,
     List,
     Optional,
 )
@@ -45,6 +49,8 @@ class Permission(betterproto.Enum):
     CLUSTER_AUTH_DELETE_EXPIRED_TOKENS = 140
     CLUSTER_AUTH_REVOKE_USER_TOKENS = 142
     CLUSTER_AUTH_ROTATE_ROOT_TOKEN = 147
+    CLUSTER_AUTH_MODIFY_ROLES = 155
+    CLUSTER_AUTH_LIST_AUDIT_EVENTS = 156
     CLUSTER_ENTERPRISE_ACTIVATE = 114
     CLUSTER_ENTERPRISE_HEARTBEAT = 115
     CLUSTER_ENTERPRISE_GET_CODE = 116
@@ -79,6 +85,14 @@ class Permission(betterproto.Enum):
     CLUSTER_DELETE_ALL = 138
     CLUSTER_SNAPSHOTTER = 152
     CLUSTER_RESTART_PACHYDERM = 153
+    CLUSTER_MANAGE_WEBHOOKS = 154
+    CLUSTER_REPLICATE = 157
+    """
+    CLUSTER_REPLICATE allows reading and writing raw chunks and filesets,
+    which bypasses repo permissions; it's needed to push and pull branches
+    between clusters.
+    """
+
     REPO_READ = 200
     REPO_WRITE = 201
     REPO_MODIFY_BINDINGS = 202
@@ -101,6 +115,9 @@ class Permission(betterproto.Enum):
     PROJECT_SET_DEFAULTS = 303
     """PROJECT_SET_DEFAULTS is part of PPS."""
 
+    CLUSTER_SET_PROJECT_QUOTA = 304
+    """CLUSTER_SET_PROJECT_QUOTA is part of PPS."""
+
     PROJECT_CREATE = 400
     PROJECT_DELETE = 401
     PROJECT_LIST_REPO = 402
@@ -228,6 +245,35 @@ class TokenInfo(betterproto.Message):
 
     expiration: datetime = betterproto.message_field(2)
     hashed_token: str = betterproto.string_field(3)
+    scope: "TokenScope" = betterproto.message_field(4)
+    """
+    scope narrows what the token may be used for.  A token without a scope
+    carries all of its subject's permissions.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class TokenScope(betterproto.Message):
+    """
+    TokenScope narrows the permissions a token grants to a subset of those its
+    subject holds.  A permission is granted only if the subject's role bindings
+    grant it and every restriction that's set allows it.
+    """
+
+    permissions: List["Permission"] = betterproto.enum_field(1)
+    """permissions, if set, are the only permissions the token may exercise."""
+
+    resources: List["Resource"] = betterproto.message_field(2)
+    """
+    resources, if set, are the only resources the token may be used on.  A
+    project also covers the repos in it.
+    """
+
+    read_only: bool = betterproto.bool_field(3)
+    """
+    read_only restricts the token to permissions that don't change the state of
+    the cluster.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -241,6 +287,9 @@ class AuthenticateRequest(betterproto.Message):
     id_token: str = betterproto.string_field(2)
     """This is an ID Token issued by the OIDC provider."""
 
+    scope: "TokenScope" = betterproto.message_field(3)
+    """scope, if set, narrows what the returned token may be used for."""
+
 
 @dataclass(eq=False, repr=False)
 class AuthenticateResponse(betterproto.Message):
@@ -261,6 +310,8 @@ class WhoAmIRequest(betterproto.Message):
 class WhoAmIResponse(betterproto.Message):
     username: str = betterproto.string_field(1)
     expiration: datetime = betterproto.message_field(2)
+    scope: "TokenScope" = betterproto.message_field(3)
+    """scope is the scope of the caller's token, if it has one."""
 
 
 @dataclass(eq=False, repr=False)
@@ -273,6 +324,127 @@ class GetRolesForPermissionResponse(betterproto.Message):
     roles: List["Role"] = betterproto.message_field(1)
 
 
+@dataclass(eq=False, repr=False)
+class CreateRoleRequest(betterproto.Message):
+    role: "Role" = betterproto.message_field(1)
+    """
+    role is the custom role to create.  Its name must not be the name of a
+    built-in role, and if returned_for is empty, it defaults to
+    can_be_bound_to.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class CreateRoleResponse(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class UpdateRoleRequest(betterproto.Message):
+    role: "Role" = betterproto.message_field(1)
+    """role replaces the custom role with the same name."""
+
+
+@dataclass(eq=False, repr=False)
+class UpdateRoleResponse(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class DeleteRoleRequest(betterproto.Message):
+    name: str = betterproto.string_field(1)
+    """
+    name is the name of the custom role to delete.  A role can't be deleted
+    while it's in a role binding.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class DeleteRoleResponse(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class ListRolesRequest(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class ListRolesResponse(betterproto.Message):
+    roles: List["Role"] = betterproto.message_field(1)
+    """roles are the built-in and custom roles, sorted by name."""
+
+
+@dataclass(eq=False, repr=False)
+class AuditEvent(betterproto.Message):
+    """
+    AuditEvent records a call to an API that changes the state of the cluster.
+    """
+
+    id: int = betterproto.int64_field(1)
+    time: datetime = betterproto.message_field(2)
+    principal: str = betterproto.string_field(3)
+    """
+    principal is the user that made the call.  It's empty if auth is not
+    active.
+    """
+
+    method: str = betterproto.string_field(4)
+    """
+    method is the full name of the gRPC method, e.g. /pfs_v2.API/CreateRepo.
+    """
+
+    resource: str = betterproto.string_field(5)
+    """
+    resource is the main object the call acted on, e.g. a repo, branch,
+    commit or pipeline, if one could be found in the request.
+    """
+
+    request: str = betterproto.string_field(6)
+    """
+    request is a JSON summary of the request, with secrets masked and file
+    contents left out.
+    """
+
+    code: str = betterproto.string_field(7)
+    """code is the gRPC status code that the call returned, e.g. OK."""
+
+    error: str = betterproto.string_field(8)
+    """error is the error message that the call returned, if it failed."""
+
+    duration: timedelta = betterproto.message_field(9)
+
+
+@dataclass(eq=False, repr=False)
+class ListAuditEventsRequest(betterproto.Message):
+    since: datetime = betterproto.message_field(1)
+    """
+    since and until, if set, limit the events to those recorded in
+    [since, until).
+    """
+
+    until: datetime = betterproto.message_field(2)
+    principal: str = betterproto.string_field(3)
+    """principal, if set, limits the events to those made by the principal."""
+
+    method: str = betterproto.string_field(4)
+    """
+    method, if set, limits the events to those whose method contains it,
+    e.g. "pfs_v2." or "CreateRepo".
+    """
+
+    resource: str = betterproto.string_field(5)
+    """
+    resource, if set, limits the events to those whose resource contains it.
+    """
+
+    limit: int = betterproto.int64_field(6)
+    """
+    limit, if positive, is the maximum number of events to return.  Events
+    are returned newest first.
+    """
+
+
 @dataclass(eq=False, repr=False)
 class Roles(betterproto.Message):
     """Roles represents the set of roles a principal has"""
@@ -280,6 +452,12 @@ class Roles(betterproto.Message):
     roles: Dict[str, bool] = betterproto.map_field(
         1, betterproto.TYPE_STRING, betterproto.TYPE_BOOL
     )
+    expiration: datetime = betterproto.message_field(2)
+    """
+    expiration, if set, is when the roles stop applying.  Expired roles are
+    ignored when authorizing requests, and are removed from the role binding by
+    a background sweeper.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -339,6 +517,12 @@ class Role(betterproto.Message):
     cannot be bound to a repo.
     """
 
+    custom: bool = betterproto.bool_field(5)
+    """
+    custom is true for roles created with CreateRole, rather than built into
+    Pachyderm.
+    """
+
 
 @dataclass(eq=False, repr=False)
 class AuthorizeRequest(betterproto.Message):
@@ -391,6 +575,90 @@ class GetPermissionsResponse(betterproto.Message):
     """roles is the set of roles the principal has"""
 
 
+@dataclass(eq=False, repr=False)
+class ExplainAuthorizationRequest(betterproto.Message):
+    """
+    ExplainAuthorization describes how a principal's role bindings and group
+    memberships do or don't grant permissions on a resource.
+    """
+
+    resource: "Resource" = betterproto.message_field(1)
+    principal: str = betterproto.string_field(2)
+    """
+    principal is the principal to explain.  If it's unset, the caller is
+    explained, including any restrictions on the caller's token.
+    """
+
+    permissions: List["Permission"] = betterproto.enum_field(3)
+    """
+    permissions are the permissions to explain.  If unset, every permission
+    that applies to the resource's type is explained.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class RoleGrant(betterproto.Message):
+    """RoleGrant is an entry in a role binding that includes a permission."""
+
+    resource: "Resource" = betterproto.message_field(1)
+    """
+    resource is the resource whose role binding has the entry.  Bindings on
+    the cluster and on a repo's project apply to the repo too.
+    """
+
+    subject: str = betterproto.string_field(2)
+    """
+    subject is the subject of the entry: the principal itself, a group the
+    principal is a member of, or allClusterUsers.
+    """
+
+    role: str = betterproto.string_field(3)
+    """role is the role in the entry that includes the permission."""
+
+    expiration: datetime = betterproto.message_field(4)
+    """expiration is when the entry expires, if it does."""
+
+    expired: bool = betterproto.bool_field(5)
+    """
+    expired is true if the entry has expired, so it no longer grants the
+    permission.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class PermissionExplanation(betterproto.Message):
+    permission: "Permission" = betterproto.enum_field(1)
+    granted: bool = betterproto.bool_field(2)
+    """granted is true if the principal has the permission."""
+
+    grants: List["RoleGrant"] = betterproto.message_field(3)
+    """grants are the role binding entries that include the permission."""
+
+    roles: List[str] = betterproto.string_field(4)
+    """
+    roles are the roles that include the permission.  Binding any of them to
+    the principal on the resource, or on a resource it inherits from, would
+    grant the permission.
+    """
+
+    denied_by_scope: bool = betterproto.bool_field(5)
+    """
+    denied_by_scope is true if the role bindings grant the permission, but
+    the scope of the caller's token doesn't allow it.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class ExplainAuthorizationResponse(betterproto.Message):
+    principal: str = betterproto.string_field(1)
+    """principal is the principal that was explained."""
+
+    groups: List[str] = betterproto.string_field(2)
+    """groups are the groups the principal is a member of."""
+
+    permissions: List["PermissionExplanation"] = betterproto.message_field(3)
+
+
 @dataclass(eq=False, repr=False)
 class ModifyRoleBindingRequest(betterproto.Message):
     resource: "Resource" = betterproto.message_field(1)
@@ -405,6 +673,12 @@ class ModifyRoleBindingRequest(betterproto.Message):
     bindings
     """
 
+    expiration: datetime = betterproto.message_field(4)
+    """
+    expiration, if set, is when roles stop applying to principal.  It must be
+    in the future.
+    """
+
 
 @dataclass(eq=False, repr=False)
 class ModifyRoleBindingResponse(betterproto.Message):
@@ -492,6 +766,9 @@ class GetRobotTokenRequest(betterproto.Message):
     in seconds
     """
 
+    scope: "TokenScope" = betterproto.message_field(3)
+    """scope, if set, narrows what the returned token may be used for."""
+
 
 @dataclass(eq=False, repr=False)
 class GetRobotTokenResponse(betterproto.Message):
@@ -660,6 +937,36 @@ class ApiStub:
             request_serializer=GetRolesForPermissionRequest.SerializeToString,
             response_deserializer=GetRolesForPermissionResponse.FromString,
         )
+        self.__rpc_explain_authorization = channel.unary_unary(
+            "/auth_v2.API/ExplainAuthorization",
+            request_serializer=ExplainAuthorizationRequest.SerializeToString,
+            response_deserializer=ExplainAuthorizationResponse.FromString,
+        )
+        self.__rpc_create_role = channel.unary_unary(
+            "/auth_v2.API/CreateRole",
+            request_serializer=CreateRoleRequest.SerializeToString,
+            response_deserializer=CreateRoleResponse.FromString,
+        )
+        self.__rpc_update_role = channel.unary_unary(
+            "/auth_v2.API/UpdateRole",
+            request_serializer=UpdateRoleRequest.SerializeToString,
+            response_deserializer=UpdateRoleResponse.FromString,
+        )
+        self.__rpc_delete_role = channel.unary_unary(
+            "/auth_v2.API/DeleteRole",
+            request_serializer=DeleteRoleRequest.SerializeToString,
+            response_deserializer=DeleteRoleResponse.FromString,
+        )
+        self.__rpc_list_roles = channel.unary_unary(
+            "/auth_v2.API/ListRoles",
+            request_serializer=ListRolesRequest.SerializeToString,
+            response_deserializer=ListRolesResponse.FromString,
+        )
+        self.__rpc_list_audit_events = channel.unary_stream(
+            "/auth_v2.API/ListAuditEvents",
+            request_serializer=ListAuditEventsRequest.SerializeToString,
+            response_deserializer=AuditEvent.FromString,
+        )
         self.__rpc_modify_role_binding = channel.unary_unary(
             "/auth_v2.API/ModifyRoleBinding",
             request_serializer=ModifyRoleBindingRequest.SerializeToString,
@@ -766,12 +1073,18 @@ class ApiStub:
         return self.__rpc_set_configuration(request)
 
     def authenticate(
-        self, *, oidc_state: str = "", id_token: str = ""
+        self,
+        *,
+        oidc_state: str = "",
+        id_token: str = "",
+        scope: "TokenScope" = None
     ) -> "AuthenticateResponse":
 
         request = AuthenticateRequest()
         request.oidc_state = oidc_state
         request.id_token = id_token
+        if scope is not None:
+            request.scope = scope
 
         return self.__rpc_authenticate(request)
 
@@ -826,12 +1139,83 @@ class ApiStub:
 
         return self.__rpc_get_roles_for_permission(request)
 
+    def explain_authorization(
+        self,
+        *,
+        resource: "Resource" = None,
+        principal: str = "",
+        permissions: Optional[List["Permission"]] = None
+    ) -> "ExplainAuthorizationResponse":
+        permissions = permissions or []
+
+        request = ExplainAuthorizationRequest()
+        if resource is not None:
+            request.resource = resource
+        request.principal = principal
+        request.permissions = permissions
+
+        return self.__rpc_explain_authorization(request)
+
+    def create_role(self, *, role: "Role" = None) -> "CreateRoleResponse":
+
+        request = CreateRoleRequest()
+        if role is not None:
+            request.role = role
+
+        return self.__rpc_create_role(request)
+
+    def update_role(self, *, role: "Role" = None) -> "UpdateRoleResponse":
+
+        request = UpdateRoleRequest()
+        if role is not None:
+            request.role = role
+
+        return self.__rpc_update_role(request)
+
+    def delete_role(self, *, name: str = "") -> "DeleteRoleResponse":
+
+        request = DeleteRoleRequest()
+        request.name = name
+
+        return self.__rpc_delete_role(request)
+
+    def list_roles(self) -> "ListRolesResponse":
+
+        request = ListRolesRequest()
+
+        return self.__rpc_list_roles(request)
+
+    def list_audit_events(
+        self,
+        *,
+        since: datetime = None,
+        until: datetime = None,
+        principal: str = "",
+        method: str = "",
+        resource: str = "",
+        limit: int = 0
+    ) -> Iterator["AuditEvent"]:
+
+        request = ListAuditEventsRequest()
+        if since is not None:
+            request.since = since
+        if until is not None:
+            request.until = until
+        request.principal = principal
+        request.method = method
+        request.resource = resource
+        request.limit = limit
+
+        for response in self.__rpc_list_audit_events(request):
+            yield response
+
     def modify_role_binding(
         self,
         *,
         resource: "Resource" = None,
         principal: str = "",
-        roles: Optional[List[str]] = None
+        roles: Optional[List[str]] = None,
+        expiration: datetime = None
     ) -> "ModifyRoleBindingResponse":
         roles = roles or []
 
@@ -840,6 +1224,8 @@ class ApiStub:
             request.resource = resource
         request.principal = principal
         request.roles = roles
+        if expiration is not None:
+            request.expiration = expiration
 
         return self.__rpc_modify_role_binding(request)
 
@@ -860,12 +1246,14 @@ class ApiStub:
         return self.__rpc_get_oidc_login(request)
 
     def get_robot_token(
-        self, *, robot: str = "", ttl: int = 0
+        self, *, robot: str = "", ttl: int = 0, scope: "TokenScope" = None
     ) -> "GetRobotTokenResponse":
 
         request = GetRobotTokenRequest()
         request.robot = robot
         request.ttl = ttl
+        if scope is not None:
+            request.scope = scope
 
         return self.__rpc_get_robot_token(request)
 
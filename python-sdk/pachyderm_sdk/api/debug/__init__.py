@@ -175,6 +175,47 @@ class Starlark(betterproto.Message):
     """
 
 
+@dataclass(eq=False, repr=False)
+class RedactionPattern(betterproto.Message):
+    """
+    RedactionPattern is a regular expression whose matches are redacted from a
+    debug dump.
+    """
+
+    name: str = betterproto.string_field(1)
+    """
+    The name of the pattern; it replaces the text the pattern redacts, and
+    names the pattern in the redaction manifest.
+    """
+
+    regex: str = betterproto.string_field(2)
+    """
+    An RE2 regular expression.  If it has capture groups, only the text they
+    match is redacted; otherwise the whole match is.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class Redaction(betterproto.Message):
+    """Redaction controls the redaction of secrets from a debug dump."""
+
+    disable_builtin: bool = betterproto.bool_field(1)
+    """
+    If true, don't run the built-in detectors for credentials, URLs with
+    passwords, JWTs and private keys.
+    """
+
+    patterns: List["RedactionPattern"] = betterproto.message_field(2)
+    """Additional patterns to redact."""
+
+    fields: List[str] = betterproto.string_field(3)
+    """
+    Fields of JSON files whose values are always redacted, as dot-separated
+    paths of keys, e.g. "transform.env".  A path matches every field whose path
+    ends with it; "*" matches any key.
+    """
+
+
 @dataclass(eq=False, repr=False)
 class DumpV2Request(betterproto.Message):
     system: "System" = betterproto.message_field(1)
@@ -197,6 +238,12 @@ class DumpV2Request(betterproto.Message):
     starlark_scripts: List["Starlark"] = betterproto.message_field(6)
     """A list of Starlark scripts to run."""
 
+    redaction: "Redaction" = betterproto.message_field(7)
+    """
+    If set, redact secrets from the debug dump, and list what was redacted in
+    redactions.json.
+    """
+
 
 @dataclass(eq=False, repr=False)
 class DumpV2RequestDefaults(betterproto.Message):
@@ -373,7 +420,8 @@ class DebugStub:
         input_repos: bool = False,
         timeout: timedelta = None,
         defaults: "DumpV2RequestDefaults" = None,
-        starlark_scripts: Optional[List["Starlark"]] = None
+        starlark_scripts: Optional[List["Starlark"]] = None,
+        redaction: "Redaction" = None
     ) -> Iterator["DumpChunk"]:
         pipelines = pipelines or []
         starlark_scripts = starlark_scripts or []
@@ -390,6 +438,8 @@ class DebugStub:
             request.defaults = defaults
         if starlark_scripts is not None:
             request.starlark_scripts = starlark_scripts
+        if redaction is not None:
+            request.redaction = redaction
 
         for response in self.__rpc_dump_v2(request):
             yield response
@@ -275,6 +275,76 @@ class LogMessage(betterproto.Message):
     """For code that wants to filter on pipeline/job/etc"""
 
 
+@dataclass(eq=False, repr=False)
+class LogRule(betterproto.Message):
+    """
+    A LogRule is evaluated continuously against the logs of a pipeline, or of
+    every pipeline in a project.  Each matching line increments the
+    pachyderm_log_rule_matches_total counter, labeled with the rule's name and
+    the line's project and pipeline.
+    """
+
+    name: str = betterproto.string_field(1)
+    """The name of the rule."""
+
+    project: str = betterproto.string_field(2)
+    """The project whose logs are matched."""
+
+    pipeline: str = betterproto.string_field(3)
+    """
+    The pipeline whose logs are matched.  If empty, every pipeline in the
+    project is matched.
+    """
+
+    pattern: str = betterproto.string_field(4)
+    """
+    Lines that match this regular expression match the rule.  If it has a
+    capture group, the first group of each matching line is parsed as a number
+    and exported as the pachyderm_log_rule_value gauge.
+    """
+
+    level: "LogLevel" = betterproto.enum_field(5)
+    """Only lines of this level or greater match the rule.  If unset, INFO."""
+
+    alert: bool = betterproto.bool_field(6)
+    """
+    If true, matching lines are sent to the webhooks that accept LOG events, at
+    most once a minute per rule.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class CreateLogRuleRequest(betterproto.Message):
+    rule: "LogRule" = betterproto.message_field(1)
+    update: bool = betterproto.bool_field(2)
+    """Update replaces an existing rule with the same name."""
+
+
+@dataclass(eq=False, repr=False)
+class CreateLogRuleResponse(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class ListLogRuleRequest(betterproto.Message):
+    pass
+
+
+@dataclass(eq=False, repr=False)
+class ListLogRuleResponse(betterproto.Message):
+    rule: "LogRule" = betterproto.message_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class DeleteLogRuleRequest(betterproto.Message):
+    name: str = betterproto.string_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class DeleteLogRuleResponse(betterproto.Message):
+    pass
+
+
 class ApiStub:
 
     def __init__(self, channel: "grpc.Channel"):
@@ -283,6 +353,21 @@ class ApiStub:
             request_serializer=GetLogsRequest.SerializeToString,
             response_deserializer=GetLogsResponse.FromString,
         )
+        self.__rpc_create_log_rule = channel.unary_unary(
+            "/logs.API/CreateLogRule",
+            request_serializer=CreateLogRuleRequest.SerializeToString,
+            response_deserializer=CreateLogRuleResponse.FromString,
+        )
+        self.__rpc_list_log_rule = channel.unary_stream(
+            "/logs.API/ListLogRule",
+            request_serializer=ListLogRuleRequest.SerializeToString,
+            response_deserializer=ListLogRuleResponse.FromString,
+        )
+        self.__rpc_delete_log_rule = channel.unary_unary(
+            "/logs.API/DeleteLogRule",
+            request_serializer=DeleteLogRuleRequest.SerializeToString,
+            response_deserializer=DeleteLogRuleResponse.FromString,
+        )
 
     def get_logs(
         self,
@@ -303,3 +388,28 @@ class ApiStub:
 
         for response in self.__rpc_get_logs(request):
             yield response
+
+    def create_log_rule(
+        self, *, rule: "LogRule" = None, update: bool = False
+    ) -> "CreateLogRuleResponse":
+
+        request = CreateLogRuleRequest()
+        if rule is not None:
+            request.rule = rule
+        request.update = update
+
+        return self.__rpc_create_log_rule(request)
+
+    def list_log_rule(self) -> Iterator["ListLogRuleResponse"]:
+
+        request = ListLogRuleRequest()
+
+        for response in self.__rpc_list_log_rule(request):
+            yield response
+
+    def delete_log_rule(self, *, name: str = "") -> "DeleteLogRuleResponse":
+
+        request = DeleteLogRuleRequest()
+        request.name = name
+
+        return self.__rpc_delete_log_rule(request)
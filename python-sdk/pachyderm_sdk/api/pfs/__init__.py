@@ -740,12 +740,23 @@ class InspectProjectRequest(betterproto.Message):
 @dataclass(eq=False, repr=False)
 class InspectProjectV2Request(betterproto.Message):
     project: "Project" = betterproto.message_field(1)
+    include_quota: bool = betterproto.bool_field(2)
+    """
+    If set, quota_json is filled in.  Computing a project's quota usage is
+    comparatively expensive.
+    """
 
 
 @dataclass(eq=False, repr=False)
 class InspectProjectV2Response(betterproto.Message):
     info: "ProjectInfo" = betterproto.message_field(1)
     defaults_json: str = betterproto.string_field(2)
+    quota_json: str = betterproto.string_field(3)
+    """
+    A JSON-encoded pps.GetProjectQuotaResponse: the project's quota, if any,
+    and its current usage.  Only set if include_quota was set in the request
+    and the quota could be read.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -804,6 +815,11 @@ class GetFileRequest(betterproto.Message):
     url: str = betterproto.string_field(2)
     offset: int = betterproto.int64_field(3)
     path_range: "PathRange" = betterproto.message_field(4)
+    size_bytes: int = betterproto.int64_field(5)
+    """
+    size_bytes limits how much of the file GetFile returns, starting at offset;
+    0 means the rest of the file.  GetFileTAR ignores it.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -1060,6 +1076,60 @@ class ReposSummaryResponse(betterproto.Message):
     summaries: List["ReposSummary"] = betterproto.message_field(1)
 
 
+@dataclass(eq=False, repr=False)
+class StorageUsage(betterproto.Message):
+    """
+    StorageUsage is the object storage used by a project, repo, branch, or
+    commit, counting each chunk once no matter how many files or commits refer
+    to it.
+    """
+
+    total_bytes: int = betterproto.int64_field(1)
+    """total_bytes is the size of the distinct chunks that are referred to."""
+
+    unique_bytes: int = betterproto.int64_field(2)
+    """
+    unique_bytes is the size of the chunks that nothing else of the same kind
+    refers to: no other project, repo, branch, or commit respectively.  It's
+    roughly what deleting the project, repo, branch, or commit would free.
+    """
+
+    shared_bytes: int = betterproto.int64_field(3)
+    """shared_bytes is total_bytes - unique_bytes."""
+
+
+@dataclass(eq=False, repr=False)
+class StorageUsageRequest(betterproto.Message):
+    repo: "Repo" = betterproto.message_field(1)
+    """If repo is set, the usage of the repo and of its branches is returned."""
+
+    commits: bool = betterproto.bool_field(2)
+    """
+    If commits is set along with repo, the usage of each of the repo's commits
+    is returned as well.
+    """
+
+    projects: List["ProjectPicker"] = betterproto.message_field(3)
+    """
+    If repo isn't set, the usage of these projects and of their repos is
+    returned; if neither is set, every project's.
+    """
+
+
+@dataclass(eq=False, repr=False)
+class StorageUsageResponse(betterproto.Message):
+    project: "Project" = betterproto.message_field(1, group="entity")
+    repo: "Repo" = betterproto.message_field(2, group="entity")
+    branch: "Branch" = betterproto.message_field(3, group="entity")
+    commit: "Commit" = betterproto.message_field(4, group="entity")
+    usage: "StorageUsage" = betterproto.message_field(5)
+    computed_at: datetime = betterproto.message_field(6)
+    """
+    computed_at is when the usage was last computed; usage is accounted
+    periodically in the background.
+    """
+
+
 @dataclass(eq=False, repr=False)
 class ForgetCommitRequest(betterproto.Message):
     commit: "CommitPicker" = betterproto.message_field(1)
@@ -1070,6 +1140,90 @@ class ForgetCommitResponse(betterproto.Message):
     pass
 
 
+@dataclass(eq=False, repr=False)
+class ShareLinkInfo(betterproto.Message):
+    """
+    ShareLinkInfo describes a share link: a URL that anyone can use to download
+    a file, or an archive of a directory or commit, without a Pachyderm account.
+    """
+
+    file: "File" = betterproto.message_field(1)
+    """
+    file is the shared file or directory.  The commit is always given by ID, so
+    the link always downloads the same data; a path of "/" shares the whole
+    commit.
+    """
+
+    file_type: "FileType" = betterproto.enum_field(2)
+    size_bytes: int = betterproto.int64_field(3)
+    issuer: str = betterproto.string_field(4)
+    """
+    issuer is the user that created the link.  The link can read the file
+    because the issuer could when it was created.
+    """
+
+    created: datetime = betterproto.message_field(5)
+    expires: datetime = betterproto.message_field(6)
+    max_downloads: int = betterproto.int64_field(7)
+    """max_downloads is how many times the link can be used; 0 means no limit."""
+
+    downloads: int = betterproto.int64_field(8)
+
+
+@dataclass(eq=False, repr=False)
+class CreateShareLinkRequest(betterproto.Message):
+    file: "File" = betterproto.message_field(1)
+    """
+    file is the file or directory to share.  If its commit is given by branch,
+    the branch's head is shared; the commit must be finished.
+    """
+
+    ttl: timedelta = betterproto.message_field(2)
+    """ttl is how long the link works for."""
+
+    max_downloads: int = betterproto.int64_field(3)
+    """max_downloads limits the number of downloads; 0 means no limit."""
+
+
+@dataclass(eq=False, repr=False)
+class CreateShareLinkResponse(betterproto.Message):
+    token: str = betterproto.string_field(1)
+    """
+    token is the secret part of the link.  It is not stored, so this is the
+    only time it's available.
+    """
+
+    path: str = betterproto.string_field(2)
+    """
+    path is the path and query of the link on Pachyderm's HTTP server: a /pfs/
+    URL for a file, or an /archive/ URL (in ZIP format; change the extension
+    for another format) for a directory.
+    """
+
+    info: "ShareLinkInfo" = betterproto.message_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class InspectShareLinkRequest(betterproto.Message):
+    token: str = betterproto.string_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class GetShareLinkRequest(betterproto.Message):
+    token: str = betterproto.string_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class RevokeShareLinkRequest(betterproto.Message):
+    token: str = betterproto.string_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class RevokeShareLinkResponse(betterproto.Message):
+    info: "ShareLinkInfo" = betterproto.message_field(1)
+    """info describes the link that was revoked."""
+
+
 class ApiStub:
 
     def __init__(self, channel: "grpc.Channel"):
@@ -1348,11 +1502,36 @@ class ApiStub:
             request_serializer=ReposSummaryRequest.SerializeToString,
             response_deserializer=ReposSummaryResponse.FromString,
         )
+        self.__rpc_storage_usage = channel.unary_stream(
+            "/pfs_v2.API/StorageUsage",
+            request_serializer=StorageUsageRequest.SerializeToString,
+            response_deserializer=StorageUsageResponse.FromString,
+        )
         self.__rpc_forget_commit = channel.unary_unary(
             "/pfs_v2.API/ForgetCommit",
             request_serializer=ForgetCommitRequest.SerializeToString,
             response_deserializer=ForgetCommitResponse.FromString,
         )
+        self.__rpc_create_share_link = channel.unary_unary(
+            "/pfs_v2.API/CreateShareLink",
+            request_serializer=CreateShareLinkRequest.SerializeToString,
+            response_deserializer=CreateShareLinkResponse.FromString,
+        )
+        self.__rpc_inspect_share_link = channel.unary_unary(
+            "/pfs_v2.API/InspectShareLink",
+            request_serializer=InspectShareLinkRequest.SerializeToString,
+            response_deserializer=ShareLinkInfo.FromString,
+        )
+        self.__rpc_get_share_link = channel.unary_stream(
+            "/pfs_v2.API/GetShareLink",
+            request_serializer=GetShareLinkRequest.SerializeToString,
+            response_deserializer=betterproto_lib_google_protobuf.BytesValue.FromString,
+        )
+        self.__rpc_revoke_share_link = channel.unary_unary(
+            "/pfs_v2.API/RevokeShareLink",
+            request_serializer=RevokeShareLinkRequest.SerializeToString,
+            response_deserializer=RevokeShareLinkResponse.FromString,
+        )
 
     def create_repo(
         self, *, repo: "Repo" = None, description: str = "", update: bool = False
@@ -1751,7 +1930,8 @@ class ApiStub:
         file: "File" = None,
         url: str = "",
         offset: int = 0,
-        path_range: "PathRange" = None
+        path_range: "PathRange" = None,
+        size_bytes: int = 0
     ) -> Iterator["betterproto_lib_google_protobuf.BytesValue"]:
 
         request = GetFileRequest()
@@ -1761,6 +1941,7 @@ class ApiStub:
         request.offset = offset
         if path_range is not None:
             request.path_range = path_range
+        request.size_bytes = size_bytes
 
         for response in self.__rpc_get_file(request):
             yield response
@@ -1771,7 +1952,8 @@ class ApiStub:
         file: "File" = None,
         url: str = "",
         offset: int = 0,
-        path_range: "PathRange" = None
+        path_range: "PathRange" = None,
+        size_bytes: int = 0
     ) -> Iterator["betterproto_lib_google_protobuf.BytesValue"]:
 
         request = GetFileRequest()
@@ -1781,6 +1963,7 @@ class ApiStub:
         request.offset = offset
         if path_range is not None:
             request.path_range = path_range
+        request.size_bytes = size_bytes
 
         for response in self.__rpc_get_file_tar(request):
             yield response
@@ -2060,12 +2243,13 @@ class ApiStub:
         return self.__rpc_inspect_project(request)
 
     def inspect_project_v2(
-        self, *, project: "Project" = None
+        self, *, project: "Project" = None, include_quota: bool = False
     ) -> "InspectProjectV2Response":
 
         request = InspectProjectV2Request()
         if project is not None:
             request.project = project
+        request.include_quota = include_quota
 
         return self.__rpc_inspect_project_v2(request)
 
@@ -2098,6 +2282,25 @@ class ApiStub:
 
         return self.__rpc_repos_summary(request)
 
+    def storage_usage(
+        self,
+        *,
+        repo: "Repo" = None,
+        commits: bool = False,
+        projects: Optional[List["ProjectPicker"]] = None
+    ) -> Iterator["StorageUsageResponse"]:
+        projects = projects or []
+
+        request = StorageUsageRequest()
+        if repo is not None:
+            request.repo = repo
+        request.commits = commits
+        if projects is not None:
+            request.projects = projects
+
+        for response in self.__rpc_storage_usage(request):
+            yield response
+
     def forget_commit(self, *, commit: "CommitPicker" = None) -> "ForgetCommitResponse":
 
         request = ForgetCommitRequest()
@@ -2105,3 +2308,44 @@ class ApiStub:
             request.commit = commit
 
         return self.__rpc_forget_commit(request)
+
+    def create_share_link(
+        self,
+        *,
+        file: "File" = None,
+        ttl: timedelta = None,
+        max_downloads: int = 0
+    ) -> "CreateShareLinkResponse":
+
+        request = CreateShareLinkRequest()
+        if file is not None:
+            request.file = file
+        if ttl is not None:
+            request.ttl = ttl
+        request.max_downloads = max_downloads
+
+        return self.__rpc_create_share_link(request)
+
+    def inspect_share_link(self, *, token: str = "") -> "ShareLinkInfo":
+
+        request = InspectShareLinkRequest()
+        request.token = token
+
+        return self.__rpc_inspect_share_link(request)
+
+    def get_share_link(
+        self, *, token: str = ""
+    ) -> Iterator["betterproto_lib_google_protobuf.BytesValue"]:
+
+        request = GetShareLinkRequest()
+        request.token = token
+
+        for response in self.__rpc_get_share_link(request):
+            yield response
+
+    def revoke_share_link(self, *, token: str = "") -> "RevokeShareLinkResponse":
+
+        request = RevokeShareLinkRequest()
+        request.token = token
+
+        return self.__rpc_revoke_share_link(request)
@@ -176,6 +176,13 @@ class Transform(betterproto.Message):
     dockerfile: str = betterproto.string_field(13)
     memory_volume: bool = betterproto.bool_field(14)
     datum_batching: bool = betterproto.bool_field(15)
+    datum_cache: bool = betterproto.bool_field(16)
+    """
+    datum_cache enables a project-wide cache of datum outputs, keyed by the
+    user image digest, cmd, stdin, env, secret values, and the datum's inputs.
+    Datums whose key matches an earlier successful datum, in any pipeline in
+    the same project, reuse its output instead of running the user code.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -342,6 +349,11 @@ class DatumInfo(betterproto.Message):
     pfs_state: "_pfs__.File" = betterproto.message_field(4)
     data: List["_pfs__.FileInfo"] = betterproto.message_field(5)
     image_id: str = betterproto.string_field(6)
+    logs: "_pfs__.File" = betterproto.message_field(7)
+    """
+    The file in the job's meta commit that holds the stdout and stderr of the
+    datum's user code.  Only set if the pipeline persists datum logs.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -360,15 +372,40 @@ class ProcessStats(betterproto.Message):
     upload_time: timedelta = betterproto.message_field(3)
     download_bytes: int = betterproto.int64_field(4)
     upload_bytes: int = betterproto.int64_field(5)
+    cpu_time: timedelta = betterproto.message_field(6)
+    """CPU time (user + system) consumed by the user code's process tree."""
+
+    peak_memory_bytes: int = betterproto.int64_field(7)
+    """
+    Peak resident memory of the user code's process tree.  When stats are
+    merged (e.g. on a JobInfo), this is the maximum over all datums.
+    """
+
+    read_bytes: int = betterproto.int64_field(8)
+    """
+    Bytes read and written by the user code's process tree via read- and
+    write-like syscalls (rchar/wchar in proc(5)).
+    """
+
+    write_bytes: int = betterproto.int64_field(9)
 
 
 @dataclass(eq=False, repr=False)
 class AggregateProcessStats(betterproto.Message):
+    """
+    AggregateProcessStats describes the distribution of ProcessStats over the
+    datums of a job.  Time aggregates are in seconds.
+    """
+
     download_time: "Aggregate" = betterproto.message_field(1)
     process_time: "Aggregate" = betterproto.message_field(2)
     upload_time: "Aggregate" = betterproto.message_field(3)
     download_bytes: "Aggregate" = betterproto.message_field(4)
     upload_bytes: "Aggregate" = betterproto.message_field(5)
+    cpu_time: "Aggregate" = betterproto.message_field(6)
+    peak_memory_bytes: "Aggregate" = betterproto.message_field(7)
+    read_bytes: "Aggregate" = betterproto.message_field(8)
+    write_bytes: "Aggregate" = betterproto.message_field(9)
 
 
 @dataclass(eq=False, repr=False)
@@ -462,6 +499,11 @@ class JobInfo(betterproto.Message):
     finished: datetime = betterproto.message_field(15)
     details: "JobInfoDetails" = betterproto.message_field(16)
     auth_token: str = betterproto.string_field(17)
+    aggregate_stats: "AggregateProcessStats" = betterproto.message_field(18)
+    """
+    Per-datum distribution of the stats above, over the datums processed by
+    this job.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -617,6 +659,7 @@ class PipelineInfoDetails(betterproto.Message):
     determined: "Determined" = betterproto.message_field(36)
     maximum_expected_uptime: timedelta = betterproto.message_field(37)
     workers_started_at: datetime = betterproto.message_field(38)
+    persist_datum_logs: bool = betterproto.bool_field(41)
 
 
 @dataclass(eq=False, repr=False)
@@ -736,6 +779,7 @@ class UpdateJobStateRequest(betterproto.Message):
     data_recovered: int = betterproto.int64_field(9)
     data_total: int = betterproto.int64_field(10)
     stats: "ProcessStats" = betterproto.message_field(11)
+    aggregate_stats: "AggregateProcessStats" = betterproto.message_field(12)
 
 
 @dataclass(eq=False, repr=False)
@@ -994,6 +1038,14 @@ class CreatePipelineRequest(betterproto.Message):
     dry_run: bool = betterproto.bool_field(37)
     determined: "Determined" = betterproto.message_field(38)
     maximum_expected_uptime: timedelta = betterproto.message_field(39)
+    persist_datum_logs: bool = betterproto.bool_field(40)
+    """
+    If true, the stdout and stderr of the user code are stored in the output
+    meta commit alongside each datum's meta file, so that they are versioned
+    with the job's output.  Datums whose output is restored from the datum
+    cache get the logs of the run that produced it, if that run persisted its
+    logs.  Not supported with datum batching.
+    """
 
 
 @dataclass(eq=False, repr=False)
@@ -1363,6 +1415,84 @@ class PipelinesSummary(betterproto.Message):
     unhealthy_pipelines: int = betterproto.int64_field(5)
     """count of pipelines with a failed latest job"""
 
+    quota: "ProjectQuota" = betterproto.message_field(6)
+    """the project's quota, if one is set"""
+
+    quota_usage: "ProjectQuotaUsage" = betterproto.message_field(7)
+    """the project's current usage of its quota"""
+
+
+@dataclass(eq=False, repr=False)
+class ProjectQuota(betterproto.Message):
+    """
+    ProjectQuota limits the resources that the pipelines in a project may use.
+    A zero or empty limit means that the resource is not limited.
+    """
+
+    pipelines: int = betterproto.int64_field(1)
+    """The maximum number of pipelines in the project."""
+
+    workers: int = betterproto.int64_field(2)
+    """The maximum number of workers across all pipelines in the project."""
+
+    cpu: str = betterproto.string_field(3)
+    """
+    The maximum number of CPUs requested by all workers in the project, as a
+    Kubernetes quantity (e.g. "4" or "500m").
+    """
+
+    memory: str = betterproto.string_field(4)
+    """
+    The maximum amount of memory requested by all workers in the project, as
+    a Kubernetes quantity (e.g. "16Gi").
+    """
+
+    gpus: int = betterproto.int64_field(5)
+    """The maximum number of GPUs requested by all workers in the project."""
+
+    concurrent_jobs: int = betterproto.int64_field(6)
+    """The maximum number of jobs that may run at once in the project."""
+
+
+@dataclass(eq=False, repr=False)
+class ProjectQuotaUsage(betterproto.Message):
+    """
+    ProjectQuotaUsage is the amount of each resource limited by a ProjectQuota
+    that a project is currently using.
+    """
+
+    pipelines: int = betterproto.int64_field(1)
+    workers: int = betterproto.int64_field(2)
+    cpu: str = betterproto.string_field(3)
+    memory: str = betterproto.string_field(4)
+    gpus: int = betterproto.int64_field(5)
+    concurrent_jobs: int = betterproto.int64_field(6)
+
+
+@dataclass(eq=False, repr=False)
+class GetProjectQuotaRequest(betterproto.Message):
+    project: "_pfs__.Project" = betterproto.message_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class GetProjectQuotaResponse(betterproto.Message):
+    quota: "ProjectQuota" = betterproto.message_field(1)
+    """The project's quota; unset if the project has no quota."""
+
+    usage: "ProjectQuotaUsage" = betterproto.message_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class SetProjectQuotaRequest(betterproto.Message):
+    project: "_pfs__.Project" = betterproto.message_field(1)
+    quota: "ProjectQuota" = betterproto.message_field(2)
+    """The new quota; an unset quota removes the project's quota."""
+
+
+@dataclass(eq=False, repr=False)
+class SetProjectQuotaResponse(betterproto.Message):
+    pass
+
 
 @dataclass(eq=False, repr=False)
 class PipelinePicker(betterproto.Message):
@@ -1588,6 +1718,16 @@ class ApiStub:
             request_serializer=PipelinesSummaryRequest.SerializeToString,
             response_deserializer=PipelinesSummaryResponse.FromString,
         )
+        self.__rpc_get_project_quota = channel.unary_unary(
+            "/pps_v2.API/GetProjectQuota",
+            request_serializer=GetProjectQuotaRequest.SerializeToString,
+            response_deserializer=GetProjectQuotaResponse.FromString,
+        )
+        self.__rpc_set_project_quota = channel.unary_unary(
+            "/pps_v2.API/SetProjectQuota",
+            request_serializer=SetProjectQuotaRequest.SerializeToString,
+            response_deserializer=SetProjectQuotaResponse.FromString,
+        )
 
     def inspect_job(
         self, *, job: "Job" = None, wait: bool = False, details: bool = False
@@ -1807,7 +1947,8 @@ class ApiStub:
         sidecar_resource_requests: "ResourceSpec" = None,
         dry_run: bool = False,
         determined: "Determined" = None,
-        maximum_expected_uptime: timedelta = None
+        maximum_expected_uptime: timedelta = None,
+        persist_datum_logs: bool = False
     ) -> "betterproto_lib_google_protobuf.Empty":
         tolerations = tolerations or []
 
@@ -1866,6 +2007,7 @@ class ApiStub:
             request.determined = determined
         if maximum_expected_uptime is not None:
             request.maximum_expected_uptime = maximum_expected_uptime
+        request.persist_datum_logs = persist_datum_logs
 
         return self.__rpc_create_pipeline(request)
 
@@ -2268,3 +2410,25 @@ class ApiStub:
             request.projects = projects
 
         return self.__rpc_pipelines_summary(request)
+
+    def get_project_quota(
+        self, *, project: "_pfs__.Project" = None
+    ) -> "GetProjectQuotaResponse":
+
+        request = GetProjectQuotaRequest()
+        if project is not None:
+            request.project = project
+
+        return self.__rpc_get_project_quota(request)
+
+    def set_project_quota(
+        self, *, project: "_pfs__.Project" = None, quota: "ProjectQuota" = None
+    ) -> "SetProjectQuotaResponse":
+
+        request = SetProjectQuotaRequest()
+        if project is not None:
+            request.project = project
+        if quota is not None:
+            request.quota = quota
+
+        return self.__rpc_set_project_quota(request)
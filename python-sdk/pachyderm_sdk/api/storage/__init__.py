@@ -129,6 +129,87 @@ class ReadFilesetCdrResponse(betterproto.Message):
     ref: "_cdr__.Ref" = betterproto.message_field(2)
 
 
+@dataclass(eq=False, repr=False)
+class ContentRange(betterproto.Message):
+    """
+    ContentRange is a range of a file's content.  Ranges are identified by where
+    their content is stored, so files that share content share ranges.
+    """
+
+    id: bytes = betterproto.bytes_field(1)
+    """
+    id identifies the range's content: ranges with the same id have the same
+    content.
+    """
+
+    size_bytes: int = betterproto.int64_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class ReadFilesetRangesResponse(betterproto.Message):
+    path: str = betterproto.string_field(1)
+    ranges: List["ContentRange"] = betterproto.message_field(2)
+    """ranges are the ranges that make up the file's content, in order."""
+
+
+@dataclass(eq=False, repr=False)
+class FileRefs(betterproto.Message):
+    """
+    FileRefs describes where a file's content is stored, rather than the
+    content itself.  It is used to copy filesets between clusters, sending only
+    the chunks that the destination doesn't already have.
+    """
+
+    path: str = betterproto.string_field(1)
+    datum: str = betterproto.string_field(2)
+    data_refs: List[bytes] = betterproto.bytes_field(3)
+    """
+    data_refs are the file's serialized data references, in order.  They are
+    opaque outside of the storage layer.
+    """
+
+    chunk_ids: List[bytes] = betterproto.bytes_field(4)
+    """chunk_ids are the IDs of the chunks that data_refs refer to."""
+
+
+@dataclass(eq=False, repr=False)
+class FindMissingChunksRequest(betterproto.Message):
+    chunk_ids: List[bytes] = betterproto.bytes_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class FindMissingChunksResponse(betterproto.Message):
+    chunk_ids: List[bytes] = betterproto.bytes_field(1)
+    """chunk_ids are the requested chunks that are not in storage."""
+
+
+@dataclass(eq=False, repr=False)
+class ReadChunkRequest(betterproto.Message):
+    chunk_id: bytes = betterproto.bytes_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class ReadChunkResponse(betterproto.Message):
+    """
+    A ReadChunkResponse is part of a chunk, as it is stored: compressed and
+    encrypted.  Chunks may be spread across multiple messages.
+    """
+
+    data: bytes = betterproto.bytes_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class WriteChunkRequest(betterproto.Message):
+    """A WriteChunkRequest is part of a chunk, as returned by ReadChunk."""
+
+    data: bytes = betterproto.bytes_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class WriteChunkResponse(betterproto.Message):
+    chunk_id: bytes = betterproto.bytes_field(1)
+
+
 @dataclass(eq=False, repr=False)
 class RenewFilesetRequest(betterproto.Message):
     fileset_id: str = betterproto.string_field(1)
@@ -198,6 +279,36 @@ class FilesetStub:
             request_serializer=ReadFilesetRequest.SerializeToString,
             response_deserializer=ReadFilesetCdrResponse.FromString,
         )
+        self.__rpc_read_fileset_ranges = channel.unary_stream(
+            "/storage.Fileset/ReadFilesetRanges",
+            request_serializer=ReadFilesetRequest.SerializeToString,
+            response_deserializer=ReadFilesetRangesResponse.FromString,
+        )
+        self.__rpc_read_fileset_refs = channel.unary_stream(
+            "/storage.Fileset/ReadFilesetRefs",
+            request_serializer=ReadFilesetRequest.SerializeToString,
+            response_deserializer=FileRefs.FromString,
+        )
+        self.__rpc_find_missing_chunks = channel.unary_unary(
+            "/storage.Fileset/FindMissingChunks",
+            request_serializer=FindMissingChunksRequest.SerializeToString,
+            response_deserializer=FindMissingChunksResponse.FromString,
+        )
+        self.__rpc_read_chunk = channel.unary_stream(
+            "/storage.Fileset/ReadChunk",
+            request_serializer=ReadChunkRequest.SerializeToString,
+            response_deserializer=ReadChunkResponse.FromString,
+        )
+        self.__rpc_write_chunk = channel.stream_unary(
+            "/storage.Fileset/WriteChunk",
+            request_serializer=WriteChunkRequest.SerializeToString,
+            response_deserializer=WriteChunkResponse.FromString,
+        )
+        self.__rpc_create_fileset_from_refs = channel.stream_unary(
+            "/storage.Fileset/CreateFilesetFromRefs",
+            request_serializer=FileRefs.SerializeToString,
+            response_deserializer=CreateFilesetResponse.FromString,
+        )
         self.__rpc_renew_fileset = channel.unary_unary(
             "/storage.Fileset/RenewFileset",
             request_serializer=RenewFilesetRequest.SerializeToString,
@@ -259,6 +370,76 @@ class FilesetStub:
         for response in self.__rpc_read_fileset_cdr(request):
             yield response
 
+    def read_fileset_ranges(
+        self,
+        *,
+        fileset_id: str = "",
+        filters: Optional[List["FileFilter"]] = None,
+        empty_files: bool = False
+    ) -> Iterator["ReadFilesetRangesResponse"]:
+        filters = filters or []
+
+        request = ReadFilesetRequest()
+        request.fileset_id = fileset_id
+        if filters is not None:
+            request.filters = filters
+        request.empty_files = empty_files
+
+        for response in self.__rpc_read_fileset_ranges(request):
+            yield response
+
+    def read_fileset_refs(
+        self,
+        *,
+        fileset_id: str = "",
+        filters: Optional[List["FileFilter"]] = None,
+        empty_files: bool = False
+    ) -> Iterator["FileRefs"]:
+        filters = filters or []
+
+        request = ReadFilesetRequest()
+        request.fileset_id = fileset_id
+        if filters is not None:
+            request.filters = filters
+        request.empty_files = empty_files
+
+        for response in self.__rpc_read_fileset_refs(request):
+            yield response
+
+    def find_missing_chunks(
+        self, *, chunk_ids: Optional[List[bytes]] = None
+    ) -> "FindMissingChunksResponse":
+        chunk_ids = chunk_ids or []
+
+        request = FindMissingChunksRequest()
+        request.chunk_ids = chunk_ids
+
+        return self.__rpc_find_missing_chunks(request)
+
+    def read_chunk(self, *, chunk_id: bytes = b"") -> Iterator["ReadChunkResponse"]:
+
+        request = ReadChunkRequest()
+        request.chunk_id = chunk_id
+
+        for response in self.__rpc_read_chunk(request):
+            yield response
+
+    def write_chunk(
+        self,
+        request_iterator: Union[
+            AsyncIterable["WriteChunkRequest"], Iterable["WriteChunkRequest"]
+        ],
+    ) -> "WriteChunkResponse":
+
+        return self.__rpc_write_chunk(request_iterator)
+
+    def create_fileset_from_refs(
+        self,
+        request_iterator: Union[AsyncIterable["FileRefs"], Iterable["FileRefs"]],
+    ) -> "CreateFilesetResponse":
+
+        return self.__rpc_create_fileset_from_refs(request_iterator)
+
     def renew_fileset(
         self, *, fileset_id: str = "", ttl_seconds: int = 0
     ) -> "betterproto_lib_google_protobuf.Empty":
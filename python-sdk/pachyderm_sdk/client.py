@@ -22,6 +22,7 @@ from .api.snapshot import ApiStub as _SnapshotStub
 from .api.storage.extension import ApiStub as _StorageStub
 from .api.transaction.extension import ApiStub as _TransactionStub
 from .api.version import ApiStub as _VersionStub, Version
+from .api.webhook import ApiStub as _WebhookStub
 from .api.worker.extension import WorkerStub as _WorkerStub
 from .config import ConfigFile
 from .constants import (
@@ -140,6 +141,7 @@ class Client:
             set_transaction_id=lambda value: setattr(self, "transaction_id", value),
         )
         self._version_api = _VersionStub(self._channel)
+        self.webhook = _WebhookStub(self._channel)
         self._worker: Optional[_WorkerStub]
 
     @classmethod
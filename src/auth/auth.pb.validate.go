@@ -1285,6 +1285,35 @@ func (m *TokenInfo) validate(all bool) error {
 
 	// no validation rules for HashedToken
 
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenInfoValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenInfoValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TokenInfoMultiError(errors)
 	}
@@ -1362,6 +1391,141 @@ var _ interface {
 	ErrorName() string
 } = TokenInfoValidationError{}
 
+// Validate checks the field values on TokenScope with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenScope) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenScope with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenScopeMultiError, or
+// nil if none found.
+func (m *TokenScope) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenScope) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenScopeValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenScopeValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenScopeValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReadOnly
+
+	if len(errors) > 0 {
+		return TokenScopeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenScopeMultiError is an error wrapping multiple validation errors
+// returned by TokenScope.ValidateAll() if the designated constraints aren't met.
+type TokenScopeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenScopeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenScopeMultiError) AllErrors() []error { return m }
+
+// TokenScopeValidationError is the validation error returned by
+// TokenScope.Validate if the designated constraints aren't met.
+type TokenScopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenScopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenScopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenScopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenScopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenScopeValidationError) ErrorName() string { return "TokenScopeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenScopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenScope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenScopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenScopeValidationError{}
+
 // Validate checks the field values on AuthenticateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1388,6 +1552,35 @@ func (m *AuthenticateRequest) validate(all bool) error {
 
 	// no validation rules for IdToken
 
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthenticateRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthenticateRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthenticateRequestValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AuthenticateRequestMultiError(errors)
 	}
@@ -1725,6 +1918,35 @@ func (m *WhoAmIResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WhoAmIResponseValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WhoAmIResponseValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WhoAmIResponseValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return WhoAmIResponseMultiError(errors)
 	}
@@ -2045,42 +2267,82 @@ var _ interface {
 	ErrorName() string
 } = GetRolesForPermissionResponseValidationError{}
 
-// Validate checks the field values on Roles with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Roles) Validate() error {
+// Validate checks the field values on CreateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Roles with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RolesMultiError, or nil if none found.
-func (m *Roles) ValidateAll() error {
+// ValidateAll checks the field values on CreateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleRequestMultiError, or nil if none found.
+func (m *CreateRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Roles) validate(all bool) error {
+func (m *CreateRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Roles
+	if m.GetRole() == nil {
+		err := CreateRoleRequestValidationError{
+			field:  "Role",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleRequestValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RolesMultiError(errors)
+		return CreateRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RolesMultiError is an error wrapping multiple validation errors returned by
-// Roles.ValidateAll() if the designated constraints aren't met.
-type RolesMultiError []error
+// CreateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RolesMultiError) Error() string {
+func (m CreateRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2089,11 +2351,11 @@ func (m RolesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RolesMultiError) AllErrors() []error { return m }
+func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
 
-// RolesValidationError is the validation error returned by Roles.Validate if
-// the designated constraints aren't met.
-type RolesValidationError struct {
+// CreateRoleRequestValidationError is the validation error returned by
+// CreateRoleRequest.Validate if the designated constraints aren't met.
+type CreateRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2101,22 +2363,24 @@ type RolesValidationError struct {
 }
 
 // Field function returns field value.
-func (e RolesValidationError) Field() string { return e.field }
+func (e CreateRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RolesValidationError) Reason() string { return e.reason }
+func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RolesValidationError) Cause() error { return e.cause }
+func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RolesValidationError) Key() bool { return e.key }
+func (e CreateRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RolesValidationError) ErrorName() string { return "RolesValidationError" }
+func (e CreateRoleRequestValidationError) ErrorName() string {
+	return "CreateRoleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RolesValidationError) Error() string {
+func (e CreateRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2128,14 +2392,14 @@ func (e RolesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoles.%s: %s%s",
+		"invalid %sCreateRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RolesValidationError{}
+var _ error = CreateRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2143,89 +2407,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RolesValidationError{}
+} = CreateRoleRequestValidationError{}
 
-// Validate checks the field values on RoleBinding with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RoleBinding) Validate() error {
+// Validate checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RoleBinding with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RoleBindingMultiError, or
-// nil if none found.
-func (m *RoleBinding) ValidateAll() error {
+// ValidateAll checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleResponseMultiError, or nil if none found.
+func (m *CreateRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RoleBinding) validate(all bool) error {
+func (m *CreateRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetEntries()))
-		i := 0
-		for key := range m.GetEntries() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetEntries()[key]
-			_ = val
-
-			// no validation rules for Entries[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, RoleBindingValidationError{
-							field:  fmt.Sprintf("Entries[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, RoleBindingValidationError{
-							field:  fmt.Sprintf("Entries[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return RoleBindingValidationError{
-						field:  fmt.Sprintf("Entries[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return RoleBindingMultiError(errors)
+		return CreateRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleBindingMultiError is an error wrapping multiple validation errors
-// returned by RoleBinding.ValidateAll() if the designated constraints aren't met.
-type RoleBindingMultiError []error
+// CreateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleBindingMultiError) Error() string {
+func (m CreateRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2234,11 +2453,11 @@ func (m RoleBindingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleBindingMultiError) AllErrors() []error { return m }
+func (m CreateRoleResponseMultiError) AllErrors() []error { return m }
 
-// RoleBindingValidationError is the validation error returned by
-// RoleBinding.Validate if the designated constraints aren't met.
-type RoleBindingValidationError struct {
+// CreateRoleResponseValidationError is the validation error returned by
+// CreateRoleResponse.Validate if the designated constraints aren't met.
+type CreateRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2246,22 +2465,24 @@ type RoleBindingValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleBindingValidationError) Field() string { return e.field }
+func (e CreateRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleBindingValidationError) Reason() string { return e.reason }
+func (e CreateRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleBindingValidationError) Cause() error { return e.cause }
+func (e CreateRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleBindingValidationError) Key() bool { return e.key }
+func (e CreateRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleBindingValidationError) ErrorName() string { return "RoleBindingValidationError" }
+func (e CreateRoleResponseValidationError) ErrorName() string {
+	return "CreateRoleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RoleBindingValidationError) Error() string {
+func (e CreateRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2273,14 +2494,14 @@ func (e RoleBindingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoleBinding.%s: %s%s",
+		"invalid %sCreateRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleBindingValidationError{}
+var _ error = CreateRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2288,47 +2509,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleBindingValidationError{}
+} = CreateRoleResponseValidationError{}
 
-// Validate checks the field values on Resource with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Resource) Validate() error {
+// Validate checks the field values on UpdateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Resource with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ResourceMultiError, or nil
-// if none found.
-func (m *Resource) ValidateAll() error {
+// ValidateAll checks the field values on UpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleRequestMultiError, or nil if none found.
+func (m *UpdateRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Resource) validate(all bool) error {
+func (m *UpdateRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	if m.GetRole() == nil {
+		err := UpdateRoleRequestValidationError{
+			field:  "Role",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRoleRequestValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ResourceMultiError(errors)
+		return UpdateRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourceMultiError is an error wrapping multiple validation errors returned
-// by Resource.ValidateAll() if the designated constraints aren't met.
-type ResourceMultiError []error
+// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceMultiError) Error() string {
+func (m UpdateRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2337,11 +2595,11 @@ func (m ResourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceMultiError) AllErrors() []error { return m }
+func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
 
-// ResourceValidationError is the validation error returned by
-// Resource.Validate if the designated constraints aren't met.
-type ResourceValidationError struct {
+// UpdateRoleRequestValidationError is the validation error returned by
+// UpdateRoleRequest.Validate if the designated constraints aren't met.
+type UpdateRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2349,22 +2607,24 @@ type ResourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceValidationError) Field() string { return e.field }
+func (e UpdateRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceValidationError) Reason() string { return e.reason }
+func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceValidationError) Cause() error { return e.cause }
+func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceValidationError) Key() bool { return e.key }
+func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+func (e UpdateRoleRequestValidationError) ErrorName() string {
+	return "UpdateRoleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResourceValidationError) Error() string {
+func (e UpdateRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2376,14 +2636,14 @@ func (e ResourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResource.%s: %s%s",
+		"invalid %sUpdateRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceValidationError{}
+var _ error = UpdateRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2391,44 +2651,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceValidationError{}
+} = UpdateRoleRequestValidationError{}
 
-// Validate checks the field values on Users with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Users) Validate() error {
+// Validate checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Users with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UsersMultiError, or nil if none found.
-func (m *Users) ValidateAll() error {
+// ValidateAll checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleResponseMultiError, or nil if none found.
+func (m *UpdateRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Users) validate(all bool) error {
+func (m *UpdateRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Usernames
-
 	if len(errors) > 0 {
-		return UsersMultiError(errors)
+		return UpdateRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UsersMultiError is an error wrapping multiple validation errors returned by
-// Users.ValidateAll() if the designated constraints aren't met.
-type UsersMultiError []error
+// UpdateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UsersMultiError) Error() string {
+func (m UpdateRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2437,11 +2697,11 @@ func (m UsersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UsersMultiError) AllErrors() []error { return m }
+func (m UpdateRoleResponseMultiError) AllErrors() []error { return m }
 
-// UsersValidationError is the validation error returned by Users.Validate if
-// the designated constraints aren't met.
-type UsersValidationError struct {
+// UpdateRoleResponseValidationError is the validation error returned by
+// UpdateRoleResponse.Validate if the designated constraints aren't met.
+type UpdateRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2449,22 +2709,24 @@ type UsersValidationError struct {
 }
 
 // Field function returns field value.
-func (e UsersValidationError) Field() string { return e.field }
+func (e UpdateRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UsersValidationError) Reason() string { return e.reason }
+func (e UpdateRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UsersValidationError) Cause() error { return e.cause }
+func (e UpdateRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UsersValidationError) Key() bool { return e.key }
+func (e UpdateRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UsersValidationError) ErrorName() string { return "UsersValidationError" }
+func (e UpdateRoleResponseValidationError) ErrorName() string {
+	return "UpdateRoleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UsersValidationError) Error() string {
+func (e UpdateRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2476,14 +2738,14 @@ func (e UsersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUsers.%s: %s%s",
+		"invalid %sUpdateRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UsersValidationError{}
+var _ error = UpdateRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2491,44 +2753,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UsersValidationError{}
+} = UpdateRoleResponseValidationError{}
 
-// Validate checks the field values on Groups with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Groups) Validate() error {
+// Validate checks the field values on DeleteRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Groups with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GroupsMultiError, or nil if none found.
-func (m *Groups) ValidateAll() error {
+// ValidateAll checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleRequestMultiError, or nil if none found.
+func (m *DeleteRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Groups) validate(all bool) error {
+func (m *DeleteRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Groups
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GroupsMultiError(errors)
+		return DeleteRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GroupsMultiError is an error wrapping multiple validation errors returned by
-// Groups.ValidateAll() if the designated constraints aren't met.
-type GroupsMultiError []error
+// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GroupsMultiError) Error() string {
+func (m DeleteRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2537,11 +2801,11 @@ func (m GroupsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GroupsMultiError) AllErrors() []error { return m }
+func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
 
-// GroupsValidationError is the validation error returned by Groups.Validate if
-// the designated constraints aren't met.
-type GroupsValidationError struct {
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.Validate if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2549,22 +2813,24 @@ type GroupsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GroupsValidationError) Field() string { return e.field }
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GroupsValidationError) Reason() string { return e.reason }
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GroupsValidationError) Cause() error { return e.cause }
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GroupsValidationError) Key() bool { return e.key }
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GroupsValidationError) ErrorName() string { return "GroupsValidationError" }
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GroupsValidationError) Error() string {
+func (e DeleteRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2576,14 +2842,14 @@ func (e GroupsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGroups.%s: %s%s",
+		"invalid %sDeleteRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GroupsValidationError{}
+var _ error = DeleteRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2591,44 +2857,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GroupsValidationError{}
+} = DeleteRoleRequestValidationError{}
 
-// Validate checks the field values on Role with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Role) Validate() error {
+// Validate checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Role with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RoleMultiError, or nil if none found.
-func (m *Role) ValidateAll() error {
+// ValidateAll checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleResponseMultiError, or nil if none found.
+func (m *DeleteRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Role) validate(all bool) error {
+func (m *DeleteRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return RoleMultiError(errors)
+		return DeleteRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleMultiError is an error wrapping multiple validation errors returned by
-// Role.ValidateAll() if the designated constraints aren't met.
-type RoleMultiError []error
+// DeleteRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleMultiError) Error() string {
+func (m DeleteRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2637,11 +2903,11 @@ func (m RoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleMultiError) AllErrors() []error { return m }
+func (m DeleteRoleResponseMultiError) AllErrors() []error { return m }
 
-// RoleValidationError is the validation error returned by Role.Validate if the
-// designated constraints aren't met.
-type RoleValidationError struct {
+// DeleteRoleResponseValidationError is the validation error returned by
+// DeleteRoleResponse.Validate if the designated constraints aren't met.
+type DeleteRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2649,22 +2915,24 @@ type RoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleValidationError) Field() string { return e.field }
+func (e DeleteRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleValidationError) Reason() string { return e.reason }
+func (e DeleteRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleValidationError) Cause() error { return e.cause }
+func (e DeleteRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleValidationError) Key() bool { return e.key }
+func (e DeleteRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+func (e DeleteRoleResponseValidationError) ErrorName() string {
+	return "DeleteRoleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RoleValidationError) Error() string {
+func (e DeleteRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2676,14 +2944,14 @@ func (e RoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRole.%s: %s%s",
+		"invalid %sDeleteRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleValidationError{}
+var _ error = DeleteRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2691,55 +2959,1698 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleValidationError{}
+} = DeleteRoleResponseValidationError{}
 
-// Validate checks the field values on AuthorizeRequest with the rules defined
+// Validate checks the field values on ListRolesRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeRequest) Validate() error {
+func (m *ListRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeRequest with the rules
+// ValidateAll checks the field values on ListRolesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthorizeRequestMultiError, or nil if none found.
-func (m *AuthorizeRequest) ValidateAll() error {
+// ListRolesRequestMultiError, or nil if none found.
+func (m *ListRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeRequest) validate(all bool) error {
+func (m *ListRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetResource() == nil {
-		err := AuthorizeRequestValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ListRolesRequestMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthorizeRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthorizeRequestValidationError{
-					field:  "Resource",
+	return nil
+}
+
+// ListRolesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRolesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolesRequestMultiError) AllErrors() []error { return m }
+
+// ListRolesRequestValidationError is the validation error returned by
+// ListRolesRequest.Validate if the designated constraints aren't met.
+type ListRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesRequestValidationError) ErrorName() string { return "ListRolesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesRequestValidationError{}
+
+// Validate checks the field values on ListRolesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRolesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolesResponseMultiError, or nil if none found.
+func (m *ListRolesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRolesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRolesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRolesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolesResponseMultiError) AllErrors() []error { return m }
+
+// ListRolesResponseValidationError is the validation error returned by
+// ListRolesResponse.Validate if the designated constraints aren't met.
+type ListRolesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesResponseValidationError) ErrorName() string {
+	return "ListRolesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRolesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesResponseValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Principal
+
+	// no validation rules for Method
+
+	// no validation rules for Resource
+
+	// no validation rules for Request
+
+	// no validation rules for Code
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Principal
+
+	// no validation rules for Method
+
+	// no validation rules for Resource
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on Roles with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Roles) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Roles with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RolesMultiError, or nil if none found.
+func (m *Roles) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Roles) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Roles
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RolesValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RolesValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RolesValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RolesMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolesMultiError is an error wrapping multiple validation errors returned by
+// Roles.ValidateAll() if the designated constraints aren't met.
+type RolesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolesMultiError) AllErrors() []error { return m }
+
+// RolesValidationError is the validation error returned by Roles.Validate if
+// the designated constraints aren't met.
+type RolesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolesValidationError) ErrorName() string { return "RolesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolesValidationError{}
+
+// Validate checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleBinding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleBindingMultiError, or
+// nil if none found.
+func (m *RoleBinding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleBinding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetEntries()))
+		i := 0
+		for key := range m.GetEntries() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetEntries()[key]
+			_ = val
+
+			// no validation rules for Entries[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, RoleBindingValidationError{
+							field:  fmt.Sprintf("Entries[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, RoleBindingValidationError{
+							field:  fmt.Sprintf("Entries[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return RoleBindingValidationError{
+						field:  fmt.Sprintf("Entries[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleBindingMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleBindingMultiError is an error wrapping multiple validation errors
+// returned by RoleBinding.ValidateAll() if the designated constraints aren't met.
+type RoleBindingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleBindingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleBindingMultiError) AllErrors() []error { return m }
+
+// RoleBindingValidationError is the validation error returned by
+// RoleBinding.Validate if the designated constraints aren't met.
+type RoleBindingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleBindingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleBindingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleBindingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleBindingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleBindingValidationError) ErrorName() string { return "RoleBindingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleBindingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleBinding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleBindingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleBindingValidationError{}
+
+// Validate checks the field values on Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Resource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Resource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Resource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceMultiError) AllErrors() []error { return m }
+
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceValidationError{}
+
+// Validate checks the field values on Users with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Users) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Users with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UsersMultiError, or nil if none found.
+func (m *Users) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Users) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Usernames
+
+	if len(errors) > 0 {
+		return UsersMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsersMultiError is an error wrapping multiple validation errors returned by
+// Users.ValidateAll() if the designated constraints aren't met.
+type UsersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsersMultiError) AllErrors() []error { return m }
+
+// UsersValidationError is the validation error returned by Users.Validate if
+// the designated constraints aren't met.
+type UsersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsersValidationError) ErrorName() string { return "UsersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsersValidationError{}
+
+// Validate checks the field values on Groups with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Groups) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Groups with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GroupsMultiError, or nil if none found.
+func (m *Groups) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Groups) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Groups
+
+	if len(errors) > 0 {
+		return GroupsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupsMultiError is an error wrapping multiple validation errors returned by
+// Groups.ValidateAll() if the designated constraints aren't met.
+type GroupsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupsMultiError) AllErrors() []error { return m }
+
+// GroupsValidationError is the validation error returned by Groups.Validate if
+// the designated constraints aren't met.
+type GroupsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupsValidationError) ErrorName() string { return "GroupsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroups.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupsValidationError{}
+
+// Validate checks the field values on Role with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Role) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Role with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoleMultiError, or nil if none found.
+func (m *Role) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Role) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Custom
+
+	if len(errors) > 0 {
+		return RoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleMultiError is an error wrapping multiple validation errors returned by
+// Role.ValidateAll() if the designated constraints aren't met.
+type RoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleMultiError) AllErrors() []error { return m }
+
+// RoleValidationError is the validation error returned by Role.Validate if the
+// designated constraints aren't met.
+type RoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleValidationError{}
+
+// Validate checks the field values on AuthorizeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeRequestMultiError, or nil if none found.
+func (m *AuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := AuthorizeRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorizeRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorizeRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorizeRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeRequestValidationError is the validation error returned by
+// AuthorizeRequest.Validate if the designated constraints aren't met.
+type AuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeRequestValidationError{}
+
+// Validate checks the field values on AuthorizeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeResponseMultiError, or nil if none found.
+func (m *AuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Authorized
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return AuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeResponseValidationError is the validation error returned by
+// AuthorizeResponse.Validate if the designated constraints aren't met.
+type AuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeResponseValidationError) ErrorName() string {
+	return "AuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeResponseValidationError{}
+
+// Validate checks the field values on GetPermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPermissionsRequestMultiError, or nil if none found.
+func (m *GetPermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := GetPermissionsRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPermissionsRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPermissionsRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPermissionsRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPermissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPermissionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPermissionsRequestMultiError) AllErrors() []error { return m }
+
+// GetPermissionsRequestValidationError is the validation error returned by
+// GetPermissionsRequest.Validate if the designated constraints aren't met.
+type GetPermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPermissionsRequestValidationError) ErrorName() string {
+	return "GetPermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPermissionsRequestValidationError{}
+
+// Validate checks the field values on GetPermissionsForPrincipalRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetPermissionsForPrincipalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPermissionsForPrincipalRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetPermissionsForPrincipalRequestMultiError, or nil if none found.
+func (m *GetPermissionsForPrincipalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := GetPermissionsForPrincipalRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -2747,7 +4658,7 @@ func (m *AuthorizeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AuthorizeRequestValidationError{
+			return GetPermissionsForPrincipalRequestValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2755,20 +4666,23 @@ func (m *AuthorizeRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Principal
+
 	if len(errors) > 0 {
-		return AuthorizeRequestMultiError(errors)
+		return GetPermissionsForPrincipalRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuthorizeRequestMultiError []error
+// GetPermissionsForPrincipalRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetPermissionsForPrincipalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsForPrincipalRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeRequestMultiError) Error() string {
+func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2777,11 +4691,12 @@ func (m AuthorizeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+func (m GetPermissionsForPrincipalRequestMultiError) AllErrors() []error { return m }
 
-// AuthorizeRequestValidationError is the validation error returned by
-// AuthorizeRequest.Validate if the designated constraints aren't met.
-type AuthorizeRequestValidationError struct {
+// GetPermissionsForPrincipalRequestValidationError is the validation error
+// returned by GetPermissionsForPrincipalRequest.Validate if the designated
+// constraints aren't met.
+type GetPermissionsForPrincipalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2789,22 +4704,24 @@ type AuthorizeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeRequestValidationError) Field() string { return e.field }
+func (e GetPermissionsForPrincipalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+func (e GetPermissionsForPrincipalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+func (e GetPermissionsForPrincipalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+func (e GetPermissionsForPrincipalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+func (e GetPermissionsForPrincipalRequestValidationError) ErrorName() string {
+	return "GetPermissionsForPrincipalRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthorizeRequestValidationError) Error() string {
+func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2816,14 +4733,14 @@ func (e AuthorizeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeRequest.%s: %s%s",
+		"invalid %sGetPermissionsForPrincipalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeRequestValidationError{}
+var _ error = GetPermissionsForPrincipalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2831,48 +4748,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeRequestValidationError{}
+} = GetPermissionsForPrincipalRequestValidationError{}
 
-// Validate checks the field values on AuthorizeResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeResponse) Validate() error {
+// Validate checks the field values on GetPermissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPermissionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeResponse with the rules
+// ValidateAll checks the field values on GetPermissionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthorizeResponseMultiError, or nil if none found.
-func (m *AuthorizeResponse) ValidateAll() error {
+// GetPermissionsResponseMultiError, or nil if none found.
+func (m *GetPermissionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeResponse) validate(all bool) error {
+func (m *GetPermissionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Authorized
-
-	// no validation rules for Principal
-
 	if len(errors) > 0 {
-		return AuthorizeResponseMultiError(errors)
+		return GetPermissionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeResponseMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AuthorizeResponseMultiError []error
+// GetPermissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPermissionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeResponseMultiError) Error() string {
+func (m GetPermissionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2881,11 +4794,11 @@ func (m AuthorizeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+func (m GetPermissionsResponseMultiError) AllErrors() []error { return m }
 
-// AuthorizeResponseValidationError is the validation error returned by
-// AuthorizeResponse.Validate if the designated constraints aren't met.
-type AuthorizeResponseValidationError struct {
+// GetPermissionsResponseValidationError is the validation error returned by
+// GetPermissionsResponse.Validate if the designated constraints aren't met.
+type GetPermissionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2893,24 +4806,24 @@ type AuthorizeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeResponseValidationError) Field() string { return e.field }
+func (e GetPermissionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+func (e GetPermissionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+func (e GetPermissionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+func (e GetPermissionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeResponseValidationError) ErrorName() string {
-	return "AuthorizeResponseValidationError"
+func (e GetPermissionsResponseValidationError) ErrorName() string {
+	return "GetPermissionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthorizeResponseValidationError) Error() string {
+func (e GetPermissionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2922,14 +4835,14 @@ func (e AuthorizeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeResponse.%s: %s%s",
+		"invalid %sGetPermissionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeResponseValidationError{}
+var _ error = GetPermissionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2937,24 +4850,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeResponseValidationError{}
+} = GetPermissionsResponseValidationError{}
 
-// Validate checks the field values on GetPermissionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExplainAuthorizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionsRequest) Validate() error {
+func (m *ExplainAuthorizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExplainAuthorizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPermissionsRequestMultiError, or nil if none found.
-func (m *GetPermissionsRequest) ValidateAll() error {
+// ExplainAuthorizationRequestMultiError, or nil if none found.
+func (m *ExplainAuthorizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionsRequest) validate(all bool) error {
+func (m *ExplainAuthorizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2962,7 +4875,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetResource() == nil {
-		err := GetPermissionsRequestValidationError{
+		err := ExplainAuthorizationRequestValidationError{
 			field:  "Resource",
 			reason: "value is required",
 		}
@@ -2976,7 +4889,141 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPermissionsRequestValidationError{
+				errors = append(errors, ExplainAuthorizationRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainAuthorizationRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainAuthorizationRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return ExplainAuthorizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainAuthorizationRequestMultiError is an error wrapping multiple
+// validation errors returned by ExplainAuthorizationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExplainAuthorizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainAuthorizationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainAuthorizationRequestMultiError) AllErrors() []error { return m }
+
+// ExplainAuthorizationRequestValidationError is the validation error returned
+// by ExplainAuthorizationRequest.Validate if the designated constraints
+// aren't met.
+type ExplainAuthorizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainAuthorizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainAuthorizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainAuthorizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainAuthorizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainAuthorizationRequestValidationError) ErrorName() string {
+	return "ExplainAuthorizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainAuthorizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainAuthorizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainAuthorizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainAuthorizationRequestValidationError{}
+
+// Validate checks the field values on RoleGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleGrantMultiError, or nil
+// if none found.
+func (m *RoleGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2984,7 +5031,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPermissionsRequestValidationError{
+				errors = append(errors, RoleGrantValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2993,7 +5040,7 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetPermissionsRequestValidationError{
+			return RoleGrantValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3001,20 +5048,54 @@ func (m *GetPermissionsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Subject
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleGrantValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleGrantValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Expired
+
 	if len(errors) > 0 {
-		return GetPermissionsRequestMultiError(errors)
+		return RoleGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetPermissionsRequestMultiError []error
+// RoleGrantMultiError is an error wrapping multiple validation errors returned
+// by RoleGrant.ValidateAll() if the designated constraints aren't met.
+type RoleGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsRequestMultiError) Error() string {
+func (m RoleGrantMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3023,11 +5104,11 @@ func (m GetPermissionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsRequestMultiError) AllErrors() []error { return m }
+func (m RoleGrantMultiError) AllErrors() []error { return m }
 
-// GetPermissionsRequestValidationError is the validation error returned by
-// GetPermissionsRequest.Validate if the designated constraints aren't met.
-type GetPermissionsRequestValidationError struct {
+// RoleGrantValidationError is the validation error returned by
+// RoleGrant.Validate if the designated constraints aren't met.
+type RoleGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3035,24 +5116,22 @@ type GetPermissionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsRequestValidationError) Field() string { return e.field }
+func (e RoleGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsRequestValidationError) Reason() string { return e.reason }
+func (e RoleGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsRequestValidationError) Cause() error { return e.cause }
+func (e RoleGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsRequestValidationError) Key() bool { return e.key }
+func (e RoleGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsRequestValidationError) ErrorName() string {
-	return "GetPermissionsRequestValidationError"
-}
+func (e RoleGrantValidationError) ErrorName() string { return "RoleGrantValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsRequestValidationError) Error() string {
+func (e RoleGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3064,14 +5143,14 @@ func (e GetPermissionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsRequest.%s: %s%s",
+		"invalid %sRoleGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsRequestValidationError{}
+var _ error = RoleGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -3079,88 +5158,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsRequestValidationError{}
+} = RoleGrantValidationError{}
 
-// Validate checks the field values on GetPermissionsForPrincipalRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetPermissionsForPrincipalRequest) Validate() error {
+// Validate checks the field values on PermissionExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionExplanation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsForPrincipalRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetPermissionsForPrincipalRequestMultiError, or nil if none found.
-func (m *GetPermissionsForPrincipalRequest) ValidateAll() error {
+// ValidateAll checks the field values on PermissionExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExplanationMultiError, or nil if none found.
+func (m *PermissionExplanation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionsForPrincipalRequest) validate(all bool) error {
+func (m *PermissionExplanation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetResource() == nil {
-		err := GetPermissionsForPrincipalRequestValidationError{
-			field:  "Resource",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Permission
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Granted
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionExplanationValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionExplanationValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPermissionsForPrincipalRequestValidationError{
-					field:  "Resource",
+				return PermissionExplanationValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetPermissionsForPrincipalRequestValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for Principal
+	// no validation rules for DeniedByScope
 
 	if len(errors) > 0 {
-		return GetPermissionsForPrincipalRequestMultiError(errors)
+		return PermissionExplanationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsForPrincipalRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetPermissionsForPrincipalRequest.ValidateAll() if the designated
+// PermissionExplanationMultiError is an error wrapping multiple validation
+// errors returned by PermissionExplanation.ValidateAll() if the designated
 // constraints aren't met.
-type GetPermissionsForPrincipalRequestMultiError []error
+type PermissionExplanationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
+func (m PermissionExplanationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3169,12 +5244,11 @@ func (m GetPermissionsForPrincipalRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsForPrincipalRequestMultiError) AllErrors() []error { return m }
+func (m PermissionExplanationMultiError) AllErrors() []error { return m }
 
-// GetPermissionsForPrincipalRequestValidationError is the validation error
-// returned by GetPermissionsForPrincipalRequest.Validate if the designated
-// constraints aren't met.
-type GetPermissionsForPrincipalRequestValidationError struct {
+// PermissionExplanationValidationError is the validation error returned by
+// PermissionExplanation.Validate if the designated constraints aren't met.
+type PermissionExplanationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3182,24 +5256,24 @@ type GetPermissionsForPrincipalRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsForPrincipalRequestValidationError) Field() string { return e.field }
+func (e PermissionExplanationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsForPrincipalRequestValidationError) Reason() string { return e.reason }
+func (e PermissionExplanationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsForPrincipalRequestValidationError) Cause() error { return e.cause }
+func (e PermissionExplanationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsForPrincipalRequestValidationError) Key() bool { return e.key }
+func (e PermissionExplanationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsForPrincipalRequestValidationError) ErrorName() string {
-	return "GetPermissionsForPrincipalRequestValidationError"
+func (e PermissionExplanationValidationError) ErrorName() string {
+	return "PermissionExplanationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
+func (e PermissionExplanationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3211,14 +5285,14 @@ func (e GetPermissionsForPrincipalRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsForPrincipalRequest.%s: %s%s",
+		"invalid %sPermissionExplanation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsForPrincipalRequestValidationError{}
+var _ error = PermissionExplanationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3226,44 +5300,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsForPrincipalRequestValidationError{}
+} = PermissionExplanationValidationError{}
 
-// Validate checks the field values on GetPermissionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExplainAuthorizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionsResponse) Validate() error {
+func (m *ExplainAuthorizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExplainAuthorizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPermissionsResponseMultiError, or nil if none found.
-func (m *GetPermissionsResponse) ValidateAll() error {
+// ExplainAuthorizationResponseMultiError, or nil if none found.
+func (m *ExplainAuthorizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionsResponse) validate(all bool) error {
+func (m *ExplainAuthorizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Principal
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplainAuthorizationResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplainAuthorizationResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainAuthorizationResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetPermissionsResponseMultiError(errors)
+		return ExplainAuthorizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetPermissionsResponseMultiError []error
+// ExplainAuthorizationResponseMultiError is an error wrapping multiple
+// validation errors returned by ExplainAuthorizationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExplainAuthorizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionsResponseMultiError) Error() string {
+func (m ExplainAuthorizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3272,11 +5382,12 @@ func (m GetPermissionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionsResponseMultiError) AllErrors() []error { return m }
+func (m ExplainAuthorizationResponseMultiError) AllErrors() []error { return m }
 
-// GetPermissionsResponseValidationError is the validation error returned by
-// GetPermissionsResponse.Validate if the designated constraints aren't met.
-type GetPermissionsResponseValidationError struct {
+// ExplainAuthorizationResponseValidationError is the validation error returned
+// by ExplainAuthorizationResponse.Validate if the designated constraints
+// aren't met.
+type ExplainAuthorizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3284,24 +5395,24 @@ type GetPermissionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionsResponseValidationError) Field() string { return e.field }
+func (e ExplainAuthorizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionsResponseValidationError) Reason() string { return e.reason }
+func (e ExplainAuthorizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionsResponseValidationError) Cause() error { return e.cause }
+func (e ExplainAuthorizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionsResponseValidationError) Key() bool { return e.key }
+func (e ExplainAuthorizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionsResponseValidationError) ErrorName() string {
-	return "GetPermissionsResponseValidationError"
+func (e ExplainAuthorizationResponseValidationError) ErrorName() string {
+	return "ExplainAuthorizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionsResponseValidationError) Error() string {
+func (e ExplainAuthorizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3313,14 +5424,14 @@ func (e GetPermissionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionsResponse.%s: %s%s",
+		"invalid %sExplainAuthorizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionsResponseValidationError{}
+var _ error = ExplainAuthorizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3328,7 +5439,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionsResponseValidationError{}
+} = ExplainAuthorizationResponseValidationError{}
 
 // Validate checks the field values on ModifyRoleBindingRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -3394,6 +5505,35 @@ func (m *ModifyRoleBindingRequest) validate(all bool) error {
 
 	// no validation rules for Principal
 
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModifyRoleBindingRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModifyRoleBindingRequestValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ModifyRoleBindingRequestMultiError(errors)
 	}
@@ -4188,6 +6328,35 @@ func (m *GetRobotTokenRequest) validate(all bool) error {
 
 	// no validation rules for Ttl
 
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRobotTokenRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRobotTokenRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRobotTokenRequestValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetRobotTokenRequestMultiError(errors)
 	}
@@ -114,6 +114,29 @@ func (x *TokenInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("subject", x.Subject)
 	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
 	enc.AddString("hashed_token", x.HashedToken)
+	enc.AddObject("scope", x.Scope)
+	return nil
+}
+
+func (x *TokenScope) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	permissionsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Permissions {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("permissions", zapcore.ArrayMarshalerFunc(permissionsArrMarshaller))
+	resourcesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Resources {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("resources", zapcore.ArrayMarshalerFunc(resourcesArrMarshaller))
+	enc.AddBool("read_only", x.ReadOnly)
 	return nil
 }
 
@@ -123,6 +146,7 @@ func (x *AuthenticateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	}
 	protoextensions.AddHalfString(enc, "oidc_state", x.OidcState)
 	protoextensions.AddHalfString(enc, "id_token", x.IdToken)
+	enc.AddObject("scope", x.Scope)
 	return nil
 }
 
@@ -147,6 +171,7 @@ func (x *WhoAmIResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	}
 	enc.AddString("username", x.Username)
 	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
+	enc.AddObject("scope", x.Scope)
 	return nil
 }
 
@@ -172,6 +197,101 @@ func (x *GetRolesForPermissionResponse) MarshalLogObject(enc zapcore.ObjectEncod
 	return nil
 }
 
+func (x *CreateRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("role", x.Role)
+	return nil
+}
+
+func (x *CreateRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("role", x.Role)
+	return nil
+}
+
+func (x *UpdateRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *DeleteRoleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *DeleteRoleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListRolesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListRolesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	rolesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Roles {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("roles", zapcore.ArrayMarshalerFunc(rolesArrMarshaller))
+	return nil
+}
+
+func (x *AuditEvent) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("id", x.Id)
+	protoextensions.AddTimestamp(enc, "time", x.Time)
+	enc.AddString("principal", x.Principal)
+	enc.AddString("method", x.Method)
+	enc.AddString("resource", x.Resource)
+	enc.AddString("request", x.Request)
+	enc.AddString("code", x.Code)
+	enc.AddString("error", x.Error)
+	protoextensions.AddDuration(enc, "duration", x.Duration)
+	return nil
+}
+
+func (x *ListAuditEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddTimestamp(enc, "since", x.Since)
+	protoextensions.AddTimestamp(enc, "until", x.Until)
+	enc.AddString("principal", x.Principal)
+	enc.AddString("method", x.Method)
+	enc.AddString("resource", x.Resource)
+	enc.AddInt64("limit", x.Limit)
+	return nil
+}
+
 func (x *Roles) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -182,6 +302,7 @@ func (x *Roles) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		}
 		return nil
 	}))
+	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
 	return nil
 }
 
@@ -259,6 +380,7 @@ func (x *Role) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddArray("returned_for", zapcore.ArrayMarshalerFunc(returned_forArrMarshaller))
+	enc.AddBool("custom", x.Custom)
 	return nil
 }
 
@@ -338,6 +460,80 @@ func (x *GetPermissionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) err
 	return nil
 }
 
+func (x *ExplainAuthorizationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("resource", x.Resource)
+	enc.AddString("principal", x.Principal)
+	permissionsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Permissions {
+			enc.AppendString(v.String())
+		}
+		return nil
+	}
+	enc.AddArray("permissions", zapcore.ArrayMarshalerFunc(permissionsArrMarshaller))
+	return nil
+}
+
+func (x *RoleGrant) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("resource", x.Resource)
+	enc.AddString("subject", x.Subject)
+	enc.AddString("role", x.Role)
+	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
+	enc.AddBool("expired", x.Expired)
+	return nil
+}
+
+func (x *PermissionExplanation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("permission", x.Permission.String())
+	enc.AddBool("granted", x.Granted)
+	grantsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Grants {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("grants", zapcore.ArrayMarshalerFunc(grantsArrMarshaller))
+	rolesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Roles {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("roles", zapcore.ArrayMarshalerFunc(rolesArrMarshaller))
+	enc.AddBool("denied_by_scope", x.DeniedByScope)
+	return nil
+}
+
+func (x *ExplainAuthorizationResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("principal", x.Principal)
+	groupsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Groups {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("groups", zapcore.ArrayMarshalerFunc(groupsArrMarshaller))
+	permissionsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Permissions {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("permissions", zapcore.ArrayMarshalerFunc(permissionsArrMarshaller))
+	return nil
+}
+
 func (x *ModifyRoleBindingRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -351,6 +547,7 @@ func (x *ModifyRoleBindingRequest) MarshalLogObject(enc zapcore.ObjectEncoder) e
 		return nil
 	}
 	enc.AddArray("roles", zapcore.ArrayMarshalerFunc(rolesArrMarshaller))
+	protoextensions.AddTimestamp(enc, "expiration", x.Expiration)
 	return nil
 }
 
@@ -409,6 +606,7 @@ func (x *GetRobotTokenRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	}
 	enc.AddString("robot", x.Robot)
 	enc.AddInt64("ttl", x.Ttl)
+	enc.AddObject("scope", x.Scope)
 	return nil
 }
 
@@ -29,6 +29,12 @@ const (
 	API_GetPermissionsForPrincipal_FullMethodName = "/auth_v2.API/GetPermissionsForPrincipal"
 	API_WhoAmI_FullMethodName                     = "/auth_v2.API/WhoAmI"
 	API_GetRolesForPermission_FullMethodName      = "/auth_v2.API/GetRolesForPermission"
+	API_ExplainAuthorization_FullMethodName       = "/auth_v2.API/ExplainAuthorization"
+	API_CreateRole_FullMethodName                 = "/auth_v2.API/CreateRole"
+	API_UpdateRole_FullMethodName                 = "/auth_v2.API/UpdateRole"
+	API_DeleteRole_FullMethodName                 = "/auth_v2.API/DeleteRole"
+	API_ListRoles_FullMethodName                  = "/auth_v2.API/ListRoles"
+	API_ListAuditEvents_FullMethodName            = "/auth_v2.API/ListAuditEvents"
 	API_ModifyRoleBinding_FullMethodName          = "/auth_v2.API/ModifyRoleBinding"
 	API_GetRoleBinding_FullMethodName             = "/auth_v2.API/GetRoleBinding"
 	API_GetOIDCLogin_FullMethodName               = "/auth_v2.API/GetOIDCLogin"
@@ -63,6 +69,16 @@ type APIClient interface {
 	GetPermissionsForPrincipal(ctx context.Context, in *GetPermissionsForPrincipalRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
 	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
 	GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error)
+	// ExplainAuthorization describes which role bindings grant, or would grant,
+	// permissions on a resource to a principal.
+	ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationRequest, opts ...grpc.CallOption) (*ExplainAuthorizationResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	// ListAuditEvents returns the recorded calls to APIs that change the state
+	// of the cluster.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (API_ListAuditEventsClient, error)
 	ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
@@ -178,6 +194,83 @@ func (c *aPIClient) GetRolesForPermission(ctx context.Context, in *GetRolesForPe
 	return out, nil
 }
 
+func (c *aPIClient) ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationRequest, opts ...grpc.CallOption) (*ExplainAuthorizationResponse, error) {
+	out := new(ExplainAuthorizationResponse)
+	err := c.cc.Invoke(ctx, API_ExplainAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, API_CreateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, API_UpdateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, API_DeleteRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, API_ListRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (API_ListAuditEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], API_ListAuditEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListAuditEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListAuditEventsClient interface {
+	Recv() (*AuditEvent, error)
+	grpc.ClientStream
+}
+
+type aPIListAuditEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListAuditEventsClient) Recv() (*AuditEvent, error) {
+	m := new(AuditEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error) {
 	out := new(ModifyRoleBindingResponse)
 	err := c.cc.Invoke(ctx, API_ModifyRoleBinding_FullMethodName, in, out, opts...)
@@ -330,6 +423,16 @@ type APIServer interface {
 	GetPermissionsForPrincipal(context.Context, *GetPermissionsForPrincipalRequest) (*GetPermissionsResponse, error)
 	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
 	GetRolesForPermission(context.Context, *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error)
+	// ExplainAuthorization describes which role bindings grant, or would grant,
+	// permissions on a resource to a principal.
+	ExplainAuthorization(context.Context, *ExplainAuthorizationRequest) (*ExplainAuthorizationResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// ListAuditEvents returns the recorded calls to APIs that change the state
+	// of the cluster.
+	ListAuditEvents(*ListAuditEventsRequest, API_ListAuditEventsServer) error
 	ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
@@ -382,6 +485,24 @@ func (UnimplementedAPIServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIRe
 func (UnimplementedAPIServer) GetRolesForPermission(context.Context, *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRolesForPermission not implemented")
 }
+func (UnimplementedAPIServer) ExplainAuthorization(context.Context, *ExplainAuthorizationRequest) (*ExplainAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAuthorization not implemented")
+}
+func (UnimplementedAPIServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedAPIServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAPIServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedAPIServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedAPIServer) ListAuditEvents(*ListAuditEventsRequest, API_ListAuditEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAPIServer) ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyRoleBinding not implemented")
 }
@@ -620,6 +741,117 @@ func _API_GetRolesForPermission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ExplainAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ExplainAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ExplainAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExplainAuthorization(ctx, req.(*ExplainAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListAuditEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListAuditEvents(m, &aPIListAuditEventsServer{stream})
+}
+
+type API_ListAuditEventsServer interface {
+	Send(*AuditEvent) error
+	grpc.ServerStream
+}
+
+type aPIListAuditEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListAuditEventsServer) Send(m *AuditEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_ModifyRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ModifyRoleBindingRequest)
 	if err := dec(in); err != nil {
@@ -937,6 +1169,26 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRolesForPermission",
 			Handler:    _API_GetRolesForPermission_Handler,
 		},
+		{
+			MethodName: "ExplainAuthorization",
+			Handler:    _API_ExplainAuthorization_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _API_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _API_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _API_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _API_ListRoles_Handler,
+		},
 		{
 			MethodName: "ModifyRoleBinding",
 			Handler:    _API_ModifyRoleBinding_Handler,
@@ -998,6 +1250,12 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _API_RotateRootToken_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAuditEvents",
+			Handler:       _API_ListAuditEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "auth/auth.proto",
 }
@@ -6,6 +6,10 @@ const (
 	// authenticated context
 	ContextTokenKey = "authn-token"
 
+	// ShareLinkKey is the URL query parameter that holds a share link's
+	// token
+	ShareLinkKey = "share"
+
 	// JSONSchemaKey is the key in JSON that names the schema of the document.
 	JSONSchemaKey = "$schema"
 )
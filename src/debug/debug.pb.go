@@ -1285,6 +1285,135 @@ func (*Starlark_Builtin) isStarlark_Source() {}
 
 func (*Starlark_Literal) isStarlark_Source() {}
 
+// RedactionPattern is a regular expression whose matches are redacted from a debug dump.
+type RedactionPattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pattern; it replaces the text the pattern redacts, and names the pattern in
+	// the redaction manifest.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// An RE2 regular expression.  If it has capture groups, only the text they match is redacted;
+	// otherwise the whole match is.
+	Regex string `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (x *RedactionPattern) Reset() {
+	*x = RedactionPattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedactionPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedactionPattern) ProtoMessage() {}
+
+func (x *RedactionPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedactionPattern.ProtoReflect.Descriptor instead.
+func (*RedactionPattern) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RedactionPattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RedactionPattern) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+// Redaction controls the redaction of secrets from a debug dump.
+type Redaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, don't run the built-in detectors for credentials, URLs with passwords, JWTs and
+	// private keys.
+	DisableBuiltin bool `protobuf:"varint,1,opt,name=disable_builtin,json=disableBuiltin,proto3" json:"disable_builtin,omitempty"`
+	// Additional patterns to redact.
+	Patterns []*RedactionPattern `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// Fields of JSON files whose values are always redacted, as dot-separated paths of keys, e.g.
+	// "transform.env".  A path matches every field whose path ends with it; "*" matches any key.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *Redaction) Reset() {
+	*x = Redaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Redaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redaction) ProtoMessage() {}
+
+func (x *Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redaction.ProtoReflect.Descriptor instead.
+func (*Redaction) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Redaction) GetDisableBuiltin() bool {
+	if x != nil {
+		return x.DisableBuiltin
+	}
+	return false
+}
+
+func (x *Redaction) GetPatterns() []*RedactionPattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *Redaction) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type DumpV2Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1302,12 +1431,14 @@ type DumpV2Request struct {
 	Defaults *DumpV2Request_Defaults `protobuf:"bytes,5,opt,name=defaults,proto3" json:"defaults,omitempty"`
 	// A list of Starlark scripts to run.
 	StarlarkScripts []*Starlark `protobuf:"bytes,6,rep,name=starlark_scripts,json=starlarkScripts,proto3" json:"starlark_scripts,omitempty"`
+	// If set, redact secrets from the debug dump, and list what was redacted in redactions.json.
+	Redaction *Redaction `protobuf:"bytes,7,opt,name=redaction,proto3" json:"redaction,omitempty"`
 }
 
 func (x *DumpV2Request) Reset() {
 	*x = DumpV2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[18]
+		mi := &file_debug_debug_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1451,7 @@ func (x *DumpV2Request) String() string {
 func (*DumpV2Request) ProtoMessage() {}
 
 func (x *DumpV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[18]
+	mi := &file_debug_debug_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1464,7 @@ func (x *DumpV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpV2Request.ProtoReflect.Descriptor instead.
 func (*DumpV2Request) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{18}
+	return file_debug_debug_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DumpV2Request) GetSystem() *System {
@@ -1378,6 +1509,13 @@ func (x *DumpV2Request) GetStarlarkScripts() []*Starlark {
 	return nil
 }
 
+func (x *DumpV2Request) GetRedaction() *Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
 type DumpContent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1389,7 +1527,7 @@ type DumpContent struct {
 func (x *DumpContent) Reset() {
 	*x = DumpContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[19]
+		mi := &file_debug_debug_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1402,7 +1540,7 @@ func (x *DumpContent) String() string {
 func (*DumpContent) ProtoMessage() {}
 
 func (x *DumpContent) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[19]
+	mi := &file_debug_debug_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +1553,7 @@ func (x *DumpContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpContent.ProtoReflect.Descriptor instead.
 func (*DumpContent) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{19}
+	return file_debug_debug_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DumpContent) GetContent() []byte {
@@ -1438,7 +1576,7 @@ type DumpProgress struct {
 func (x *DumpProgress) Reset() {
 	*x = DumpProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[20]
+		mi := &file_debug_debug_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1451,7 +1589,7 @@ func (x *DumpProgress) String() string {
 func (*DumpProgress) ProtoMessage() {}
 
 func (x *DumpProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[20]
+	mi := &file_debug_debug_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +1602,7 @@ func (x *DumpProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpProgress.ProtoReflect.Descriptor instead.
 func (*DumpProgress) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{20}
+	return file_debug_debug_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DumpProgress) GetTask() string {
@@ -1503,7 +1641,7 @@ type DumpChunk struct {
 func (x *DumpChunk) Reset() {
 	*x = DumpChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[21]
+		mi := &file_debug_debug_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1516,7 +1654,7 @@ func (x *DumpChunk) String() string {
 func (*DumpChunk) ProtoMessage() {}
 
 func (x *DumpChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[21]
+	mi := &file_debug_debug_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1529,7 +1667,7 @@ func (x *DumpChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpChunk.ProtoReflect.Descriptor instead.
 func (*DumpChunk) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{21}
+	return file_debug_debug_proto_rawDescGZIP(), []int{23}
 }
 
 func (m *DumpChunk) GetChunk() isDumpChunk_Chunk {
@@ -1583,7 +1721,7 @@ type RunPFSLoadTestRequest struct {
 func (x *RunPFSLoadTestRequest) Reset() {
 	*x = RunPFSLoadTestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[22]
+		mi := &file_debug_debug_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1596,7 +1734,7 @@ func (x *RunPFSLoadTestRequest) String() string {
 func (*RunPFSLoadTestRequest) ProtoMessage() {}
 
 func (x *RunPFSLoadTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[22]
+	mi := &file_debug_debug_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +1747,7 @@ func (x *RunPFSLoadTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunPFSLoadTestRequest.ProtoReflect.Descriptor instead.
 func (*RunPFSLoadTestRequest) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{22}
+	return file_debug_debug_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RunPFSLoadTestRequest) GetSpec() string {
@@ -1656,7 +1794,7 @@ type RunPFSLoadTestResponse struct {
 func (x *RunPFSLoadTestResponse) Reset() {
 	*x = RunPFSLoadTestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[23]
+		mi := &file_debug_debug_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +1807,7 @@ func (x *RunPFSLoadTestResponse) String() string {
 func (*RunPFSLoadTestResponse) ProtoMessage() {}
 
 func (x *RunPFSLoadTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[23]
+	mi := &file_debug_debug_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +1820,7 @@ func (x *RunPFSLoadTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunPFSLoadTestResponse.ProtoReflect.Descriptor instead.
 func (*RunPFSLoadTestResponse) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{23}
+	return file_debug_debug_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RunPFSLoadTestResponse) GetSpec() string {
@@ -1738,7 +1876,7 @@ type TraceRequest struct {
 func (x *TraceRequest) Reset() {
 	*x = TraceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[24]
+		mi := &file_debug_debug_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1751,7 +1889,7 @@ func (x *TraceRequest) String() string {
 func (*TraceRequest) ProtoMessage() {}
 
 func (x *TraceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[24]
+	mi := &file_debug_debug_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,7 +1902,7 @@ func (x *TraceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TraceRequest.ProtoReflect.Descriptor instead.
 func (*TraceRequest) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{24}
+	return file_debug_debug_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TraceRequest) GetDuration() *durationpb.Duration {
@@ -1788,7 +1926,7 @@ type TraceChunk struct {
 func (x *TraceChunk) Reset() {
 	*x = TraceChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[25]
+		mi := &file_debug_debug_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1801,7 +1939,7 @@ func (x *TraceChunk) String() string {
 func (*TraceChunk) ProtoMessage() {}
 
 func (x *TraceChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[25]
+	mi := &file_debug_debug_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,7 +1952,7 @@ func (x *TraceChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TraceChunk.ProtoReflect.Descriptor instead.
 func (*TraceChunk) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{25}
+	return file_debug_debug_proto_rawDescGZIP(), []int{27}
 }
 
 func (m *TraceChunk) GetReply() isTraceChunk_Reply {
@@ -1853,7 +1991,7 @@ type DumpV2Request_Defaults struct {
 func (x *DumpV2Request_Defaults) Reset() {
 	*x = DumpV2Request_Defaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[26]
+		mi := &file_debug_debug_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2004,7 @@ func (x *DumpV2Request_Defaults) String() string {
 func (*DumpV2Request_Defaults) ProtoMessage() {}
 
 func (x *DumpV2Request_Defaults) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[26]
+	mi := &file_debug_debug_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2017,7 @@ func (x *DumpV2Request_Defaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpV2Request_Defaults.ProtoReflect.Descriptor instead.
 func (*DumpV2Request_Defaults) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{18, 0}
+	return file_debug_debug_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *DumpV2Request_Defaults) GetClusterDefaults() bool {
@@ -2037,124 +2175,139 @@ var file_debug_debug_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x0d,
-	0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
-	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
-	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x09,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x3c, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d,
-	0x70, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3d, 0x0a,
-	0x10, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
-	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x0f, 0x73, 0x74, 0x61,
-	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x1a, 0x35, 0x0a, 0x08,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0c,
-	0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x7d, 0x0a, 0x09, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
-	0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e,
-	0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64,
-	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12,
-	0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x50, 0x46,
-	0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x0c, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01,
-	0x2a, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0a,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xa2, 0x05, 0x0a,
-	0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x44, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x06,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76,
-	0x32, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x3e, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x15, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1c, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
-	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
-	0x0a, 0x06, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x12, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d,
-	0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c,
-	0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
-	0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x15,
-	0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c,
-	0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64,
-	0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x10, 0x52,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0x84, 0x01, 0x0a, 0x09, 0x52, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
+	0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x08,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0xa8, 0x03, 0x0a, 0x0d, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x09,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76,
+	0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
+	0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x44,
+	0x75, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0c, 0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x0a, 0x09, 0x44, 0x75,
+	0x6d, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x75,
+	0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x66, 0x73, 0x5f, 0x76, 0x32,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0xd0,
+	0x01, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x66, 0x73, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x22, 0x51, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x32, 0xa2, 0x05, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x44,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70,
+	0x12, 0x15, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x75, 0x6d,
+	0x70, 0x56, 0x32, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x75,
+	0x6d, 0x70, 0x56, 0x32, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x44, 0x75, 0x6d, 0x70, 0x56, 0x32,
+	0x12, 0x17, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70,
+	0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x76, 0x32, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x55, 0x0a,
+	0x0e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x46,
+	0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x50,
+	0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f,
+	0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x76, 0x32,
+	0x2e, 0x52, 0x75, 0x6e, 0x50, 0x46, 0x53, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72,
+	0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2170,7 +2323,7 @@ func file_debug_debug_proto_rawDescGZIP() []byte {
 }
 
 var file_debug_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_debug_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_debug_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_debug_debug_proto_goTypes = []interface{}{
 	(SetLogLevelRequest_LogLevel)(0),  // 0: debug_v2.SetLogLevelRequest.LogLevel
 	(*ProfileRequest)(nil),            // 1: debug_v2.ProfileRequest
@@ -2191,35 +2344,37 @@ var file_debug_debug_proto_goTypes = []interface{}{
 	(*System)(nil),                    // 16: debug_v2.System
 	(*StarlarkLiteral)(nil),           // 17: debug_v2.StarlarkLiteral
 	(*Starlark)(nil),                  // 18: debug_v2.Starlark
-	(*DumpV2Request)(nil),             // 19: debug_v2.DumpV2Request
-	(*DumpContent)(nil),               // 20: debug_v2.DumpContent
-	(*DumpProgress)(nil),              // 21: debug_v2.DumpProgress
-	(*DumpChunk)(nil),                 // 22: debug_v2.DumpChunk
-	(*RunPFSLoadTestRequest)(nil),     // 23: debug_v2.RunPFSLoadTestRequest
-	(*RunPFSLoadTestResponse)(nil),    // 24: debug_v2.RunPFSLoadTestResponse
-	(*TraceRequest)(nil),              // 25: debug_v2.TraceRequest
-	(*TraceChunk)(nil),                // 26: debug_v2.TraceChunk
-	(*DumpV2Request_Defaults)(nil),    // 27: debug_v2.DumpV2Request.Defaults
-	(*durationpb.Duration)(nil),       // 28: google.protobuf.Duration
-	(*pps.Pipeline)(nil),              // 29: pps_v2.Pipeline
-	(*pfs.Branch)(nil),                // 30: pfs_v2.Branch
-	(*wrapperspb.BytesValue)(nil),     // 31: google.protobuf.BytesValue
-	(*emptypb.Empty)(nil),             // 32: google.protobuf.Empty
+	(*RedactionPattern)(nil),          // 19: debug_v2.RedactionPattern
+	(*Redaction)(nil),                 // 20: debug_v2.Redaction
+	(*DumpV2Request)(nil),             // 21: debug_v2.DumpV2Request
+	(*DumpContent)(nil),               // 22: debug_v2.DumpContent
+	(*DumpProgress)(nil),              // 23: debug_v2.DumpProgress
+	(*DumpChunk)(nil),                 // 24: debug_v2.DumpChunk
+	(*RunPFSLoadTestRequest)(nil),     // 25: debug_v2.RunPFSLoadTestRequest
+	(*RunPFSLoadTestResponse)(nil),    // 26: debug_v2.RunPFSLoadTestResponse
+	(*TraceRequest)(nil),              // 27: debug_v2.TraceRequest
+	(*TraceChunk)(nil),                // 28: debug_v2.TraceChunk
+	(*DumpV2Request_Defaults)(nil),    // 29: debug_v2.DumpV2Request.Defaults
+	(*durationpb.Duration)(nil),       // 30: google.protobuf.Duration
+	(*pps.Pipeline)(nil),              // 31: pps_v2.Pipeline
+	(*pfs.Branch)(nil),                // 32: pfs_v2.Branch
+	(*wrapperspb.BytesValue)(nil),     // 33: google.protobuf.BytesValue
+	(*emptypb.Empty)(nil),             // 34: google.protobuf.Empty
 }
 var file_debug_debug_proto_depIdxs = []int32{
 	2,  // 0: debug_v2.ProfileRequest.profile:type_name -> debug_v2.Profile
 	3,  // 1: debug_v2.ProfileRequest.filter:type_name -> debug_v2.Filter
-	28, // 2: debug_v2.Profile.duration:type_name -> google.protobuf.Duration
-	29, // 3: debug_v2.Filter.pipeline:type_name -> pps_v2.Pipeline
+	30, // 2: debug_v2.Profile.duration:type_name -> google.protobuf.Duration
+	31, // 3: debug_v2.Filter.pipeline:type_name -> pps_v2.Pipeline
 	4,  // 4: debug_v2.Filter.worker:type_name -> debug_v2.Worker
 	3,  // 5: debug_v2.BinaryRequest.filter:type_name -> debug_v2.Filter
 	3,  // 6: debug_v2.DumpRequest.filter:type_name -> debug_v2.Filter
 	0,  // 7: debug_v2.SetLogLevelRequest.pachyderm:type_name -> debug_v2.SetLogLevelRequest.LogLevel
 	0,  // 8: debug_v2.SetLogLevelRequest.grpc:type_name -> debug_v2.SetLogLevelRequest.LogLevel
-	28, // 9: debug_v2.SetLogLevelRequest.duration:type_name -> google.protobuf.Duration
-	19, // 10: debug_v2.GetDumpV2TemplateResponse.request:type_name -> debug_v2.DumpV2Request
+	30, // 9: debug_v2.SetLogLevelRequest.duration:type_name -> google.protobuf.Duration
+	21, // 10: debug_v2.GetDumpV2TemplateResponse.request:type_name -> debug_v2.DumpV2Request
 	12, // 11: debug_v2.App.pods:type_name -> debug_v2.Pod
-	28, // 12: debug_v2.App.timeout:type_name -> google.protobuf.Duration
+	30, // 12: debug_v2.App.timeout:type_name -> google.protobuf.Duration
 	11, // 13: debug_v2.App.pipeline:type_name -> debug_v2.Pipeline
 	15, // 14: debug_v2.App.loki_args:type_name -> debug_v2.LokiArgs
 	14, // 15: debug_v2.App.profile_args:type_name -> debug_v2.ProfileArgs
@@ -2230,42 +2385,44 @@ var file_debug_debug_proto_depIdxs = []int32{
 	13, // 20: debug_v2.System.binaries:type_name -> debug_v2.App
 	13, // 21: debug_v2.System.profiles:type_name -> debug_v2.App
 	17, // 22: debug_v2.Starlark.literal:type_name -> debug_v2.StarlarkLiteral
-	28, // 23: debug_v2.Starlark.timeout:type_name -> google.protobuf.Duration
-	16, // 24: debug_v2.DumpV2Request.system:type_name -> debug_v2.System
-	11, // 25: debug_v2.DumpV2Request.pipelines:type_name -> debug_v2.Pipeline
-	28, // 26: debug_v2.DumpV2Request.timeout:type_name -> google.protobuf.Duration
-	27, // 27: debug_v2.DumpV2Request.defaults:type_name -> debug_v2.DumpV2Request.Defaults
-	18, // 28: debug_v2.DumpV2Request.starlark_scripts:type_name -> debug_v2.Starlark
-	20, // 29: debug_v2.DumpChunk.content:type_name -> debug_v2.DumpContent
-	21, // 30: debug_v2.DumpChunk.progress:type_name -> debug_v2.DumpProgress
-	30, // 31: debug_v2.RunPFSLoadTestRequest.branch:type_name -> pfs_v2.Branch
-	30, // 32: debug_v2.RunPFSLoadTestResponse.branch:type_name -> pfs_v2.Branch
-	28, // 33: debug_v2.RunPFSLoadTestResponse.duration:type_name -> google.protobuf.Duration
-	28, // 34: debug_v2.TraceRequest.duration:type_name -> google.protobuf.Duration
-	31, // 35: debug_v2.TraceChunk.content:type_name -> google.protobuf.BytesValue
-	1,  // 36: debug_v2.Debug.Profile:input_type -> debug_v2.ProfileRequest
-	5,  // 37: debug_v2.Debug.Binary:input_type -> debug_v2.BinaryRequest
-	6,  // 38: debug_v2.Debug.Dump:input_type -> debug_v2.DumpRequest
-	7,  // 39: debug_v2.Debug.SetLogLevel:input_type -> debug_v2.SetLogLevelRequest
-	9,  // 40: debug_v2.Debug.GetDumpV2Template:input_type -> debug_v2.GetDumpV2TemplateRequest
-	19, // 41: debug_v2.Debug.DumpV2:input_type -> debug_v2.DumpV2Request
-	25, // 42: debug_v2.Debug.Trace:input_type -> debug_v2.TraceRequest
-	23, // 43: debug_v2.Debug.RunPFSLoadTest:input_type -> debug_v2.RunPFSLoadTestRequest
-	32, // 44: debug_v2.Debug.RunPFSLoadTestDefault:input_type -> google.protobuf.Empty
-	31, // 45: debug_v2.Debug.Profile:output_type -> google.protobuf.BytesValue
-	31, // 46: debug_v2.Debug.Binary:output_type -> google.protobuf.BytesValue
-	31, // 47: debug_v2.Debug.Dump:output_type -> google.protobuf.BytesValue
-	8,  // 48: debug_v2.Debug.SetLogLevel:output_type -> debug_v2.SetLogLevelResponse
-	10, // 49: debug_v2.Debug.GetDumpV2Template:output_type -> debug_v2.GetDumpV2TemplateResponse
-	22, // 50: debug_v2.Debug.DumpV2:output_type -> debug_v2.DumpChunk
-	26, // 51: debug_v2.Debug.Trace:output_type -> debug_v2.TraceChunk
-	24, // 52: debug_v2.Debug.RunPFSLoadTest:output_type -> debug_v2.RunPFSLoadTestResponse
-	24, // 53: debug_v2.Debug.RunPFSLoadTestDefault:output_type -> debug_v2.RunPFSLoadTestResponse
-	45, // [45:54] is the sub-list for method output_type
-	36, // [36:45] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	30, // 23: debug_v2.Starlark.timeout:type_name -> google.protobuf.Duration
+	19, // 24: debug_v2.Redaction.patterns:type_name -> debug_v2.RedactionPattern
+	16, // 25: debug_v2.DumpV2Request.system:type_name -> debug_v2.System
+	11, // 26: debug_v2.DumpV2Request.pipelines:type_name -> debug_v2.Pipeline
+	30, // 27: debug_v2.DumpV2Request.timeout:type_name -> google.protobuf.Duration
+	29, // 28: debug_v2.DumpV2Request.defaults:type_name -> debug_v2.DumpV2Request.Defaults
+	18, // 29: debug_v2.DumpV2Request.starlark_scripts:type_name -> debug_v2.Starlark
+	20, // 30: debug_v2.DumpV2Request.redaction:type_name -> debug_v2.Redaction
+	22, // 31: debug_v2.DumpChunk.content:type_name -> debug_v2.DumpContent
+	23, // 32: debug_v2.DumpChunk.progress:type_name -> debug_v2.DumpProgress
+	32, // 33: debug_v2.RunPFSLoadTestRequest.branch:type_name -> pfs_v2.Branch
+	32, // 34: debug_v2.RunPFSLoadTestResponse.branch:type_name -> pfs_v2.Branch
+	30, // 35: debug_v2.RunPFSLoadTestResponse.duration:type_name -> google.protobuf.Duration
+	30, // 36: debug_v2.TraceRequest.duration:type_name -> google.protobuf.Duration
+	33, // 37: debug_v2.TraceChunk.content:type_name -> google.protobuf.BytesValue
+	1,  // 38: debug_v2.Debug.Profile:input_type -> debug_v2.ProfileRequest
+	5,  // 39: debug_v2.Debug.Binary:input_type -> debug_v2.BinaryRequest
+	6,  // 40: debug_v2.Debug.Dump:input_type -> debug_v2.DumpRequest
+	7,  // 41: debug_v2.Debug.SetLogLevel:input_type -> debug_v2.SetLogLevelRequest
+	9,  // 42: debug_v2.Debug.GetDumpV2Template:input_type -> debug_v2.GetDumpV2TemplateRequest
+	21, // 43: debug_v2.Debug.DumpV2:input_type -> debug_v2.DumpV2Request
+	27, // 44: debug_v2.Debug.Trace:input_type -> debug_v2.TraceRequest
+	25, // 45: debug_v2.Debug.RunPFSLoadTest:input_type -> debug_v2.RunPFSLoadTestRequest
+	34, // 46: debug_v2.Debug.RunPFSLoadTestDefault:input_type -> google.protobuf.Empty
+	33, // 47: debug_v2.Debug.Profile:output_type -> google.protobuf.BytesValue
+	33, // 48: debug_v2.Debug.Binary:output_type -> google.protobuf.BytesValue
+	33, // 49: debug_v2.Debug.Dump:output_type -> google.protobuf.BytesValue
+	8,  // 50: debug_v2.Debug.SetLogLevel:output_type -> debug_v2.SetLogLevelResponse
+	10, // 51: debug_v2.Debug.GetDumpV2Template:output_type -> debug_v2.GetDumpV2TemplateResponse
+	24, // 52: debug_v2.Debug.DumpV2:output_type -> debug_v2.DumpChunk
+	28, // 53: debug_v2.Debug.Trace:output_type -> debug_v2.TraceChunk
+	26, // 54: debug_v2.Debug.RunPFSLoadTest:output_type -> debug_v2.RunPFSLoadTestResponse
+	26, // 55: debug_v2.Debug.RunPFSLoadTestDefault:output_type -> debug_v2.RunPFSLoadTestResponse
+	47, // [47:56] is the sub-list for method output_type
+	38, // [38:47] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_debug_debug_proto_init() }
@@ -2491,7 +2648,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpV2Request); i {
+			switch v := v.(*RedactionPattern); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2503,7 +2660,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpContent); i {
+			switch v := v.(*Redaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2515,7 +2672,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpProgress); i {
+			switch v := v.(*DumpV2Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2527,7 +2684,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DumpChunk); i {
+			switch v := v.(*DumpContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2539,7 +2696,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunPFSLoadTestRequest); i {
+			switch v := v.(*DumpProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2551,7 +2708,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunPFSLoadTestResponse); i {
+			switch v := v.(*DumpChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2563,7 +2720,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceRequest); i {
+			switch v := v.(*RunPFSLoadTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2575,7 +2732,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceChunk); i {
+			switch v := v.(*RunPFSLoadTestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2587,6 +2744,30 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DumpV2Request_Defaults); i {
 			case 0:
 				return &v.state
@@ -2617,11 +2798,11 @@ func file_debug_debug_proto_init() {
 		(*Starlark_Builtin)(nil),
 		(*Starlark_Literal)(nil),
 	}
-	file_debug_debug_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_debug_debug_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*DumpChunk_Content)(nil),
 		(*DumpChunk_Progress)(nil),
 	}
-	file_debug_debug_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_debug_debug_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*TraceChunk_Content)(nil),
 	}
 	type x struct{}
@@ -2630,7 +2811,7 @@ func file_debug_debug_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_debug_debug_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
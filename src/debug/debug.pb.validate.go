@@ -2671,6 +2671,245 @@ var _ interface {
 	ErrorName() string
 } = StarlarkValidationError{}
 
+// Validate checks the field values on RedactionPattern with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RedactionPattern) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedactionPattern with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedactionPatternMultiError, or nil if none found.
+func (m *RedactionPattern) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedactionPattern) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Regex
+
+	if len(errors) > 0 {
+		return RedactionPatternMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedactionPatternMultiError is an error wrapping multiple validation errors
+// returned by RedactionPattern.ValidateAll() if the designated constraints
+// aren't met.
+type RedactionPatternMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedactionPatternMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedactionPatternMultiError) AllErrors() []error { return m }
+
+// RedactionPatternValidationError is the validation error returned by
+// RedactionPattern.Validate if the designated constraints aren't met.
+type RedactionPatternValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedactionPatternValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedactionPatternValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedactionPatternValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedactionPatternValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedactionPatternValidationError) ErrorName() string { return "RedactionPatternValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedactionPatternValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedactionPattern.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedactionPatternValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedactionPatternValidationError{}
+
+// Validate checks the field values on Redaction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Redaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Redaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RedactionMultiError, or nil
+// if none found.
+func (m *Redaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Redaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisableBuiltin
+
+	for idx, item := range m.GetPatterns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RedactionValidationError{
+						field:  fmt.Sprintf("Patterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RedactionValidationError{
+						field:  fmt.Sprintf("Patterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RedactionValidationError{
+					field:  fmt.Sprintf("Patterns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RedactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedactionMultiError is an error wrapping multiple validation errors returned
+// by Redaction.ValidateAll() if the designated constraints aren't met.
+type RedactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedactionMultiError) AllErrors() []error { return m }
+
+// RedactionValidationError is the validation error returned by
+// Redaction.Validate if the designated constraints aren't met.
+type RedactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedactionValidationError) ErrorName() string { return "RedactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedactionValidationError{}
+
 // Validate checks the field values on DumpV2Request with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2850,6 +3089,35 @@ func (m *DumpV2Request) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetRedaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DumpV2RequestValidationError{
+					field:  "Redaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DumpV2RequestValidationError{
+					field:  "Redaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DumpV2RequestValidationError{
+				field:  "Redaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DumpV2RequestMultiError(errors)
 	}
@@ -248,6 +248,37 @@ func (x *Starlark) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+func (x *RedactionPattern) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("regex", x.Regex)
+	return nil
+}
+
+func (x *Redaction) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddBool("disable_builtin", x.DisableBuiltin)
+	patternsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Patterns {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("patterns", zapcore.ArrayMarshalerFunc(patternsArrMarshaller))
+	fieldsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Fields {
+			enc.AppendString(v)
+		}
+		return nil
+	}
+	enc.AddArray("fields", zapcore.ArrayMarshalerFunc(fieldsArrMarshaller))
+	return nil
+}
+
 func (x *DumpV2Request) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -270,6 +301,7 @@ func (x *DumpV2Request) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		return nil
 	}
 	enc.AddArray("starlark_scripts", zapcore.ArrayMarshalerFunc(starlark_scriptsArrMarshaller))
+	enc.AddObject("redaction", x.Redaction)
 	return nil
 }
 
@@ -5,8 +5,8 @@ package archiveserver
 
 import (
 	"archive/tar"
-	"archive/zip"
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/constants"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
@@ -44,16 +45,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		http.Error(w, fmt.Sprintf("invalid URL: %v", err), http.StatusBadRequest)
 		return
 	}
-	// For now we make no effort to be flexible about the output format.  downloadZip would need
-	// to be refactored, because 99% of it is not related to the ZIP format.
-	if got, want := archive.Format, ArchiveFormatZip; got != want {
-		log.Debug(ctx, "unsupported archive format requested", zap.String("format", string(got)))
-		http.Error(w, fmt.Sprintf("unknown archive format %v requested; only .zip is supported", string(got)), http.StatusBadRequest)
-		return
-	}
 
 	pachClient := s.pachClientFromRequest(ctx, req)
-	if err := s.downloadZip(pachClient.Ctx(), w, pachClient, archive); err != nil {
+	var getTAR tarGetter = func(ctx context.Context, file *pfs.File) (io.ReadCloser, error) {
+		return pachClient.WithCtx(ctx).GetFileTAR(file.Commit, file.Path)
+	}
+	if token := req.URL.Query().Get(constants.ShareLinkKey); token != "" {
+		var code int
+		if getTAR, code, err = shareLinkTAR(pachClient.Ctx(), pachClient, archive, token); err != nil {
+			log.Debug(ctx, "invalid share link", zap.Error(err))
+			http.Error(w, err.Error(), code)
+			return
+		}
+	}
+	if err := s.download(pachClient.Ctx(), w, pachClient, archive, getTAR); err != nil {
 		log.Info(ctx, "problem encountered mid-download", zap.Error(err))
 		return
 	}
@@ -85,8 +90,13 @@ func (w *writeFlusher) Write(p []byte) (int, error) {
 	return n, nil
 }
 
-func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachClient *client.APIClient, req *ArchiveRequest) (retErr error) {
-	ctx, done := log.SpanContext(ctx, "downloadZip")
+// tarGetter returns a TAR stream of a file or directory, like GetFileTAR.
+type tarGetter func(ctx context.Context, file *pfs.File) (io.ReadCloser, error)
+
+// download writes an archive of the requested files in the requested format to rw.  Each file is
+// streamed from getTAR into the archive as it arrives.
+func (s *Server) download(ctx context.Context, rw http.ResponseWriter, pachClient *client.APIClient, req *ArchiveRequest, getTAR tarGetter) (retErr error) {
+	ctx, done := log.SpanContext(ctx, "download")
 	defer done(log.Errorp(&retErr))
 
 	// Make sure we don't have to buffer the entire response; this should always be ok.
@@ -102,9 +112,13 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 			meters.Inc(ctx, "archive_download_tx_bytes", i)
 		},
 	}
-	// Create a ZIP writer backed by a (chunked) buffer.
+	// Create an archive writer backed by a (chunked) buffer.
 	bw := bufio.NewWriterSize(wf, units.MB) // Send an HTTP chunk this often.
-	zw := zip.NewWriter(bw)
+	aw, err := newArchiveWriter(req.Format, bw)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return err
+	}
 
 	// Setup headers for a download based on the current time.
 	now := time.Now()
@@ -168,7 +182,7 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 		return nil
 	})
 
-	// Now try to download the resolved files, appending each to the ZIP.
+	// Now try to download the resolved files, appending each to the archive.
 	var downloadErrs error
 	if resolveErr == nil {
 		for _, file := range files {
@@ -178,9 +192,9 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 				defer done(log.Errorp(&retErr))
 
 				// Ask pachyderm for this file (or directory).
-				tr, err := pachClient.WithCtx(ctx).GetFileTAR(file.Commit, file.Path)
+				tr, err := getTAR(ctx, file)
 				if err != nil {
-					return errors.Wrapf(err, "path %v: start GetFileTAR", path)
+					return errors.Wrapf(err, "path %v: start TAR download", path)
 				}
 				defer errors.Close(&retErr, tr, "close tar reader")
 
@@ -197,29 +211,25 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 						return errors.Wrapf(err, "path %v: read TAR header", path)
 					}
 
-					// Skip directories, as they do not need to be in the resulting ZIP.
-					if h.Typeflag == tar.TypeDir {
-						continue
-					}
-
 					// Show what files we're putting in the archive, in case things go awry.
 					log.Debug(ctx, "got file", zap.String("file", h.Name), zap.String("for_path", path))
 
 					// Create a path in the archive <project>/<repo>/commit/<actual
 					// path, including directories>.
-					zp := filepath.Join(file.Commit.Repo.Project.Name, file.Commit.Repo.Name, file.Commit.Id, h.Name)
-					w, err := zw.CreateHeader(&zip.FileHeader{
-						Name:     zp,
-						Method:   zip.Deflate,
-						Modified: now,
-					})
-					if err != nil {
-						return errors.Wrapf(err, "create zip path %v (for %v in %v)", zp, h.Name, path)
+					ap := filepath.Join(file.Commit.Repo.Project.Name, file.Commit.Repo.Name, file.Commit.Id, h.Name)
+					ah := archiveHeader(h, ap, now)
+					if err := aw.WriteHeader(ah); err != nil {
+						return errors.Wrapf(err, "create archive path %v (for %v in %v)", ap, h.Name, path)
 					}
-					// Copy the data for this file into the zip.
-					n, err := io.Copy(w, r)
+					// Copy the data for this file into the archive.
+					n, err := io.Copy(aw, r)
 					if err != nil {
-						return errors.Wrapf(err, "write data for zip path %v (for %v in %v)", zp, h.Name, path)
+						// A TAR entry must be as long as its header says, or nothing
+						// after it (like @error.txt) can be read; pad it out.
+						if _, perr := io.CopyN(aw, zeros{}, ah.Size-n); perr != nil {
+							errors.JoinInto(&err, errors.Wrap(perr, "pad truncated file"))
+						}
+						return errors.Wrapf(err, "write data for archive path %v (for %v in %v)", ap, h.Name, path)
 					}
 					meters.Inc(ctx, "archive_download_added_bytes", n)
 				}
@@ -230,58 +240,45 @@ func (s *Server) downloadZip(ctx context.Context, rw http.ResponseWriter, pachCl
 		}
 	}
 
-	// If there is an error generated by a per-file callback, try writing it to a file called
-	// @error.txt and then completing the ZIP normally.  We'll return this error after flushing
-	// the ZIP, so that logs indiciate an error, but the user will have a valid partial ZIP to
-	// look at.
-	//
-	// We choose the name @error.txt because PFS cannot contain a file called @error.txt, so no
-	// confusion with actual files is possible.  (Note that the root directory contains
-	// projects, and you can't call a project errors.txt either, but the @ hopefully draws
-	// attention to the problem.)
-	var w io.Writer
+	// If there is an error generated by a per-file callback, try writing it to @error.txt and
+	// then completing the archive normally.  We'll return this error after flushing the archive,
+	// so that logs indiciate an error, but the user will have a valid partial archive to look at.
+	var msg bytes.Buffer
 	var finalErr error
-	if resolveErr != nil || downloadErrs != nil {
-		// If there are errors to write out, create @error.txt in the ZIP file.
-		var zerr error
-		w, zerr = zw.CreateHeader(&zip.FileHeader{
-			Name:     "@error.txt",
-			Method:   zip.Store, // So the actual bytes of the error appear on the wire.
-			Modified: now,
-		})
-		if zerr != nil {
-			// Now we have the exciting situation of an error while handling the error.
-			// Bail out with both errors; print both to the HTTP stream (sorry ZIP
-			// enjoyers), and return an error containing the text of each.
-			fmt.Fprintf(bw, "\n\ncreate @error.txt: %v\n\ncaused by: %v\n", zerr, resolveErr)
-			bw.Flush() //nolint:errcheck
-			return errors.Errorf("create @error.txt: %v; caused by %v", zerr, resolveErr)
-		}
-	}
 	if resolveErr != nil {
-		if _, werr := fmt.Fprintf(w, "%v\n", resolveErr); werr != nil {
-			// See above; error handling the error.
-			fmt.Fprintf(bw, "\n\nwrite @error.txt: %v\n\ncaused by: %v\n", werr, resolveErr)
-			bw.Flush() //nolint:errcheck
-			return errors.Errorf("write @error.txt: %v; caused by %v", werr, resolveErr)
-		}
-
+		fmt.Fprintf(&msg, "%v\n", resolveErr)
 		// We will eventually return finalErr to the caller.
 		errors.JoinInto(&finalErr, errors.Wrap(resolveErr, "resolve files (reported via @error.txt)"))
 	}
 	if downloadErrs != nil {
-		if _, werr := fmt.Fprintf(w, "%v\n", downloadErrs); werr != nil {
-			// Error handling the error, bail out.
-			fmt.Fprintf(bw, "\n\nwrite @error.txt: %v\n\ncaused by: %v\n", werr, downloadErrs)
+		fmt.Fprintf(&msg, "%v\n", downloadErrs)
+		errors.JoinInto(&finalErr, errors.Wrap(downloadErrs, "download files (reported via @error.txt)"))
+	}
+	if finalErr != nil {
+		// The header has to be written before the content, and TAR needs the size up front.
+		err := aw.WriteHeader(&tar.Header{
+			Name:     errorFile,
+			Typeflag: tar.TypeReg,
+			Size:     int64(msg.Len()),
+			Mode:     0o644,
+			ModTime:  now,
+		})
+		if err == nil {
+			_, err = aw.Write(msg.Bytes())
+		}
+		if err != nil {
+			// Now we have the exciting situation of an error while handling the error.
+			// Bail out with both errors; print both to the HTTP stream (sorry archive
+			// enjoyers), and return an error containing the text of each.
+			fmt.Fprintf(bw, "\n\nwrite %v: %v\n\ncaused by: %v\n", errorFile, err, finalErr)
 			bw.Flush() //nolint:errcheck
-			return errors.Errorf("write @error.txt: %v; cause by %v", werr, downloadErrs)
+			return errors.Errorf("write %v: %v; caused by %v", errorFile, err, finalErr)
 		}
-		errors.JoinInto(&finalErr, errors.Wrap(downloadErrs, "download files (reported via @error.txt)"))
 	}
 
-	// Finish the ZIP file.
-	if err := zw.Close(); err != nil {
-		return errors.Wrapf(err, "zip.Writer.Close()")
+	// Finish the archive.
+	if err := aw.Close(); err != nil {
+		return errors.Wrap(err, "close archive")
 	}
 
 	// Flush any data in the buffered writer.
@@ -15,12 +15,16 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -99,6 +103,40 @@ func (fakePFS) InspectBranch(ctx context.Context, req *pfs.InspectBranchRequest,
 	return nil, errors.New("not found")
 }
 
+const fakeShareLinkToken = "secret"
+
+func (fakePFS) InspectShareLink(ctx context.Context, req *pfs.InspectShareLinkRequest, opts ...grpc.CallOption) (*pfs.ShareLinkInfo, error) {
+	if req.Token != fakeShareLinkToken {
+		return nil, status.Error(codes.NotFound, "share link not found")
+	}
+	repo := &pfs.Repo{Name: "images", Type: pfs.UserRepoType, Project: &pfs.Project{Name: "default"}}
+	return &pfs.ShareLinkInfo{
+		File:     repo.NewCommit("", fakeCommit).NewFile("/"),
+		FileType: pfs.FileType_DIR,
+	}, nil
+}
+
+func (f fakePFS) GetShareLink(ctx context.Context, req *pfs.GetShareLinkRequest, opts ...grpc.CallOption) (pfs.API_GetShareLinkClient, error) {
+	info, err := f.InspectShareLink(ctx, &pfs.InspectShareLinkRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+	c, err := f.GetFileTAR(ctx, &pfs.GetFileRequest{File: info.File})
+	if err != nil {
+		return nil, err
+	}
+	return c.(*getFileTARClient), nil
+}
+
+// mustEncodeV1 returns the encoded part of an archive URL that downloads paths.
+func mustEncodeV1(paths ...string) string {
+	result, err := EncodeV1(paths)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
 // so TestHTTP and FuzzHTTP can share the implementation
 func doTest(t *testing.T, method, url string) (int, *bytes.Buffer) {
 	fake := &client.APIClient{}
@@ -120,6 +158,65 @@ func doTest(t *testing.T, method, url string) (int, *bytes.Buffer) {
 	return rec.Code, rec.Body
 }
 
+// readArchive returns the content of each file in an archive, and the mode of each entry.
+func readArchive(format ArchiveFormat, bs []byte) (map[string]string, map[string]int64, error) {
+	files := map[string]string{}
+	modes := map[string]int64{}
+	if format == ArchiveFormatZip {
+		r, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs))) // zip needs a ReaderAt.
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "create zip reader")
+		}
+		for _, fileinfo := range r.File {
+			file, err := r.Open(fileinfo.Name)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "open %v", fileinfo.Name)
+			}
+			buf := new(bytes.Buffer)
+			if _, err := io.Copy(buf, file); err != nil {
+				return nil, nil, errors.Wrapf(err, "read %v", fileinfo.Name)
+			}
+			files[fileinfo.Name] = buf.String()
+		}
+		return files, nil, nil
+	}
+	var tr io.Reader = bytes.NewReader(bs)
+	switch format {
+	case ArchiveFormatTarGzip:
+		gr, err := gzip.NewReader(tr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "create gzip reader")
+		}
+		tr = gr
+	case ArchiveFormatTarZstd:
+		zr, err := zstd.NewReader(tr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "create zstd reader")
+		}
+		defer zr.Close()
+		tr = zr
+	}
+	r := tar.NewReader(tr)
+	for {
+		h, err := r.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return files, modes, nil
+			}
+			return nil, nil, errors.Wrap(err, "read tar header")
+		}
+		modes[h.Name] = h.Mode
+		if h.Typeflag == tar.TypeDir {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if _, err := io.Copy(buf, r); err != nil {
+			return nil, nil, errors.Wrapf(err, "read %v", h.Name)
+		}
+		files[h.Name] = buf.String()
+	}
+}
+
 // These URLs should be fetched by both TestHTTP (deep validation) and FuzzHTTP (good starting
 // points for fuzzing).
 var testData = []struct {
@@ -128,6 +225,7 @@ var testData = []struct {
 	url       string
 	wantCode  int
 	wantFiles map[string]string
+	wantModes map[string]int64 // Only checked for TAR formats.
 }{
 	{
 		name:     "unknown route",
@@ -182,6 +280,81 @@ var testData = []struct {
 			"default/montage/44444444444444444444444444444444/montage.png": "beautiful artwork is here",
 		},
 	},
+	{
+		name:     "tar download with some content",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/ASi1L_0EaHUBAEQCZGVmYXVsdC9pbWFnZXNAbWFzdGVyOi8AbW9udGFnZS5wbmcAAxQEBQPYsGPLbFDb.tar",
+		wantCode: http.StatusOK,
+		wantFiles: map[string]string{
+			"default/images/44444444444444444444444444444444/hello.txt":         "hello",
+			"default/images/44444444444444444444444444444444/a/nested/file.txt": "i'm nested!",
+			"default/montage/44444444444444444444444444444444/montage.png":      "beautiful artwork is here",
+		},
+		wantModes: map[string]int64{
+			"default/images/44444444444444444444444444444444/hello.txt":         0o644,
+			"default/images/44444444444444444444444444444444/a/":                0o755,
+			"default/images/44444444444444444444444444444444/a/nested/":         0o755,
+			"default/images/44444444444444444444444444444444/a/nested/file.txt": 0o644,
+			"default/montage/44444444444444444444444444444444/montage.png":      0o644,
+		},
+	},
+	{
+		name:     "tar.gz download with some content",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/ASi1L_0EaL0BAIQCZGVmYXVsdC9pbWFnZXNANDovaGVsbG8udHh0AG1vbnRhZ2UucG5nAAQATRHgK2e8IpIGLAGgJI8S.tar.gz",
+		wantCode: http.StatusOK,
+		wantFiles: map[string]string{
+			"default/images/44444444444444444444444444444444/hello.txt":    "hello",
+			"default/montage/44444444444444444444444444444444/montage.png": "beautiful artwork is here",
+		},
+	},
+	{
+		name:     "tar.zst download with some content",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/ASi1L_0EaL0BAIQCZGVmYXVsdC9pbWFnZXNANDovaGVsbG8udHh0AG1vbnRhZ2UucG5nAAQATRHgK2e8IpIGLAGgJI8S.tar.zst",
+		wantCode: http.StatusOK,
+		wantFiles: map[string]string{
+			"default/images/44444444444444444444444444444444/hello.txt":    "hello",
+			"default/montage/44444444444444444444444444444444/montage.png": "beautiful artwork is here",
+		},
+	},
+	{
+		name:     "tar download with an error reading files",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/ASi1L_0EaPkAAGRlZmF1bHQvdGVzdEBtYXN0ZXI6L2Vycm9yLnR4dABwDhIY.tar",
+		wantCode: http.StatusOK,
+		wantFiles: map[string]string{
+			"@error.txt": "path default/test@=44444444444444444444444444444444:/error.txt: read TAR header: error reading from the server\n",
+		},
+	},
+	{
+		name:     "share link download",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/" + mustEncodeV1("default/images@"+fakeCommit+":/") + ".zip?share=" + fakeShareLinkToken,
+		wantCode: http.StatusOK,
+		wantFiles: map[string]string{
+			"default/images/44444444444444444444444444444444/hello.txt":         "hello",
+			"default/images/44444444444444444444444444444444/a/nested/file.txt": "i'm nested!",
+		},
+	},
+	{
+		name:     "share link for other files",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/" + mustEncodeV1("default/images@"+fakeCommit+":/hello.txt") + ".zip?share=" + fakeShareLinkToken,
+		wantCode: http.StatusForbidden,
+	},
+	{
+		name:     "share link for a branch",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/" + mustEncodeV1("default/images@master:/") + ".zip?share=" + fakeShareLinkToken,
+		wantCode: http.StatusForbidden,
+	},
+	{
+		name:     "unknown share link",
+		method:   "GET",
+		url:      "https://pachyderm.example.com/archive/" + mustEncodeV1("default/images@"+fakeCommit+":/") + ".zip?share=nope",
+		wantCode: http.StatusNotFound,
+	},
 	{
 		name:     "download with an error reading files",
 		method:   "GET",
@@ -202,26 +375,26 @@ func TestHTTP(t *testing.T) {
 			}
 
 			if test.wantFiles != nil {
-				bs := body.Bytes() // zip needs a ReaderAt, which Body isn't.
-				r, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs)))
+				u, err := url.Parse(test.url)
 				if err != nil {
-					t.Fatalf("create zip reader: %v", err)
+					t.Fatalf("parse url: %v", err)
 				}
-				got := map[string]string{}
-				for _, fileinfo := range r.File {
-					file, err := r.Open(fileinfo.Name)
-					if err != nil {
-						t.Fatalf("open %v: %v", file, err)
-					}
-					buf := new(bytes.Buffer)
-					if _, err := io.Copy(buf, file); err != nil {
-						t.Fatalf("read %v: %v", file, err)
-					}
-					got[fileinfo.Name] = buf.String()
+				req, err := ArchiveFromURL(u)
+				if err != nil {
+					t.Fatalf("ArchiveFromURL: %v", err)
+				}
+				got, gotModes, err := readArchive(req.Format, body.Bytes())
+				if err != nil {
+					t.Fatalf("read archive: %v", err)
 				}
 				if diff := cmp.Diff(got, test.wantFiles); diff != "" {
 					t.Errorf("downloaded files (-got +want):\n%s", diff)
 				}
+				if test.wantModes != nil {
+					if diff := cmp.Diff(gotModes, test.wantModes); diff != "" {
+						t.Errorf("modes (-got +want):\n%s", diff)
+					}
+				}
 			}
 		})
 	}
@@ -245,22 +418,16 @@ func FuzzHTTP(f *testing.F) {
 		code, body := doTest(t, "GET", u)
 		if code == http.StatusOK && isArchive && body.Len() > 0 {
 			// If the code is OK and the URL starts with /archive/, then there should
-			// be either nothing, or a zip.  Assert that the ZIP is readable.
+			// be either nothing, or an archive.  Assert that the archive is readable.
 			bs := body.Bytes()
-			t.Logf("potential zip bytes: %x %s", bs, bs)
+			t.Logf("potential archive bytes: %x %s", bs, bs)
 
-			r, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs)))
+			req, err := ArchiveFromURL(up)
 			if err != nil {
-				t.Fatalf("zip.NewReader: %v", err)
+				t.Fatalf("ArchiveFromURL: %v", err)
 			}
-			for _, fileinfo := range r.File {
-				file, err := r.Open(fileinfo.Name)
-				if err != nil {
-					t.Fatalf("open(%v): %v", fileinfo.Name, err)
-				}
-				if _, err := io.Copy(io.Discard, file); err != nil {
-					t.Fatalf("read error(%v): %v", fileinfo.Name, err)
-				}
+			if _, _, err := readArchive(req.Format, bs); err != nil {
+				t.Fatalf("read archive: %v", err)
 			}
 		}
 	})
@@ -11,7 +11,10 @@ import (
 type ArchiveFormat string
 
 const (
-	ArchiveFormatZip ArchiveFormat = "zip" // A ZIP file.
+	ArchiveFormatZip     ArchiveFormat = "zip"     // A ZIP file.
+	ArchiveFormatTar     ArchiveFormat = "tar"     // An uncompressed TAR file.
+	ArchiveFormatTarGzip ArchiveFormat = "tar.gz"  // A gzip-compressed TAR file.
+	ArchiveFormatTarZstd ArchiveFormat = "tar.zst" // A zstd-compressed TAR file.
 )
 
 func (f ArchiveFormat) ContentType() string {
@@ -19,6 +22,12 @@ func (f ArchiveFormat) ContentType() string {
 	switch f {
 	case ArchiveFormatZip:
 		return "application/zip"
+	case ArchiveFormatTar:
+		return "application/x-tar"
+	case ArchiveFormatTarGzip:
+		return "application/gzip"
+	case ArchiveFormatTarZstd:
+		return "application/zstd"
 	}
 	panic("unknown archive format")
 }
@@ -50,11 +59,11 @@ func ArchiveFromURL(u *url.URL) (*ArchiveRequest, error) {
 		return nil, errors.New("no extension on provided archive filename")
 	}
 	rawFormat := fileParts[1]
-	switch ArchiveFormat(rawFormat) {
-	case ArchiveFormatZip:
+	switch f := ArchiveFormat(rawFormat); f {
+	case ArchiveFormatZip, ArchiveFormatTar, ArchiveFormatTarGzip, ArchiveFormatTarZstd:
 		return &ArchiveRequest{
 			rawFiles: fileParts[0],
-			Format:   ArchiveFormatZip,
+			Format:   f,
 		}, nil
 	}
 	return nil, errors.Errorf("unknown archive format %v", rawFormat)
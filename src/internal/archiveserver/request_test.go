@@ -50,9 +50,21 @@ func TestArchiveRequest(t *testing.T) {
 		},
 		{
 			name:    "unsupported extension",
-			url:     "https://pachyderm.example.com/archive/AQ.tar",
+			url:     "https://pachyderm.example.com/archive/AQ.tar.bz2",
 			wantErr: true,
 		},
+		{
+			name: "tar",
+			url:  "https://pachyderm.example.com/archive/AQ.tar",
+		},
+		{
+			name: "tar.gz",
+			url:  "https://pachyderm.example.com/archive/AQ.tar.gz",
+		},
+		{
+			name: "tar.zst",
+			url:  "https://pachyderm.example.com/archive/AQ.tar.zst",
+		},
 		{
 			name: "doc example",
 			url:  "https://pachyderm.example.com/archive/ASi1L_0EaHUBAEQCZGVmYXVsdC9pbWFnZXNAbWFzdGVyOi8AbW9udGFnZS5wbmcAAxQEBQPYsGPLbFDb.zip",
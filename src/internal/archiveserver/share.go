@@ -0,0 +1,47 @@
+package archiveserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shareLinkTAR checks that the share link with the given token shares exactly what req asks for,
+// and returns a tarGetter that downloads it through the link.  The token is all the authentication
+// the download needs.  On error, it also returns the HTTP status code to report.
+func shareLinkTAR(ctx context.Context, pachClient *client.APIClient, req *ArchiveRequest, token string) (tarGetter, int, error) {
+	info, err := pachClient.PfsAPIClient.InspectShareLink(ctx, &pfs.InspectShareLinkRequest{Token: token})
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.NotFound:
+			code = http.StatusNotFound
+		case codes.PermissionDenied:
+			code = http.StatusForbidden
+		}
+		return nil, code, errors.Wrap(err, "inspect share link")
+	}
+	var files []*pfs.File
+	if err := req.ForEachPath(func(path string) error {
+		file, err := DecodeV1Path(path)
+		if err != nil {
+			return errors.Wrapf(err, "path %v: decode", path)
+		}
+		files = append(files, file)
+		return nil
+	}); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if len(files) != 1 || !info.Shares(files[0]) {
+		return nil, http.StatusForbidden, errors.New("the share link does not share the requested files")
+	}
+	return func(ctx context.Context, _ *pfs.File) (io.ReadCloser, error) {
+		return client.GetShareLink(ctx, pachClient.PfsAPIClient, token)
+	}, 0, nil
+}
@@ -0,0 +1,143 @@
+package archiveserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"time"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// errorFile is the name of the file in an archive that describes any errors encountered while
+// building it.  PFS cannot contain a file called @error.txt, so no confusion with actual files is
+// possible.  (Note that the root directory contains projects, and you can't call a project
+// error.txt either, but the @ hopefully draws attention to the problem.)
+const errorFile = "@error.txt"
+
+// An archiveWriter writes an archive of files.  It has the same interface as tar.Writer: each
+// entry is started by WriteHeader, and its content follows with Write.
+type archiveWriter interface {
+	WriteHeader(h *tar.Header) error
+	io.Writer
+	// Close finishes the archive, but does not close the underlying writer.
+	Close() error
+}
+
+// newArchiveWriter returns an archiveWriter that writes an archive of the given format to w.  Every
+// format is written as the files arrive, so nothing is buffered beyond what compression needs.
+func newArchiveWriter(format ArchiveFormat, w io.Writer) (archiveWriter, error) {
+	//exhaustive:enforce
+	switch format {
+	case ArchiveFormatZip:
+		return &zipWriter{zw: zip.NewWriter(w)}, nil
+	case ArchiveFormatTar:
+		return tar.NewWriter(w), nil
+	case ArchiveFormatTarGzip:
+		gw := gzip.NewWriter(w)
+		return &compressedTarWriter{Writer: tar.NewWriter(gw), compressor: gw}, nil
+	case ArchiveFormatTarZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "zstd.NewWriter")
+		}
+		return &compressedTarWriter{Writer: tar.NewWriter(zw), compressor: zw}, nil
+	}
+	return nil, errors.Errorf("unknown archive format %v", format)
+}
+
+// archiveHeader returns the header for an entry in the archive at name, based on h, which came from
+// GetFileTAR.  PFS doesn't record modes or modification times, so files get the usual defaults and
+// the time of the download.
+func archiveHeader(h *tar.Header, name string, now time.Time) *tar.Header {
+	result := &tar.Header{
+		Name:     name,
+		Typeflag: tar.TypeReg,
+		Size:     h.Size,
+		Mode:     h.Mode,
+		ModTime:  h.ModTime,
+		Format:   tar.FormatPAX, // Long paths are common in PFS.
+	}
+	if h.Typeflag == tar.TypeDir {
+		result.Typeflag = tar.TypeDir
+		result.Name += "/"
+		result.Size = 0
+	}
+	if result.Mode == 0 {
+		result.Mode = 0o644
+		if result.Typeflag == tar.TypeDir {
+			result.Mode = 0o755
+		}
+	}
+	if result.ModTime.IsZero() {
+		result.ModTime = now
+	}
+	return result
+}
+
+// zipWriter is an archiveWriter that writes a ZIP file.
+type zipWriter struct {
+	zw  *zip.Writer
+	cur io.Writer
+}
+
+// WriteHeader implements archiveWriter.  Directories are skipped, as they do not need to be in a
+// ZIP.
+func (w *zipWriter) WriteHeader(h *tar.Header) error {
+	if h.Typeflag == tar.TypeDir {
+		w.cur = io.Discard
+		return nil
+	}
+	method := zip.Deflate
+	if h.Name == errorFile {
+		method = zip.Store // So the actual bytes of the error appear on the wire.
+	}
+	cur, err := w.zw.CreateHeader(&zip.FileHeader{
+		Name:     h.Name,
+		Method:   method,
+		Modified: h.ModTime,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create zip path %v", h.Name)
+	}
+	w.cur = cur
+	return nil
+}
+
+// Write implements io.Writer.
+func (w *zipWriter) Write(p []byte) (int, error) {
+	if w.cur == nil {
+		return 0, errors.New("write before WriteHeader")
+	}
+	return w.cur.Write(p) //nolint:wrapcheck
+}
+
+// Close implements archiveWriter.
+func (w *zipWriter) Close() error {
+	return errors.Wrap(w.zw.Close(), "zip.Writer.Close()")
+}
+
+// compressedTarWriter is an archiveWriter that writes a compressed TAR file.
+type compressedTarWriter struct {
+	*tar.Writer
+	compressor io.WriteCloser
+}
+
+// Close implements archiveWriter.
+func (w *compressedTarWriter) Close() error {
+	if err := w.Writer.Close(); err != nil {
+		return errors.Wrap(err, "tar.Writer.Close()")
+	}
+	return errors.Wrap(w.compressor.Close(), "close compressor")
+}
+
+// zeros is an io.Reader of endless zero bytes.
+type zeros struct{}
+
+// Read implements io.Reader.
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,150 @@
+package authdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+const (
+	insertAuditEvents = `insert into auth.audit_events (time, principal, method, resource, request, code, error, duration_ms)
+		select time, principal, method, resource, request::jsonb, code, error, duration_ms
+		from unnest($1::timestamptz[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[], $8::bigint[])
+			as e(time, principal, method, resource, request, code, error, duration_ms)`
+	selectAuditEvents = `select id, time, principal, method, resource, request, code, error, duration_ms
+		from auth.audit_events`
+	deleteOldAuditEvents = `delete from auth.audit_events where time < $1`
+)
+
+type auditEventRecord struct {
+	ID         int64     `db:"id"`
+	Time       time.Time `db:"time"`
+	Principal  string    `db:"principal"`
+	Method     string    `db:"method"`
+	Resource   string    `db:"resource"`
+	Request    string    `db:"request"`
+	Code       string    `db:"code"`
+	Error      string    `db:"error"`
+	DurationMS int64     `db:"duration_ms"`
+}
+
+func (r *auditEventRecord) toEvent() *auth.AuditEvent {
+	return &auth.AuditEvent{
+		Id:        r.ID,
+		Time:      timestamppb.New(r.Time),
+		Principal: r.Principal,
+		Method:    r.Method,
+		Resource:  r.Resource,
+		Request:   r.Request,
+		Code:      r.Code,
+		Error:     r.Error,
+		Duration:  durationpb.New(time.Duration(r.DurationMS) * time.Millisecond),
+	}
+}
+
+// InsertAuditEvents records audit events in a single statement.  The events'
+// ids are ignored, and their times default to now if they're unset.
+func InsertAuditEvents(ctx context.Context, db *pachsql.DB, evs ...*auth.AuditEvent) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	// The events are passed as one array per column.
+	var (
+		now                                                   = time.Now()
+		times                                                 []time.Time
+		principals, methods, resources, requests, codes, errs []string
+		durations                                             []int64
+	)
+	for _, ev := range evs {
+		t := now
+		if ev.Time != nil {
+			t = ev.Time.AsTime()
+		}
+		request := ev.Request
+		if request == "" {
+			request = "{}"
+		}
+		times = append(times, t)
+		principals = append(principals, ev.Principal)
+		methods = append(methods, ev.Method)
+		resources = append(resources, ev.Resource)
+		requests = append(requests, request)
+		codes = append(codes, ev.Code)
+		errs = append(errs, ev.Error)
+		durations = append(durations, ev.Duration.AsDuration().Milliseconds())
+	}
+	if _, err := db.ExecContext(ctx, insertAuditEvents, times, principals, methods, resources,
+		requests, codes, errs, durations); err != nil {
+		return errors.Wrap(err, "insert audit events")
+	}
+	return nil
+}
+
+// ListAuditEvents calls cb with each of the audit events matching req, newest
+// first.
+func ListAuditEvents(ctx context.Context, db *pachsql.DB, req *auth.ListAuditEventsRequest, cb func(*auth.AuditEvent) error) (retErr error) {
+	var conds []string
+	var args []any
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if req.Since != nil {
+		where("time >= $%d", req.Since.AsTime())
+	}
+	if req.Until != nil {
+		where("time < $%d", req.Until.AsTime())
+	}
+	if req.Principal != "" {
+		where("principal = $%d", req.Principal)
+	}
+	if req.Method != "" {
+		where("strpos(method, $%d) > 0", req.Method)
+	}
+	if req.Resource != "" {
+		where("strpos(resource, $%d) > 0", req.Resource)
+	}
+	query := selectAuditEvents
+	if len(conds) > 0 {
+		query += " where " + strings.Join(conds, " and ")
+	}
+	query += " order by time desc, id desc"
+	if req.Limit > 0 {
+		args = append(args, req.Limit)
+		query += fmt.Sprintf(" limit $%d", len(args))
+	}
+	rows, err := db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "list audit events")
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		var r auditEventRecord
+		if err := rows.StructScan(&r); err != nil {
+			return errors.Wrap(err, "scan audit event")
+		}
+		if err := cb(r.toEvent()); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(rows.Err(), "iterate over audit events")
+}
+
+// DeleteAuditEventsBefore deletes the audit events recorded before t, and
+// returns the number deleted.
+func DeleteAuditEventsBefore(ctx context.Context, db *pachsql.DB, t time.Time) (int64, error) {
+	res, err := db.ExecContext(ctx, deleteOldAuditEvents, t)
+	if err != nil {
+		return 0, errors.Wrap(err, "delete old audit events")
+	}
+	n, err := res.RowsAffected()
+	return n, errors.Wrap(err, "rows affected")
+}
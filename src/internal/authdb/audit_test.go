@@ -0,0 +1,67 @@
+package authdb_test
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestAuditEvents(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewMigratedTestDB(t, clusterstate.DesiredClusterState)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	evs := []*auth.AuditEvent{
+		{Principal: "user:alice", Method: "/pfs_v2.API/CreateRepo", Resource: "default/images", Code: "OK"},
+		{Principal: "user:alice", Method: "/pps_v2.API/CreatePipeline", Resource: "default/edges", Request: `{"update":true}`, Code: "OK"},
+		{Principal: "user:bob", Method: "/pfs_v2.API/DeleteRepo", Resource: "default/images", Code: "PermissionDenied", Error: "not authorized"},
+	}
+	for i, ev := range evs {
+		ev.Time = timestamppb.New(start.Add(time.Duration(i) * time.Hour))
+		ev.Duration = durationpb.New(time.Duration(i+1) * time.Millisecond)
+	}
+	require.NoError(t, authdb.InsertAuditEvents(ctx, db, evs[0]))
+	require.NoError(t, authdb.InsertAuditEvents(ctx, db, evs[1:]...))
+
+	list := func(req *auth.ListAuditEventsRequest) []string {
+		var methods []string
+		require.NoError(t, authdb.ListAuditEvents(ctx, db, req, func(ev *auth.AuditEvent) error {
+			methods = append(methods, ev.Method)
+			return nil
+		}))
+		return methods
+	}
+	require.Equal(t, []string{"/pfs_v2.API/DeleteRepo", "/pps_v2.API/CreatePipeline", "/pfs_v2.API/CreateRepo"}, list(&auth.ListAuditEventsRequest{}))
+	require.Equal(t, []string{"/pps_v2.API/CreatePipeline", "/pfs_v2.API/CreateRepo"}, list(&auth.ListAuditEventsRequest{Principal: "user:alice"}))
+	require.Equal(t, []string{"/pfs_v2.API/DeleteRepo", "/pfs_v2.API/CreateRepo"}, list(&auth.ListAuditEventsRequest{Method: "pfs_v2.", Resource: "images"}))
+	require.Equal(t, []string{"/pps_v2.API/CreatePipeline"}, list(&auth.ListAuditEventsRequest{
+		Since: timestamppb.New(start.Add(time.Hour)),
+		Until: timestamppb.New(start.Add(2 * time.Hour)),
+	}))
+	require.Equal(t, []string{"/pfs_v2.API/DeleteRepo"}, list(&auth.ListAuditEventsRequest{Limit: 1}))
+
+	var got *auth.AuditEvent
+	require.NoError(t, authdb.ListAuditEvents(ctx, db, &auth.ListAuditEventsRequest{Principal: "user:bob"}, func(ev *auth.AuditEvent) error {
+		got = ev
+		return nil
+	}))
+	require.Equal(t, "PermissionDenied", got.Code)
+	require.Equal(t, "not authorized", got.Error)
+	require.Equal(t, "{}", got.Request)
+	require.Equal(t, 3*time.Millisecond, got.Duration.AsDuration())
+	require.True(t, start.Add(2*time.Hour).Equal(got.Time.AsTime()))
+
+	n, err := authdb.DeleteAuditEventsBefore(ctx, db, start.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+	require.Equal(t, []string{"/pfs_v2.API/DeleteRepo"}, list(&auth.ListAuditEventsRequest{}))
+}
@@ -17,6 +17,7 @@ const (
 	roleBindingsCollectionName = "role_bindings"
 	membersCollectionName      = "members"
 	groupsCollectionName       = "groups"
+	rolesCollectionName        = "roles"
 
 	// InternalUser is the name of the user representing the auth system. By default, it is a cluster admin.
 	InternalUser = auth.InternalPrefix + "auth-server"
@@ -74,6 +75,19 @@ func GroupsCollection(db *pachsql.DB, listener col.PostgresListener) col.Postgre
 	)
 }
 
+var rolesIndexes = []*col.Index{}
+
+// RolesCollection returns a postgres collection representing custom roles.
+func RolesCollection(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		rolesCollectionName,
+		db,
+		listener,
+		&auth.Role{},
+		rolesIndexes,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -88,6 +102,17 @@ func CollectionsV0() []col.PostgresCollection {
 	}
 }
 
+// CollectionsV2_12_0 returns a list of the auth collections added in 2.12.0,
+// for postgres-initialization purposes. These collections are not usable for
+// querying.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func CollectionsV2_12_0() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(rolesCollectionName, nil, nil, nil, rolesIndexes),
+	}
+}
+
 // InternalAuthUserPermissions adds the Internal Auth User as a cluster admin
 func InternalAuthUserPermissions(ctx context.Context, tx *pachsql.Tx) error {
 	roleBindings := RoleBindingCollection(nil, nil)
@@ -54,6 +54,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
 )
 
 const (
@@ -76,6 +77,9 @@ type PjsAPIClient pjs.APIClient
 
 type SnapshotAPIClient snapshot.APIClient
 
+// WebhookAPIClient is an alias of webhook.APIClient
+type WebhookAPIClient webhook.APIClient
+
 // FilesetClient is an alias for storage.FilesetClient.
 type FilesetClient storage.FilesetClient
 
@@ -111,6 +115,7 @@ type APIClient struct {
 	PfsAPIClient
 	PjsAPIClient
 	SnapshotAPIClient
+	WebhookAPIClient
 	FilesetClient
 	PpsAPIClient
 	AuthAPIClient
@@ -542,6 +547,24 @@ func NewOnUserMachine(ctx context.Context, prefix string, options ...Option) (*A
 	return newOnUserMachine(ctx, cfg, context, name, prefix, options...)
 }
 
+// NewOnUserMachineForContext is like NewOnUserMachine, but connects to the cluster of the named
+// context rather than the active one.  It's used to talk to a second cluster, like a replication
+// remote.
+func NewOnUserMachineForContext(ctx context.Context, contextName, prefix string, options ...Option) (*APIClient, error) {
+	cfg, err := config.Read(false, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	if cfg.V2 == nil {
+		return nil, errors.Errorf("cannot get context %q from non-v2 config", contextName)
+	}
+	context, ok := cfg.V2.Contexts[contextName]
+	if !ok || context == nil {
+		return nil, errors.Errorf("pachctl config error: no context named %q has been configured", contextName)
+	}
+	return newOnUserMachine(ctx, cfg, context, contextName, prefix, options...)
+}
+
 // NewEnterpriseClientOnUserMachine constructs a new APIClient using $HOME/.pachyderm/config
 // if it exists. This is intended to be used in the pachctl binary to communicate with the
 // enterprise server.
@@ -877,6 +900,7 @@ func (c *APIClient) connect(rctx context.Context, timeout time.Duration, unaryIn
 	c.PfsAPIClient = pfs.NewAPIClient(clientConn)
 	c.PjsAPIClient = pjs.NewAPIClient(clientConn)
 	c.SnapshotAPIClient = snapshot.NewAPIClient(clientConn)
+	c.WebhookAPIClient = webhook.NewAPIClient(clientConn)
 	c.FilesetClient = storage.NewFilesetClient(clientConn)
 	c.PpsAPIClient = pps.NewAPIClient(clientConn)
 	c.AuthAPIClient = auth.NewAPIClient(clientConn)
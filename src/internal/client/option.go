@@ -86,3 +86,10 @@ func WithOffset(offset int64) GetFileOption {
 		gf.Offset = offset
 	}
 }
+
+// WithSizeBytes limits the number of bytes that a get file request returns.
+func WithSizeBytes(sizeBytes int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.SizeBytes = sizeBytes
+	}
+}
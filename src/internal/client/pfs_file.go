@@ -626,6 +626,21 @@ func getFileTar(ctx context.Context, c pfs.APIClient, commit *pfs.Commit, path s
 	return grpcutil.NewStreamingBytesReader(client, cf), nil
 }
 
+// GetShareLink gets a tar file of the file or directory a share link shares, and counts it as one of
+// the link's downloads.
+func GetShareLink(ctx context.Context, c pfs.APIClient, token string) (_ io.ReadCloser, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(ctx)
+	client, err := c.GetShareLink(ctx, &pfs.GetShareLinkRequest{Token: token})
+	if err != nil {
+		cf()
+		return nil, err
+	}
+	return grpcutil.NewStreamingBytesReader(client, cf), nil
+}
+
 // GetFileReader gets a reader for the specified path
 // TODO: This should probably be an io.ReadCloser so we can close the rpc if the full file isn't read.
 
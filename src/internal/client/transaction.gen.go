@@ -22,6 +22,7 @@ import (
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 	transaction_v2 "github.com/pachyderm/pachyderm/v2/src/transaction"
 	versionpb_v2 "github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	webhook "github.com/pachyderm/pachyderm/v2/src/webhook"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -55,6 +56,10 @@ func (c *unsupportedAuthBuilderClient) Authorize(_ context.Context, _ *auth_v2.A
 	return nil, unsupportedError("Authorize")
 }
 
+func (c *unsupportedAuthBuilderClient) CreateRole(_ context.Context, _ *auth_v2.CreateRoleRequest, opts ...grpc.CallOption) (*auth_v2.CreateRoleResponse, error) {
+	return nil, unsupportedError("CreateRole")
+}
+
 func (c *unsupportedAuthBuilderClient) Deactivate(_ context.Context, _ *auth_v2.DeactivateRequest, opts ...grpc.CallOption) (*auth_v2.DeactivateResponse, error) {
 	return nil, unsupportedError("Deactivate")
 }
@@ -63,6 +68,14 @@ func (c *unsupportedAuthBuilderClient) DeleteExpiredAuthTokens(_ context.Context
 	return nil, unsupportedError("DeleteExpiredAuthTokens")
 }
 
+func (c *unsupportedAuthBuilderClient) DeleteRole(_ context.Context, _ *auth_v2.DeleteRoleRequest, opts ...grpc.CallOption) (*auth_v2.DeleteRoleResponse, error) {
+	return nil, unsupportedError("DeleteRole")
+}
+
+func (c *unsupportedAuthBuilderClient) ExplainAuthorization(_ context.Context, _ *auth_v2.ExplainAuthorizationRequest, opts ...grpc.CallOption) (*auth_v2.ExplainAuthorizationResponse, error) {
+	return nil, unsupportedError("ExplainAuthorization")
+}
+
 func (c *unsupportedAuthBuilderClient) ExtractAuthTokens(_ context.Context, _ *auth_v2.ExtractAuthTokensRequest, opts ...grpc.CallOption) (*auth_v2.ExtractAuthTokensResponse, error) {
 	return nil, unsupportedError("ExtractAuthTokens")
 }
@@ -107,6 +120,14 @@ func (c *unsupportedAuthBuilderClient) GetUsers(_ context.Context, _ *auth_v2.Ge
 	return nil, unsupportedError("GetUsers")
 }
 
+func (c *unsupportedAuthBuilderClient) ListAuditEvents(_ context.Context, _ *auth_v2.ListAuditEventsRequest, opts ...grpc.CallOption) (auth_v2.API_ListAuditEventsClient, error) {
+	return nil, unsupportedError("ListAuditEvents")
+}
+
+func (c *unsupportedAuthBuilderClient) ListRoles(_ context.Context, _ *auth_v2.ListRolesRequest, opts ...grpc.CallOption) (*auth_v2.ListRolesResponse, error) {
+	return nil, unsupportedError("ListRoles")
+}
+
 func (c *unsupportedAuthBuilderClient) ModifyMembers(_ context.Context, _ *auth_v2.ModifyMembersRequest, opts ...grpc.CallOption) (*auth_v2.ModifyMembersResponse, error) {
 	return nil, unsupportedError("ModifyMembers")
 }
@@ -139,6 +160,10 @@ func (c *unsupportedAuthBuilderClient) SetGroupsForUser(_ context.Context, _ *au
 	return nil, unsupportedError("SetGroupsForUser")
 }
 
+func (c *unsupportedAuthBuilderClient) UpdateRole(_ context.Context, _ *auth_v2.UpdateRoleRequest, opts ...grpc.CallOption) (*auth_v2.UpdateRoleResponse, error) {
+	return nil, unsupportedError("UpdateRole")
+}
+
 func (c *unsupportedAuthBuilderClient) WhoAmI(_ context.Context, _ *auth_v2.WhoAmIRequest, opts ...grpc.CallOption) (*auth_v2.WhoAmIResponse, error) {
 	return nil, unsupportedError("WhoAmI")
 }
@@ -309,10 +334,22 @@ func (c *unsupportedLicenseBuilderClient) UpdateCluster(_ context.Context, _ *li
 
 type unsupportedLogsBuilderClient struct{}
 
+func (c *unsupportedLogsBuilderClient) CreateLogRule(_ context.Context, _ *logs.CreateLogRuleRequest, opts ...grpc.CallOption) (*logs.CreateLogRuleResponse, error) {
+	return nil, unsupportedError("CreateLogRule")
+}
+
+func (c *unsupportedLogsBuilderClient) DeleteLogRule(_ context.Context, _ *logs.DeleteLogRuleRequest, opts ...grpc.CallOption) (*logs.DeleteLogRuleResponse, error) {
+	return nil, unsupportedError("DeleteLogRule")
+}
+
 func (c *unsupportedLogsBuilderClient) GetLogs(_ context.Context, _ *logs.GetLogsRequest, opts ...grpc.CallOption) (logs.API_GetLogsClient, error) {
 	return nil, unsupportedError("GetLogs")
 }
 
+func (c *unsupportedLogsBuilderClient) ListLogRule(_ context.Context, _ *logs.ListLogRuleRequest, opts ...grpc.CallOption) (logs.API_ListLogRuleClient, error) {
+	return nil, unsupportedError("ListLogRule")
+}
+
 type unsupportedMetadataBuilderClient struct{}
 
 func (c *unsupportedMetadataBuilderClient) EditMetadata(_ context.Context, _ *metadata.EditMetadataRequest, opts ...grpc.CallOption) (*metadata.EditMetadataResponse, error) {
@@ -361,6 +398,10 @@ func (c *unsupportedPfsBuilderClient) CreateRepo(_ context.Context, _ *pfs_v2.Cr
 	return nil, unsupportedError("CreateRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateShareLink(_ context.Context, _ *pfs_v2.CreateShareLinkRequest, opts ...grpc.CallOption) (*pfs_v2.CreateShareLinkResponse, error) {
+	return nil, unsupportedError("CreateShareLink")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteAll(_ context.Context, _ *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -429,6 +470,10 @@ func (c *unsupportedPfsBuilderClient) GetFileTAR(_ context.Context, _ *pfs_v2.Ge
 	return nil, unsupportedError("GetFileTAR")
 }
 
+func (c *unsupportedPfsBuilderClient) GetShareLink(_ context.Context, _ *pfs_v2.GetShareLinkRequest, opts ...grpc.CallOption) (pfs_v2.API_GetShareLinkClient, error) {
+	return nil, unsupportedError("GetShareLink")
+}
+
 func (c *unsupportedPfsBuilderClient) GlobFile(_ context.Context, _ *pfs_v2.GlobFileRequest, opts ...grpc.CallOption) (pfs_v2.API_GlobFileClient, error) {
 	return nil, unsupportedError("GlobFile")
 }
@@ -461,6 +506,10 @@ func (c *unsupportedPfsBuilderClient) InspectRepo(_ context.Context, _ *pfs_v2.I
 	return nil, unsupportedError("InspectRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectShareLink(_ context.Context, _ *pfs_v2.InspectShareLinkRequest, opts ...grpc.CallOption) (*pfs_v2.ShareLinkInfo, error) {
+	return nil, unsupportedError("InspectShareLink")
+}
+
 func (c *unsupportedPfsBuilderClient) ListBranch(_ context.Context, _ *pfs_v2.ListBranchRequest, opts ...grpc.CallOption) (pfs_v2.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -505,6 +554,10 @@ func (c *unsupportedPfsBuilderClient) ReposSummary(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("ReposSummary")
 }
 
+func (c *unsupportedPfsBuilderClient) RevokeShareLink(_ context.Context, _ *pfs_v2.RevokeShareLinkRequest, opts ...grpc.CallOption) (*pfs_v2.RevokeShareLinkResponse, error) {
+	return nil, unsupportedError("RevokeShareLink")
+}
+
 func (c *unsupportedPfsBuilderClient) ShardFileSet(_ context.Context, _ *pfs_v2.ShardFileSetRequest, opts ...grpc.CallOption) (*pfs_v2.ShardFileSetResponse, error) {
 	return nil, unsupportedError("ShardFileSet")
 }
@@ -521,6 +574,10 @@ func (c *unsupportedPfsBuilderClient) StartCommit(_ context.Context, _ *pfs_v2.S
 	return nil, unsupportedError("StartCommit")
 }
 
+func (c *unsupportedPfsBuilderClient) StorageUsage(_ context.Context, _ *pfs_v2.StorageUsageRequest, opts ...grpc.CallOption) (pfs_v2.API_StorageUsageClient, error) {
+	return nil, unsupportedError("StorageUsage")
+}
+
 func (c *unsupportedPfsBuilderClient) SubscribeCommit(_ context.Context, _ *pfs_v2.SubscribeCommitRequest, opts ...grpc.CallOption) (pfs_v2.API_SubscribeCommitClient, error) {
 	return nil, unsupportedError("SubscribeCommit")
 }
@@ -649,6 +706,10 @@ func (c *unsupportedPpsBuilderClient) GetProjectDefaults(_ context.Context, _ *p
 	return nil, unsupportedError("GetProjectDefaults")
 }
 
+func (c *unsupportedPpsBuilderClient) GetProjectQuota(_ context.Context, _ *pps_v2.GetProjectQuotaRequest, opts ...grpc.CallOption) (*pps_v2.GetProjectQuotaResponse, error) {
+	return nil, unsupportedError("GetProjectQuota")
+}
+
 func (c *unsupportedPpsBuilderClient) InspectDatum(_ context.Context, _ *pps_v2.InspectDatumRequest, opts ...grpc.CallOption) (*pps_v2.DatumInfo, error) {
 	return nil, unsupportedError("InspectDatum")
 }
@@ -737,6 +798,10 @@ func (c *unsupportedPpsBuilderClient) SetProjectDefaults(_ context.Context, _ *p
 	return nil, unsupportedError("SetProjectDefaults")
 }
 
+func (c *unsupportedPpsBuilderClient) SetProjectQuota(_ context.Context, _ *pps_v2.SetProjectQuotaRequest, opts ...grpc.CallOption) (*pps_v2.SetProjectQuotaResponse, error) {
+	return nil, unsupportedError("SetProjectQuota")
+}
+
 func (c *unsupportedPpsBuilderClient) StartPipeline(_ context.Context, _ *pps_v2.StartPipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, unsupportedError("StartPipeline")
 }
@@ -816,3 +881,25 @@ type unsupportedVersionpbBuilderClient struct{}
 func (c *unsupportedVersionpbBuilderClient) GetVersion(_ context.Context, _ *emptypb.Empty, opts ...grpc.CallOption) (*versionpb_v2.Version, error) {
 	return nil, unsupportedError("GetVersion")
 }
+
+type unsupportedWebhookBuilderClient struct{}
+
+func (c *unsupportedWebhookBuilderClient) CreateWebhook(_ context.Context, _ *webhook.CreateWebhookRequest, opts ...grpc.CallOption) (*webhook.CreateWebhookResponse, error) {
+	return nil, unsupportedError("CreateWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) DeleteWebhook(_ context.Context, _ *webhook.DeleteWebhookRequest, opts ...grpc.CallOption) (*webhook.DeleteWebhookResponse, error) {
+	return nil, unsupportedError("DeleteWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) InspectWebhook(_ context.Context, _ *webhook.InspectWebhookRequest, opts ...grpc.CallOption) (*webhook.InspectWebhookResponse, error) {
+	return nil, unsupportedError("InspectWebhook")
+}
+
+func (c *unsupportedWebhookBuilderClient) ListDelivery(_ context.Context, _ *webhook.ListDeliveryRequest, opts ...grpc.CallOption) (webhook.API_ListDeliveryClient, error) {
+	return nil, unsupportedError("ListDelivery")
+}
+
+func (c *unsupportedWebhookBuilderClient) ListWebhook(_ context.Context, _ *webhook.ListWebhookRequest, opts ...grpc.CallOption) (webhook.API_ListWebhookClient, error) {
+	return nil, unsupportedError("ListWebhook")
+}
@@ -0,0 +1,42 @@
+package v2_12_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+func createRolesCollection(ctx context.Context, env migrations.Env) error {
+	return col.SetupPostgresCollections(ctx, env.Tx, authdb.CollectionsV2_12_0()...)
+}
+
+func createAuditEventsTable(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `create table auth.audit_events (
+		id bigserial not null primary key,
+		time timestamptz not null default now(),
+		principal text not null default '',
+		method text not null,
+		resource text not null default '',
+		request jsonb not null default '{}',
+		code text not null,
+		error text not null default '',
+		duration_ms bigint not null default 0
+	)`); err != nil {
+		return errors.Wrap(err, "create auth.audit_events table")
+	}
+	if _, err := tx.ExecContext(ctx, `create index audit_events_time_idx on auth.audit_events (time)`); err != nil {
+		return errors.Wrap(err, "create auth.audit_events index")
+	}
+	return nil
+}
+
+func addAuthTokenScope(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `alter table auth.auth_tokens add column scope jsonb`); err != nil {
+		return errors.Wrap(err, "add scope column to auth.auth_tokens")
+	}
+	return nil
+}
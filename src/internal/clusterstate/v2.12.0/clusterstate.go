@@ -3,8 +3,12 @@ package v2_12_0
 import (
 	"context"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 )
 
 func Migrate(state migrations.State) migrations.State {
@@ -16,5 +20,25 @@ func Migrate(state migrations.State) migrations.State {
 			return fileset.CreatePinsTable(ctx, env.Tx)
 		}, migrations.Squash).
 		Apply("Create snapshot schema", createSnapshotSchema, migrations.Squash).
-		Apply("Create admin schema + restarts table", createPachydermRestartSchema, migrations.Squash)
+		Apply("Create admin schema + restarts table", createPachydermRestartSchema, migrations.Squash).
+		Apply("Create webhook schema", createWebhookSchema, migrations.Squash).
+		Apply("Create project quotas collection", createProjectQuotasCollection, migrations.Squash).
+		Apply("Create auth roles collection", createRolesCollection, migrations.Squash).
+		Apply("Create auth.audit_events table", createAuditEventsTable, migrations.Squash).
+		Apply("Add scope to auth.auth_tokens", addAuthTokenScope, migrations.Squash).
+		Apply("Create pfs.share_links table", createShareLinksTable, migrations.Squash).
+		Apply("Create task and dlock schemas", func(ctx context.Context, env migrations.Env) error {
+			if err := task.SetupPostgresV0(ctx, env.Tx); err != nil {
+				return errors.Wrap(err, "setup task schema")
+			}
+			return errors.Wrap(dlock.SetupPostgresV0(ctx, env.Tx), "setup dlock schema")
+		}, migrations.Squash).
+		Apply("Create logs schema", createLogsSchema, migrations.Squash).
+		Apply("Create logs.rules table", createLogRulesTable, migrations.Squash).
+		Apply("Create pfs.storage_usage table", createStorageUsageTable, migrations.Squash).
+		Apply("Index logs.entries labels", createLogsLabelsIndex, migrations.Squash).
+		Apply("Add profiles repo type", addProfilesRepoType, migrations.Squash).
+		Apply("Create consistenthashing schema", func(ctx context.Context, env migrations.Env) error {
+			return consistenthashing.SetupPostgresV0(ctx, env.Tx)
+		}, migrations.Squash)
 }
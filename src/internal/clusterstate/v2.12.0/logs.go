@@ -0,0 +1,50 @@
+package v2_12_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+func createLogsSchema(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `create schema logs`); err != nil {
+		return errors.Wrap(err, "create logs schema")
+	}
+	// ts is in nanoseconds since the Unix epoch; timestamptz only has microseconds, and paging
+	// through logs needs to distinguish lines logged in the same microsecond.
+	if _, err := tx.ExecContext(ctx, `create table logs.entries (
+		id bigserial not null primary key,
+		ts bigint not null,
+		labels jsonb not null,
+		line text not null
+	)`); err != nil {
+		return errors.Wrap(err, "create logs.entries table")
+	}
+	if _, err := tx.ExecContext(ctx, `create index entries_ts_id_idx on logs.entries (ts, id)`); err != nil {
+		return errors.Wrap(err, "create logs.entries index")
+	}
+	return nil
+}
+
+// createLogsLabelsIndex indexes the labels of log entries, which every query filters on with @>.
+// jsonb_path_ops indexes are smaller than the default jsonb_ops, and only support @>.
+func createLogsLabelsIndex(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `create index entries_labels_idx on logs.entries using gin (labels jsonb_path_ops)`); err != nil {
+		return errors.Wrap(err, "create logs.entries labels index")
+	}
+	return nil
+}
+
+func createLogRulesTable(ctx context.Context, env migrations.Env) error {
+	if _, err := env.Tx.ExecContext(ctx, `create table logs.rules (
+		name text not null primary key,
+		rule jsonb not null,
+		created_at timestamptz not null default now(),
+		updated_at timestamptz not null default now()
+	)`); err != nil {
+		return errors.Wrap(err, "create logs.rules table")
+	}
+	return nil
+}
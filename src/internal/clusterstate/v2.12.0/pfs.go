@@ -19,3 +19,52 @@ func alterBranchProvenanceTable(ctx context.Context, env migrations.Env) error {
 	}
 	return nil
 }
+
+func createShareLinksTable(ctx context.Context, env migrations.Env) error {
+	ctx = pctx.Child(ctx, "createShareLinksTable")
+	if _, err := env.Tx.ExecContext(ctx, `
+		CREATE TABLE pfs.share_links (
+			token_hash TEXT PRIMARY KEY,
+			project TEXT NOT NULL,
+			repo TEXT NOT NULL,
+			repo_type TEXT NOT NULL,
+			commit_id TEXT NOT NULL,
+			path TEXT NOT NULL,
+			file_type INT NOT NULL,
+			size_bytes BIGINT NOT NULL DEFAULT 0,
+			issuer TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			expires_at TIMESTAMPTZ NOT NULL,
+			max_downloads BIGINT NOT NULL DEFAULT 0,
+			downloads BIGINT NOT NULL DEFAULT 0
+		);
+	`); err != nil {
+		return errors.Wrap(err, "create pfs.share_links table")
+	}
+	return nil
+}
+
+func createStorageUsageTable(ctx context.Context, env migrations.Env) error {
+	ctx = pctx.Child(ctx, "createStorageUsageTable")
+	if _, err := env.Tx.ExecContext(ctx, `
+		CREATE TABLE pfs.storage_usage (
+			kind TEXT NOT NULL,
+			id BIGINT NOT NULL,
+			total_bytes BIGINT NOT NULL,
+			unique_bytes BIGINT NOT NULL,
+			computed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (kind, id)
+		);
+	`); err != nil {
+		return errors.Wrap(err, "create pfs.storage_usage table")
+	}
+	return nil
+}
+
+func addProfilesRepoType(ctx context.Context, env migrations.Env) error {
+	ctx = pctx.Child(ctx, "addProfilesRepoType")
+	if _, err := env.Tx.ExecContext(ctx, `ALTER TYPE pfs.repo_type ADD VALUE IF NOT EXISTS 'profiles'`); err != nil {
+		return errors.Wrap(err, "add profiles to pfs.repo_type")
+	}
+	return nil
+}
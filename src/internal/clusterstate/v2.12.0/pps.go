@@ -0,0 +1,13 @@
+package v2_12_0
+
+import (
+	"context"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+)
+
+func createProjectQuotasCollection(ctx context.Context, env migrations.Env) error {
+	return col.SetupPostgresCollections(ctx, env.Tx, ppsdb.CollectionsV2_12_0()...)
+}
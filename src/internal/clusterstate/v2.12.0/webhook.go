@@ -0,0 +1,46 @@
+package v2_12_0
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+)
+
+func createWebhookSchema(ctx context.Context, env migrations.Env) error {
+	tx := env.Tx
+	if _, err := tx.ExecContext(ctx, `create schema webhook`); err != nil {
+		return errors.Wrap(err, "create webhook schema")
+	}
+	if _, err := tx.ExecContext(ctx, `create table webhook.webhooks (
+		name text not null primary key,
+		url text not null,
+		secret text not null default '',
+		filter jsonb not null default '{}',
+		created_at timestamptz not null default now(),
+		updated_at timestamptz not null default now()
+	)`); err != nil {
+		return errors.Wrap(err, "create webhook.webhooks table")
+	}
+	if _, err := tx.ExecContext(ctx, `create table webhook.deliveries (
+		id bigserial not null primary key,
+		webhook text not null references webhook.webhooks(name) on delete cascade,
+		event jsonb not null,
+		state text not null default 'PENDING',
+		attempts int not null default 0,
+		last_status_code int not null default 0,
+		last_error text not null default '',
+		created_at timestamptz not null default now(),
+		last_attempt_at timestamptz,
+		next_attempt_at timestamptz not null default now()
+	)`); err != nil {
+		return errors.Wrap(err, "create webhook.deliveries table")
+	}
+	if _, err := tx.ExecContext(ctx, `create index deliveries_state_next_attempt_at_idx on webhook.deliveries (state, next_attempt_at)`); err != nil {
+		return errors.Wrap(err, "create webhook.deliveries index")
+	}
+	if _, err := tx.ExecContext(ctx, `create index deliveries_webhook_created_at_idx on webhook.deliveries (webhook, created_at)`); err != nil {
+		return errors.Wrap(err, "create webhook.deliveries webhook index")
+	}
+	return nil
+}
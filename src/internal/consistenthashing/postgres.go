@@ -0,0 +1,147 @@
+package consistenthashing
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+)
+
+// pollInterval is how often a postgres ring checks for members that have joined or left.
+var pollInterval = time.Second
+
+// SetupPostgresV0 creates the table of postgres ring members.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE SCHEMA consistenthashing;
+
+		CREATE TABLE consistenthashing.members (
+			prefix TEXT NOT NULL,
+			id TEXT NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL,
+			PRIMARY KEY (prefix, id)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// WithPostgresRing is like WithRing, but keeps the ring's members in postgres, in the table created by
+// SetupPostgresV0, and its locks in postgres with dlock.NewPostgresDLock.  Members are leases that are
+// renewed while the ring is live, and rings poll for members that have joined or whose lease has expired.
+func WithPostgresRing(ctx context.Context, db *pachsql.DB, prefix string, cb func(ctx context.Context, ring *Ring) error) error {
+	return withRing(ctx, newPostgresMembership(db, prefix), prefix, uuid.New(), cb)
+}
+
+// postgresMembership keeps the members of a ring as rows that expire unless they're renewed.
+type postgresMembership struct {
+	db     *pachsql.DB
+	prefix string
+}
+
+func newPostgresMembership(db *pachsql.DB, prefix string) *postgresMembership {
+	return &postgresMembership{db: db, prefix: prefix}
+}
+
+// ttl is how long a member is kept without being renewed.  Like the etcd membership, it uses the
+// collection package's lease TTL.
+func (m *postgresMembership) ttl() time.Duration {
+	return time.Duration(collection.DefaultTTL) * time.Second
+}
+
+// join inserts the member and renews it until ctx is done, when it's deleted.  It returns an error if
+// the member can't be renewed before it expires.
+func (m *postgresMembership) join(ctx context.Context, id string) (retErr error) {
+	ttl := m.ttl()
+	if err := m.renew(ctx, id, ttl); err != nil {
+		return err
+	}
+	defer func() {
+		// ctx is done, so the member is deleted with a fresh context.
+		ctx, cancel := context.WithTimeout(pctx.Child(context.WithoutCancel(ctx), "leave"), ttl)
+		defer cancel()
+		if _, err := m.db.ExecContext(ctx, `DELETE FROM consistenthashing.members WHERE prefix = $1 AND id = $2`, m.prefix, id); err != nil {
+			log.Info(ctx, "failed to leave ring; the member will expire", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := m.renew(ctx, id, ttl); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if time.Since(renewed) >= ttl {
+				return errors.Wrap(err, "ring membership expired")
+			}
+			log.Info(ctx, "problem renewing ring membership; will retry", zap.Error(err))
+			continue
+		}
+		renewed = time.Now()
+	}
+}
+
+func (m *postgresMembership) renew(ctx context.Context, id string, ttl time.Duration) error {
+	_, err := m.db.ExecContext(ctx, `
+		INSERT INTO consistenthashing.members (prefix, id, expires_at) VALUES ($1, $2, now() + make_interval(secs => $3))
+		ON CONFLICT (prefix, id) DO UPDATE SET expires_at = excluded.expires_at
+	`, m.prefix, id, ttl.Seconds())
+	return errors.Wrap(err, "renew ring membership")
+}
+
+// watch polls the live members, deleting expired ones, and reports the differences from the last poll.
+func (m *postgresMembership) watch(ctx context.Context, put, del func(id string) error) error {
+	known := make(map[string]bool)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.db.ExecContext(ctx, `DELETE FROM consistenthashing.members WHERE prefix = $1 AND expires_at < now()`, m.prefix); err != nil {
+			return errors.Wrap(err, "delete expired ring members")
+		}
+		var ids []string
+		if err := m.db.SelectContext(ctx, &ids, `SELECT id FROM consistenthashing.members WHERE prefix = $1`, m.prefix); err != nil {
+			return errors.Wrap(err, "list ring members")
+		}
+		live := make(map[string]bool)
+		for _, id := range ids {
+			live[id] = true
+			if !known[id] {
+				if err := put(id); err != nil {
+					return err
+				}
+			}
+		}
+		for id := range known {
+			if !live[id] {
+				if err := del(id); err != nil {
+					return err
+				}
+			}
+		}
+		known = live
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *postgresMembership) newLock(key string) dlock.DLock {
+	return dlock.NewPostgresDLock(m.db, key)
+}
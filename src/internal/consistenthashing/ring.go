@@ -0,0 +1,321 @@
+// Package consistenthashing needs to be documented.
+//
+// TODO: document
+package consistenthashing
+
+import (
+	"context"
+	"hash/crc32"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+var (
+	// hashFn is overridable for testing purposes.
+	hashFn = crc32.ChecksumIEEE
+)
+
+// Ring is a consistent hash Ring. Each process should only create one instance with a given prefix. Nodes can be
+// added locally to a Ring. A Ring watches for changes to the prefix to determine if new nodes have been added
+// or deleted.
+type Ring struct {
+	membership membership
+	stateLock  sync.Mutex
+	members    []member
+	node       node // node represents the member instance in a Ring that is local to the current process.
+	prefix     string
+}
+
+// node is a local member of a Ring. Upon creation, a node joins the ring's membership, which keeps it a member
+// until it is shutdown.
+type node struct {
+	member
+	locks map[string]lockInfo
+}
+
+// membership is where the members of the rings with a prefix are kept, in etcd or postgres.  A member that
+// stops refreshing its membership is removed from it after a TTL.
+type membership interface {
+	// join adds id to the ring and keeps it a member until ctx is done.
+	join(ctx context.Context, id string) error
+	// watch calls put with the id of each member that joins the ring, and del with the id of each
+	// member that leaves it, until ctx is done.
+	watch(ctx context.Context, put, del func(id string) error) error
+	// newLock returns a distributed lock on key.
+	newLock(key string) dlock.DLock
+}
+
+// member contains metadata about members in a Ring.
+type member struct {
+	Id   string
+	hash uint32
+}
+
+// lockInfo instances are created for keys by a ring's node.
+// A context lockInfo.ctx is stored so the proper context is used when unlocking lockInfo.lock
+type lockInfo struct {
+	lock dlock.DLock
+	ctx  context.Context
+}
+
+// MemberIds returns the id of each member in the ring.
+func (ring *Ring) MemberIds() []string {
+	ring.stateLock.Lock()
+	defer ring.stateLock.Unlock()
+	var ids []string
+	for _, member := range ring.members {
+		ids = append(ids, member.Id)
+	}
+	return ids
+}
+
+func withRing(rctx context.Context, membership membership, prefix, id string, cb func(ctx context.Context, ring *Ring) error) error {
+	ring := ring(membership, prefix, id)
+
+	cancelCtx, cancel := pctx.WithCancel(pctx.Child(rctx, "ring", pctx.WithFields(zap.Inline(ring))))
+	defer cancel()
+
+	eg, ctx := errgroup.WithContext(cancelCtx)
+	defer log.Info(ctx, "shutting down ring")
+
+	eg.Go(func() error { return ring.watch(ctx) })
+	eg.Go(func() error { return ring.createNode(ctx) })
+	eg.Go(func() error {
+		log.Info(ctx, "started ring")
+		if err := cb(ctx, ring); err != nil {
+			return err
+		}
+		cancel()
+		return nil
+	})
+	err := eg.Wait()
+	if errors.Is(context.Cause(cancelCtx), context.Canceled) {
+		err = nil
+	}
+	return errors.EnsureStack(err)
+}
+
+func ring(membership membership, prefix string, id string) *Ring {
+	localMember := member{
+		Id:   id,
+		hash: hashFn([]byte(id)),
+	}
+	return &Ring{
+		membership: membership,
+		members:    []member{localMember},
+		node: node{
+			member: localMember,
+			locks:  map[string]lockInfo{},
+		},
+		prefix: prefix,
+	}
+}
+
+// createNode adds the ring's node to the membership, and keeps it there until the context is canceled.
+func (ring *Ring) createNode(ctx context.Context) error {
+	if err := ring.membership.join(ctx, ring.node.Id); err != nil {
+		log.Info(ctx, "failed to keep ring membership", zap.Error(err))
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// watch watches the membership to determine if a member has been added or removed.
+// When a member is added, the ring calls rebalance to release any locks that no longer associate to its node. Rebalance
+// on delete happens by default since each member attempts to retrieve all locks. When a member is deleted,
+// the pending calls to Lock by each member will go through on the nodes that associates to given lock.
+func (ring *Ring) watch(ctx context.Context) error {
+	if err := ring.membership.watch(ctx, func(id string) error {
+		ring.stateLock.Lock()
+		defer ring.stateLock.Unlock()
+		ring.insertById(ctx, id)
+		// Need to release locks that no longer associate to the ring's node.
+		if err := ring.rebalance(); err != nil {
+			log.Error(ctx, "failed rebalancing", zap.Error(err))
+			return err
+		}
+		return nil
+	}, func(id string) error {
+		ring.stateLock.Lock()
+		defer ring.stateLock.Unlock()
+		ring.removeById(ctx, id)
+		return nil
+	}); err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil
+		}
+		log.Error(ctx, "failed watch", zap.Error(err))
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (ring *Ring) keyWithRingPrefix(key string) string {
+	return path.Join(ring.prefix, key)
+}
+
+// rebalance iterates through held locks and releases locks that no longer associate to a ring's node.
+func (ring *Ring) rebalance() error {
+	for key := range ring.node.locks {
+		if ring.get(key).Id == ring.node.Id {
+			continue
+		}
+		if err := ring.releaseLock(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ring *Ring) get(key string) member {
+	return ring.members[ring.getIndex(key)]
+}
+
+// getIndex returns the index of the member associated with key. A key belongs to the first member whose hash is
+// greater than the key. If no Members are greater, then the Ring wraps around to the first member.
+func (ring *Ring) getIndex(key string) int {
+	keyHash := hashFn([]byte(key))
+	index := sort.Search(len(ring.members), func(i int) bool {
+		return ring.members[i].hash >= keyHash
+	})
+	// wrap around to the first member in the Ring.
+	if index >= len(ring.members) {
+		index = 0
+	}
+	return index
+}
+
+func (ring *Ring) releaseLock(key string) error {
+	lockInfo, exists := ring.node.locks[key]
+	if !exists {
+		return nil
+	}
+	delete(ring.node.locks, key)
+	// The lock is released when either:
+	// - The lock context is canceled.
+	// - The unlock call completes successfully.
+	ctx := lockInfo.ctx
+	return backoff.RetryUntilCancel(ctx,
+		func() error { return lockInfo.lock.Unlock(ctx) },
+		backoff.NewInfiniteBackOff(),
+		func(err error, d time.Duration) error {
+			log.Error(ctx, "releasing lock; retrying", zap.Error(err), zap.Duration("retryAfter", d), zap.String("lock", key))
+			return nil
+		},
+	)
+}
+
+func (ring *Ring) insertById(ctx context.Context, id string) {
+	member := member{
+		Id:   id,
+		hash: hashFn([]byte(id)),
+	}
+	ring.insert(ctx, member)
+}
+
+// insert updates Ring.members, and sorts Ring.members by the member hash.
+// If the member already exists, insert is a no-op. The member can be mocked for testing purposes.
+func (ring *Ring) insert(ctx context.Context, member member) {
+	index := sort.Search(len(ring.members), func(i int) bool {
+		return ring.members[i].hash >= member.hash
+	})
+	if index < len(ring.members) && ring.members[index].hash == member.hash {
+		return // member already exists.
+	}
+	log.Info(ctx, "adding member to ring", zap.String("memberID", member.Id))
+	if index >= len(ring.members) {
+		ring.members = append(ring.members, member)
+		return
+	}
+	ring.members = append(ring.members[:index+1], ring.members[index:]...)
+	ring.members[index] = member
+}
+
+func (ring *Ring) removeById(ctx context.Context, id string) {
+	member := member{
+		Id:   id,
+		hash: hashFn([]byte(id)),
+	}
+	ring.remove(ctx, member)
+}
+
+// remove deletes the member from Ring.members if it exists.
+func (ring *Ring) remove(ctx context.Context, member member) {
+	index := sort.Search(len(ring.members), func(i int) bool {
+		return ring.members[i].hash >= member.hash
+	})
+	if ring.members == nil || index >= len(ring.members) || ring.members[index].Id != member.Id {
+		return // member doesn't exist.
+	}
+	log.Info(ctx, "deleting member from ring", zap.String("memberID", member.Id))
+	ring.members = append(ring.members[:index], ring.members[index+1:]...)
+}
+
+// Lock attempts to lock a key using consistent hashing. If key does not belong to the ring's node,
+// the call blocks until context is cancelled.
+func (ring *Ring) Lock(ctx context.Context, key string) (context.Context, error) {
+	key = ring.keyWithRingPrefix(key)
+	ring.stateLock.Lock()
+	defer ring.stateLock.Unlock()
+	info, exists := ring.node.locks[key]
+	if exists && info.lock != nil {
+		return info.ctx, nil
+	}
+	ticker := time.NewTicker(time.Second * 5)
+	defer ticker.Stop()
+	// TODO: this should be event driven instead of by polling for better scalability.
+	for {
+		if len(ring.members) != 0 && ring.node.Id == ring.get(key).Id {
+			nodeLock := ring.membership.newLock(key)
+			lockCtx, err := nodeLock.TryLock(ctx)
+			if err != nil && !errors.Is(err, concurrency.ErrLocked) {
+				return nil, errors.EnsureStack(err)
+			}
+			if err == nil { // lock() must fallthrough in the case where err == concurrency.ErrLocked
+				l := lockInfo{
+					lock: nodeLock,
+					ctx:  pctx.Child(lockCtx, "lock", pctx.WithFields(zap.String("lock", key))),
+				}
+				ring.node.locks[key] = l
+				return lockCtx, nil
+			}
+		}
+		ring.stateLock.Unlock()
+		select {
+		case <-ctx.Done():
+			ring.stateLock.Lock()
+			return nil, errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+			ring.stateLock.Lock()
+		}
+	}
+}
+
+// Unlock attempts to unlock a key if and only if a ring's node owns a lock reference to it.
+// Remote member instances must give up their own locks.
+func (ring *Ring) Unlock(key string) error {
+	key = ring.keyWithRingPrefix(key)
+	ring.stateLock.Lock()
+	defer ring.stateLock.Unlock()
+	return ring.releaseLock(key)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (ring *Ring) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("ring", ring.prefix)
+	enc.AddString("node", ring.node.Id)
+	return nil
+}
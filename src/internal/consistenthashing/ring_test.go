@@ -9,9 +9,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"go.uber.org/zap"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/collection"
@@ -19,15 +24,18 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
 
-	etcd "go.etcd.io/etcd/client/v3"
-
 	"golang.org/x/sync/errgroup"
 )
 
 type testRingConfig struct {
-	client *etcd.Client
-	ctx    context.Context
-	cancel context.CancelFunc
+	newMembership func(prefix string) membership
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// withRing is WithRing or WithPostgresRing, as the config's backend is.
+func (config testRingConfig) withRing(ctx context.Context, prefix string, cb func(ctx context.Context, ring *Ring) error) error {
+	return withRing(ctx, config.newMembership(prefix), prefix, uuid.New(), cb)
 }
 
 type lockTestConfig struct {
@@ -46,37 +54,71 @@ type lockTestConfig struct {
 	doneUnlocking  chan struct{}
 }
 
-func setupTest(t *testing.T) testRingConfig {
+var backends = []string{"etcd", "postgres"}
+
+func setupTest(t *testing.T, backend string) testRingConfig {
 	ctx, cancel := pctx.WithCancel(pctx.TestContext(t))
-	etcdEnv := testetcd.NewEnv(ctx, t)
-	return testRingConfig{
-		client: etcdEnv.EtcdClient,
-		ctx:    ctx,
-		cancel: cancel,
+	switch backend {
+	case "etcd":
+		etcdEnv := testetcd.NewEnv(ctx, t)
+		return testRingConfig{
+			newMembership: func(prefix string) membership { return newEtcdMembership(etcdEnv.EtcdClient, prefix) },
+			ctx:           ctx,
+			cancel:        cancel,
+		}
+	case "postgres":
+		db := dockertestenv.NewTestDB(t)
+		require.NoError(t, dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+			if err := dlock.SetupPostgresV0(ctx, tx); err != nil {
+				return err
+			}
+			return SetupPostgresV0(ctx, tx)
+		}))
+		pollInterval = 100 * time.Millisecond
+		return testRingConfig{
+			newMembership: func(prefix string) membership { return newPostgresMembership(db, prefix) },
+			ctx:           ctx,
+			cancel:        cancel,
+		}
+	}
+	t.Fatalf("unknown backend %q", backend)
+	return testRingConfig{}
+}
+
+// forEachBackend runs f as a subtest with each backend.
+func forEachBackend(t *testing.T, f func(t *testing.T, backend string)) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) { f(t, backend) })
 	}
 }
 
-func TestCleanShutdown(t *testing.T) {
-	config := setupTest(t)
-	err := WithRing(config.ctx, config.client, "master", func(ctx context.Context, ring *Ring) error { return nil })
+func TestCleanShutdown(t *testing.T) { forEachBackend(t, testCleanShutdown) }
+
+func testCleanShutdown(t *testing.T, backend string) {
+	config := setupTest(t, backend)
+	err := config.withRing(config.ctx, "master", func(ctx context.Context, ring *Ring) error { return nil })
 	require.NoError(t, err, "should not fail")
 }
 
-func TestGracefulShutdown(t *testing.T) {
-	config := setupTest(t)
-	err := WithRing(config.ctx, config.client, "master", func(ctx context.Context, ring *Ring) error {
+func TestGracefulShutdown(t *testing.T) { forEachBackend(t, testGracefulShutdown) }
+
+func testGracefulShutdown(t *testing.T, backend string) {
+	config := setupTest(t, backend)
+	err := config.withRing(config.ctx, "master", func(ctx context.Context, ring *Ring) error {
 		return errors.EnsureStack(errors.New("fail test"))
 	})
 	require.YesError(t, err)
 	require.Equal(t, err.Error(), "fail test")
 }
 
-func TestWatch(t *testing.T) {
-	config := setupTest(t)
+func TestWatch(t *testing.T) { forEachBackend(t, testWatch) }
+
+func testWatch(t *testing.T, backend string) {
+	config := setupTest(t, backend)
 	defer config.cancel()
 	collection.DefaultTTL = 1
-	err := WithRing(config.ctx, config.client, "master", func(ctx context.Context, ring *Ring) error {
-		err := WithRing(config.ctx, config.client, "master", func(ctx context.Context, innerRing *Ring) error {
+	err := config.withRing(config.ctx, "master", func(ctx context.Context, ring *Ring) error {
+		err := config.withRing(config.ctx, "master", func(ctx context.Context, innerRing *Ring) error {
 			time.Sleep(2 * time.Second)
 			require.Len(t, ring.MemberIds(), 2, "there should be 2 total members")
 			return nil
@@ -89,8 +131,10 @@ func TestWatch(t *testing.T) {
 	require.NoError(t, err, "should be able to create first ring")
 }
 
-func TestLocking(t *testing.T) {
-	test := setupLockTest(t, 3, 9)
+func TestLocking(t *testing.T) { forEachBackend(t, testLocking) }
+
+func testLocking(t *testing.T, backend string) {
+	test := setupLockTest(t, backend, 3, 9)
 	defer func() {
 		test.cancel()
 	}()
@@ -109,8 +153,10 @@ func TestLocking(t *testing.T) {
 	}
 }
 
-func TestLockingWithDeleteWorker(t *testing.T) {
-	test := setupLockTest(t, 3, 9)
+func TestLockingWithDeleteWorker(t *testing.T) { forEachBackend(t, testLockingWithDeleteWorker) }
+
+func testLockingWithDeleteWorker(t *testing.T, backend string) {
+	test := setupLockTest(t, backend, 3, 9)
 	defer func() {
 		test.cancel()
 		require.NoError(t, test.eg.Wait())
@@ -143,8 +189,10 @@ func TestLockingWithDeleteWorker(t *testing.T) {
 	test.unlockAllLocks()
 }
 
-func TestLockingWithAddWorker(t *testing.T) {
-	test := setupLockTest(t, 3, 9)
+func TestLockingWithAddWorker(t *testing.T) { forEachBackend(t, testLockingWithAddWorker) }
+
+func testLockingWithAddWorker(t *testing.T, backend string) {
+	test := setupLockTest(t, backend, 3, 9)
 	test.workers-- // allows test to generate keys with a hash that should rebalance locks/members locks
 	defer func() {
 		test.cancel()
@@ -186,7 +234,7 @@ func hashFnForTests(t *testing.T) func([]byte) uint32 {
 	}
 }
 
-func setupLockTest(t *testing.T, numNodes, numLocks int) lockTestConfig {
+func setupLockTest(t *testing.T, backend string, numNodes, numLocks int) lockTestConfig {
 	hashFn = hashFnForTests(t)
 	// number of goroutines is test.Nodes * test.locks, so we should test with fairly small numbers.
 	test := lockTestConfig{
@@ -194,7 +242,7 @@ func setupLockTest(t *testing.T, numNodes, numLocks int) lockTestConfig {
 		keys:       &sync.Map{},
 		workers:    numNodes,
 		workerIds:  map[int]string{},
-		ringConfig: setupTest(t),
+		ringConfig: setupTest(t, backend),
 	}
 	test.eg, test.ctx = errgroup.WithContext(test.ringConfig.ctx)
 	test.ctx, test.cancel = pctx.WithCancel(test.ctx)
@@ -252,7 +300,7 @@ func (test *lockTestConfig) locksPerWorker() map[string]int {
 func (test *lockTestConfig) runWorker(ctx context.Context, t *testing.T, id string) {
 	collection.DefaultTTL = 1
 	eg, ctx := errgroup.WithContext(pctx.Child(ctx, "worker."+id))
-	err := withRing(ctx, test.ringConfig.client, "master", id, func(ctx context.Context, ring *Ring) error {
+	err := withRing(ctx, test.ringConfig.newMembership("master"), "master", id, func(ctx context.Context, ring *Ring) error {
 		time.Sleep(time.Second * 1)
 		test.workersReady <- struct{}{}
 		<-test.beginLocking
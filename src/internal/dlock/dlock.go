@@ -1,4 +1,4 @@
-// Package dlock implements a distributed lock on top of etcd.
+// Package dlock implements a distributed lock on top of etcd or postgres.
 package dlock
 
 import (
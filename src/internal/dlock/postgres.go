@@ -0,0 +1,175 @@
+package dlock
+
+import (
+	"context"
+	"time"
+
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+)
+
+const (
+	// leaseTTL is how long a postgres lock is held without being renewed.  Like the etcd lock's
+	// session TTL, it bounds how long a lock outlives a node that dies holding it.
+	leaseTTL = 15 * time.Second
+	// retryInterval is how often Lock tries to take a lock that's held.
+	retryInterval = time.Second
+)
+
+// SetupPostgresV0 creates the table of the postgres lock.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE SCHEMA dlock;
+
+		CREATE TABLE dlock.locks (
+			prefix TEXT PRIMARY KEY,
+			holder TEXT NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL
+		);
+	`)
+	return errors.EnsureStack(err)
+}
+
+type postgresImpl struct {
+	db     *pachsql.DB
+	prefix string
+	holder string
+
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+// NewPostgresDLock returns a distributed lock on a given prefix that is kept in postgres, in the
+// table created by SetupPostgresV0.  The lock is a lease that's renewed while it's held; if it
+// can't be renewed, then the context returned by Lock is cancelled.
+func NewPostgresDLock(db *pachsql.DB, prefix string) DLock {
+	return &postgresImpl{
+		db:     db,
+		prefix: prefix,
+	}
+}
+
+func (d *postgresImpl) Lock(ctx context.Context) (_ context.Context, retErr error) {
+	ctx = pctx.Child(ctx, "", pctx.WithFields(zap.String("withLock", d.prefix)))
+	defer log.Span(ctx, "DLock.Lock")(log.Errorp(&retErr))
+
+	holder := uuid.NewWithoutDashes()
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+	for {
+		locked, err := d.acquire(ctx, holder)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return d.hold(ctx, holder), nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+func (d *postgresImpl) TryLock(ctx context.Context) (_ context.Context, retErr error) {
+	ctx = pctx.Child(ctx, "", pctx.WithFields(zap.String("withLock", d.prefix)))
+	defer log.Span(ctx, "DLock.TryLock")(log.Errorp(&retErr))
+
+	holder := uuid.NewWithoutDashes()
+	locked, err := d.acquire(ctx, holder)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		// Match the etcd lock, so that callers can check for a held lock the same way.
+		return nil, errors.EnsureStack(concurrency.ErrLocked)
+	}
+	return d.hold(ctx, holder), nil
+}
+
+// acquire takes the lock if it's free or its holder's lease has expired.
+func (d *postgresImpl) acquire(ctx context.Context, holder string) (bool, error) {
+	res, err := d.db.ExecContext(ctx, `
+		INSERT INTO dlock.locks (prefix, holder, expires_at) VALUES ($1, $2, now() + make_interval(secs => $3))
+		ON CONFLICT (prefix) DO UPDATE SET holder = excluded.holder, expires_at = excluded.expires_at
+		WHERE dlock.locks.expires_at < now()
+	`, d.prefix, holder, leaseTTL.Seconds())
+	if err != nil {
+		return false, errors.Wrap(err, "acquire lock")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "acquire lock")
+	}
+	return n > 0, nil
+}
+
+// hold renews the lease on an acquired lock until it's unlocked, and returns a context that's
+// cancelled if the lease is lost.
+func (d *postgresImpl) hold(ctx context.Context, holder string) context.Context {
+	start := time.Now()
+	log.Debug(ctx, "acquired lock ok")
+
+	ctx, cancel := pctx.WithCancel(pctx.Child(ctx, "", pctx.WithFields(zap.Bool("locked", true))))
+	stopCtx, stop := pctx.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(leaseTTL / 3)
+		defer ticker.Stop()
+		renewed := time.Now()
+		for {
+			select {
+			case <-stopCtx.Done():
+				log.Debug(ctx, "lock's context is done", zap.Error(context.Cause(ctx)), zap.Duration("lockLifetime", time.Since(start)))
+				return
+			case <-ticker.C:
+			}
+			res, err := d.db.ExecContext(stopCtx, `
+				UPDATE dlock.locks SET expires_at = now() + make_interval(secs => $3)
+				WHERE prefix = $1 AND holder = $2
+			`, d.prefix, holder, leaseTTL.Seconds())
+			var n int64
+			if err == nil {
+				n, err = res.RowsAffected()
+			}
+			switch {
+			case stopCtx.Err() != nil:
+			case err != nil && time.Since(renewed) < leaseTTL:
+				log.Info(ctx, "problem renewing lock; will retry", zap.Error(err))
+			case err != nil || n == 0:
+				log.Debug(ctx, "lock's lease is lost; cancelling associated context", zap.Error(err), zap.Duration("lockLifetime", time.Since(start)))
+				cancel()
+				return
+			default:
+				renewed = time.Now()
+			}
+		}
+	}()
+
+	d.holder = holder
+	d.stop = stop
+	d.done = done
+	return ctx
+}
+
+func (d *postgresImpl) Unlock(ctx context.Context) (retErr error) {
+	defer log.Span(ctx, "DLock.Unlock", zap.String("prefix", d.prefix))(log.Errorp(&retErr))
+
+	d.stop()
+	<-d.done
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM dlock.locks WHERE prefix = $1 AND holder = $2`, d.prefix, d.holder); err != nil {
+		return errors.EnsureStack(err)
+	}
+	log.Debug(ctx, "relinquished lock ok", zap.String("prefix", d.prefix))
+	return nil
+}
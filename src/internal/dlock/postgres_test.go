@@ -0,0 +1,43 @@
+package dlock
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestPostgresDLock(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	require.NoError(t, dbutil.WithTx(ctx, db, SetupPostgresV0))
+
+	a := NewPostgresDLock(db, "a")
+	lockCtx, err := a.Lock(ctx)
+	require.NoError(t, err)
+
+	// The lock is held, so it can't be taken again, but other prefixes can.
+	_, err = NewPostgresDLock(db, "a").TryLock(ctx)
+	require.True(t, errors.Is(err, concurrency.ErrLocked))
+	b := NewPostgresDLock(db, "b")
+	_, err = b.TryLock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, b.Unlock(ctx))
+
+	// Once it's unlocked, it can be taken again.
+	require.NoError(t, a.Unlock(lockCtx))
+	a = NewPostgresDLock(db, "a")
+	lockCtx, err = a.TryLock(ctx)
+	require.NoError(t, err)
+
+	// A lock whose lease is lost cancels its context.
+	_, err = db.ExecContext(ctx, `DELETE FROM dlock.locks WHERE prefix = 'a'`)
+	require.NoError(t, err)
+	<-lockCtx.Done()
+	require.NoError(t, a.Unlock(ctx))
+}
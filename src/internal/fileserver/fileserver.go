@@ -1,4 +1,5 @@
-// Package fileserver implements a server for downloading PFS files over plain HTTP (i.e. browser downloads).
+// Package fileserver implements a server for downloading PFS files over plain HTTP (i.e. browser
+// downloads), and for uploading them with PUT, multipart POST, DELETE, or WebDAV.
 //
 // See: https://www.notion.so/2023-04-03-HTTP-file-and-archive-downloads-cfb56fac16e54957b015070416b09e94
 package fileserver
@@ -15,9 +16,11 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pachyderm/pachyderm/v2/src/constants"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/conditionalrequest"
@@ -26,8 +29,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/timewasted/go-accept-headers"
 	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -71,6 +76,9 @@ var (
 // Server is an http.Handler that can download from and upload to PFS.
 type Server struct {
 	ClientFactory func(context.Context) *client.APIClient
+
+	locksMu sync.Mutex
+	locks   *lru.Cache[string, webdav.LockSystem] // WebDAV locks, by commit URL prefix.
 }
 
 type Request struct {
@@ -95,11 +103,35 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		HTML:           acceptsHTML(req),
 	}
 
+	if token := req.URL.Query().Get(constants.ShareLinkKey); token != "" {
+		if req.Method != http.MethodHead && req.Method != http.MethodGet {
+			ourRequest.displayErrorf(ctx, http.StatusMethodNotAllowed, "share links can only be downloaded")
+			return
+		}
+		ourRequest.getShared(ctx, token)
+		return
+	}
+
 	switch req.Method {
 	case http.MethodHead, http.MethodGet:
 		w.Header().Set("vary", constants.ContextTokenKey)
 		ourRequest.get(ctx)
 		return
+	case http.MethodPut:
+		ourRequest.put(ctx)
+		return
+	case http.MethodPost:
+		ourRequest.post(ctx)
+		return
+	case http.MethodDelete:
+		ourRequest.delete(ctx)
+		return
+	case methodCopy:
+		ourRequest.copy(ctx)
+		return
+	case http.MethodOptions, methodPropfind, methodProppatch, methodMkcol, methodMove, methodLock, methodUnlock:
+		ourRequest.webdav(ctx, s)
+		return
 	default:
 		ourRequest.displayErrorf(ctx, http.StatusMethodNotAllowed, "unknown HTTP method %q", req.Method)
 		return
@@ -132,20 +164,10 @@ func (r *Request) get(ctx context.Context) {
 		return
 	}
 	file := &pfs.File{
-		Path: parts[5],
-	}
-	repo := &pfs.Repo{
-		Name: parts[3],
-		Type: pfs.UserRepoType,
-		Project: &pfs.Project{
-			Name: parts[2],
-		},
+		Commit: commitFromURL(parts[2], parts[3], parts[4]),
+		Path:   parts[5],
 	}
-	if commitish := parts[4]; uuid.IsUUIDWithoutDashes(commitish) {
-		file.Commit = &pfs.Commit{
-			Repo: repo,
-			Id:   commitish,
-		}
+	if file.Commit.Id != "" {
 		var finished bool
 		if commit, err := r.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
 			Commit: file.Commit,
@@ -164,12 +186,6 @@ func (r *Request) get(ctx context.Context) {
 			r.ResponseWriter.Header().Set("cache-control", "private, no-cache")
 		}
 	} else {
-		file.Commit = &pfs.Commit{
-			Branch: &pfs.Branch{
-				Repo: repo,
-				Name: commitish,
-			},
-		}
 		// Branch references are never cacheable; the branch can move at any time.
 		r.ResponseWriter.Header().Set("cache-control", "private, no-cache")
 	}
@@ -225,6 +241,54 @@ func (r *Request) get(ctx context.Context) {
 	r.sendFile(ctx, info)
 }
 
+// commitFromURL returns the commit that the <commit|branch> part of a /pfs/ URL refers to.
+func commitFromURL(project, repo, commitish string) *pfs.Commit {
+	r := &pfs.Repo{
+		Name: repo,
+		Type: pfs.UserRepoType,
+		Project: &pfs.Project{
+			Name: project,
+		},
+	}
+	if uuid.IsUUIDWithoutDashes(commitish) {
+		return &pfs.Commit{
+			Repo: r,
+			Id:   commitish,
+		}
+	}
+	return &pfs.Commit{
+		Branch: &pfs.Branch{
+			Repo: r,
+			Name: commitish,
+		},
+	}
+}
+
+// parseFile returns the file that a /pfs/<project>/<repo>/<commit|branch>/<path...> URL path refers
+// to.  Unlike get, which redirects URLs that are missing the trailing slash, the path must be
+// present, even if it's empty.
+func parseFile(urlPath string) (*pfs.File, bool) {
+	parts := strings.Split(urlPath, "/")
+	if len(parts) < 6 || parts[1] != "pfs" || parts[2] == "" || parts[3] == "" || parts[4] == "" {
+		return nil, false
+	}
+	return &pfs.File{
+		Commit: commitFromURL(parts[2], parts[3], parts[4]),
+		Path:   path.Join("/", path.Join(parts[5:]...)),
+	}, true
+}
+
+// parseFile is parseFile for the request URL; it displays an error if the URL is invalid.
+func (r *Request) parseFile(ctx context.Context) (*pfs.File, bool) {
+	file, ok := parseFile(r.Request.URL.Path)
+	if !ok {
+		r.displayErrorf(ctx, http.StatusNotFound,
+			"invalid URL; expecting /pfs/<project>/<repo>/<commit|branch>/<path...>, got %v",
+			r.Request.URL.Path)
+	}
+	return file, ok
+}
+
 func (r *Request) displayDirectoryListing(ctx context.Context, path string, info *pfs.FileInfo) {
 	ctx, c := pctx.WithCancel(ctx)
 	defer c()
@@ -382,14 +446,21 @@ func (r *Request) displayGRPCError(ctx context.Context, msg string, err error) {
 	code := http.StatusInternalServerError
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
 		case codes.NotFound:
 			code = http.StatusNotFound
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			code = http.StatusConflict
 		case codes.Unauthenticated:
 			code = http.StatusUnauthorized
 		case codes.PermissionDenied:
 			code = http.StatusForbidden
 		}
 	}
+	if pfsserver.IsCommitFinishedErr(err) {
+		code = http.StatusConflict
+	}
 	r.displayErrorf(ctx, code, "%s: %s", msg, err.Error())
 }
 
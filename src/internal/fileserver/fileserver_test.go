@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/http/httputil"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -587,7 +590,7 @@ func TestDownload(t *testing.T) {
 		},
 		{
 			name:   "html invalid method error",
-			method: http.MethodPost,
+			method: http.MethodPatch,
 			requestHeader: http.Header{
 				"Accept": {"text/html"},
 			},
@@ -642,3 +645,205 @@ func TestDownload(t *testing.T) {
 		})
 	}
 }
+
+func TestUpload(t *testing.T) {
+	rctx := pctx.TestContext(t)
+	e := realenv.NewRealEnv(rctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption)
+	s := &fileserver.Server{
+		ClientFactory: func(ctx context.Context) *client.APIClient {
+			return e.PachClient.WithCtx(ctx)
+		},
+	}
+	if _, err := e.PachClient.PfsAPIClient.CreateRepo(rctx, &pfs.CreateRepoRequest{
+		Repo: &pfs.Repo{
+			Name: "test",
+			Type: pfs.UserRepoType,
+			Project: &pfs.Project{
+				Name: pfs.DefaultProjectName,
+			},
+		},
+	}); err != nil {
+		t.Fatalf("create test repo: %v", err)
+	}
+
+	form := new(bytes.Buffer)
+	mw := multipart.NewWriter(form)
+	for name, content := range map[string]string{"a.txt": "a\n", "b.txt": "b\n"} {
+		fw, err := mw.CreateFormFile("file", name)
+		if err != nil {
+			t.Fatalf("create form file %v: %v", name, err)
+		}
+		if _, err := io.WriteString(fw, content); err != nil {
+			t.Fatalf("write form file %v: %v", name, err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatalf("close multipart writer: %v", err)
+	}
+
+	// Each step runs against the state the previous steps left behind.
+	testData := []struct {
+		name          string
+		method        string
+		url           string
+		requestHeader http.Header
+		body          string
+		wantCode      int
+		wantContent   string
+	}{
+		{
+			name:     "put new file",
+			method:   http.MethodPut,
+			url:      "https://example.com/pfs/default/test/master/dir/hello.txt",
+			body:     "hello, world\n",
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:        "get new file",
+			method:      http.MethodGet,
+			url:         "https://example.com/pfs/default/test/master/dir/hello.txt",
+			wantCode:    http.StatusOK,
+			wantContent: "hello, world\n",
+		},
+		{
+			name:     "put existing file",
+			method:   http.MethodPut,
+			url:      "https://example.com/pfs/default/test/master/dir/hello.txt",
+			body:     "goodbye, world\n",
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:        "put directory",
+			method:      http.MethodPut,
+			url:         "https://example.com/pfs/default/test/master/dir",
+			body:        "x",
+			wantCode:    http.StatusConflict,
+			wantContent: "/dir is a directory",
+		},
+		{
+			name:   "multipart post",
+			method: http.MethodPost,
+			url:    "https://example.com/pfs/default/test/master/uploads/",
+			requestHeader: http.Header{
+				"Content-Type": {mw.FormDataContentType()},
+			},
+			body:        form.String(),
+			wantCode:    http.StatusCreated,
+			wantContent: `/^/uploads/[ab].txt\n/uploads/[ab].txt\n$/`,
+		},
+		{
+			name:        "post without a form",
+			method:      http.MethodPost,
+			url:         "https://example.com/pfs/default/test/master/uploads/",
+			body:        "x",
+			wantCode:    http.StatusUnsupportedMediaType,
+			wantContent: `/^POST uploads must be multipart/form-data/`,
+		},
+		{
+			name:        "directory listing after uploads",
+			method:      http.MethodGet,
+			url:         "https://example.com/pfs/default/test/master/uploads/",
+			wantCode:    http.StatusOK,
+			wantContent: "-\t2\t/uploads/a.txt\n-\t2\t/uploads/b.txt\n",
+		},
+		{
+			name:   "webdav copy",
+			method: "COPY",
+			url:    "https://example.com/pfs/default/test/master/uploads/",
+			requestHeader: http.Header{
+				"Destination": {"https://example.com/pfs/default/test/master/copied"},
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:   "webdav copy without overwrite",
+			method: "COPY",
+			url:    "https://example.com/pfs/default/test/master/dir/hello.txt",
+			requestHeader: http.Header{
+				"Destination": {"https://example.com/pfs/default/test/master/copied/a.txt"},
+				"Overwrite":   {"F"},
+			},
+			wantCode:    http.StatusPreconditionFailed,
+			wantContent: "/copied/a.txt already exists",
+		},
+		{
+			name:   "webdav move",
+			method: "MOVE",
+			url:    "https://example.com/pfs/default/test/master/dir/hello.txt",
+			requestHeader: http.Header{
+				"Destination": {"https://example.com/pfs/default/test/master/moved.txt"},
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:        "get moved file",
+			method:      http.MethodGet,
+			url:         "https://example.com/pfs/default/test/master/moved.txt",
+			wantCode:    http.StatusOK,
+			wantContent: "goodbye, world\n",
+		},
+		{
+			name:     "delete directory",
+			method:   http.MethodDelete,
+			url:      "https://example.com/pfs/default/test/master/uploads",
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:        "delete nonexistent file",
+			method:      http.MethodDelete,
+			url:         "https://example.com/pfs/default/test/master/uploads/a.txt",
+			wantCode:    http.StatusNotFound,
+			wantContent: "/uploads/a.txt not found",
+		},
+		{
+			name:   "webdav propfind",
+			method: "PROPFIND",
+			url:    "https://example.com/pfs/default/test/master/",
+			requestHeader: http.Header{
+				"Depth": {"1"},
+			},
+			wantCode:    http.StatusMultiStatus,
+			wantContent: `/(?s)<D:href>/pfs/default/test/master/copied/</D:href>.*<D:href>/pfs/default/test/master/moved.txt</D:href>/`,
+		},
+		{
+			name:        "webdav propfind in a nonexistent repo",
+			method:      "PROPFIND",
+			url:         "https://example.com/pfs/default/nonexistent/master/",
+			wantCode:    http.StatusNotFound,
+			wantContent: "/^problem inspecting commit: .*not found/",
+		},
+		{
+			name:        "webdav mkcol",
+			method:      "MKCOL",
+			url:         "https://example.com/pfs/default/test/master/newdir",
+			wantCode:    http.StatusMethodNotAllowed,
+			wantContent: "empty directories cannot be created; put a file in the directory instead",
+		},
+		{
+			name:        "webdav propfind outside a commit",
+			method:      "PROPFIND",
+			url:         "https://example.com/pfs/default/",
+			wantCode:    http.StatusNotFound,
+			wantContent: "invalid URL; expecting /pfs/<project>/<repo>/<commit|branch>/<path...>, got /pfs/default/",
+		},
+	}
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.url, strings.NewReader(test.body))
+			req = req.WithContext(pctx.TestContext(t))
+			for k, vs := range test.requestHeader {
+				for _, v := range vs {
+					req.Header.Add(k, v)
+				}
+			}
+			s.ServeHTTP(rec, req)
+			if got, want := rec.Code, test.wantCode; got != want {
+				t.Errorf("response code:\n  got: %v\n want: %v", got, want)
+			}
+			if diff := cmp.Diff(test.wantContent, rec.Body.String(), cmputil.RegexpStrings()); diff != "" {
+				t.Errorf("body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package fileserver
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"go.uber.org/zap"
+)
+
+// getShared serves a file through a share link: a GET or HEAD of the file's URL, with the link's
+// token in the share query parameter.  The token is all the authentication needed.  Only GETs count
+// as downloads of the link.
+func (r *Request) getShared(ctx context.Context, token string) {
+	file, ok := r.parseFile(ctx)
+	if !ok {
+		return
+	}
+	info, err := r.PachClient.PfsAPIClient.InspectShareLink(ctx, &pfs.InspectShareLinkRequest{
+		Token: token,
+	})
+	if err != nil {
+		r.displayGRPCError(ctx, "problem inspecting share link", err)
+		return
+	}
+	if !info.Shares(file) {
+		r.displayErrorf(ctx, http.StatusForbidden, "the share link does not share %v", file.Path)
+		return
+	}
+	if info.GetFileType() == pfs.FileType_DIR {
+		r.displayErrorf(ctx, http.StatusBadRequest, "share links to directories are downloaded from /archive/")
+		return
+	}
+
+	// The shared commit is finished, so the file never changes.
+	w := r.ResponseWriter
+	w.Header().Set("cache-control", "private")
+	if r.Request.Method == http.MethodHead {
+		w.Header().Set("content-length", strconv.FormatInt(info.GetSizeBytes(), 10))
+		return
+	}
+
+	ctx, c := pctx.WithCancel(ctx)
+	defer c()
+	rc, err := client.GetShareLink(ctx, r.PachClient.PfsAPIClient, token)
+	if err != nil {
+		r.displayGRPCError(ctx, "problem starting download", err)
+		return
+	}
+	defer rc.Close() //nolint:errcheck
+	tr := tar.NewReader(rc)
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				r.displayErrorf(ctx, http.StatusNotFound, "%v not found", info.GetFile().GetPath())
+				return
+			}
+			r.displayGRPCError(ctx, "problem starting download", err)
+			return
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		w.Header().Set("content-length", strconv.FormatInt(h.Size, 10))
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, tr); err != nil {
+			log.Info(ctx, "share link download broke unexpectedly", zap.Error(err))
+		}
+		return
+	}
+}
@@ -0,0 +1,147 @@
+package fileserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inspect returns information about file, or nil if it doesn't exist.
+func (r *Request) inspect(ctx context.Context, file *pfs.File) (*pfs.FileInfo, error) {
+	info, err := r.PachClient.PfsAPIClient.InspectFile(ctx, &pfs.InspectFileRequest{
+		File: file,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err //nolint:wrapcheck
+	}
+	return info, nil
+}
+
+// deletePath returns the path to delete to remove everything that info describes.  PFS deletes a
+// directory's contents only if the path ends in a slash.
+func deletePath(info *pfs.FileInfo) string {
+	p := info.GetFile().GetPath()
+	if info.GetFileType() == pfs.FileType_DIR && !strings.HasSuffix(p, "/") {
+		p += "/"
+	}
+	return p
+}
+
+// put stores the request body at the URL's path, replacing anything that was there.  If the URL
+// names a branch, a new commit is created on it; if it names a commit, the commit must be open.
+func (r *Request) put(ctx context.Context) {
+	file, ok := r.parseFile(ctx)
+	if !ok {
+		return
+	}
+	if file.Path == "/" || strings.HasSuffix(r.Request.URL.Path, "/") {
+		r.displayErrorf(ctx, http.StatusBadRequest, "cannot PUT a directory; PUT each file in it instead")
+		return
+	}
+	info, err := r.inspect(ctx, file)
+	if err != nil {
+		r.displayGRPCError(ctx, "problem inspecting file", err)
+		return
+	}
+	if info.GetFileType() == pfs.FileType_DIR {
+		r.displayErrorf(ctx, http.StatusConflict, "%v is a directory", file.Path)
+		return
+	}
+	if err := client.PutFile(ctx, r.PachClient.PfsAPIClient, file.Commit, file.Path, r.Request.Body); err != nil {
+		r.displayGRPCError(ctx, "problem uploading file", err)
+		return
+	}
+	if info != nil {
+		r.ResponseWriter.WriteHeader(http.StatusNoContent)
+		return
+	}
+	r.ResponseWriter.WriteHeader(http.StatusCreated)
+}
+
+// post stores each file in a multipart/form-data request body in the directory at the URL's path,
+// all in one commit.  This is what an HTML form with <input type="file"> sends.
+func (r *Request) post(ctx context.Context) {
+	file, ok := r.parseFile(ctx)
+	if !ok {
+		return
+	}
+	mr, err := r.Request.MultipartReader()
+	if err != nil {
+		r.displayErrorf(ctx, http.StatusUnsupportedMediaType, "POST uploads must be multipart/form-data: %v", err)
+		return
+	}
+	var uploaded []string
+	if err := client.WithModifyFileClient(ctx, r.PachClient.PfsAPIClient, file.Commit, func(mf client.ModifyFile) error {
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err //nolint:wrapcheck
+			}
+			// Browsers send the file's name, or its path relative to a chosen directory.
+			// Joining it to "/" first keeps it inside the target directory.
+			if part.FileName() == "" {
+				continue
+			}
+			p := path.Join(file.Path, path.Join("/", part.FileName()))
+			if err := mf.PutFile(p, part); err != nil {
+				return err //nolint:wrapcheck
+			}
+			uploaded = append(uploaded, p)
+		}
+	}); err != nil {
+		r.displayGRPCError(ctx, "problem uploading files", err)
+		return
+	}
+	if r.HTML {
+		// Back to the directory listing, which now includes the new files.
+		u := *r.Request.URL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		http.Redirect(r.ResponseWriter, r.Request, u.String(), http.StatusSeeOther)
+		return
+	}
+	w := r.ResponseWriter
+	w.Header().Set("content-type", "text/plain")
+	w.WriteHeader(http.StatusCreated)
+	for _, p := range uploaded {
+		fmt.Fprintln(w, p)
+	}
+}
+
+// delete deletes the file, or the directory and everything in it, at the URL's path.
+func (r *Request) delete(ctx context.Context) {
+	file, ok := r.parseFile(ctx)
+	if !ok {
+		return
+	}
+	info, err := r.inspect(ctx, file)
+	if err != nil {
+		r.displayGRPCError(ctx, "problem inspecting file", err)
+		return
+	}
+	if info == nil {
+		r.displayErrorf(ctx, http.StatusNotFound, "%v not found", file.Path)
+		return
+	}
+	if err := client.DeleteFile(ctx, r.PachClient.PfsAPIClient, file.Commit, deletePath(info)); err != nil {
+		r.displayGRPCError(ctx, "problem deleting file", err)
+		return
+	}
+	r.ResponseWriter.WriteHeader(http.StatusNoContent)
+}
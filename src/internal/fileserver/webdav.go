@@ -0,0 +1,393 @@
+package fileserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WebDAV methods; see RFC 4918.
+const (
+	methodCopy      = "COPY"
+	methodLock      = "LOCK"
+	methodMkcol     = "MKCOL"
+	methodMove      = "MOVE"
+	methodPropfind  = "PROPFIND"
+	methodProppatch = "PROPPATCH"
+	methodUnlock    = "UNLOCK"
+)
+
+// maxLockSystems bounds how many commits' WebDAV lock systems are kept.  When there are more, the
+// least recently used is dropped, along with any locks it holds; clients see that as their locks
+// expiring early.
+const maxLockSystems = 1024
+
+// lockSystem returns the WebDAV lock system for the commit at prefix.  The WebDAV handler only
+// sees paths relative to the commit, so each commit needs its own.  The caller must have checked
+// that the commit exists and that the request can read it.
+func (s *Server) lockSystem(prefix string) webdav.LockSystem {
+	s.locksMu.Lock()
+	defer s.locksMu.Unlock()
+	if s.locks == nil {
+		s.locks, _ = lru.New[string, webdav.LockSystem](maxLockSystems)
+	}
+	ls, ok := s.locks.Get(prefix)
+	if !ok {
+		ls = webdav.NewMemLS()
+		s.locks.Add(prefix, ls)
+	}
+	return ls
+}
+
+// webdav serves the WebDAV methods that read a commit's files or move them around within it.  The
+// commit is the root of the WebDAV collection, so /pfs/<project>/<repo>/<commit|branch>/ is what a
+// WebDAV client mounts.  GET, PUT, DELETE, and COPY are handled by this package's own handlers.
+func (r *Request) webdav(ctx context.Context, s *Server) {
+	parts := strings.SplitN(r.Request.URL.Path, "/", 6)
+	if len(parts) < 5 || parts[1] != "pfs" || parts[2] == "" || parts[3] == "" || parts[4] == "" {
+		r.displayErrorf(ctx, http.StatusNotFound,
+			"invalid URL; expecting /pfs/<project>/<repo>/<commit|branch>/<path...>, got %v",
+			r.Request.URL.Path)
+		return
+	}
+	if r.Request.Method == methodMkcol {
+		// PFS has no empty directories, so there is nothing to create until a file is put in
+		// the directory.
+		r.displayErrorf(ctx, http.StatusMethodNotAllowed, "empty directories cannot be created; put a file in the directory instead")
+		return
+	}
+	commit := commitFromURL(parts[2], parts[3], parts[4])
+	// Don't keep locks for commits that don't exist or that the caller can't see.
+	if _, err := r.PachClient.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{Commit: commit}); err != nil {
+		r.displayGRPCError(ctx, "problem inspecting commit", err)
+		return
+	}
+	prefix := strings.Join(parts[:5], "/")
+	h := &webdav.Handler{
+		Prefix: prefix,
+		FileSystem: &davFS{
+			pachClient: r.PachClient,
+			commit:     commit,
+			infos:      make(map[string]*pfs.FileInfo),
+		},
+		LockSystem: s.lockSystem(prefix),
+		Logger: func(req *http.Request, err error) {
+			if err != nil {
+				log.Debug(ctx, "webdav request failed", zap.String("method", req.Method), zap.Error(err))
+			}
+		},
+	}
+	// The WebDAV handler calls the file system with the request's context, which needs the
+	// caller's auth token.
+	h.ServeHTTP(r.ResponseWriter, r.Request.WithContext(ctx))
+}
+
+// copy implements the WebDAV COPY method with PFS's CopyFile, which copies a file or a directory
+// without downloading it.  The destination can be in any repo or commit.
+func (r *Request) copy(ctx context.Context) {
+	src, ok := r.parseFile(ctx)
+	if !ok {
+		return
+	}
+	u, err := url.Parse(r.Request.Header.Get("destination"))
+	if err != nil {
+		r.displayErrorf(ctx, http.StatusBadRequest, "invalid destination: %v", err)
+		return
+	}
+	if u.Host != "" && u.Host != r.Request.Host {
+		r.displayErrorf(ctx, http.StatusBadGateway, "cannot copy to another server (%v)", u.Host)
+		return
+	}
+	dst, ok := parseFile(u.Path)
+	if !ok {
+		r.displayErrorf(ctx, http.StatusBadRequest,
+			"invalid destination; expecting /pfs/<project>/<repo>/<commit|branch>/<path...>, got %v", u.Path)
+		return
+	}
+	existing, err := r.inspect(ctx, dst)
+	if err != nil {
+		r.displayGRPCError(ctx, "problem inspecting destination", err)
+		return
+	}
+	if existing != nil && r.Request.Header.Get("overwrite") == "F" {
+		r.displayErrorf(ctx, http.StatusPreconditionFailed, "%v already exists", dst.Path)
+		return
+	}
+	if err := client.WithModifyFileClient(ctx, r.PachClient.PfsAPIClient, dst.Commit, func(mf client.ModifyFile) error {
+		if existing != nil {
+			if err := mf.DeleteFile(deletePath(existing)); err != nil {
+				return err //nolint:wrapcheck
+			}
+		}
+		return mf.CopyFile(dst.Path, src) //nolint:wrapcheck
+	}); err != nil {
+		r.displayGRPCError(ctx, "problem copying file", err)
+		return
+	}
+	if existing != nil {
+		r.ResponseWriter.WriteHeader(http.StatusNoContent)
+		return
+	}
+	r.ResponseWriter.WriteHeader(http.StatusCreated)
+}
+
+// davFS is a webdav.FileSystem for the files in a commit.  It lives for one request, so it
+// remembers what ListFile returned instead of inspecting each file in a directory listing again.
+type davFS struct {
+	pachClient *client.APIClient
+	commit     *pfs.Commit
+	infos      map[string]*pfs.FileInfo
+}
+
+var _ webdav.FileSystem = (*davFS)(nil)
+
+func davClean(name string) string {
+	return path.Join("/", name)
+}
+
+// davError converts a PFS error into one that the WebDAV handler turns into the right status.
+func davError(op, name string, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		err = fs.ErrNotExist
+	case codes.PermissionDenied, codes.Unauthenticated:
+		err = fs.ErrPermission
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+func (d *davFS) inspect(ctx context.Context, name string) (*pfs.FileInfo, error) {
+	name = davClean(name)
+	if info, ok := d.infos[name]; ok {
+		return info, nil
+	}
+	info, err := d.pachClient.PfsAPIClient.InspectFile(ctx, &pfs.InspectFileRequest{
+		File: d.commit.NewFile(name),
+	})
+	if err != nil {
+		return nil, davError("stat", name, err)
+	}
+	d.infos[name] = info
+	return info, nil
+}
+
+// Mkdir implements webdav.FileSystem.  PFS has no empty directories, so MKCOL is rejected before
+// it gets here; a new directory only appears once a file is put in it.
+func (d *davFS) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	return &fs.PathError{Op: "mkdir", Path: davClean(name), Err: errors.ErrUnsupported}
+}
+
+// OpenFile implements webdav.FileSystem.  Files can only be opened for reading; uploads go through
+// PUT.
+func (d *davFS) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+	}
+	info, err := d.inspect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &davFile{ctx: ctx, fs: d, info: info}, nil
+}
+
+// RemoveAll implements webdav.FileSystem.
+func (d *davFS) RemoveAll(ctx context.Context, name string) error {
+	info, err := d.inspect(ctx, name)
+	if err != nil {
+		return err
+	}
+	clear(d.infos)
+	if err := client.DeleteFile(ctx, d.pachClient.PfsAPIClient, d.commit, deletePath(info)); err != nil {
+		return davError("remove", name, err)
+	}
+	return nil
+}
+
+// Rename implements webdav.FileSystem by copying oldName to newName and deleting oldName in one
+// commit.
+func (d *davFS) Rename(ctx context.Context, oldName, newName string) error {
+	info, err := d.inspect(ctx, oldName)
+	if err != nil {
+		return err
+	}
+	clear(d.infos)
+	if err := client.WithModifyFileClient(ctx, d.pachClient.PfsAPIClient, d.commit, func(mf client.ModifyFile) error {
+		if err := mf.CopyFile(davClean(newName), info.GetFile()); err != nil {
+			return err //nolint:wrapcheck
+		}
+		return mf.DeleteFile(deletePath(info)) //nolint:wrapcheck
+	}); err != nil {
+		return davError("rename", oldName, err)
+	}
+	return nil
+}
+
+// Stat implements webdav.FileSystem.
+func (d *davFS) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	info, err := d.inspect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return davFileInfo{info}, nil
+}
+
+// davFile is an open file or directory.  Its content is only downloaded once it's read.
+type davFile struct {
+	ctx     context.Context
+	fs      *davFS
+	info    *pfs.FileInfo
+	content io.ReadSeeker
+	entries []os.FileInfo
+	listed  bool
+}
+
+var _ webdav.File = (*davFile)(nil)
+
+func (f *davFile) open() error {
+	if f.content != nil {
+		return nil
+	}
+	if f.info.GetFileType() == pfs.FileType_DIR {
+		return &fs.PathError{Op: "read", Path: f.info.GetFile().GetPath(), Err: errors.New("is a directory")}
+	}
+	r, err := client.GetFileReadSeeker(f.ctx, f.fs.pachClient.PfsAPIClient, f.fs.commit, f.info.GetFile().GetPath())
+	if err != nil {
+		return davError("open", f.info.GetFile().GetPath(), err)
+	}
+	f.content = r
+	return nil
+}
+
+func (f *davFile) Read(p []byte) (int, error) {
+	if err := f.open(); err != nil {
+		return 0, err
+	}
+	return f.content.Read(p) //nolint:wrapcheck
+}
+
+func (f *davFile) Seek(offset int64, whence int) (int64, error) {
+	if err := f.open(); err != nil {
+		return 0, err
+	}
+	return f.content.Seek(offset, whence) //nolint:wrapcheck
+}
+
+func (f *davFile) Write(p []byte) (int, error) {
+	return 0, &fs.PathError{Op: "write", Path: f.info.GetFile().GetPath(), Err: fs.ErrPermission}
+}
+
+// Readdir returns the directory's entries, count at a time, or all of them if count <= 0, as
+// os.File.Readdir does.
+func (f *davFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		res, err := f.fs.pachClient.PfsAPIClient.ListFile(f.ctx, &pfs.ListFileRequest{
+			File: f.info.GetFile(),
+		})
+		if err != nil {
+			return nil, davError("readdir", f.info.GetFile().GetPath(), err)
+		}
+		for {
+			info, err := res.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, davError("readdir", f.info.GetFile().GetPath(), err)
+			}
+			f.fs.infos[davClean(info.GetFile().GetPath())] = info
+			f.entries = append(f.entries, davFileInfo{info})
+		}
+		f.listed = true
+	}
+	if count <= 0 {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+	n := min(count, len(f.entries))
+	entries := f.entries[:n]
+	f.entries = f.entries[n:]
+	return entries, nil
+}
+
+func (f *davFile) Stat() (os.FileInfo, error) {
+	return davFileInfo{f.info}, nil
+}
+
+func (f *davFile) Close() error {
+	return nil
+}
+
+// davFileInfo is an os.FileInfo for a PFS file.  It also provides the WebDAV ETag and content type,
+// so that the WebDAV handler doesn't download the file to compute them.
+type davFileInfo struct {
+	info *pfs.FileInfo
+}
+
+var (
+	_ webdav.ETager       = davFileInfo{}
+	_ webdav.ContentTyper = davFileInfo{}
+)
+
+func (i davFileInfo) Name() string {
+	return path.Base(davClean(i.info.GetFile().GetPath()))
+}
+
+func (i davFileInfo) Size() int64 {
+	return i.info.GetSizeBytes()
+}
+
+func (i davFileInfo) Mode() os.FileMode {
+	if i.IsDir() {
+		return os.ModeDir | 0o755
+	}
+	return 0o644
+}
+
+func (i davFileInfo) ModTime() time.Time {
+	if i.info.GetCommitted() == nil {
+		return time.Time{}
+	}
+	return i.info.GetCommitted().AsTime()
+}
+
+func (i davFileInfo) IsDir() bool {
+	return i.info.GetFileType() == pfs.FileType_DIR
+}
+
+func (i davFileInfo) Sys() any {
+	return i.info
+}
+
+// ETag matches the etag header sent by GET.
+func (i davFileInfo) ETag(context.Context) (string, error) {
+	return `"` + hex.EncodeToString(i.info.GetHash()) + `"`, nil
+}
+
+func (i davFileInfo) ContentType(context.Context) (string, error) {
+	if t := mime.TypeByExtension(path.Ext(i.Name())); t != "" {
+		return t, nil
+	}
+	return "application/octet-stream", nil
+}
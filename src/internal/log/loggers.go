@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -49,6 +50,9 @@ var (
 	// droppedLogs and droppedHealthLogs record how many logs or health check logs we dropped
 	// because of log sampling.
 	droppedLogs, droppedHealthLogs atomic.Uint64
+
+	extraOutputsMu sync.Mutex                  // extraOutputsMu serializes AddOutput.
+	extraOutputs   atomic.Pointer[[]io.Writer] // Additional outputs of the global logger.
 )
 
 const (
@@ -295,7 +299,7 @@ func makeLoggerOnce(enc zapcore.Encoder, w zapcore.WriteSyncer, sample bool, opt
 			warningsLogged.Store(true)
 			warnings = nil
 		}()
-		w = zapcore.Lock(w)
+		w = zapcore.Lock(teeOutputs{w})
 		if developmentLogger {
 			opts = append(opts, zap.Development())
 		}
@@ -331,6 +335,35 @@ func makeLoggerOnce(enc zapcore.Encoder, w zapcore.WriteSyncer, sample bool, opt
 	})
 }
 
+// AddOutput copies everything the global logger writes from now on to w, in addition to its usual
+// output.  Writes to w are serialized with the logger's own writes, so w must not block, and it must
+// not retain the slice it's passed.  Errors returned by w are ignored.
+func AddOutput(w io.Writer) {
+	extraOutputsMu.Lock()
+	defer extraOutputsMu.Unlock()
+	var outs []io.Writer
+	if old := extraOutputs.Load(); old != nil {
+		outs = append(outs, *old...)
+	}
+	outs = append(outs, w)
+	extraOutputs.Store(&outs)
+}
+
+// teeOutputs is a WriteSyncer that also writes to the outputs added with AddOutput.
+type teeOutputs struct {
+	zapcore.WriteSyncer
+}
+
+func (t teeOutputs) Write(p []byte) (int, error) {
+	n, err := t.WriteSyncer.Write(p)
+	if outs := extraOutputs.Load(); outs != nil {
+		for _, w := range *outs {
+			w.Write(p) //nolint:errcheck
+		}
+	}
+	return n, err //nolint:wrapcheck
+}
+
 // makeLogger actually builds a global logger, but doesn't make it global.  It is safe to use in
 // tests or benchmarks.
 func makeLogger(enc zapcore.Encoder, w zapcore.WriteSyncer, lvl zapcore.LevelEnabler, sample bool, opts []zap.Option) *zap.Logger {
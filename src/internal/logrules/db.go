@@ -0,0 +1,127 @@
+package logrules
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/logs"
+)
+
+const (
+	insertRule = `insert into logs.rules (name, rule) values ($1, $2::jsonb)`
+	upsertRule = insertRule + ` on conflict (name) do update set rule = excluded.rule, updated_at = now()`
+	selectRule = `select name, rule from logs.rules`
+	deleteRule = `delete from logs.rules where name = $1`
+)
+
+// RuleNotFoundError is returned when a log rule does not exist.
+type RuleNotFoundError struct {
+	Name string
+}
+
+func (err *RuleNotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, err.Error())
+}
+
+func (err *RuleNotFoundError) Error() string {
+	return fmt.Sprintf("log rule %q not found", err.Name)
+}
+
+// RuleExistsError is returned when creating a log rule whose name is taken.
+type RuleExistsError struct {
+	Name string
+}
+
+func (err *RuleExistsError) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, err.Error())
+}
+
+func (err *RuleExistsError) Error() string {
+	return fmt.Sprintf("log rule %q already exists", err.Name)
+}
+
+type ruleRecord struct {
+	Name string `db:"name"`
+	Rule []byte `db:"rule"`
+}
+
+func (r *ruleRecord) toRule() (*logs.LogRule, error) {
+	rule := &logs.LogRule{}
+	if err := protojson.Unmarshal(r.Rule, rule); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal log rule %q", r.Name)
+	}
+	return rule, nil
+}
+
+// CreateRule stores a log rule, replacing an existing one of the same name if update is set.
+func CreateRule(ctx context.Context, tx *pachsql.Tx, rule *logs.LogRule, update bool) error {
+	js, err := protojson.Marshal(rule)
+	if err != nil {
+		return errors.Wrap(err, "marshal log rule")
+	}
+	query := insertRule
+	if update {
+		query = upsertRule
+	}
+	if _, err := tx.ExecContext(ctx, query, rule.Name, string(js)); err != nil {
+		if dbutil.IsUniqueViolation(err) {
+			return &RuleExistsError{Name: rule.Name}
+		}
+		return errors.Wrap(err, "insert log rule")
+	}
+	return nil
+}
+
+// GetRule returns the log rule with the given name.
+func GetRule(ctx context.Context, tx *pachsql.Tx, name string) (*logs.LogRule, error) {
+	var r ruleRecord
+	if err := sqlx.GetContext(ctx, tx, &r, selectRule+` where name = $1`, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &RuleNotFoundError{Name: name}
+		}
+		return nil, errors.Wrap(err, "get log rule")
+	}
+	return r.toRule()
+}
+
+// ListRules returns all log rules, ordered by name.
+func ListRules(ctx context.Context, tx *pachsql.Tx) ([]*logs.LogRule, error) {
+	var records []ruleRecord
+	if err := sqlx.SelectContext(ctx, tx, &records, selectRule+` order by name`); err != nil {
+		return nil, errors.Wrap(err, "list log rules")
+	}
+	var ret []*logs.LogRule
+	for _, r := range records {
+		rule, err := r.toRule()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, rule)
+	}
+	return ret, nil
+}
+
+// DeleteRule deletes a log rule.
+func DeleteRule(ctx context.Context, tx *pachsql.Tx, name string) error {
+	res, err := tx.ExecContext(ctx, deleteRule, name)
+	if err != nil {
+		return errors.Wrap(err, "delete log rule")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "rows affected")
+	}
+	if n == 0 {
+		return &RuleNotFoundError{Name: name}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Package logrules evaluates log rules: standing queries over pipeline logs whose matches are
+// counted in Prometheus metrics and, optionally, sent to webhooks as alerts.
+package logrules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/logs"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+// alertInterval is the minimum time between two alerts from the same rule.  Lines that match in
+// between are counted, and the count is reported with the next alert.
+const alertInterval = time.Minute
+
+var (
+	matchesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "log_rule",
+		Name:      "matches_total",
+		Help:      "Count of log lines that matched a log rule, by rule, project, and pipeline.",
+	}, []string{"rule", "project", "pipeline"})
+	valueMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "log_rule",
+		Name:      "value",
+		Help:      "The value captured by a log rule's pattern in the most recent matching line, by rule, project, and pipeline.",
+	}, []string{"rule", "project", "pipeline"})
+)
+
+// Validate returns an error if rule can't be evaluated.
+func Validate(rule *logs.LogRule) error {
+	if rule.GetName() == "" {
+		return errors.New("rule name must be set")
+	}
+	if rule.GetProject() == "" {
+		return errors.New("rule project must be set")
+	}
+	if _, err := regexp.Compile(rule.GetPattern()); err != nil {
+		return errors.Errorf("invalid pattern %q: %v", rule.GetPattern(), err)
+	}
+	return nil
+}
+
+// request returns the GetLogs request that tails the lines that rule matches, starting now.
+func request(rule *logs.LogRule) *logs.GetLogsRequest {
+	user := &logs.UserLogQuery{UserType: &logs.UserLogQuery_Project{Project: rule.Project}}
+	if rule.Pipeline != "" {
+		user.UserType = &logs.UserLogQuery_Pipeline{Pipeline: &logs.PipelineLogQuery{Project: rule.Project, Pipeline: rule.Pipeline}}
+	}
+	req := &logs.GetLogsRequest{
+		Query: &logs.LogQuery{QueryType: &logs.LogQuery_Admin{Admin: &logs.AdminLogQuery{
+			AdminType: &logs.AdminLogQuery_User{User: user},
+		}}},
+		Filter: &logs.LogFilter{
+			Level:     rule.Level,
+			TimeRange: &logs.TimeRangeLogFilter{From: timestamppb.Now()},
+		},
+		Tail: true,
+	}
+	if req.Filter.Level == logs.LogLevel_LOG_LEVEL_UNSET {
+		// Like user queries, rules ignore the debug logs that workers write by default.
+		req.Filter.Level = logs.LogLevel_LOG_LEVEL_INFO
+	}
+	if rule.Pattern != "" {
+		req.Filter.Regex = &logs.RegexLogFilter{Pattern: rule.Pattern}
+	}
+	return req
+}
+
+// An evaluator receives the lines that match a rule, and records them.  It's a
+// logservice.ResponsePublisher.
+type evaluator struct {
+	rule  *logs.LogRule
+	regex *regexp.Regexp
+	// notify enqueues an alert.
+	notify func(context.Context, *webhookpb.Event) error
+	now    func() time.Time
+
+	lastAlert  time.Time
+	suppressed int
+}
+
+func newEvaluator(rule *logs.LogRule, notify func(context.Context, *webhookpb.Event) error) (*evaluator, error) {
+	regex, err := regexp.Compile(rule.GetPattern())
+	if err != nil {
+		return nil, errors.Wrapf(err, "compile pattern of rule %q", rule.GetName())
+	}
+	return &evaluator{rule: rule, regex: regex, notify: notify, now: time.Now}, nil
+}
+
+// Publish implements logservice.ResponsePublisher.
+func (e *evaluator) Publish(ctx context.Context, resp *logs.GetLogsResponse) error {
+	msg := resp.GetLog()
+	if msg == nil {
+		return nil
+	}
+	line := string(msg.GetVerbatim().GetLine())
+	pipeline := e.rule.Pipeline
+	if pipeline == "" {
+		pipeline = pipelineOf(msg)
+	}
+	matchesMetric.WithLabelValues(e.rule.Name, e.rule.Project, pipeline).Inc()
+	if e.regex.NumSubexp() > 0 {
+		if m := e.regex.FindStringSubmatch(line); m != nil {
+			if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+				valueMetric.WithLabelValues(e.rule.Name, e.rule.Project, pipeline).Set(v)
+			}
+		}
+	}
+	if !e.rule.Alert {
+		return nil
+	}
+	now := e.now()
+	if now.Sub(e.lastAlert) < alertInterval {
+		e.suppressed++
+		return nil
+	}
+	event := &webhookpb.Event{
+		Type:     webhookpb.EventType_LOG,
+		Time:     msg.GetVerbatim().GetTimestamp(),
+		Project:  e.rule.Project,
+		Pipeline: pipeline,
+		Rule:     e.rule.Name,
+		Message:  line,
+	}
+	if m := msg.GetPpsLogMessage().GetMessage(); m != "" {
+		event.Message = m
+	}
+	if e.suppressed > 0 {
+		event.Reason = fmt.Sprintf("%d more lines matched since the last alert", e.suppressed)
+	}
+	if err := e.notify(ctx, event); err != nil {
+		// A failed alert shouldn't stop the rule from being counted; the next matching
+		// line will try again.
+		log.Error(ctx, "could not enqueue log rule alert", zap.String("rule", e.rule.Name), zap.Error(err))
+		return nil
+	}
+	e.lastAlert, e.suppressed = now, 0
+	return nil
+}
+
+// pipelineOf returns the name of the pipeline that logged msg, if it can be determined.
+func pipelineOf(msg *logs.LogMessage) string {
+	if p := msg.GetPpsLogMessage().GetPipelineName(); p != "" {
+		return p
+	}
+	ff := msg.GetObject().GetFields()
+	for _, k := range []string{"#pipelineName", "pipelineName"} {
+		if v := ff[k].GetStringValue(); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// forget removes the metrics of the named rule.
+func forget(name string) {
+	matchesMetric.DeletePartialMatch(prometheus.Labels{"rule": name})
+	valueMetric.DeletePartialMatch(prometheus.Labels{"rule": name})
+}
@@ -0,0 +1,116 @@
+package logrules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/logs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func logMessage(pipeline, message string) *logs.GetLogsResponse {
+	return &logs.GetLogsResponse{ResponseType: &logs.GetLogsResponse_Log{Log: &logs.LogMessage{
+		Verbatim: &logs.VerbatimLogMessage{
+			Line:      []byte(`{"pipelineName":"` + pipeline + `","message":"` + message + `"}`),
+			Timestamp: timestamppb.Now(),
+		},
+		PpsLogMessage: &pps.LogMessage{PipelineName: pipeline, Message: message},
+	}}}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(&logs.LogRule{Name: "a", Project: "default"}))
+	require.NoError(t, Validate(&logs.LogRule{Name: "a", Project: "default", Pipeline: "edges", Pattern: `ACCURACY=([0-9.]+)`}))
+	require.YesError(t, Validate(&logs.LogRule{Project: "default"}))
+	require.YesError(t, Validate(&logs.LogRule{Name: "a"}))
+	require.YesError(t, Validate(&logs.LogRule{Name: "a", Project: "default", Pattern: "("}))
+}
+
+func TestEvaluator(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	var alerts []*webhookpb.Event
+	rule := &logs.LogRule{Name: "accuracy", Project: "default", Pattern: `ACCURACY=([0-9.]+)`, Alert: true}
+	e, err := newEvaluator(rule, func(ctx context.Context, event *webhookpb.Event) error {
+		alerts = append(alerts, event)
+		return nil
+	})
+	require.NoError(t, err)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+	t.Cleanup(func() { forget(rule.Name) })
+
+	require.NoError(t, e.Publish(ctx, logMessage("train", "ACCURACY=0.91")))
+	require.NoError(t, e.Publish(ctx, logMessage("train", "ACCURACY=0.93")))
+	require.NoError(t, e.Publish(ctx, logMessage("eval", "ACCURACY=.")))
+	require.NoError(t, e.Publish(ctx, &logs.GetLogsResponse{ResponseType: &logs.GetLogsResponse_PagingHint{PagingHint: &logs.PagingHint{}}}))
+
+	require.Equal(t, 2.0, testutil.ToFloat64(matchesMetric.WithLabelValues("accuracy", "default", "train")))
+	require.Equal(t, 1.0, testutil.ToFloat64(matchesMetric.WithLabelValues("accuracy", "default", "eval")))
+	require.Equal(t, 0.93, testutil.ToFloat64(valueMetric.WithLabelValues("accuracy", "default", "train")))
+
+	// Alerts are rate limited, and report the lines that matched in between.
+	require.Equal(t, 1, len(alerts))
+	require.Equal(t, webhookpb.EventType_LOG, alerts[0].Type)
+	require.Equal(t, "train", alerts[0].Pipeline)
+	require.Equal(t, "ACCURACY=0.91", alerts[0].Message)
+	now = now.Add(alertInterval)
+	require.NoError(t, e.Publish(ctx, logMessage("train", "ACCURACY=0.95")))
+	require.Equal(t, 2, len(alerts))
+	require.Equal(t, "2 more lines matched since the last alert", alerts[1].Reason)
+
+	forget(rule.Name)
+	require.Equal(t, 0, testutil.CollectAndCount(matchesMetric, "pachyderm_log_rule_matches_total"))
+}
+
+func TestRules(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewMigratedTestDB(t, clusterstate.DesiredClusterState)
+	withTx := func(f func(context.Context, *pachsql.Tx) error) error {
+		return dbutil.WithTx(ctx, db, f)
+	}
+	rule := &logs.LogRule{Name: "accuracy", Project: "default", Pipeline: "train", Pattern: `ACCURACY=([0-9.]+)`}
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return CreateRule(ctx, tx, rule, false)
+	}))
+	err := withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return CreateRule(ctx, tx, rule, false)
+	})
+	require.True(t, errors.As(err, new(*RuleExistsError)), "creating a duplicate rule: %v", err)
+
+	rule.Alert = true
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return CreateRule(ctx, tx, rule, true)
+	}))
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		rules, err := ListRules(ctx, tx)
+		if err != nil {
+			return err
+		}
+		require.Equal(t, 1, len(rules))
+		require.NoDiff(t, rule, rules[0], []cmp.Option{protocmp.Transform()})
+		return nil
+	}))
+
+	require.NoError(t, withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return DeleteRule(ctx, tx, rule.Name)
+	}))
+	err = withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		_, err := GetRule(ctx, tx, rule.Name)
+		return err
+	})
+	require.True(t, errors.As(err, new(*RuleNotFoundError)), "getting a deleted rule: %v", err)
+}
@@ -0,0 +1,156 @@
+package logrules
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhook"
+	"github.com/pachyderm/pachyderm/v2/src/logs"
+	logservice "github.com/pachyderm/pachyderm/v2/src/server/logs"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	masterLockPath = "log-rules-master-lock"
+	// pollInterval is how often the master reads the rules, to start evaluating new rules and
+	// stop evaluating deleted ones.
+	pollInterval = 10 * time.Second
+)
+
+// Env contains the dependencies of the log rules master.
+type Env struct {
+	DB *pachsql.DB
+	// Logs queries the logs that rules match.  Rules are authorized when they're created, so
+	// it shouldn't have an AuthServer.
+	Logs       logservice.LogService
+	EtcdPrefix string
+	NewDLock   func(prefix string) dlock.DLock
+}
+
+// Master evaluates every log rule.  Only one master is active in a cluster at a time, so that
+// each matching line is counted once.
+type Master struct {
+	env Env
+}
+
+func NewMaster(env Env) *Master {
+	return &Master{env: env}
+}
+
+type running struct {
+	rule   *logs.LogRule
+	cancel context.CancelFunc
+}
+
+func (m *Master) Run(ctx context.Context) error {
+	return backoff.RetryUntilCancel(ctx, func() error {
+		lock := m.env.NewDLock(path.Join(m.env.EtcdPrefix, masterLockPath))
+		ctx, err := lock.Lock(ctx)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer func() {
+			if err := lock.Unlock(ctx); err != nil {
+				log.Error(ctx, "error unlocking in log rules master", zap.Error(err))
+			}
+		}()
+		rs := make(map[string]*running)
+		defer func() {
+			for name, r := range rs {
+				r.cancel()
+				forget(name)
+			}
+		}()
+		t := time.NewTicker(pollInterval)
+		defer t.Stop()
+		for {
+			if err := m.sync(ctx, rs); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return errors.EnsureStack(context.Cause(ctx))
+			case <-t.C:
+			}
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Error(ctx, "error in log rules master; restarting", zap.Error(err), zap.Duration("retryAfter", d))
+		return nil
+	})
+}
+
+// sync starts evaluating the rules that aren't in rs, restarts the ones that changed, and stops
+// the ones that were deleted.
+func (m *Master) sync(ctx context.Context, rs map[string]*running) error {
+	var rules []*logs.LogRule
+	if err := dbutil.WithTx(ctx, m.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		rules, err = ListRules(ctx, tx)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return errors.Wrap(err, "list log rules")
+	}
+	current := make(map[string]bool)
+	for _, rule := range rules {
+		current[rule.Name] = true
+		if r, ok := rs[rule.Name]; ok {
+			if proto.Equal(r.rule, rule) {
+				continue
+			}
+			r.cancel()
+			forget(rule.Name)
+		}
+		ctx, cancel := pctx.WithCancel(pctx.Child(ctx, "rule", pctx.WithFields(zap.String("rule", rule.Name))))
+		rs[rule.Name] = &running{rule: rule, cancel: cancel}
+		go m.evaluate(ctx, rule)
+	}
+	for name, r := range rs {
+		if !current[name] {
+			r.cancel()
+			forget(name)
+			delete(rs, name)
+		}
+	}
+	return nil
+}
+
+// evaluate tails the logs that rule matches until ctx is done.
+func (m *Master) evaluate(ctx context.Context, rule *logs.LogRule) {
+	e, err := newEvaluator(rule, m.notify)
+	if err != nil {
+		log.Error(ctx, "cannot evaluate log rule", zap.Error(err))
+		return
+	}
+	backoff.RetryUntilCancel(ctx, func() error { //nolint:errcheck
+		return errors.EnsureStack(m.env.Logs.GetLogs(ctx, request(rule), e))
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Info(ctx, "error evaluating log rule; retrying", zap.Error(err), zap.Duration("retryAfter", d))
+		return nil
+	})
+}
+
+func (m *Master) notify(ctx context.Context, event *webhookpb.Event) error {
+	var n int
+	if err := dbutil.WithTx(ctx, m.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		n, err = webhook.Enqueue(ctx, tx, event)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "enqueue deliveries")
+	}
+	if n > 0 {
+		log.Debug(ctx, "enqueued log rule alert", zap.Int("deliveries", n))
+	}
+	return nil
+}
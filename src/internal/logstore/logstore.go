@@ -0,0 +1,238 @@
+// Package logstore keeps worker logs in Postgres, for clusters that don't run Loki.
+//
+// Workers write each line they log to a Writer, which inserts them into the logs.entries table
+// labeled the way Loki's Kubernetes scraping would label them.  Store reads them back with the
+// same BatchedQueryRange semantics as the Loki client, so the logs service can query either one.
+package logstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	loki "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// Postgres is the value of the LOG_STORE setting that stores logs in Postgres.
+const Postgres = "postgres"
+
+// Enabled returns true if the configuration enables the log store.
+func Enabled(config *pachconfig.Configuration) (bool, error) {
+	switch config.LogStore {
+	case "":
+		return false, nil
+	case Postgres:
+		return true, nil
+	default:
+		return false, errors.Errorf("unknown LOG_STORE %q; must be empty or %q", config.LogStore, Postgres)
+	}
+}
+
+const (
+	// batchSize is how many entries are read from the database at a time.
+	batchSize = 1000
+	// ingestionLag is how far behind the present lines may still be arriving; Writers buffer
+	// lines for flushInterval, and pods' clocks differ a little.
+	ingestionLag = 10 * flushInterval
+)
+
+// startOfTime is where queries without a start time begin, like they do for Loki.
+var startOfTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Store reads the entries that Writers insert.
+type Store struct {
+	DB *pachsql.DB
+}
+
+type record struct {
+	ID     int64  `db:"id"`
+	TS     int64  `db:"ts"`
+	Labels []byte `db:"labels"`
+	Line   string `db:"line"`
+}
+
+// BatchedQueryRange calls recv with each entry that matches query, which may only use the subset of
+// LogQL described by ErrUnsupportedQuery, in the time range between start and end.  It interprets
+// its arguments and returns paging hints like loki.Client.BatchedQueryRange: start and end are
+// inclusive, the range is traversed backwards if start is after end (or is zero and end isn't),
+// offset skips that many entries at the first nanosecond traversed, and limit is the maximum number
+// of entries that recv counts, or 0 for no limit.
+//
+// An empty query matches nothing.
+func (s *Store) BatchedQueryRange(ctx context.Context, query string, start, end time.Time, offset uint, limit int, recv loki.RecvFunc) (prevPage, nextPage time.Time, prevOffset, nextOffset uint, retErr error) {
+	ctx, done := log.SpanContext(ctx, "BatchedQueryRange", zap.String("query", query))
+	defer done(log.Errorp(&retErr))
+
+	now := time.Now()
+	forward, lo, hi := true, start, end
+	switch {
+	case start.IsZero() && end.IsZero():
+		lo, hi = startOfTime, now
+	case start.IsZero():
+		forward, lo = false, startOfTime
+	case end.IsZero():
+		hi = now
+	case start.After(end):
+		forward, lo, hi = false, end, start
+	}
+	if limit == 0 {
+		limit = math.MaxInt
+	}
+
+	var sel *selector
+	if query != "" {
+		var err error
+		if sel, err = parseSelector(query); err != nil {
+			return time.Time{}, time.Time{}, 0, 0, err
+		}
+	}
+
+	var (
+		last   time.Time // The timestamp of the last entry read.
+		atLast uint      // How many entries at that timestamp were read.
+		full   bool      // True if limit entries were counted.
+		seen   bool      // True if any entries were read.
+	)
+	boundary := lo
+	if !forward {
+		boundary = hi
+	}
+	if sel != nil {
+		labels := make(map[string]loki.LabelSet)
+		var cursor *record
+		for !full {
+			batch, err := s.read(ctx, sel, lo, hi, forward, cursor)
+			if err != nil {
+				return time.Time{}, time.Time{}, 0, 0, err
+			}
+			for i := range batch {
+				r := &batch[i]
+				cursor = r
+				ts := time.Unix(0, r.TS)
+				if seen && ts.Equal(last) {
+					atLast++
+				} else {
+					last, atLast, seen = ts, 1, true
+				}
+				if offset > 0 && ts.Equal(boundary) {
+					offset--
+					continue
+				}
+				ls, ok := labels[string(r.Labels)]
+				if !ok {
+					if err := json.Unmarshal(r.Labels, &ls); err != nil {
+						return time.Time{}, time.Time{}, 0, 0, errors.Wrapf(err, "unmarshal labels of entry %d", r.ID)
+					}
+					labels[string(r.Labels)] = ls
+				}
+				count, err := recv(ctx, ls, &loki.Entry{Timestamp: ts, Line: r.Line})
+				if err != nil {
+					return time.Time{}, time.Time{}, 0, 0, errors.Wrap(err, "recv")
+				}
+				if count {
+					if limit--; limit == 0 {
+						full = true
+						break
+					}
+				}
+			}
+			if len(batch) < batchSize {
+				break
+			}
+		}
+	}
+
+	if forward {
+		prevPage = lo.Add(-time.Nanosecond)
+		switch {
+		case full:
+			nextPage, nextOffset = last, atLast
+		case end.IsZero():
+			// Lines logged just before now may not have been inserted yet.
+			nextPage = now.Add(-ingestionLag)
+			if seen && !last.Before(nextPage) {
+				nextPage = last.Add(time.Nanosecond)
+			}
+			if nextPage.Before(lo) {
+				nextPage = lo
+			}
+		default:
+			nextPage = hi.Add(time.Nanosecond)
+		}
+	} else {
+		nextPage = hi.Add(time.Nanosecond)
+		if full {
+			prevPage, prevOffset = last, atLast
+		} else {
+			prevPage = lo.Add(-time.Nanosecond)
+		}
+	}
+	return prevPage, nextPage, prevOffset, nextOffset, nil
+}
+
+// read reads the next batch of entries that match sel, after cursor if it's set.
+func (s *Store) read(ctx context.Context, sel *selector, lo, hi time.Time, forward bool, cursor *record) ([]record, error) {
+	labels, err := json.Marshal(sel.labels)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal labels")
+	}
+	q := new(strings.Builder)
+	q.WriteString(`select id, ts, labels, line from logs.entries where ts >= $1 and ts <= $2 and labels @> $3::jsonb`)
+	args := []any{lo.UnixNano(), hi.UnixNano(), labels}
+	for _, c := range sel.contains {
+		args = append(args, c)
+		fmt.Fprintf(q, ` and strpos(line, $%d) > 0`, len(args))
+	}
+	cmp, order := ">", "asc"
+	if !forward {
+		cmp, order = "<", "desc"
+	}
+	if cursor != nil {
+		args = append(args, cursor.TS, cursor.ID)
+		fmt.Fprintf(q, ` and (ts, id) %s ($%d, $%d)`, cmp, len(args)-1, len(args))
+	}
+	fmt.Fprintf(q, ` order by ts %s, id %s limit %d`, order, order, batchSize)
+	var batch []record
+	if err := s.DB.SelectContext(ctx, &batch, q.String(), args...); err != nil {
+		return nil, errors.Wrap(err, "select log entries")
+	}
+	return batch, nil
+}
+
+// DeleteBefore deletes the entries logged before t, and returns how many were deleted.
+func DeleteBefore(ctx context.Context, db *pachsql.DB, t time.Time) (int64, error) {
+	res, err := db.ExecContext(ctx, `delete from logs.entries where ts < $1`, t.UnixNano())
+	if err != nil {
+		return 0, errors.Wrap(err, "delete old log entries")
+	}
+	n, err := res.RowsAffected()
+	return n, errors.Wrap(err, "rows affected")
+}
+
+// Prune deletes the entries older than retention every hour, until ctx is done.
+func Prune(ctx context.Context, db *pachsql.DB, retention time.Duration) {
+	t := time.NewTicker(time.Hour)
+	defer t.Stop()
+	for {
+		n, err := DeleteBefore(ctx, db, time.Now().Add(-retention))
+		if err != nil {
+			log.Error(ctx, "could not delete old log entries", zap.Error(err))
+		} else if n > 0 {
+			log.Info(ctx, "deleted old log entries", zap.Int64("count", n))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
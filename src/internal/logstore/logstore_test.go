@@ -0,0 +1,131 @@
+package logstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	loki "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	for query, want := range map[string]*selector{
+		`{}`: {labels: map[string]string{}},
+		`{app="pipeline",suite="pachyderm",container="user",pipelineProject="default",pipelineName="edges"}`: {
+			labels: map[string]string{"app": "pipeline", "suite": "pachyderm", "container": "user", "pipelineProject": "default", "pipelineName": "edges"},
+		},
+		`{suite="pachyderm", app="pipeline"} |= "abc\"def" |= "x"`: {
+			labels:   map[string]string{"suite": "pachyderm", "app": "pipeline"},
+			contains: []string{`abc"def`, "x"},
+		},
+	} {
+		got, err := parseSelector(query)
+		if err != nil {
+			t.Errorf("parseSelector(%v): %v", query, err)
+			continue
+		}
+		if diff := cmp.Diff(want, got, cmp.AllowUnexported(selector{})); diff != "" {
+			t.Errorf("parseSelector(%v) (-want +got):\n%s", query, diff)
+		}
+	}
+	for _, query := range []string{
+		`app="pipeline"`,
+		`{app=~"pipe.*"}`,
+		`{app!="pipeline"}`,
+		`{app="pipeline"} |~ "error"`,
+		`{app="pipeline"} | json`,
+		`{app="pipeline" suite="pachyderm"}`,
+		`{app="pipeline"`,
+	} {
+		if _, err := parseSelector(query); !errors.Is(err, ErrUnsupportedQuery) {
+			t.Errorf("parseSelector(%v): expected ErrUnsupportedQuery; got %v", query, err)
+		}
+	}
+}
+
+func TestWriterDropsOldest(t *testing.T) {
+	w, err := NewWriter(nil, nil)
+	require.NoError(t, err)
+	for i := 0; i < maxPending; i++ {
+		w.Write([]byte("a\n")) //nolint:errcheck
+	}
+	w.Write([]byte("b\nc\x00\n")) //nolint:errcheck
+	require.Equal(t, maxPending, len(w.pending))
+	require.Equal(t, 2, w.dropped)
+	require.Equal(t, "b", w.pending[maxPending-2].line)
+	require.Equal(t, "c", w.pending[maxPending-1].line)
+}
+
+func TestStore(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewMigratedTestDB(t, clusterstate.DesiredClusterState)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	edges, err := NewWriter(db, map[string]string{"app": "pipeline", "pipelineName": "edges"})
+	require.NoError(t, err)
+	montage, err := NewWriter(db, map[string]string{"app": "pipeline", "pipelineName": "montage"})
+	require.NoError(t, err)
+	// Three lines share a nanosecond, so paging has to use offsets.
+	for i, d := range []time.Duration{0, time.Second, time.Second, time.Second, 2 * time.Second} {
+		edges.pending = append(edges.pending, entry{ts: start.Add(d).UnixNano(), line: "edges " + string(rune('a'+i))})
+	}
+	montage.pending = append(montage.pending, entry{ts: start.Add(time.Second).UnixNano(), line: "montage a"})
+	require.NoError(t, edges.Flush(ctx))
+	require.NoError(t, montage.Flush(ctx))
+
+	s := &Store{DB: db}
+	query := func(query string, from, until time.Time, offset uint, limit int) ([]string, time.Time, time.Time, uint, uint) {
+		t.Helper()
+		var lines []string
+		prev, next, prevOffset, nextOffset, err := s.BatchedQueryRange(ctx, query, from, until, offset, limit, func(ctx context.Context, labels loki.LabelSet, e *loki.Entry) (bool, error) {
+			lines = append(lines, e.Line)
+			return true, nil
+		})
+		require.NoError(t, err)
+		return lines, prev, next, prevOffset, nextOffset
+	}
+
+	got, _, _, _, _ := query(`{app="pipeline"} |= "a"`, start, start.Add(time.Minute), 0, 0)
+	require.NoDiff(t, []string{"edges a", "montage a"}, got, nil, "line filter")
+
+	// Page forwards two lines at a time.
+	var (
+		all    []string
+		from   = start
+		offset uint
+	)
+	for i := 0; i < 4; i++ {
+		lines, _, next, _, nextOffset := query(`{pipelineName="edges"}`, from, start.Add(time.Minute), offset, 2)
+		all = append(all, lines...)
+		if len(lines) < 2 {
+			break
+		}
+		from, offset = next, nextOffset
+	}
+	require.NoDiff(t, []string{"edges a", "edges b", "edges c", "edges d", "edges e"}, all, nil, "paging forwards")
+
+	// And backwards.
+	all = nil
+	until := start.Add(time.Minute)
+	offset = 0
+	for i := 0; i < 4; i++ {
+		lines, prev, _, prevOffset, _ := query(`{pipelineName="edges"}`, time.Time{}, until, offset, 2)
+		all = append(all, lines...)
+		if len(lines) < 2 {
+			break
+		}
+		until, offset = prev, prevOffset
+	}
+	require.NoDiff(t, []string{"edges e", "edges d", "edges c", "edges b", "edges a"}, all, nil, "paging backwards")
+
+	n, err := DeleteBefore(ctx, db, start.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+}
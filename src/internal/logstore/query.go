@@ -0,0 +1,107 @@
+package logstore
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ErrUnsupportedQuery is returned for LogQL queries that the store can't run.
+var ErrUnsupportedQuery = errors.New("unsupported query; the log store only supports label equality matchers and |= line filters")
+
+// A selector is the subset of LogQL that the store understands: a stream selector made of label
+// equality matchers, followed by any number of |= line filters.  That's all LogService compiles
+// queries to, other than admin LogQL queries.
+type selector struct {
+	labels   map[string]string
+	contains []string
+}
+
+// parseSelector parses a query like `{app="pipeline",pipelineName="edges"} |= "datum"`.
+func parseSelector(query string) (*selector, error) {
+	p := &parser{rest: query}
+	sel := &selector{labels: make(map[string]string)}
+	if !p.consume("{") {
+		return nil, p.errorf("expected {")
+	}
+	for !p.consume("}") {
+		if len(sel.labels) > 0 && !p.consume(",") {
+			return nil, p.errorf("expected , or }")
+		}
+		name := p.label()
+		if name == "" {
+			return nil, p.errorf("expected a label name")
+		}
+		if !p.consume("=") || strings.HasPrefix(p.rest, "~") {
+			return nil, p.errorf("expected = after %v", name)
+		}
+		value, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		sel.labels[name] = value
+	}
+	for {
+		p.skipSpace()
+		if p.rest == "" {
+			return sel, nil
+		}
+		if !p.consume("|=") {
+			return nil, p.errorf("expected |=")
+		}
+		s, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		sel.contains = append(sel.contains, s)
+	}
+}
+
+type parser struct {
+	rest string
+}
+
+func (p *parser) skipSpace() {
+	p.rest = strings.TrimLeft(p.rest, " \t\r\n")
+}
+
+func (p *parser) consume(token string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.rest, token) {
+		p.rest = p.rest[len(token):]
+		return true
+	}
+	return false
+}
+
+func (p *parser) label() string {
+	p.skipSpace()
+	i := strings.IndexFunc(p.rest, func(r rune) bool {
+		return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	if i < 0 {
+		i = len(p.rest)
+	}
+	name := p.rest[:i]
+	p.rest = p.rest[i:]
+	return name
+}
+
+func (p *parser) string() (string, error) {
+	p.skipSpace()
+	quoted, err := strconv.QuotedPrefix(p.rest)
+	if err != nil {
+		return "", p.errorf("expected a quoted string")
+	}
+	p.rest = p.rest[len(quoted):]
+	s, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", p.errorf("unquote %v: %v", quoted, err)
+	}
+	return s, nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return errors.Wrapf(ErrUnsupportedQuery, "at %q: "+format, append([]any{p.rest}, args...)...)
+}
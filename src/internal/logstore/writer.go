@@ -0,0 +1,157 @@
+package logstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+const (
+	// flushInterval is how often a Writer inserts the lines written to it.
+	flushInterval = time.Second
+	// maxPending is how many lines a Writer buffers before it starts dropping the oldest, so
+	// that an unavailable database doesn't take the worker's memory with it.
+	maxPending = 10000
+	// insertBatchSize is how many lines are inserted per statement.
+	insertBatchSize = 1000
+)
+
+// WorkerLabels returns the labels of a worker's logs; they're the ones Loki's Kubernetes scraping
+// gives the logs of a worker's user container, so that the same queries match them.
+func WorkerLabels(config *pachconfig.Configuration) map[string]string {
+	return map[string]string{
+		"suite":           "pachyderm",
+		"app":             "pipeline",
+		"container":       "user",
+		"pod":             config.PodName,
+		"pipelineProject": config.PPSProjectName,
+		"pipelineName":    config.PPSPipelineName,
+	}
+}
+
+type entry struct {
+	ts   int64
+	line string
+}
+
+// Writer inserts each line written to it into the log store, with a fixed set of labels.  Writes
+// never block on the database; lines are buffered, and inserted in batches by Run.
+type Writer struct {
+	db     *pachsql.DB
+	labels []byte
+
+	mu      sync.Mutex
+	pending []entry
+	dropped int
+}
+
+// NewWriter returns a Writer that labels the lines written to it with labels.
+func NewWriter(db *pachsql.DB, labels map[string]string) (*Writer, error) {
+	js, err := json.Marshal(labels)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal labels")
+	}
+	return &Writer{db: db, labels: js}, nil
+}
+
+// Write implements io.Writer.  p is one or more lines, and is not retained.
+func (w *Writer) Write(p []byte) (int, error) {
+	ts := time.Now().UnixNano()
+	// Postgres text can't hold NULs or invalid UTF-8.
+	s := strings.ToValidUTF8(strings.ReplaceAll(string(p), "\x00", ""), "\uFFFD")
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		w.pending = append(w.pending, entry{ts: ts, line: line})
+	}
+	if n := len(w.pending) - maxPending; n > 0 {
+		w.pending = w.pending[n:]
+		w.dropped += n
+	}
+	return len(p), nil
+}
+
+// Run inserts the buffered lines every flushInterval until ctx is done, and then inserts the lines
+// that are left.
+func (w *Writer) Run(ctx context.Context) {
+	t := time.NewTicker(flushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			ctx, cancel := context.WithTimeout(pctx.Background("logstore.Writer"), 5*time.Second)
+			defer cancel()
+			if err := w.Flush(ctx); err != nil {
+				log.Info(ctx, "could not insert final log lines", zap.Error(err))
+			}
+			return
+		case <-t.C:
+			if err := w.Flush(ctx); err != nil {
+				// This is logged to the Writer too, and inserted when the database
+				// is back.
+				log.Info(ctx, "could not insert log lines; will retry", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Flush inserts the buffered lines.  If they can't be inserted, they're buffered again.
+func (w *Writer) Flush(ctx context.Context) error {
+	w.mu.Lock()
+	es, dropped := w.pending, w.dropped
+	w.pending, w.dropped = nil, 0
+	w.mu.Unlock()
+	if dropped > 0 {
+		// Logging here, rather than in Write, avoids logging while the logger is writing.
+		log.Info(ctx, "log store dropped log lines because the database was unavailable", zap.Int("dropped", dropped))
+	}
+	if len(es) == 0 {
+		return nil
+	}
+	n, err := w.insert(ctx, es)
+	if err != nil {
+		w.mu.Lock()
+		w.pending = append(es[n:], w.pending...)
+		if extra := len(w.pending) - maxPending; extra > 0 {
+			w.pending = w.pending[extra:]
+			w.dropped += extra
+		}
+		w.mu.Unlock()
+	}
+	return err
+}
+
+// insert inserts es and returns how many were inserted.
+func (w *Writer) insert(ctx context.Context, es []entry) (int, error) {
+	var inserted int
+	for len(es) > 0 {
+		batch := es[:min(len(es), insertBatchSize)]
+		q := new(strings.Builder)
+		q.WriteString(`insert into logs.entries (ts, labels, line) values `)
+		args := []any{w.labels}
+		for i, e := range batch {
+			if i > 0 {
+				q.WriteString(", ")
+			}
+			args = append(args, e.ts, e.line)
+			fmt.Fprintf(q, "($%d, $1::jsonb, $%d)", len(args)-1, len(args))
+		}
+		if _, err := w.db.ExecContext(ctx, q.String(), args...); err != nil {
+			return inserted, errors.Wrap(err, "insert log entries")
+		}
+		inserted += len(batch)
+		es = es[len(batch):]
+	}
+	return inserted, nil
+}
@@ -0,0 +1,404 @@
+// Package audit implements a gRPC middleware that records calls to APIs that
+// change the state of the cluster in the audit log.
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+const (
+	// insertTimeout bounds how long one attempt to insert events may take.
+	insertTimeout = 10 * time.Second
+	// queueSize bounds how many events may wait to be inserted.  Events are
+	// inserted in the background, so that recording them doesn't slow down
+	// calls; if the database falls this far behind, calls insert their own
+	// events before returning.
+	queueSize = 10000
+	// batchSize bounds how many events are inserted at once.
+	batchSize = 100
+	// maxRetryTime bounds how long a batch of events is retried before it's
+	// dropped.
+	maxRetryTime = 5 * time.Minute
+)
+
+var droppedEventsMetric = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "pachyderm",
+	Subsystem: "audit",
+	Name:      "dropped_event_count",
+	Help:      "Count of audit events that couldn't be inserted into the audit log.",
+})
+
+// auditedMethods are the RPCs that are recorded in the audit log.  Calls that
+// only read the state of the cluster aren't recorded.  The interceptor is only
+// installed on the external server, so internal calls made by workers to
+// report their progress aren't recorded either.
+var auditedMethods = map[string]bool{
+	//
+	// Admin API
+	//
+	"/admin_v2.API/RestartPachyderm": true,
+
+	//
+	// Auth API
+	//
+	"/auth_v2.API/Activate":                true,
+	"/auth_v2.API/Deactivate":              true,
+	"/auth_v2.API/SetConfiguration":        true,
+	"/auth_v2.API/ModifyRoleBinding":       true,
+	"/auth_v2.API/GetRobotToken":           true,
+	"/auth_v2.API/RevokeAuthToken":         true,
+	"/auth_v2.API/RevokeAuthTokensForUser": true,
+	"/auth_v2.API/RestoreAuthToken":        true,
+	"/auth_v2.API/ExtractAuthTokens":       true,
+	"/auth_v2.API/DeleteExpiredAuthTokens": true,
+	"/auth_v2.API/RotateRootToken":         true,
+	"/auth_v2.API/SetGroupsForUser":        true,
+	"/auth_v2.API/ModifyMembers":           true,
+	"/auth_v2.API/CreateRole":              true,
+	"/auth_v2.API/UpdateRole":              true,
+	"/auth_v2.API/DeleteRole":              true,
+
+	//
+	// Enterprise API
+	//
+	"/enterprise_v2.API/Activate":   true,
+	"/enterprise_v2.API/Deactivate": true,
+	"/enterprise_v2.API/Pause":      true,
+	"/enterprise_v2.API/Unpause":    true,
+
+	//
+	// Identity API
+	//
+	"/identity_v2.API/SetIdentityServerConfig": true,
+	"/identity_v2.API/CreateIDPConnector":      true,
+	"/identity_v2.API/UpdateIDPConnector":      true,
+	"/identity_v2.API/DeleteIDPConnector":      true,
+	"/identity_v2.API/CreateOIDCClient":        true,
+	"/identity_v2.API/UpdateOIDCClient":        true,
+	"/identity_v2.API/DeleteOIDCClient":        true,
+	"/identity_v2.API/DeleteAll":               true,
+
+	//
+	// License API
+	//
+	"/license_v2.API/Activate":      true,
+	"/license_v2.API/AddCluster":    true,
+	"/license_v2.API/UpdateCluster": true,
+	"/license_v2.API/DeleteCluster": true,
+	"/license_v2.API/DeleteAll":     true,
+
+	//
+	// PFS API
+	//
+	"/pfs_v2.API/CreateRepo":      true,
+	"/pfs_v2.API/DeleteRepo":      true,
+	"/pfs_v2.API/DeleteRepos":     true,
+	"/pfs_v2.API/StartCommit":     true,
+	"/pfs_v2.API/FinishCommit":    true,
+	"/pfs_v2.API/ClearCommit":     true,
+	"/pfs_v2.API/SquashCommit":    true,
+	"/pfs_v2.API/DropCommit":      true,
+	"/pfs_v2.API/ForgetCommit":    true,
+	"/pfs_v2.API/SquashCommitSet": true,
+	"/pfs_v2.API/DropCommitSet":   true,
+	"/pfs_v2.API/CreateBranch":    true,
+	"/pfs_v2.API/DeleteBranch":    true,
+	"/pfs_v2.API/CreateProject":   true,
+	"/pfs_v2.API/DeleteProject":   true,
+	"/pfs_v2.API/ModifyFile":      true,
+	"/pfs_v2.API/AddFileSet":      true,
+	"/pfs_v2.API/DeleteAll":       true,
+	"/pfs_v2.API/CreateShareLink": true,
+	"/pfs_v2.API/RevokeShareLink": true,
+
+	//
+	// PPS API
+	//
+	"/pps_v2.API/DeleteJob":          true,
+	"/pps_v2.API/StopJob":            true,
+	"/pps_v2.API/RestartDatum":       true,
+	"/pps_v2.API/CreatePipeline":     true,
+	"/pps_v2.API/CreatePipelineV2":   true,
+	"/pps_v2.API/RerunPipeline":      true,
+	"/pps_v2.API/DeletePipeline":     true,
+	"/pps_v2.API/DeletePipelines":    true,
+	"/pps_v2.API/StartPipeline":      true,
+	"/pps_v2.API/StopPipeline":       true,
+	"/pps_v2.API/RunPipeline":        true,
+	"/pps_v2.API/RunCron":            true,
+	"/pps_v2.API/CreateSecret":       true,
+	"/pps_v2.API/DeleteSecret":       true,
+	"/pps_v2.API/SetClusterDefaults": true,
+	"/pps_v2.API/SetProjectDefaults": true,
+	"/pps_v2.API/SetProjectQuota":    true,
+	"/pps_v2.API/DeleteAll":          true,
+
+	//
+	// Transaction API
+	//
+	"/transaction_v2.API/BatchTransaction":  true,
+	"/transaction_v2.API/FinishTransaction": true,
+	"/transaction_v2.API/DeleteAll":         true,
+
+	//
+	// Other APIs
+	//
+	"/logs.API/CreateLogRule":                true,
+	"/logs.API/DeleteLogRule":                true,
+	"/metadata.API/EditMetadata":             true,
+	"/snapshot.API/CreateSnapshot":           true,
+	"/snapshot.API/DeleteSnapshot":           true,
+	"/storage.Fileset/WriteChunk":            true,
+	"/storage.Fileset/CreateFilesetFromRefs": true,
+	"/webhook.API/CreateWebhook":             true,
+	"/webhook.API/DeleteWebhook":             true,
+}
+
+// omitRequest are the audited RPCs whose requests aren't summarized, because
+// they contain secrets that aren't masked in logs.
+var omitRequest = map[string]bool{
+	"/pps_v2.API/CreateSecret": true,
+}
+
+type principalKey struct{}
+
+// principal holds the principal making a call.  It's added to the call's
+// context before the call is authenticated, so that the auth middleware can
+// fill it in even when it rejects the call.
+type principal struct {
+	mu   sync.Mutex
+	name string
+}
+
+// SetPrincipal records the principal making the call whose context is ctx, if
+// the call is being audited.
+func SetPrincipal(ctx context.Context, name string) {
+	if p, ok := ctx.Value(principalKey{}).(*principal); ok {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.name = name
+	}
+}
+
+func (p *principal) get() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.name
+}
+
+// Interceptor records calls to the RPCs in auditedMethods.  It must come
+// before the auth interceptor in the chain, so that calls that are denied are
+// recorded too.
+type Interceptor struct {
+	insert     func(ctx context.Context, events ...*auth.AuditEvent) error
+	newBackOff func() backoff.BackOff
+	events     chan *auth.AuditEvent
+	start      sync.Once
+	done       chan struct{}
+
+	// mu is held for reading while events are queued, so that Close doesn't
+	// close the queue under them.
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewInterceptor returns an Interceptor that records events in the database
+// returned by getDB.  Close must be called to insert the events that are
+// still queued when the server stops.
+func NewInterceptor(getDB func() *pachsql.DB) *Interceptor {
+	return newInterceptor(func(ctx context.Context, events ...*auth.AuditEvent) error {
+		return authdb.InsertAuditEvents(ctx, getDB(), events...)
+	}, func() backoff.BackOff {
+		b := backoff.NewExponentialBackOff()
+		b.MaxElapsedTime = maxRetryTime
+		return b
+	})
+}
+
+func newInterceptor(insert func(ctx context.Context, events ...*auth.AuditEvent) error, newBackOff func() backoff.BackOff) *Interceptor {
+	return &Interceptor{
+		insert:     insert,
+		newBackOff: newBackOff,
+		events:     make(chan *auth.AuditEvent, queueSize),
+		done:       make(chan struct{}),
+	}
+}
+
+// Close stops queueing events, and waits until the events that are already
+// queued are inserted or ctx is done.  Events recorded after Close are
+// inserted by the calls that record them.
+func (i *Interceptor) Close(ctx context.Context) error {
+	i.startInserting()
+	i.mu.Lock()
+	if !i.closed {
+		i.closed = true
+		close(i.events)
+	}
+	i.mu.Unlock()
+	select {
+	case <-i.done:
+		return nil
+	case <-ctx.Done():
+		n := len(i.events)
+		droppedEventsMetric.Add(float64(n))
+		return errors.Wrapf(context.Cause(ctx), "%d audit events weren't inserted", n)
+	}
+}
+
+// InterceptUnary records audited unary RPCs.
+func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !auditedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	p := &principal{}
+	start := time.Now()
+	resp, err := handler(context.WithValue(ctx, principalKey{}, p), req)
+	i.record(ctx, info.FullMethod, p.get(), req, start, err)
+	return resp, err
+}
+
+// InterceptStream records audited streaming RPCs.  The request of a streaming
+// RPC is summarized from the first message the client sends.
+func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !auditedMethods[info.FullMethod] {
+		return handler(srv, stream)
+	}
+	ctx := stream.Context()
+	p := &principal{}
+	s := &serverStream{
+		ServerStream: stream,
+		ctx:          context.WithValue(ctx, principalKey{}, p),
+	}
+	start := time.Now()
+	err := handler(srv, s)
+	i.record(ctx, info.FullMethod, p.get(), s.firstMsg(), start, err)
+	return err
+}
+
+// serverStream keeps the first message received on a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	mu    sync.Mutex
+	first interface{}
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err //nolint:wrapcheck
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.first == nil {
+		s.first = m
+	}
+	return nil
+}
+
+func (s *serverStream) firstMsg() interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.first
+}
+
+// record queues an event for a call to be inserted into the audit log.
+// Failing to record an event is logged and counted by droppedEventsMetric,
+// but doesn't fail the call.
+func (i *Interceptor) record(ctx context.Context, method, principal string, req interface{}, start time.Time, err error) {
+	ev := &auth.AuditEvent{
+		Time:      timestamppb.New(start),
+		Principal: principal,
+		Method:    method,
+		Resource:  resource(req),
+		Code:      status.Code(err).String(),
+		Duration:  durationpb.New(time.Since(start)),
+	}
+	if !omitRequest[method] {
+		ev.Request = summarize(req)
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	i.startInserting()
+	i.mu.RLock()
+	if !i.closed {
+		select {
+		case i.events <- ev:
+			i.mu.RUnlock()
+			return
+		default:
+		}
+	}
+	i.mu.RUnlock()
+	// The queue is full or closed, so the event is inserted before the call
+	// returns.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), insertTimeout)
+	defer cancel()
+	if err := i.insert(ctx, ev); err != nil {
+		droppedEventsMetric.Inc()
+		log.Error(ctx, "could not record audit event; dropping it", zap.String("method", method), zap.String("principal", principal), zap.Error(err))
+	}
+}
+
+func (i *Interceptor) startInserting() {
+	i.start.Do(func() {
+		go i.insertEvents(pctx.Background("audit"))
+	})
+}
+
+// insertEvents inserts queued events into the audit log in batches, until the
+// queue is closed and drained.  A batch that can't be inserted is retried with
+// backoff, and dropped after maxRetryTime.
+func (i *Interceptor) insertEvents(ctx context.Context) {
+	defer close(i.done)
+	batch := make([]*auth.AuditEvent, 0, batchSize)
+	for ev := range i.events {
+		batch = append(batch[:0], ev)
+	fill:
+		for len(batch) < batchSize {
+			select {
+			case ev, ok := <-i.events:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, ev)
+			default:
+				break fill
+			}
+		}
+		if err := backoff.RetryNotify(func() error {
+			ctx, cancel := context.WithTimeout(ctx, insertTimeout)
+			defer cancel()
+			return i.insert(ctx, batch...)
+		}, i.newBackOff(), func(err error, d time.Duration) error {
+			log.Info(ctx, "could not record audit events; retrying", zap.Int("events", len(batch)), zap.Duration("retryAfter", d), zap.Error(err))
+			return nil
+		}); err != nil {
+			droppedEventsMetric.Add(float64(len(batch)))
+			log.Error(ctx, "could not record audit events; dropping them", zap.Int("events", len(batch)), zap.Error(err))
+		}
+	}
+}
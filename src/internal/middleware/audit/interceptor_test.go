@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// fakeLog is an audit log whose inserts fail until it's told otherwise.
+type fakeLog struct {
+	mu       sync.Mutex
+	failing  bool
+	attempts int
+	events   []*auth.AuditEvent
+}
+
+func (l *fakeLog) insert(ctx context.Context, events ...*auth.AuditEvent) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.attempts++
+	if l.failing {
+		return errors.New("database is down")
+	}
+	l.events = append(l.events, events...)
+	return nil
+}
+
+func (l *fakeLog) setFailing(failing bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.failing = failing
+}
+
+func (l *fakeLog) get() (attempts int, events []*auth.AuditEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.attempts, l.events
+}
+
+func TestInterceptorRetries(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	fl := &fakeLog{failing: true}
+	i := newInterceptor(fl.insert, func() backoff.BackOff {
+		return backoff.NewConstantBackOff(10 * time.Millisecond)
+	})
+	call := func() {
+		_, err := i.InterceptUnary(ctx, &pfs.CreateRepoRequest{}, &grpc.UnaryServerInfo{FullMethod: "/pfs_v2.API/CreateRepo"},
+			func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+		require.NoError(t, err)
+	}
+
+	// Events that can't be inserted are retried until the database recovers.
+	call()
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if attempts, _ := fl.get(); attempts < 3 {
+			return errors.Errorf("%d attempts to insert the event", attempts)
+		}
+		return nil
+	})
+	fl.setFailing(false)
+	call()
+	// Close waits for the queued events to be inserted.
+	require.NoError(t, i.Close(ctx))
+	_, events := fl.get()
+	require.Len(t, events, 2)
+	require.Equal(t, "/pfs_v2.API/CreateRepo", events[0].Method)
+
+	// After Close, calls insert their own events, and count those that they can't.
+	fl.setFailing(true)
+	dropped := testutil.ToFloat64(droppedEventsMetric)
+	call()
+	require.Equal(t, dropped+1, testutil.ToFloat64(droppedEventsMetric))
+}
+
+func TestInterceptorDropsAfterRetrying(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	fl := &fakeLog{failing: true}
+	i := newInterceptor(fl.insert, func() backoff.BackOff {
+		b := backoff.NewExponentialBackOff()
+		b.InitialInterval = time.Millisecond
+		b.MaxElapsedTime = 50 * time.Millisecond
+		return b
+	})
+	dropped := testutil.ToFloat64(droppedEventsMetric)
+	_, err := i.InterceptUnary(ctx, &pfs.CreateRepoRequest{}, &grpc.UnaryServerInfo{FullMethod: "/pfs_v2.API/CreateRepo"},
+		func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+	require.NoError(t, err)
+	require.NoError(t, i.Close(ctx))
+	require.Equal(t, dropped+1, testutil.ToFloat64(droppedEventsMetric))
+}
@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// maxRequestSize is the largest request summary that's stored as is.  Larger
+// summaries are stored as a truncated string.
+const maxRequestSize = 4096
+
+// resourceFields are the names of the request fields that identify the
+// resource a call acts on, most specific first.
+var resourceFields = []protoreflect.Name{
+	"resource",
+	"file",
+	"commit",
+	"set_commit",
+	"branch",
+	"job",
+	"pipeline",
+	"repo",
+	"project",
+	"username",
+	"name",
+}
+
+// resource returns the main resource that req acts on, or "" if it can't
+// tell.
+func resource(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for _, name := range resourceFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			return m.Get(fd).String()
+		case protoreflect.MessageKind:
+			if s := resourceString(m.Get(fd).Message().Interface()); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func resourceString(msg proto.Message) string {
+	switch x := msg.(type) {
+	case *auth.Resource:
+		return authdb.ResourceKey(x)
+	case *pfs.File:
+		if x.Commit == nil {
+			return x.Path
+		}
+		return x.Commit.String() + ":" + x.Path
+	case fmt.Stringer:
+		return x.String()
+	}
+	return ""
+}
+
+// summarize returns a JSON summary of req.  Fields are encoded as they are
+// in the logs, so secrets are masked and large byte fields are abridged.
+func summarize(req interface{}) string {
+	m, ok := req.(zapcore.ObjectMarshaler)
+	if !ok {
+		return ""
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	if err := m.MarshalLogObject(enc); err != nil {
+		return ""
+	}
+	js, err := json.Marshal(enc.Fields)
+	if err != nil {
+		return ""
+	}
+	if len(js) > maxRequestSize {
+		js, err = json.Marshal(map[string]string{"truncated": string(js[:maxRequestSize])})
+		if err != nil {
+			return ""
+		}
+	}
+	return string(js)
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestResource(t *testing.T) {
+	repo := client.NewRepo(pfs.DefaultProjectName, "images")
+	commit := repo.NewCommit("master", "")
+	for _, tc := range []struct {
+		req  interface{}
+		want string
+	}{
+		{&pfs.CreateRepoRequest{Repo: repo}, "default/images"},
+		{&pfs.StartCommitRequest{Branch: repo.NewBranch("master")}, "default/images@master"},
+		{&pfs.ModifyFileRequest{Body: &pfs.ModifyFileRequest_SetCommit{SetCommit: commit}}, "default/images@master"},
+		{&pps.CreatePipelineRequest{Pipeline: &pps.Pipeline{Project: &pfs.Project{Name: "p"}, Name: "edges"}}, "p/edges"},
+		{&auth.ModifyRoleBindingRequest{
+			Principal: "user:alice",
+			Resource:  &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"},
+		}, "REPO:default/images"},
+		{&auth.ModifyRoleBindingRequest{Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER}}, auth.ClusterRoleBindingKey},
+		{&auth.RevokeAuthTokensForUserRequest{Username: "user:alice"}, "user:alice"},
+		{&auth.DeleteRoleRequest{Name: "viewer"}, "viewer"},
+		{&pfs.DeleteReposRequest{All: true}, ""},
+		{nil, ""},
+	} {
+		require.Equal(t, tc.want, resource(tc.req))
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s := summarize(&auth.ActivateRequest{RootToken: "secret"})
+	require.False(t, strings.Contains(s, "secret"), "root token should be masked: %s", s)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &fields))
+	require.Equal(t, 1, len(fields))
+
+	s = summarize(&pfs.CreateRepoRequest{
+		Repo:        client.NewRepo(pfs.DefaultProjectName, "images"),
+		Description: strings.Repeat("x", 2*maxRequestSize),
+	})
+	require.NoError(t, json.Unmarshal([]byte(s), &fields))
+	require.Equal(t, maxRequestSize, len(fields["truncated"].(string)))
+
+	require.Equal(t, "", summarize(nil))
+}
+
+func TestWebhookSecretMasked(t *testing.T) {
+	req := &webhook.CreateWebhookRequest{Name: "hook", Url: "https://example.com/hook", Secret: "hunter2"}
+	ctx, h := log.TestWithCapture(t)
+	log.Info(ctx, "request", log.Proto("request", req))
+	require.Equal(t, 1, len(h.Logs()))
+	logged := string(h.Logs()[0].Orig)
+	require.True(t, strings.Contains(logged, "example.com"), "request should be logged: %s", logged)
+	require.False(t, strings.Contains(logged, "hunter2"), "secret should be masked in the log: %s", logged)
+
+	s := summarize(req)
+	require.True(t, strings.Contains(s, "example.com"), "request should be summarized: %s", s)
+	require.False(t, strings.Contains(s, "hunter2"), "secret should be masked in the audit log: %s", s)
+}
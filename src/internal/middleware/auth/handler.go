@@ -13,57 +13,65 @@ import (
 	authiface "github.com/pachyderm/pachyderm/v2/src/server/auth"
 )
 
-// an authHandler can optionally return a username string that will be cached in the request's context
-type authHandler func(context.Context, authiface.APIServer, string) (string, error)
+// an authHandler can optionally return the caller's identity, which will be cached in the request's context
+type authHandler func(context.Context, authiface.APIServer, string) (*auth.WhoAmIResponse, error)
 
 type ContextKey string
 
-const whoAmIResultKey = ContextKey("WhoAmI")
+const (
+	whoAmIResultKey = ContextKey("WhoAmI")
+	tokenScopeKey   = ContextKey("TokenScope")
+)
 
 // authDisabledOr wraps an authHandler and permits the RPC if authHandler succeeds or
 // if auth is disabled on the cluster
 func authDisabledOr(h authHandler) authHandler {
-	return func(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
-		username, err := h(ctx, authApi, fullMethod)
+	return func(ctx context.Context, authApi authiface.APIServer, fullMethod string) (*auth.WhoAmIResponse, error) {
+		who, err := h(ctx, authApi, fullMethod)
 
 		if auth.IsErrNotActivated(err) {
-			return "", nil
+			return nil, nil
 		}
-		return username, err
+		return who, err
 	}
 }
 
 // unauthenticated permits any RPC even if the user has no authentication token
-func unauthenticated(ctx context.Context, _ authiface.APIServer, fullMethod string) (string, error) {
-	return "", nil
+func unauthenticated(ctx context.Context, _ authiface.APIServer, fullMethod string) (*auth.WhoAmIResponse, error) {
+	return nil, nil
 }
 
 // authenticated permits an RPC if auth is fully enabled and the user is authenticated
-func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
-	r, err := authApi.WhoAmI(ctx, &auth.WhoAmIRequest{})
-	var username string
-	if err == nil {
-		username = r.Username
+func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod string) (*auth.WhoAmIResponse, error) {
+	who, err := authApi.WhoAmI(ctx, &auth.WhoAmIRequest{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
 	}
-	return username, errors.EnsureStack(err)
+	return who, nil
 }
 
 // clusterPermissions permits an RPC if the user is authorized with the given permissions on the cluster
 func clusterPermissions(permissions ...auth.Permission) authHandler {
-	return func(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
-		resp, err := authApi.Authorize(ctx, &auth.AuthorizeRequest{
+	return func(ctx context.Context, authApi authiface.APIServer, fullMethod string) (*auth.WhoAmIResponse, error) {
+		// Look up the caller first, so that the token's scope is known when
+		// the permissions are checked.
+		who, err := authApi.WhoAmI(ctx, &auth.WhoAmIRequest{})
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		resp, err := authApi.Authorize(setWhoAmI(ctx, who), &auth.AuthorizeRequest{
 			Resource:    &auth.Resource{Type: auth.ResourceType_CLUSTER},
 			Permissions: permissions,
 		})
 		if err != nil {
-			return "", errors.EnsureStack(err)
+			return who, errors.EnsureStack(err)
 		}
 
 		if resp.Authorized {
-			return resp.Principal, nil
+			return who, nil
 		}
 
-		return resp.Principal, &auth.ErrNotAuthorized{
+		return who, &auth.ErrNotAuthorized{
 			Subject:  resp.Principal,
 			Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER},
 			Required: permissions,
@@ -78,12 +86,23 @@ func GetWhoAmI(ctx context.Context) string {
 	return ""
 }
 
-// setWhoAmI sets the username in the context, and also causes future log operations on this context
+// GetTokenScope returns the scope of the caller's token, or nil if the token
+// isn't scoped.
+func GetTokenScope(ctx context.Context) *auth.TokenScope {
+	if v := ctx.Value(tokenScopeKey); v != nil {
+		return v.(*auth.TokenScope)
+	}
+	return nil
+}
+
+// setWhoAmI sets the username and token scope in the context, and also causes future log operations on this context
 // to include the username.
-func setWhoAmI(ctx context.Context, username string) context.Context {
-	return pctx.Child(
-		context.WithValue(ctx, whoAmIResultKey, username), "",
-		pctx.WithFields(zap.String("user", username)))
+func setWhoAmI(ctx context.Context, who *auth.WhoAmIResponse) context.Context {
+	ctx = context.WithValue(ctx, whoAmIResultKey, who.Username)
+	if who.Scope != nil {
+		ctx = context.WithValue(ctx, tokenScopeKey, who.Scope)
+	}
+	return pctx.Child(ctx, "", pctx.WithFields(zap.String("user", who.Username)))
 }
 
 // AsInternalUser should never be used during user requests, only internal background jobs.
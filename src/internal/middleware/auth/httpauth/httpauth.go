@@ -11,8 +11,9 @@ import (
 	"go.uber.org/zap"
 )
 
-// ClientWithToken extracts an auth token from the HTTP request (special header or query parameter),
-// and returns a Pach client that will use that token for future requests.
+// ClientWithToken extracts an auth token from the HTTP request (special header, query parameter, or
+// the password of HTTP basic auth, which is all that WebDAV clients can send), and returns a Pach
+// client that will use that token for future requests.
 func ClientWithToken(ctx context.Context, c *client.APIClient, req *http.Request) *client.APIClient {
 	if token := req.URL.Query().Get(constants.ContextTokenKey); token != "" {
 		log.Debug(ctx, "using authn-token from URL query", zap.Int("len", len(token)))
@@ -24,5 +25,10 @@ func ClientWithToken(ctx context.Context, c *client.APIClient, req *http.Request
 		c.SetAuthToken(token)
 		return c
 	}
+	if _, token, ok := req.BasicAuth(); ok && token != "" {
+		log.Debug(ctx, "using authn-token from HTTP basic auth", zap.Int("len", len(token)))
+		c.SetAuthToken(token)
+		return c
+	}
 	return c
 }
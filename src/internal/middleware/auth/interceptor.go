@@ -9,6 +9,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	auditmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth"
 
@@ -48,6 +49,8 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/GetGroups":             authenticated,
 	"/auth_v2.API/GetPermissions":        authenticated,
 	"/auth_v2.API/GetRolesForPermission": authenticated,
+	"/auth_v2.API/ListRoles":             authenticated,
+	"/auth_v2.API/ExplainAuthorization":  authenticated,
 
 	"/auth_v2.API/GetGroupsForPrincipal":      clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_GROUPS),
 	"/auth_v2.API/GetPermissionsForPrincipal": clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL),
@@ -63,6 +66,10 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/DeleteExpiredAuthTokens":    clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS),
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
+	"/auth_v2.API/CreateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/UpdateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/DeleteRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/ListAuditEvents":            authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS)),
 
 	//
 	// Debug API
@@ -192,6 +199,13 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/ListTask":       authDisabledOr(authenticated),
 	"/pfs_v2.API/Egress":         authDisabledOr(authenticated),
 	"/pfs_v2.API/ReposSummary":   authDisabledOr(authenticated),
+	"/pfs_v2.API/StorageUsage":   authDisabledOr(authenticated),
+	// CreateShareLink checks that the caller can read the file.  A share link's
+	// token is all that's needed to use it.
+	"/pfs_v2.API/CreateShareLink":  authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectShareLink": unauthenticated,
+	"/pfs_v2.API/GetShareLink":     unauthenticated,
+	"/pfs_v2.API/RevokeShareLink":  unauthenticated,
 
 	//
 	// PJS API
@@ -212,12 +226,21 @@ var authHandlers = map[string]authHandler{
 	// Storage API
 	//
 
-	"/storage.Fileset/CreateFileset":  authDisabledOr(authenticated),
-	"/storage.Fileset/ReadFileset":    authDisabledOr(authenticated),
-	"/storage.Fileset/ReadFilesetCDR": authDisabledOr(authenticated),
-	"/storage.Fileset/RenewFileset":   authDisabledOr(authenticated),
-	"/storage.Fileset/ComposeFileset": authDisabledOr(authenticated),
-	"/storage.Fileset/ShardFileset":   authDisabledOr(authenticated),
+	"/storage.Fileset/CreateFileset":     authDisabledOr(authenticated),
+	"/storage.Fileset/ReadFileset":       authDisabledOr(authenticated),
+	"/storage.Fileset/ReadFilesetCDR":    authDisabledOr(authenticated),
+	"/storage.Fileset/ReadFilesetRanges": authDisabledOr(authenticated),
+	"/storage.Fileset/RenewFileset":      authDisabledOr(authenticated),
+	"/storage.Fileset/ComposeFileset":    authDisabledOr(authenticated),
+	"/storage.Fileset/ShardFileset":      authDisabledOr(authenticated),
+	// The replication RPCs work on raw chunks and data references, which
+	// aren't tied to any repo, so they can't be checked against repo
+	// permissions.
+	"/storage.Fileset/ReadFilesetRefs":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_REPLICATE)),
+	"/storage.Fileset/FindMissingChunks":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_REPLICATE)),
+	"/storage.Fileset/ReadChunk":             authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_REPLICATE)),
+	"/storage.Fileset/WriteChunk":            authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_REPLICATE)),
+	"/storage.Fileset/CreateFilesetFromRefs": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_REPLICATE)),
 
 	//
 	// PPS API
@@ -251,6 +274,9 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/RunCron":          authDisabledOr(authenticated),
 	"/pps_v2.API/GetLogs":          authDisabledOr(authenticated),
 	"/logs.API/GetLogs":            authDisabledOr(authenticated),
+	"/logs.API/CreateLogRule":      authDisabledOr(authenticated),
+	"/logs.API/ListLogRule":        authDisabledOr(authenticated),
+	"/logs.API/DeleteLogRule":      authDisabledOr(authenticated),
 	"/pps_v2.API/GarbageCollect":   authDisabledOr(authenticated),
 	"/pps_v2.API/UpdateJobState":   authDisabledOr(authenticated),
 	"/pps_v2.API/ListPipeline":     authDisabledOr(authenticated),
@@ -272,6 +298,8 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/GetProjectDefaults": authDisabledOr(authenticated),
 	"/pps_v2.API/SetProjectDefaults": authDisabledOr(authenticated),
 	"/pps_v2.API/PipelinesSummary":   authDisabledOr(authenticated),
+	"/pps_v2.API/GetProjectQuota":    authDisabledOr(authenticated),
+	"/pps_v2.API/SetProjectQuota":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SET_PROJECT_QUOTA)),
 
 	//
 	// TransactionAPI
@@ -310,6 +338,15 @@ var authHandlers = map[string]authHandler{
 	"/snapshot.API/ListSnapshot":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SNAPSHOTTER)),
 	"/snapshot.API/InspectSnapshot": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SNAPSHOTTER)),
 	"/snapshot.API/DeleteSnapshot":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_SNAPSHOTTER)),
+
+	//
+	// Webhook API
+	//
+	"/webhook.API/CreateWebhook":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_WEBHOOKS)),
+	"/webhook.API/InspectWebhook": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_WEBHOOKS)),
+	"/webhook.API/ListWebhook":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_WEBHOOKS)),
+	"/webhook.API/DeleteWebhook":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_WEBHOOKS)),
+	"/webhook.API/ListDelivery":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MANAGE_WEBHOOKS)),
 }
 
 // NewInterceptor instantiates a new Interceptor
@@ -343,14 +380,16 @@ func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info
 		return nil, errors.Errorf("no auth function for %q, this is a bug", info.FullMethod)
 	}
 
-	username, err := a(pctx.Child(ctx, "checkAuth"), i.getAuthServer(), info.FullMethod)
+	who, err := a(pctx.Child(ctx, "checkAuth"), i.getAuthServer(), info.FullMethod)
+	username := who.GetUsername()
+	auditmw.SetPrincipal(ctx, username)
 	if err != nil {
 		log.Info(ctx, "denied unary call", zap.Error(err), zap.String("user", username))
 		return nil, err
 	}
 
 	if username != "" {
-		ctx = setWhoAmI(ctx, username)
+		ctx = setWhoAmI(ctx, who)
 	}
 
 	return handler(ctx, req)
@@ -365,14 +404,16 @@ func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream,
 		return errors.Errorf("no auth function for %q, this is a bug", info.FullMethod)
 	}
 
-	username, err := a(pctx.Child(ctx, "checkAuth"), i.getAuthServer(), info.FullMethod)
+	who, err := a(pctx.Child(ctx, "checkAuth"), i.getAuthServer(), info.FullMethod)
+	username := who.GetUsername()
+	auditmw.SetPrincipal(ctx, username)
 	if err != nil {
 		log.Info(ctx, "denied streaming call", zap.Error(err), zap.String("user", username))
 		return err
 	}
 
 	if username != "" {
-		newCtx := setWhoAmI(ctx, username)
+		newCtx := setWhoAmI(ctx, who)
 		stream = ServerStreamWrapper{stream, newCtx}
 	}
 	return handler(srv, stream)
@@ -56,6 +56,12 @@ var endpoints = map[string]logConfig{
 	"/auth_v2.API/GetGroupsForPrincipal":      authConfig,
 	"/auth_v2.API/GetUsers":                   authConfig,
 	"/auth_v2.API/GetRolesForPermission":      authConfig,
+	"/auth_v2.API/ExplainAuthorization":       authConfig,
+	"/auth_v2.API/CreateRole":                 authConfig,
+	"/auth_v2.API/UpdateRole":                 authConfig,
+	"/auth_v2.API/DeleteRole":                 authConfig,
+	"/auth_v2.API/ListRoles":                  authConfig,
+	"/auth_v2.API/ListAuditEvents":            authConfig,
 	"/auth_v2.API/DeleteExpiredAuthTokens":    authConfig,
 	"/auth_v2.API/RevokeAuthTokensForUser":    authConfig,
 
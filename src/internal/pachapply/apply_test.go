@@ -0,0 +1,199 @@
+package pachapply_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachapply"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func writeManifests(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	require.NoError(t, os.RemoveAll(dir))
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func planLines(t *testing.T, plan *pachapply.Plan) []string {
+	t.Helper()
+	var lines []string
+	for _, a := range plan.Actions {
+		lines = append(lines, a.String())
+	}
+	return lines
+}
+
+const projectManifests = `
+kind: Project
+name: proj
+description: a project
+---
+- kind: Repo
+  project: proj
+  name: images
+- kind: Branch
+  project: proj
+  repo: images
+  name: master
+- kind: Branch
+  project: proj
+  repo: images
+  name: staging
+  trigger:
+    branch: master
+    commits: 2
+`
+
+const pipelinesJsonnet = `
+local pipeline = import "lib/pipeline.libsonnet";
+[
+  pipeline("edges", "images"),
+  {
+    kind: "ProjectDefaults",
+    project: "proj",
+    defaults: {createPipelineRequest: {datumTries: 2}},
+  },
+]
+`
+
+const pipelineLibsonnet = `
+function(name, input) {
+  kind: "Pipeline",
+  spec: {
+    pipeline: {project: {name: "proj"}, name: name},
+    transform: {image: "busybox:1", cmd: ["cp", "-r", "/pfs/in", "/pfs/out"]},
+    input: {pfs: {project: "proj", repo: input, glob: "/*", name: "in"}},
+  },
+}
+`
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeManifests(t, dir, map[string]string{
+		"project.yaml":           projectManifests,
+		"pipelines.jsonnet":      pipelinesJsonnet,
+		"lib/pipeline.libsonnet": pipelineLibsonnet,
+		"default.json":           `{"kind": "Repo", "name": "raw"}`,
+		"README.md":              "not a manifest",
+	})
+	m, err := pachapply.Load(dir, "default")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(m.Projects))
+	require.Equal(t, 2, len(m.Repos))
+	require.Equal(t, "default", m.Repos[0].Project)
+	require.Equal(t, "raw", m.Repos[0].Name)
+	require.Equal(t, 2, len(m.Branches))
+	require.Equal(t, int64(2), m.Branches[1].Trigger.Commits)
+	require.Equal(t, 1, len(m.Pipelines))
+	require.Equal(t, "proj", m.Pipelines[0].Pipeline.Project.GetName())
+	require.Equal(t, 1, len(m.ProjectDefaults))
+
+	writeManifests(t, dir, map[string]string{"bad.yaml": "kind: Repo\nname: a\ndescripton: typo\n"})
+	_, err = pachapply.Load(dir, "default")
+	require.YesError(t, err)
+	writeManifests(t, dir, map[string]string{"bad.yaml": "kind: Widget\nname: a\n"})
+	_, err = pachapply.Load(dir, "default")
+	require.YesError(t, err)
+	writeManifests(t, dir, map[string]string{"a.yaml": "kind: Repo\nname: a\n", "b.yaml": "kind: Repo\nname: a\n"})
+	_, err = pachapply.Load(dir, "default")
+	require.YesError(t, err)
+}
+
+func TestApply(t *testing.T) {
+	c := pachd.NewTestPachd(t, pachd.ActivateAuthOption(""))
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, "unmanaged"))
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"project.yaml":           projectManifests,
+		"pipelines.jsonnet":      pipelinesJsonnet,
+		"lib/pipeline.libsonnet": pipelineLibsonnet,
+		"bindings.yaml": `
+kind: RoleBinding
+resource: {type: REPO, name: proj/images}
+principal: user:alice
+roles: [repoReader]
+`,
+	}
+	writeManifests(t, dir, files)
+	m, err := pachapply.Load(dir, pfs.DefaultProjectName)
+	require.NoError(t, err)
+	plan, err := pachapply.MakePlan(c, m, pachapply.PruneNone)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"+ project proj",
+		"+ defaults of project proj",
+		"+ repo proj/images",
+		"+ branch proj/images@master",
+		"+ branch proj/images@staging",
+		"+ pipeline proj/edges",
+		"+ role binding of user:alice on repo proj/images (repoReader)",
+	}, planLines(t, plan))
+	require.NoError(t, plan.Apply(c))
+
+	// Applying the same manifests again does nothing.
+	plan, err = pachapply.MakePlan(c, m, pachapply.PruneNone)
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), "plan: %v", planLines(t, plan))
+	bi, err := c.InspectBranch("proj", "images", "staging")
+	require.NoError(t, err)
+	require.Equal(t, "master", bi.Trigger.GetBranch())
+
+	// Dropping the staging branch and changing the repo's description updates them, and prune
+	// deletes everything that isn't declared in the manifests' projects.
+	files["project.yaml"] = strings.Replace(projectManifests, "  name: images\n", "  name: images\n  description: raw images\n", 1)
+	files["project.yaml"] = files["project.yaml"][:strings.Index(files["project.yaml"], "- kind: Branch\n  project: proj\n  repo: images\n  name: staging")]
+	writeManifests(t, dir, files)
+	m, err = pachapply.Load(dir, pfs.DefaultProjectName)
+	require.NoError(t, err)
+	plan, err = pachapply.MakePlan(c, m, pachapply.PruneProjects)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"~ repo proj/images (description)",
+		"- branch proj/images@staging",
+	}, planLines(t, plan))
+	require.NoError(t, plan.Apply(c))
+	plan, err = pachapply.MakePlan(c, m, pachapply.PruneProjects)
+	require.NoError(t, err)
+	require.True(t, plan.Empty(), "plan: %v", planLines(t, plan))
+	_, err = c.InspectRepo(pfs.DefaultProjectName, "unmanaged")
+	require.NoError(t, err)
+
+	// Pruning every project also deletes the repo in the default project, but not the
+	// pipeline's spec and meta repos.
+	plan, err = pachapply.MakePlan(c, m, pachapply.PruneAll)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"- repo default/unmanaged",
+	}, planLines(t, plan))
+	require.NoError(t, plan.Apply(c))
+	_, err = c.InspectRepo(pfs.DefaultProjectName, "unmanaged")
+	require.YesError(t, err)
+
+	// The role binding is kept only while it's declared.
+	files["bindings.yaml"] = `
+kind: RoleBinding
+resource: {type: REPO, name: proj/images}
+principal: user:bob
+roles: [repoWriter]
+`
+	writeManifests(t, dir, files)
+	m, err = pachapply.Load(dir, pfs.DefaultProjectName)
+	require.NoError(t, err)
+	plan, err = pachapply.MakePlan(c, m, pachapply.PruneProjects)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply(c))
+	rb, err := c.GetRepoRoleBinding(c.Ctx(), "proj", "images")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rb.Entries["user:alice"].GetRoles()))
+	require.True(t, rb.Entries["user:bob"].GetRoles()[auth.RepoWriterRole])
+}
@@ -0,0 +1,97 @@
+// Package cmds implements the apply command
+package cmds
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachapply"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+)
+
+func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var file, project string
+	var prune, allProjects, dryRun bool
+	apply := &cobra.Command{
+		Short: "Make the cluster match a set of manifests.",
+		Long: "This command reads manifests of projects, repos, branches, pipelines, project defaults and role bindings from a file or a directory of YAML, JSON and jsonnet files, " +
+			"prints the plan of changes needed to make the cluster match them, and applies it. " +
+			"Changes to repos, branches and pipelines are applied in a single transaction. \n\n" +
+			"Each manifest has a `kind`, one of `Project`, `Repo`, `Branch`, `Pipeline`, `ProjectDefaults` or `RoleBinding`: \n" +
+			"\t- Project: `name` and `description`. \n" +
+			"\t- Repo: `project`, `name` and `description`. \n" +
+			"\t- Branch: `project`, `repo`, `name` and `trigger`, as in `pachctl create branch`. \n" +
+			"\t- Pipeline: `spec`, a pipeline spec, as in `pachctl create pipeline`. \n" +
+			"\t- ProjectDefaults: `project` and `defaults`, as in `pachctl update defaults`. \n" +
+			"\t- RoleBinding: `resource` (e.g. `{type: REPO, name: myproject/images}`), `principal` and `roles`. \n\n" +
+			"Objects without a project are in the current project. " +
+			"Jsonnet files may import any other file in the directory; use the .libsonnet extension for files that are only imported. \n" +
+			"\t- To delete the objects that aren't in the manifests, use the `--prune` flag. " +
+			"Only the projects that the manifests declare anything in are pruned; to prune every project, and delete the projects that aren't in the manifests, also use the `--all-projects` flag. " +
+			"The default project, system repos, pipelines' output repos, the branches of repos without declared branches, and the role bindings of resources without declared role bindings are never deleted. \n" +
+			"\t- To only print the plan, use the `--dry-run` flag.",
+		Example: "\t- {{alias}} -f manifests/ \n" +
+			"\t- {{alias}} -f manifests/ --dry-run \n" +
+			"\t- {{alias}} -f manifests/ --prune \n" +
+			"\t- {{alias}} -f manifests/ --prune --all-projects \n" +
+			"\t- {{alias}} -f pipelines.jsonnet --project myproject \n",
+		Run: cmdutil.RunFixedArgs(0, func(cmd *cobra.Command, args []string) (retErr error) {
+			if file == "" {
+				return errors.New("--file must be set")
+			}
+			if allProjects && !prune {
+				return errors.New("--all-projects requires --prune")
+			}
+			mode := pachapply.PruneNone
+			if prune {
+				mode = pachapply.PruneProjects
+				if allProjects {
+					mode = pachapply.PruneAll
+				}
+			}
+			m, err := pachapply.Load(file, project)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			plan, err := pachapply.MakePlan(c, m, mode)
+			if err != nil {
+				return errors.Wrap(err, "make plan")
+			}
+			if plan.Empty() {
+				fmt.Println("The cluster matches the manifests; there's nothing to do.")
+				return nil
+			}
+			if err := plan.Print(os.Stdout); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			if err := plan.Apply(c); err != nil {
+				return errors.Wrap(err, "apply plan")
+			}
+			fmt.Printf("Applied %d changes.\n", len(plan.Actions))
+			return nil
+		}),
+	}
+	apply.Flags().StringVarP(&file, "file", "f", "", "Specify the manifest file, or directory of manifest files, to apply.")
+	apply.Flags().BoolVar(&prune, "prune", false, "Delete objects that aren't in the manifests.")
+	apply.Flags().BoolVar(&allProjects, "all-projects", false, "With --prune, prune every project rather than only the projects in the manifests.")
+	apply.Flags().BoolVar(&dryRun, "dry-run", false, "Print the plan without applying it.")
+	apply.Flags().StringVar(&project, "project", pachCtx.Project, "Specify the project (by name) of objects that don't name one.")
+	commands = append(commands, cmdutil.CreateAlias(apply, "apply"))
+
+	return commands
+}
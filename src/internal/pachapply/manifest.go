@@ -0,0 +1,342 @@
+// Package pachapply applies declarative manifests of projects, repos, branches, pipelines,
+// project defaults and role bindings to a cluster.  Manifests are read from YAML, JSON or
+// jsonnet files; a plan of the changes needed to make the cluster match them is computed against
+// the live cluster and then applied.
+package pachapply
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachtmpl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// The kinds of manifest.  Each manifest is an object with a "kind" field naming one of these.
+const (
+	KindProject         = "Project"
+	KindRepo            = "Repo"
+	KindBranch          = "Branch"
+	KindPipeline        = "Pipeline"
+	KindProjectDefaults = "ProjectDefaults"
+	KindRoleBinding     = "RoleBinding"
+)
+
+// Project declares a project.
+type Project struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Repo declares a user repo.  If Project is empty, the default project is used.
+type Repo struct {
+	Project     string `json:"project"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Branch declares a branch of a repo, and optionally its trigger.  Only the branches of repos
+// with at least one declared branch are managed.
+type Branch struct {
+	Project    string          `json:"project"`
+	Repo       string          `json:"repo"`
+	Name       string          `json:"name"`
+	RawTrigger json.RawMessage `json:"trigger"`
+
+	Trigger *pfs.Trigger `json:"-"`
+}
+
+// Pipeline declares a pipeline.  Spec is the pipeline spec, as passed to `pachctl create
+// pipeline`.
+type Pipeline struct {
+	RawSpec json.RawMessage `json:"spec"`
+
+	Pipeline *pps.Pipeline `json:"-"`
+	// Spec is the JSON of the pipeline spec, with the pipeline's project filled in.
+	Spec string `json:"-"`
+}
+
+// ProjectDefaults declares the defaults of a project's pipelines.
+type ProjectDefaults struct {
+	Project  string          `json:"project"`
+	Defaults json.RawMessage `json:"defaults"`
+}
+
+// RoleBinding declares the roles of a principal on a resource.  Only the role bindings of
+// resources with at least one declared role binding are managed.
+type RoleBinding struct {
+	RawResource json.RawMessage `json:"resource"`
+	Principal   string          `json:"principal"`
+	Roles       []string        `json:"roles"`
+
+	Resource *auth.Resource `json:"-"`
+}
+
+// Manifests are the objects declared by a set of manifest files.
+type Manifests struct {
+	Projects        []*Project
+	Repos           []*Repo
+	Branches        []*Branch
+	Pipelines       []*Pipeline
+	ProjectDefaults []*ProjectDefaults
+	RoleBindings    []*RoleBinding
+}
+
+// Load reads the manifests in path, which is either a file or a directory.  All of the .yaml,
+// .yml, .json and .jsonnet files in a directory and its subdirectories are read; jsonnet files
+// may import any file in the directory.  Objects that don't name a project are in
+// defaultProject.
+func Load(path, defaultProject string) (*Manifests, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	root, files := path, []string{filepath.Base(path)}
+	if fi.IsDir() {
+		files = nil
+		if err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				rel, err := filepath.Rel(path, p)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				files = append(files, filepath.ToSlash(rel))
+			}
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "walk %s", path)
+		}
+	} else {
+		root = filepath.Dir(path)
+	}
+	sort.Strings(files)
+	fsContext := make(map[string][]byte)
+	for _, f := range files {
+		data, err := os.ReadFile(filepath.Join(root, f))
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		fsContext[f] = data
+	}
+	m := &Manifests{}
+	for _, f := range files {
+		data := fsContext[f]
+		switch filepath.Ext(f) {
+		case ".yaml", ".yml", ".json":
+		case ".jsonnet":
+			data, err = pachtmpl.Eval(fsContext, f)
+			if err != nil {
+				return nil, errors.Wrapf(err, "evaluate %s", f)
+			}
+		default:
+			continue
+		}
+		if err := m.read(bytes.NewReader(data), defaultProject); err != nil {
+			return nil, errors.Wrapf(err, "read %s", f)
+		}
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// read adds the manifests in r, a stream of YAML documents or JSON objects, each of which is a
+// manifest or a list of them.
+func (m *Manifests) read(r io.Reader, defaultProject string) error {
+	specs := ppsutil.NewSpecReader(r).DisableValidation()
+	for {
+		spec, err := specs.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var header struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal([]byte(spec), &header); err != nil {
+			return errors.Wrapf(err, "unmarshal manifest %s", spec)
+		}
+		// Everything other than the kind is decoded strictly, so that typos are caught.
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(spec), &fields); err != nil {
+			return errors.Wrapf(err, "unmarshal manifest %s", spec)
+		}
+		delete(fields, "kind")
+		body, err := json.Marshal(fields)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		switch header.Kind {
+		case KindProject:
+			var p Project
+			if err := decodeStrict(body, &p); err != nil {
+				return err
+			}
+			m.Projects = append(m.Projects, &p)
+		case KindRepo:
+			r := Repo{Project: defaultProject}
+			if err := decodeStrict(body, &r); err != nil {
+				return err
+			}
+			m.Repos = append(m.Repos, &r)
+		case KindBranch:
+			b := Branch{Project: defaultProject}
+			if err := decodeStrict(body, &b); err != nil {
+				return err
+			}
+			if len(b.RawTrigger) > 0 && string(b.RawTrigger) != "null" {
+				b.Trigger = &pfs.Trigger{}
+				if err := protojson.Unmarshal(b.RawTrigger, b.Trigger); err != nil {
+					return errors.Wrapf(err, "unmarshal trigger of branch %s@%s", b.Repo, b.Name)
+				}
+			}
+			m.Branches = append(m.Branches, &b)
+		case KindPipeline:
+			var p Pipeline
+			if err := decodeStrict(body, &p); err != nil {
+				return err
+			}
+			if err := p.fill(defaultProject); err != nil {
+				return err
+			}
+			m.Pipelines = append(m.Pipelines, &p)
+		case KindProjectDefaults:
+			d := ProjectDefaults{Project: defaultProject}
+			if err := decodeStrict(body, &d); err != nil {
+				return err
+			}
+			if len(d.Defaults) == 0 || string(d.Defaults) == "null" {
+				d.Defaults = json.RawMessage("{}")
+			}
+			if err := protojson.Unmarshal(d.Defaults, &pps.ProjectDefaults{}); err != nil {
+				return errors.Wrapf(err, "unmarshal defaults of project %s", d.Project)
+			}
+			m.ProjectDefaults = append(m.ProjectDefaults, &d)
+		case KindRoleBinding:
+			var b RoleBinding
+			if err := decodeStrict(body, &b); err != nil {
+				return err
+			}
+			b.Resource = &auth.Resource{}
+			if err := protojson.Unmarshal(b.RawResource, b.Resource); err != nil {
+				return errors.Wrapf(err, "unmarshal resource of role binding for %s", b.Principal)
+			}
+			m.RoleBindings = append(m.RoleBindings, &b)
+		case "":
+			return errors.Errorf("manifest has no kind: %s", spec)
+		default:
+			return errors.Errorf("unknown kind %q; must be one of %s", header.Kind,
+				strings.Join([]string{KindProject, KindRepo, KindBranch, KindPipeline, KindProjectDefaults, KindRoleBinding}, ", "))
+		}
+	}
+}
+
+func decodeStrict(data []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	return errors.Wrapf(d.Decode(v), "unmarshal manifest %s", data)
+}
+
+// fill parses the pipeline's spec, and sets its project to defaultProject if it doesn't have
+// one, the same way `pachctl create pipeline` does.
+func (p *Pipeline) fill(defaultProject string) error {
+	var spec map[string]any
+	d := json.NewDecoder(bytes.NewReader(p.RawSpec))
+	d.UseNumber()
+	if err := d.Decode(&spec); err != nil {
+		return errors.Wrapf(err, "unmarshal pipeline spec %s", p.RawSpec)
+	}
+	pipeline, ok := spec["pipeline"].(map[string]any)
+	if !ok {
+		return errors.Errorf("pipeline spec %s must specify pipeline, an object", p.RawSpec)
+	}
+	if project, ok := pipeline["project"].(map[string]any); !ok || project["name"] == nil || project["name"] == "" {
+		pipeline["project"] = map[string]any{"name": defaultProject}
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	var req pps.CreatePipelineRequest
+	if err := protojson.Unmarshal(b, &req); err != nil {
+		return errors.Wrapf(err, "unmarshal pipeline spec %s", b)
+	}
+	if req.GetPipeline().GetName() == "" {
+		return errors.Errorf("pipeline spec %s has no pipeline name", p.RawSpec)
+	}
+	p.Pipeline = req.Pipeline
+	p.Spec = string(b)
+	return nil
+}
+
+// validate checks that no object is declared twice.
+func (m *Manifests) validate() error {
+	seen := make(map[string]bool)
+	check := func(name string) error {
+		if seen[name] {
+			return errors.Errorf("%s is declared more than once", name)
+		}
+		seen[name] = true
+		return nil
+	}
+	for _, p := range m.Projects {
+		if p.Name == "" {
+			return errors.New("a project has no name")
+		}
+		if err := check(projectName(p.Name)); err != nil {
+			return err
+		}
+	}
+	for _, r := range m.Repos {
+		if r.Name == "" {
+			return errors.New("a repo has no name")
+		}
+		if err := check(repoName(r.Project, r.Name)); err != nil {
+			return err
+		}
+	}
+	for _, b := range m.Branches {
+		if b.Repo == "" || b.Name == "" {
+			return errors.New("a branch has no repo or name")
+		}
+		if err := check(branchName(b.Project, b.Repo, b.Name)); err != nil {
+			return err
+		}
+	}
+	for _, p := range m.Pipelines {
+		if err := check(pipelineName(p.Pipeline.Project.GetName(), p.Pipeline.Name)); err != nil {
+			return err
+		}
+	}
+	for _, d := range m.ProjectDefaults {
+		if err := check(defaultsName(d.Project)); err != nil {
+			return err
+		}
+	}
+	for _, b := range m.RoleBindings {
+		if b.Principal == "" {
+			return errors.Errorf("a role binding on %s has no principal", resourceName(b.Resource))
+		}
+		if err := check(roleBindingName(b.Resource, b.Principal)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
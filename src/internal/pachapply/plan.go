@@ -0,0 +1,633 @@
+package pachapply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// An Op is what an Action does to an object.
+type Op int
+
+const (
+	OpCreate Op = iota
+	OpUpdate
+	OpDelete
+)
+
+// Symbol returns the symbol of the op in a printed plan.
+func (o Op) Symbol() string {
+	return [...]string{"+", "~", "-"}[o]
+}
+
+func (o Op) String() string {
+	return [...]string{"create", "update", "delete"}[o]
+}
+
+// An Action is a single change to the cluster.
+type Action struct {
+	Op Op
+	// Object names the object that's changed, e.g. "repo default/images".
+	Object string
+	// Detail says what's changed by an update.
+	Detail string
+
+	// transactional actions are run in a transaction with the transactional actions next to
+	// them in the plan.
+	transactional bool
+	do            func(c *client.APIClient) error
+}
+
+func (a *Action) String() string {
+	if a.Detail != "" {
+		return fmt.Sprintf("%s %s (%s)", a.Op.Symbol(), a.Object, a.Detail)
+	}
+	return fmt.Sprintf("%s %s", a.Op.Symbol(), a.Object)
+}
+
+// A Plan is the changes needed to make the cluster match a set of manifests, in the order that
+// they're applied.
+//
+// Changes to repos, branches and pipelines are applied in a single transaction.  The rest aren't
+// supported by transactions, so they're applied around it: projects and project defaults are
+// changed first, since the objects in the transaction depend on them, and pipelines are deleted
+// before it so that their inputs can be deleted in it.  Role bindings are changed after it, so
+// that the repos they're on exist, and projects are deleted last, once they're empty.
+type Plan struct {
+	Actions []*Action
+}
+
+// Empty returns true if the cluster already matches the manifests.
+func (p *Plan) Empty() bool {
+	return len(p.Actions) == 0
+}
+
+// Print writes the plan to w.
+func (p *Plan) Print(w io.Writer) error {
+	var counts [3]int
+	for _, a := range p.Actions {
+		counts[a.Op]++
+		if _, err := fmt.Fprintln(w, a); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	_, err := fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete.\n", counts[OpCreate], counts[OpUpdate], counts[OpDelete])
+	return errors.EnsureStack(err)
+}
+
+// Apply applies the plan.  If an action fails, the actions before it (other than those in the
+// same transaction) remain applied, and those after it aren't.
+func (p *Plan) Apply(c *client.APIClient) error {
+	for i := 0; i < len(p.Actions); {
+		a := p.Actions[i]
+		if !a.transactional {
+			if err := a.do(c); err != nil {
+				return errors.Wrapf(grpcutil.ScrubGRPC(err), "%s %s", a.Op, a.Object)
+			}
+			i++
+			continue
+		}
+		j := i
+		for j < len(p.Actions) && p.Actions[j].transactional {
+			j++
+		}
+		if _, err := c.ExecuteInTransaction(func(c *client.APIClient) error {
+			for _, a := range p.Actions[i:j] {
+				if err := a.do(c); err != nil {
+					return errors.Wrapf(grpcutil.ScrubGRPC(err), "%s %s", a.Op, a.Object)
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "transaction")
+		}
+		i = j
+	}
+	return nil
+}
+
+// A Prune says which of the objects that aren't declared in the manifests MakePlan deletes.
+type Prune int
+
+const (
+	// PruneNone deletes nothing.
+	PruneNone Prune = iota
+	// PruneProjects deletes the undeclared objects in the projects that the manifests declare
+	// anything in, and leaves other projects alone.
+	PruneProjects
+	// PruneAll deletes the undeclared objects in every project, and the undeclared projects.
+	PruneAll
+)
+
+// MakePlan compares the manifests to the live cluster, and returns the plan that makes the
+// cluster match them.  Objects that aren't declared in the manifests are deleted as prune says,
+// with these exceptions:
+//   - the default project (though the objects in it are deleted);
+//   - system repos, such as the spec and meta repos of pipelines and the repo that continuous
+//     profiling commits to, and the output repos of pipelines, which are deleted with their
+//     pipelines;
+//   - branches of repos without any declared branches;
+//   - role bindings on resources without any declared role bindings, and role bindings of
+//     pipelines and of Pachyderm itself.
+func MakePlan(c *client.APIClient, m *Manifests, prune Prune) (*Plan, error) {
+	l, err := getLive(c, m)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		projects, defaults, deletePipelines []*Action
+		txn, txnDeletes                     []*Action
+		roleBindings, deleteProjects        []*Action
+	)
+
+	// Projects are kept if anything is declared in them.
+	keepProjects := make(map[string]bool)
+	for _, p := range m.Projects {
+		keepProjects[p.Name] = true
+	}
+	for _, r := range m.Repos {
+		keepProjects[r.Project] = true
+	}
+	for _, b := range m.Branches {
+		keepProjects[b.Project] = true
+	}
+	for _, p := range m.Pipelines {
+		keepProjects[p.Pipeline.Project.GetName()] = true
+	}
+	for _, d := range m.ProjectDefaults {
+		keepProjects[d.Project] = true
+	}
+	pruned := func(project string) bool {
+		return prune == PruneAll || prune == PruneProjects && keepProjects[project]
+	}
+	for _, p := range m.Projects {
+		req := &pfs.CreateProjectRequest{Project: &pfs.Project{Name: p.Name}, Description: p.Description}
+		do := func(c *client.APIClient) error {
+			_, err := c.PfsAPIClient.CreateProject(c.Ctx(), req)
+			return errors.EnsureStack(err)
+		}
+		if live, ok := l.projects[p.Name]; !ok {
+			projects = append(projects, &Action{Op: OpCreate, Object: projectName(p.Name), do: do})
+		} else if live.Description != p.Description {
+			req.Update = true
+			projects = append(projects, &Action{Op: OpUpdate, Object: projectName(p.Name), Detail: "description", do: do})
+		}
+	}
+	if prune == PruneAll {
+		for _, name := range sortedKeys(l.projects) {
+			if keepProjects[name] || name == pfs.DefaultProjectName {
+				continue
+			}
+			deleteProjects = append(deleteProjects, &Action{Op: OpDelete, Object: projectName(name), do: func(c *client.APIClient) error {
+				return c.DeleteProject(name, false)
+			}})
+		}
+	}
+
+	// Project defaults
+	declaredDefaults := make(map[string]bool)
+	for _, d := range m.ProjectDefaults {
+		declaredDefaults[d.Project] = true
+		live := l.defaults[d.Project]
+		if jsonEqual(live, string(d.Defaults)) {
+			continue
+		}
+		op := OpUpdate
+		if jsonEqual(live, "{}") {
+			op = OpCreate
+		}
+		defaults = append(defaults, &Action{Op: op, Object: defaultsName(d.Project), do: setDefaults(d.Project, string(d.Defaults))})
+	}
+	if prune != PruneNone {
+		for _, name := range sortedKeys(l.defaults) {
+			// The defaults of a project that's deleted go with it.
+			if declaredDefaults[name] || !pruned(name) || !keepProjects[name] && name != pfs.DefaultProjectName || jsonEqual(l.defaults[name], "{}") {
+				continue
+			}
+			defaults = append(defaults, &Action{Op: OpDelete, Object: defaultsName(name), do: setDefaults(name, "{}")})
+		}
+	}
+
+	// Repos
+	declaredRepos := make(map[string]bool)
+	for _, r := range m.Repos {
+		key := repoKey(r.Project, r.Name)
+		declaredRepos[key] = true
+		req := &pfs.CreateRepoRequest{Repo: client.NewRepo(r.Project, r.Name), Description: r.Description}
+		do := func(c *client.APIClient) error {
+			_, err := c.PfsAPIClient.CreateRepo(c.Ctx(), req)
+			return errors.EnsureStack(err)
+		}
+		if live, ok := l.repos[key]; !ok {
+			txn = append(txn, &Action{Op: OpCreate, Object: repoName(r.Project, r.Name), transactional: true, do: do})
+		} else if live.Description != r.Description {
+			req.Update = true
+			txn = append(txn, &Action{Op: OpUpdate, Object: repoName(r.Project, r.Name), Detail: "description", transactional: true, do: do})
+		}
+	}
+	if prune != PruneNone {
+		// Output repos belong to their pipelines, and repos with declared branches are kept.
+		keepRepos := make(map[string]bool)
+		for key := range l.pipelines {
+			keepRepos[key] = true
+		}
+		for _, p := range m.Pipelines {
+			keepRepos[repoKey(p.Pipeline.Project.GetName(), p.Pipeline.Name)] = true
+		}
+		for _, b := range m.Branches {
+			keepRepos[repoKey(b.Project, b.Repo)] = true
+		}
+		for _, key := range sortedKeys(l.repos) {
+			repo := l.repos[key].Repo
+			if declaredRepos[key] || keepRepos[key] || !pruned(repo.Project.GetName()) {
+				continue
+			}
+			txnDeletes = append(txnDeletes, &Action{Op: OpDelete, Object: repoName(repo.Project.GetName(), repo.Name), transactional: true, do: func(c *client.APIClient) error {
+				_, err := c.PfsAPIClient.DeleteRepo(c.Ctx(), &pfs.DeleteRepoRequest{Repo: repo})
+				return errors.EnsureStack(err)
+			}})
+		}
+	}
+
+	// Branches
+	declaredBranches := make(map[string]bool)
+	for _, b := range m.Branches {
+		branch := client.NewBranch(b.Project, b.Repo, b.Name)
+		key := branchKey(b.Project, b.Repo, b.Name)
+		declaredBranches[key] = true
+		create := func(c *client.APIClient) error {
+			_, err := c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{Branch: branch, Trigger: b.Trigger})
+			return errors.EnsureStack(err)
+		}
+		live, ok := l.branches[key]
+		switch {
+		case !ok:
+			txn = append(txn, &Action{Op: OpCreate, Object: branchName(b.Project, b.Repo, b.Name), transactional: true, do: create})
+		case proto.Equal(live.Trigger, b.Trigger):
+		case b.Trigger == nil:
+			// CreateBranch can't remove a trigger, so the branch is recreated at its head.
+			head := live.Head
+			txn = append(txn, &Action{Op: OpUpdate, Object: branchName(b.Project, b.Repo, b.Name), Detail: "remove trigger", transactional: true, do: func(c *client.APIClient) error {
+				if _, err := c.PfsAPIClient.DeleteBranch(c.Ctx(), &pfs.DeleteBranchRequest{Branch: branch}); err != nil {
+					return errors.EnsureStack(err)
+				}
+				_, err := c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{Branch: branch, Head: head})
+				return errors.EnsureStack(err)
+			}})
+		default:
+			txn = append(txn, &Action{Op: OpUpdate, Object: branchName(b.Project, b.Repo, b.Name), Detail: "trigger", transactional: true, do: create})
+		}
+	}
+	if prune != PruneNone {
+		var deletes []*Action
+		for _, key := range sortedKeys(l.branches) {
+			if declaredBranches[key] {
+				continue
+			}
+			branch := l.branches[key].Branch
+			deletes = append(deletes, &Action{Op: OpDelete, Object: branchName(branch.Repo.Project.GetName(), branch.Repo.Name, branch.Name), transactional: true, do: func(c *client.APIClient) error {
+				_, err := c.PfsAPIClient.DeleteBranch(c.Ctx(), &pfs.DeleteBranchRequest{Branch: branch})
+				return errors.EnsureStack(err)
+			}})
+		}
+		// Branches are deleted before repos.
+		txnDeletes = append(deletes, txnDeletes...)
+	}
+
+	// Pipelines
+	declaredPipelines := make(map[string]bool)
+	for _, p := range m.Pipelines {
+		project, name := p.Pipeline.Project.GetName(), p.Pipeline.Name
+		key := repoKey(project, name)
+		declaredPipelines[key] = true
+		req := &pps.CreatePipelineV2Request{CreatePipelineRequestJson: p.Spec}
+		do := func(c *client.APIClient) error {
+			_, err := c.PpsAPIClient.CreatePipelineV2(c.Ctx(), req)
+			return errors.EnsureStack(err)
+		}
+		if live, ok := l.pipelines[key]; !ok {
+			txn = append(txn, &Action{Op: OpCreate, Object: pipelineName(project, name), transactional: true, do: do})
+		} else if !pipelineSpecEqual(live.UserSpecJson, p.Spec) {
+			req.Update = true
+			txn = append(txn, &Action{Op: OpUpdate, Object: pipelineName(project, name), Detail: "spec", transactional: true, do: do})
+		}
+	}
+	if prune != PruneNone {
+		var undeclared []*pps.PipelineInfo
+		for _, key := range sortedKeys(l.pipelines) {
+			if pi := l.pipelines[key]; !declaredPipelines[key] && pruned(pi.Pipeline.Project.GetName()) {
+				undeclared = append(undeclared, pi)
+			}
+		}
+		for _, pi := range deleteOrder(undeclared) {
+			pipeline := pi.Pipeline
+			deletePipelines = append(deletePipelines, &Action{Op: OpDelete, Object: pipelineName(pipeline.Project.GetName(), pipeline.Name), do: func(c *client.APIClient) error {
+				return c.DeletePipeline(pipeline.Project.GetName(), pipeline.Name, false)
+			}})
+		}
+	}
+
+	// Role bindings
+	declaredBindings := make(map[string]bool)
+	for _, b := range m.RoleBindings {
+		declaredBindings[roleBindingKey(b.Resource, b.Principal)] = true
+		roles := append([]string(nil), b.Roles...)
+		sort.Strings(roles)
+		live := liveRoles(l.roleBindings[resourceKey(b.Resource)], b.Principal)
+		if reflect.DeepEqual(live, roles) {
+			continue
+		}
+		op := OpUpdate
+		if len(live) == 0 {
+			op = OpCreate
+		}
+		roleBindings = append(roleBindings, &Action{Op: op, Object: roleBindingName(b.Resource, b.Principal), Detail: strings.Join(roles, ", "), do: modifyRoleBinding(b.Resource, b.Principal, roles)})
+	}
+	if prune != PruneNone {
+		for _, key := range sortedKeys(l.roleBindings) {
+			rb := l.roleBindings[key]
+			for _, principal := range sortedKeys(rb.binding.GetEntries()) {
+				if declaredBindings[roleBindingKey(rb.resource, principal)] ||
+					strings.HasPrefix(principal, auth.PipelinePrefix) ||
+					strings.HasPrefix(principal, auth.PachPrefix) ||
+					strings.HasPrefix(principal, auth.InternalPrefix) ||
+					len(liveRoles(rb, principal)) == 0 {
+					continue
+				}
+				roleBindings = append(roleBindings, &Action{Op: OpDelete, Object: roleBindingName(rb.resource, principal), do: modifyRoleBinding(rb.resource, principal, nil)})
+			}
+		}
+	}
+
+	p := &Plan{}
+	for _, actions := range [][]*Action{projects, defaults, deletePipelines, txn, txnDeletes, roleBindings, deleteProjects} {
+		p.Actions = append(p.Actions, actions...)
+	}
+	return p, nil
+}
+
+// live is the state of the live cluster.  Maps are keyed by project name, or by the key of the
+// object.
+type live struct {
+	projects     map[string]*pfs.ProjectInfo
+	defaults     map[string]string
+	repos        map[string]*pfs.RepoInfo
+	branches     map[string]*pfs.BranchInfo
+	pipelines    map[string]*pps.PipelineInfo
+	roleBindings map[string]*liveRoleBinding
+}
+
+type liveRoleBinding struct {
+	resource *auth.Resource
+	binding  *auth.RoleBinding
+}
+
+// getLive reads the parts of the live cluster that are relevant to the manifests.
+func getLive(c *client.APIClient, m *Manifests) (*live, error) {
+	l := &live{
+		projects:     make(map[string]*pfs.ProjectInfo),
+		defaults:     make(map[string]string),
+		repos:        make(map[string]*pfs.RepoInfo),
+		branches:     make(map[string]*pfs.BranchInfo),
+		pipelines:    make(map[string]*pps.PipelineInfo),
+		roleBindings: make(map[string]*liveRoleBinding),
+	}
+	projects, err := c.ListProject()
+	if err != nil {
+		return nil, errors.Wrap(err, "list projects")
+	}
+	for _, pi := range projects {
+		name := pi.Project.GetName()
+		l.projects[name] = pi
+		resp, err := c.PpsAPIClient.GetProjectDefaults(c.Ctx(), &pps.GetProjectDefaultsRequest{Project: pi.Project})
+		if err != nil {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "get defaults of project %s", name)
+		}
+		l.defaults[name] = resp.ProjectDefaultsJson
+	}
+	repos, err := c.ListRepo()
+	if err != nil {
+		return nil, errors.Wrap(err, "list repos")
+	}
+	for _, ri := range repos {
+		// Only user repos are managed; system repos belong to their pipelines.
+		if ri.Repo.Type != pfs.UserRepoType {
+			continue
+		}
+		l.repos[repoKey(ri.Repo.Project.GetName(), ri.Repo.Name)] = ri
+	}
+	pipelines, err := c.ListPipeline()
+	if err != nil {
+		return nil, errors.Wrap(err, "list pipelines")
+	}
+	for _, pi := range pipelines {
+		l.pipelines[repoKey(pi.Pipeline.Project.GetName(), pi.Pipeline.Name)] = pi
+	}
+	// Only the branches of repos with declared branches are managed.
+	listed := make(map[string]bool)
+	for _, b := range m.Branches {
+		key := repoKey(b.Project, b.Repo)
+		if _, ok := l.repos[key]; !ok || listed[key] {
+			continue
+		}
+		listed[key] = true
+		bis, err := c.ListBranch(b.Project, b.Repo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list branches of %s", key)
+		}
+		for _, bi := range bis {
+			l.branches[branchKey(b.Project, b.Repo, bi.Branch.Name)] = bi
+		}
+	}
+	// Likewise, only the role bindings of resources with declared role bindings are managed.
+	for _, b := range m.RoleBindings {
+		key := resourceKey(b.Resource)
+		if _, ok := l.roleBindings[key]; ok {
+			continue
+		}
+		switch b.Resource.Type {
+		case auth.ResourceType_PROJECT:
+			if _, ok := l.projects[b.Resource.Name]; !ok {
+				continue
+			}
+		case auth.ResourceType_REPO:
+			if _, ok := l.repos[b.Resource.Name]; !ok {
+				continue
+			}
+		}
+		resp, err := c.AuthAPIClient.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{Resource: b.Resource})
+		if err != nil {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "get role binding of %s", resourceName(b.Resource))
+		}
+		l.roleBindings[key] = &liveRoleBinding{resource: b.Resource, binding: resp.Binding}
+	}
+	return l, nil
+}
+
+// deleteOrder orders pipelines so that each comes after the pipelines that read its output,
+// which must be deleted first.
+func deleteOrder(pis []*pps.PipelineInfo) []*pps.PipelineInfo {
+	readers := make(map[string][]*pps.PipelineInfo)
+	for _, pi := range pis {
+		for _, b := range pps.InputBranches(pi.Details.GetInput()) {
+			key := repoKey(b.Repo.Project.GetName(), b.Repo.Name)
+			readers[key] = append(readers[key], pi)
+		}
+	}
+	var result []*pps.PipelineInfo
+	visited := make(map[*pps.PipelineInfo]bool)
+	var visit func(pi *pps.PipelineInfo)
+	visit = func(pi *pps.PipelineInfo) {
+		if visited[pi] {
+			return
+		}
+		visited[pi] = true
+		for _, r := range readers[repoKey(pi.Pipeline.Project.GetName(), pi.Pipeline.Name)] {
+			visit(r)
+		}
+		result = append(result, pi)
+	}
+	for _, pi := range pis {
+		visit(pi)
+	}
+	return result
+}
+
+func setDefaults(project, defaults string) func(c *client.APIClient) error {
+	return func(c *client.APIClient) error {
+		_, err := c.PpsAPIClient.SetProjectDefaults(c.Ctx(), &pps.SetProjectDefaultsRequest{
+			Project:             &pfs.Project{Name: project},
+			ProjectDefaultsJson: defaults,
+		})
+		return errors.EnsureStack(err)
+	}
+}
+
+func modifyRoleBinding(resource *auth.Resource, principal string, roles []string) func(c *client.APIClient) error {
+	return func(c *client.APIClient) error {
+		_, err := c.AuthAPIClient.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+			Resource:  resource,
+			Principal: principal,
+			Roles:     roles,
+		})
+		return errors.EnsureStack(err)
+	}
+}
+
+// liveRoles returns the sorted roles of principal in a live role binding.
+func liveRoles(rb *liveRoleBinding, principal string) []string {
+	var roles []string
+	if rb == nil {
+		return roles
+	}
+	for role, ok := range rb.binding.GetEntries()[principal].GetRoles() {
+		if ok {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// pipelineSpecEqual compares pipeline specs, ignoring the fields that are options of
+// `pachctl create pipeline` rather than parts of the spec.
+func pipelineSpecEqual(a, b string) bool {
+	var x, y map[string]any
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return a == b
+	}
+	for _, k := range []string{"update", "reprocess", "dry_run", "dryRun"} {
+		delete(x, k)
+		delete(y, k)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// jsonEqual compares JSON objects, treating the empty string as the empty object.
+func jsonEqual(a, b string) bool {
+	var x, y any
+	if a == "" {
+		a = "{}"
+	}
+	if b == "" {
+		b = "{}"
+	}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func repoKey(project, repo string) string {
+	return project + "/" + repo
+}
+
+func branchKey(project, repo, branch string) string {
+	return repoKey(project, repo) + "@" + branch
+}
+
+func resourceKey(r *auth.Resource) string {
+	return r.Type.String() + ":" + r.Name
+}
+
+func roleBindingKey(r *auth.Resource, principal string) string {
+	return resourceKey(r) + " " + principal
+}
+
+func projectName(name string) string {
+	return "project " + name
+}
+
+func repoName(project, repo string) string {
+	return "repo " + repoKey(project, repo)
+}
+
+func branchName(project, repo, branch string) string {
+	return "branch " + branchKey(project, repo, branch)
+}
+
+func pipelineName(project, pipeline string) string {
+	return "pipeline " + repoKey(project, pipeline)
+}
+
+func defaultsName(project string) string {
+	return "defaults of project " + project
+}
+
+func resourceName(r *auth.Resource) string {
+	switch r.GetType() {
+	case auth.ResourceType_CLUSTER:
+		return "cluster"
+	case auth.ResourceType_PROJECT:
+		return "project " + r.Name
+	case auth.ResourceType_REPO:
+		return "repo " + r.Name
+	default:
+		return strings.ToLower(r.GetType().String()) + " " + r.GetName()
+	}
+}
+
+func roleBindingName(r *auth.Resource, principal string) string {
+	return "role binding of " + principal + " on " + resourceName(r)
+}
@@ -50,9 +50,21 @@ type GlobalConfiguration struct {
 	Metrics              bool   `env:"METRICS,default=true"`
 	MetricsEndpoint      string `env:"METRICS_ENDPOINT,default="`
 
+	// CoordinationBackend is where distributed tasks, locks and the consistent hashing rings
+	// that shard the PFS master are kept: "etcd" or "postgres".  This does not make etcd
+	// optional: it's still required for worker status, pipeline tracing, OIDC login state and
+	// the enterprise token.
+	//
+	// TODO: move those to postgres as well, so that pachd can run without etcd.
+	CoordinationBackend string `env:"COORDINATION_BACKEND,default=etcd"`
+
 	// SessionDurationMinutes it how long auth tokens are valid for, defaults to 30 days (30 * 24 * 60)
 	SessionDurationMinutes int `env:"SESSION_DURATION_MINUTES,default=43200"`
 
+	// AuditLogRetentionDays is how long events are kept in the audit log.  If
+	// it's 0, events are kept forever.
+	AuditLogRetentionDays int `env:"AUDIT_LOG_RETENTION_DAYS,default=90"`
+
 	IdentityServerDatabase string `env:"IDENTITY_SERVER_DATABASE,default=dex"`
 
 	// PPSSpecCommitID and PPSPipelineName are only set for workers and sidecar
@@ -74,6 +86,26 @@ type GlobalConfiguration struct {
 	// also need permission).
 	GoogleCloudProfilerProject string `env:"GOOGLE_CLOUD_PROFILER_PROJECT"`
 
+	// If not 0, pachd, pachw and workers capture CPU, heap, goroutine and mutex profiles of
+	// themselves this often and commit them to the __profiles__ system repo; see
+	// src/internal/profileutil/continuous.  Each CPU profile runs for
+	// ContinuousProfilingCPUSeconds.  pachd deletes profiles older than
+	// ContinuousProfilingRetentionHours, unless it's 0.  If set on a pachd pod, propagates to
+	// workers.  ContinuousProfilingAuthToken is the token profiles are committed with; pachd
+	// creates it and passes it to pachw and workers through the
+	// pachyderm-continuous-profiling-authentication secret.
+	ContinuousProfilingIntervalSeconds int    `env:"CONTINUOUS_PROFILING_INTERVAL_SECONDS,default=0"`
+	ContinuousProfilingCPUSeconds      int    `env:"CONTINUOUS_PROFILING_CPU_SECONDS,default=10"`
+	ContinuousProfilingRetentionHours  int    `env:"CONTINUOUS_PROFILING_RETENTION_HOURS,default=72"`
+	ContinuousProfilingAuthToken       string `env:"CONTINUOUS_PROFILING_AUTH_TOKEN,default="`
+
+	// If set to "postgres", workers insert their logs into Postgres, and the logs service reads
+	// them from there instead of Loki; see src/internal/logstore.  It's meant for clusters that
+	// don't run Loki.  pachd deletes logs older than LogStoreRetentionHours, unless it's 0.  If
+	// set on a pachd pod, propagates to workers.
+	LogStore               string `env:"LOG_STORE"`
+	LogStoreRetentionHours int    `env:"LOG_STORE_RETENTION_HOURS,default=168"`
+
 	// The number of concurrent requests that the PPS Master can make against kubernetes
 	PPSMaxConcurrentK8sRequests int `env:"PPS_MAX_CONCURRENT_K8S_REQUESTS,default=10"`
 
@@ -97,6 +129,12 @@ type GlobalConfiguration struct {
 
 func (GlobalConfiguration) isPachConfig() {}
 
+// The coordination backends.
+const (
+	CoordinationBackendEtcd     = "etcd"
+	CoordinationBackendPostgres = "postgres"
+)
+
 // PostgresConfiguration configures postgres and pg-bouncer.
 type PostgresConfiguration struct {
 	PostgresSSL                    string `env:"POSTGRES_SSL,default=disable"`
@@ -202,6 +240,7 @@ type StorageConfiguration struct {
 	StoragePutFileConcurrencyLimit       int   `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
 	StorageGCPeriod                      int64 `env:"STORAGE_GC_PERIOD,default=60"`
 	StorageChunkGCPeriod                 int64 `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
+	StorageUsagePeriod                   int64 `env:"STORAGE_USAGE_PERIOD,default=3600"`
 	StorageCompactionMaxFanIn            int   `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
 	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
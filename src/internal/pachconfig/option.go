@@ -17,6 +17,7 @@ func ConfigFromOptions(opts ...ConfigOption) *Configuration {
 			StorageConfiguration: StorageConfiguration{
 				StorageGCPeriod:      0,
 				StorageChunkGCPeriod: 0,
+				StorageUsagePeriod:   0,
 			},
 		},
 		WorkerSpecificConfiguration:     &WorkerSpecificConfiguration{},
@@ -43,6 +43,24 @@ func (cfg *Config) NewOnUserMachine(ctx context.Context, enterprise bool, opts .
 	return c.WithCtxCancel(ctx, cancel), nil
 }
 
+// NewOnUserMachineForContext is like NewOnUserMachine, but connects to the cluster of the named
+// context rather than the active one.
+func (cfg *Config) NewOnUserMachineForContext(ctx context.Context, contextName string, opts ...client.Option) (*client.APIClient, error) {
+	if cfg.Verbose {
+		opts = append(opts, client.WithAdditionalStreamClientInterceptors(ci.LogStream), client.WithAdditionalUnaryClientInterceptors(ci.LogUnary))
+	}
+	cancel := func() {}
+	if cfg.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+	}
+	c, err := client.NewOnUserMachineForContext(ctx, contextName, "user", opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return c.WithCtxCancel(ctx, cancel), nil
+}
+
 func (cfg *Config) NewInWorker(ctx context.Context, opts ...client.Option) (*client.APIClient, error) {
 	if cfg.Verbose {
 		opts = append(opts, client.WithAdditionalStreamClientInterceptors(ci.LogStream), client.WithAdditionalUnaryClientInterceptors(ci.LogUnary))
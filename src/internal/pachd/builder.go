@@ -6,12 +6,15 @@ import (
 	"math"
 	"path"
 	"runtime/debug"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	gmd "google.golang.org/grpc/metadata"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes"
@@ -30,6 +33,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/http"
 	identity_server "github.com/pachyderm/pachyderm/v2/src/server/identity/server"
 	licenseserver "github.com/pachyderm/pachyderm/v2/src/server/license/server"
+	logservice "github.com/pachyderm/pachyderm/v2/src/server/logs"
 	logsserver "github.com/pachyderm/pachyderm/v2/src/server/logs/server"
 	metadata_server "github.com/pachyderm/pachyderm/v2/src/server/metadata/server"
 	pachw "github.com/pachyderm/pachyderm/v2/src/server/pachw/server"
@@ -42,6 +46,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
@@ -55,7 +60,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/logrules"
+	"github.com/pachyderm/pachyderm/v2/src/internal/logstore"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	auditmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	authmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	internalauth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
@@ -67,14 +75,20 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	pjs_server "github.com/pachyderm/pachyderm/v2/src/internal/pjs"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 	"github.com/pachyderm/pachyderm/v2/src/internal/restart"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	snapshot_server "github.com/pachyderm/pachyderm/v2/src/internal/snapshot"
 	storageserver "github.com/pachyderm/pachyderm/v2/src/internal/storage"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/internal/webhook"
 )
 
+// auditCloseTimeout bounds how long a stopping pachd waits for queued audit
+// events to be inserted.
+const auditCloseTimeout = 30 * time.Second
+
 // An envBootstrapper is a type which needs to have some bootstrap code run
 // after initialization and before the dæmon starts listening.
 //
@@ -97,6 +111,7 @@ type builder struct {
 	enterpriseEnv      *eprsserver.Env
 	reporter           *metrics.Reporter
 	authInterceptor    *authmw.Interceptor
+	auditInterceptor   *auditmw.Interceptor
 	loggingInterceptor *loggingmw.LoggingInterceptor
 
 	txn    transactionserver.APIServer
@@ -143,6 +158,7 @@ func (b *builder) initKube(ctx context.Context) error {
 		b.env.Config().EtcdPrefix = collection.DefaultPrefix
 	}
 	b.authInterceptor = authmw.NewInterceptor(b.env.AuthServer)
+	b.auditInterceptor = auditmw.NewInterceptor(b.env.GetDBClient)
 	b.loggingInterceptor = loggingmw.NewLoggingInterceptor(ctx)
 	if b.env.Config() != nil && b.env.Config().PachdSpecificConfiguration != nil {
 		b.daemon.criticalServersOnly = b.env.Config().RequireCriticalServersOnly
@@ -213,6 +229,7 @@ func (b *builder) initExternalServer(ctx context.Context) error {
 		true,
 		grpc.ChainUnaryInterceptor(
 			b.loggingInterceptor.UnarySetup,
+			b.auditInterceptor.InterceptUnary,
 			errorsmw.UnaryServerInterceptor,
 			version_middleware.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
@@ -223,6 +240,7 @@ func (b *builder) initExternalServer(ctx context.Context) error {
 		),
 		grpc.ChainStreamInterceptor(
 			b.loggingInterceptor.StreamSetup,
+			b.auditInterceptor.InterceptStream,
 			errorsmw.StreamServerInterceptor,
 			version_middleware.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
@@ -235,6 +253,20 @@ func (b *builder) initExternalServer(ctx context.Context) error {
 	return err
 }
 
+// serve serves until ctx is done, then inserts the audit events that are still
+// queued.
+func (b *builder) serve(ctx context.Context) error {
+	err := b.daemon.serve(ctx)
+	if b.auditInterceptor != nil {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditCloseTimeout)
+		defer cancel()
+		if err := b.auditInterceptor.Close(ctx); err != nil {
+			log.Error(ctx, "could not insert queued audit events", zap.Error(err))
+		}
+	}
+	return err
+}
+
 func (b builder) forGRPCServer(f func(*grpc.Server)) {
 	b.daemon.forGRPCServer(f)
 }
@@ -323,6 +355,12 @@ func (b *builder) registerSnapshotServer(ctx context.Context) error {
 	return nil
 }
 
+func (b *builder) registerWebhookServer(ctx context.Context) error {
+	apiServer := webhook_server.APIServer{DB: b.env.GetDBClient()}
+	b.forGRPCServer(func(s *grpc.Server) { webhook.RegisterAPIServer(s, &apiServer) })
+	return nil
+}
+
 func (b *builder) registerStorageServer(ctx context.Context) error {
 	env, err := StorageEnv(b.env)
 	if err != nil {
@@ -395,10 +433,17 @@ func (b *builder) registerProxyServer(ctx context.Context) error {
 }
 
 func (b *builder) registerLogsServer(ctx context.Context) error {
-	apiServer, err := logsserver.NewAPIServer(logsserver.Env{
+	env := logsserver.Env{
 		GetLokiClient: b.env.GetLokiClient,
 		AuthServer:    b.env.AuthServer(),
-	})
+		DB:            b.env.GetDBClient(),
+	}
+	if ok, err := logstore.Enabled(b.env.Config()); err != nil {
+		return err
+	} else if ok {
+		env.Store = &logstore.Store{DB: b.env.GetDBClient()}
+	}
+	apiServer, err := logsserver.NewAPIServer(env)
 	if err != nil {
 		return err
 	}
@@ -531,6 +576,45 @@ func (b *builder) startPFSMaster(ctx context.Context) error {
 	return nil
 }
 
+func (b *builder) startWebhookMaster(ctx context.Context) error {
+	m := webhook_server.NewMaster(webhook_server.Env{
+		DB:         b.env.GetDBClient(),
+		Listener:   b.env.GetPostgresListener(),
+		EtcdClient: b.env.GetEtcdClient(),
+		EtcdPrefix: b.env.Config().EtcdPrefix,
+		NewDLock:   b.env.NewDLock,
+	})
+	go func() {
+		ctx := pctx.Child(ctx, "webhook-master")
+		if err := m.Run(ctx); err != nil {
+			log.Error(ctx, "from webhook-master", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (b *builder) startLogRulesMaster(ctx context.Context) error {
+	env := logrules.Env{
+		DB:         b.env.GetDBClient(),
+		Logs:       logservice.LogService{GetLokiClient: b.env.GetLokiClient},
+		EtcdPrefix: b.env.Config().EtcdPrefix,
+		NewDLock:   b.env.NewDLock,
+	}
+	if ok, err := logstore.Enabled(b.env.Config()); err != nil {
+		return err
+	} else if ok {
+		env.Logs.Store = &logstore.Store{DB: b.env.GetDBClient()}
+	}
+	m := logrules.NewMaster(env)
+	go func() {
+		ctx := pctx.Child(ctx, "log-rules-master")
+		if err := m.Run(ctx); err != nil {
+			log.Error(ctx, "from log-rules-master", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
 func (b *builder) startPPSWorker(ctx context.Context) error {
 	etcdPrefix := path.Join(b.env.Config().EtcdPrefix, b.env.Config().PPSEtcdPrefix)
 	w := pps_server.NewWorker(pps_server.WorkerEnv{
@@ -559,37 +643,103 @@ func (b *builder) startDebugWorker(ctx context.Context) error {
 	return nil
 }
 
+func (b *builder) startContinuousProfiler(ctx context.Context) error {
+	continuous.Start(ctx, b.env.GetPachClient(ctx), continuous.ServicePachd, b.env.Config().PachdPodName, b.env.Config())
+	return nil
+}
+
+func (b *builder) startLogStorePruner(ctx context.Context) error {
+	ok, err := logstore.Enabled(b.env.Config())
+	if err != nil {
+		return err
+	}
+	retention := time.Duration(b.env.Config().LogStoreRetentionHours) * time.Hour
+	if !ok || retention <= 0 {
+		return nil
+	}
+	go logstore.Prune(pctx.Child(ctx, "log-store-pruner"), b.env.GetDBClient(), retention)
+	return nil
+}
+
 func (b *builder) ensurePJSWorkerSecret(ctx context.Context) error {
 	return ensurePJSWorkerSecret(ctx, b.env.AuthServer(), b.env.GetKubeClient(), b.env.Config())
 }
 
 func ensurePJSWorkerSecret(ctx context.Context, authServer auth.APIServer, kubeClient kubernetes.Interface,
 	config *pachconfig.Configuration) error {
-	secretName := "pachyderm-pjs-worker-authentication"
-	secretKey := "pjsWorkerAuthToken"
+	token, err := ensureRobotSecret(ctx, authServer, kubeClient, config.Namespace,
+		"pachyderm-pjs-worker-authentication", "pjsWorkerAuthToken", "pjs-worker")
+	if err != nil {
+		return err
+	}
+	config.PJSWorkerAuthToken = token
+	return nil
+}
+
+// ensureContinuousProfiler creates the continuous profiling repo and the token that pachd, pachw
+// and workers commit profiles with, and makes sure that token may write to the repo.
+func (b *builder) ensureContinuousProfiler(ctx context.Context) error {
+	config := b.env.Config()
+	if config.ContinuousProfilingIntervalSeconds <= 0 {
+		return nil
+	}
+	token, err := ensureRobotSecret(ctx, b.env.AuthServer(), b.env.GetKubeClient(), config.Namespace,
+		continuous.SecretName, continuous.SecretKey, continuous.Principal)
+	if err != nil {
+		return err
+	}
+	config.ContinuousProfilingAuthToken = token
+	ctx = internalauth.AsInternalUser(ctx, authdb.InternalUser)
+	if _, err := b.env.PfsServer().CreateRepo(ctx, &pfs.CreateRepoRequest{
+		Repo:        continuous.Repo(),
+		Description: "Continuous profiles of pachd, pachw and workers.",
+		Update:      true,
+	}); err != nil {
+		return errors.Wrapf(err, "create %v repo", continuous.Repo())
+	}
+	// The role binding is also created when auth is activated, but not if the repo was created
+	// after that.
+	if _, err := b.env.AuthServer().ModifyRoleBinding(ctx, &auth.ModifyRoleBindingRequest{
+		Principal: continuous.Principal,
+		Roles:     []string{auth.RepoWriterRole},
+		Resource:  continuous.Repo().AuthResource(),
+	}); err != nil && !auth.IsErrNotActivated(err) {
+		return errors.Wrapf(err, "grant %v write access to %v", continuous.Principal, continuous.Repo())
+	}
+	return nil
+}
+
+// ensureRobotSecret returns the token for robot stored in the secretKey key of the named Kubernetes
+// secret, minting a token and creating the secret if it doesn't exist.  If auth is active and the
+// token isn't known, as it won't be after auth is deactivated and activated again, it's restored.
+func ensureRobotSecret(ctx context.Context, authServer auth.APIServer, kubeClient kubernetes.Interface,
+	namespace, secretName, secretKey, robot string) (string, error) {
 	// First read the secret in case it exists.
-	existingSecret, err := kubeClient.CoreV1().Secrets(config.Namespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	existingSecret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), secretName, metav1.GetOptions{})
 	if err == nil {
 		// Secret exists, read the value from the specified key
-		if encodedValue, ok := existingSecret.Data[secretKey]; ok {
-			// Decode the value since it is stored as base64
-			token, err := base64.StdEncoding.DecodeString(string(encodedValue))
-			if err != nil {
-				return errors.Wrap(err, "decoding from base64")
-			}
-			config.PJSWorkerAuthToken = string(token)
-			return nil
+		encodedValue, ok := existingSecret.Data[secretKey]
+		if !ok {
+			// TODO: delete and recreate?
+			return "", errors.New(secretName + " does not contain expect secret key")
 		}
-		// TODO: delete and recreate?
-		return errors.New(secretName + " does not contain expect secret key")
+		// Decode the value since it is stored as base64
+		token, err := base64.StdEncoding.DecodeString(string(encodedValue))
+		if err != nil {
+			return "", errors.Wrap(err, "decoding from base64")
+		}
+		if err := restoreRobotToken(ctx, authServer, robot, string(token)); err != nil {
+			return "", err
+		}
+		return string(token), nil
 	} else if !k8serrors.IsNotFound(err) {
-		return errors.Wrap(err, "getting pjs worker auth secret")
+		return "", errors.Wrapf(err, "getting %v secret", secretName)
 	}
 	// create the secret if it doesn't exist.
 	ctx = internalauth.AsInternalUser(ctx, authdb.InternalUser)
-	tokenResp, err := authServer.GetRobotToken(ctx, &auth.GetRobotTokenRequest{Robot: "pjs-worker"})
+	tokenResp, err := authServer.GetRobotToken(ctx, &auth.GetRobotTokenRequest{Robot: robot})
 	if err != nil {
-		return errors.Wrap(err, "getting robot token")
+		return "", errors.Wrap(err, "getting robot token")
 	}
 	//TODO: TTL?
 	encodedSecret := base64.StdEncoding.EncodeToString([]byte(tokenResp.Token))
@@ -602,11 +752,28 @@ func ensurePJSWorkerSecret(ctx context.Context, authServer auth.APIServer, kubeC
 		},
 		Type: v1.SecretTypeOpaque,
 	}
-	_, err = kubeClient.CoreV1().Secrets(config.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	_, err = kubeClient.CoreV1().Secrets(namespace).Create(context.Background(), secret, metav1.CreateOptions{})
 	if err != nil {
-		return errors.Wrap(err, "creating kubernetes secret")
+		return "", errors.Wrap(err, "creating kubernetes secret")
+	}
+	return tokenResp.Token, nil
+}
+
+// restoreRobotToken restores robot's token if auth is active and doesn't know it.
+func restoreRobotToken(ctx context.Context, authServer auth.APIServer, robot, token string) error {
+	_, err := authServer.WhoAmI(gmd.NewIncomingContext(ctx, gmd.Pairs(auth.ContextTokenKey, token)), &auth.WhoAmIRequest{})
+	if err == nil || auth.IsErrNotActivated(err) {
+		return nil
+	} else if !auth.IsErrBadToken(err) {
+		return errors.Wrap(err, "check robot token")
+	}
+	ctx = internalauth.AsInternalUser(ctx, authdb.InternalUser)
+	if _, err := authServer.RestoreAuthToken(ctx, &auth.RestoreAuthTokenRequest{Token: &auth.TokenInfo{
+		HashedToken: auth.HashToken(token),
+		Subject:     auth.RobotPrefix + strings.TrimPrefix(robot, auth.RobotPrefix),
+	}}); err != nil {
+		return errors.Wrap(err, "restore robot token")
 	}
-	config.PJSWorkerAuthToken = tokenResp.Token
 	return nil
 }
 
@@ -80,7 +80,7 @@ func (eb *enterpriseBuilder) buildAndRun(ctx context.Context) error {
 		eb.bootstrap,
 		eb.externallyListen,
 		eb.resumeHealth,
-		eb.daemon.serve,
+		eb.serve,
 	)
 }
 
@@ -38,6 +38,7 @@ func AuthEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) auth_ser
 		EtcdClient: senv.GetEtcdClient(),
 		Listener:   senv.GetPostgresListener(),
 		TxnEnv:     txnEnv,
+		NewDLock:   senv.NewDLock,
 
 		GetEnterpriseServer: senv.EnterpriseServer,
 		GetIdentityServer:   senv.IdentityServer,
@@ -89,6 +90,7 @@ func PachwEnv(env serviceenv.ServiceEnv) (*pachw_server.Env, error) {
 		EtcdClient:        env.GetEtcdClient(),
 		PFSTaskService:    env.GetTaskService(PFSEtcdPrefix),
 		PPSTaskService:    env.GetTaskService(PPSEtcdPrefix),
+		NewDLock:          env.NewDLock,
 		KubeClient:        env.GetKubeClient(),
 		Namespace:         env.Config().Namespace,
 		MinReplicas:       env.Config().PachwMinReplicas,
@@ -115,6 +117,8 @@ func PFSEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*pfs_serv
 		EtcdPrefix:  etcdPrefix,
 		EtcdClient:  env.GetEtcdClient(),
 		TaskService: env.GetTaskService(etcdPrefix),
+		NewDLock:    env.NewDLock,
+		WithRing:    env.WithRing,
 
 		Auth:                 env.AuthServer(),
 		GetPipelineInspector: func() pfs_server.PipelineInspector { return env.PpsServer() },
@@ -162,6 +166,7 @@ func PPSEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, reporter
 		EtcdClient:    senv.GetEtcdClient(),
 		EtcdPrefix:    etcdPrefix,
 		TaskService:   senv.GetTaskService(etcdPrefix),
+		NewDLock:      senv.NewDLock,
 		GetLokiClient: senv.GetLokiClient,
 
 		PFSServer:     senv.PfsServer(),
@@ -49,15 +49,19 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 	version_server "github.com/pachyderm/pachyderm/v2/src/version"
 	version "github.com/pachyderm/pachyderm/v2/src/version/versionpb"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
 	"github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	lokiclient "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
+	audit_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	auth_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	clientlog_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
@@ -71,6 +75,7 @@ import (
 	storage_server "github.com/pachyderm/pachyderm/v2/src/internal/storage"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	webhook_server "github.com/pachyderm/pachyderm/v2/src/internal/webhook"
 )
 
 // A fullBuilder builds a full-mode pachd.
@@ -146,6 +151,7 @@ func (fb *fullBuilder) buildAndRun(ctx context.Context) error {
 		fb.registerPFSServer,
 		fb.registerPJSServer,
 		fb.registerSnapshotServer,
+		fb.registerWebhookServer,
 		fb.registerStorageServer,
 		fb.registerPPSServer,
 		fb.registerTransactionServer,
@@ -168,10 +174,15 @@ func (fb *fullBuilder) buildAndRun(ctx context.Context) error {
 		fb.resumeHealth,
 		fb.startPFSWorker,
 		fb.startPFSMaster,
+		fb.startWebhookMaster,
+		fb.startLogRulesMaster,
 		fb.startPPSWorker,
 		fb.startDebugWorker,
+		fb.ensureContinuousProfiler,
+		fb.startContinuousProfiler,
+		fb.startLogStorePruner,
 		fb.ensurePJSWorkerSecret,
-		fb.daemon.serve,
+		fb.serve,
 	)
 }
 
@@ -204,9 +215,10 @@ type Full struct {
 	dbListener collection.PostgresListener
 	authReady  chan struct{}
 
-	selfGRPC        *grpc.ClientConn
-	authInterceptor *auth_interceptor.Interceptor
-	txnEnv          *transactionenv.TransactionEnv
+	selfGRPC         *grpc.ClientConn
+	authInterceptor  *auth_interceptor.Interceptor
+	auditInterceptor *audit_interceptor.Interceptor
+	txnEnv           *transactionenv.TransactionEnv
 
 	healthServer     grpc_health_v1.HealthServer
 	version          version.APIServer
@@ -215,6 +227,7 @@ type Full struct {
 	pfsServer        pfs.APIServer
 	pjsServer        pjs.APIServer
 	snapshotServer   snapshot.APIServer
+	webhookServer    webhook.APIServer
 	storageServer    *storage_server.Server
 	ppsServer        pps.APIServer
 	metadataServer   metadata.APIServer
@@ -230,7 +243,8 @@ type Full struct {
 	ppsWorker   *pps_server.Worker
 	debugWorker *debug_server.Worker
 
-	pfsMaster *pfs_server.Master
+	pfsMaster     *pfs_server.Master
+	webhookMaster *webhook_server.Master
 
 	pachClient      *client.APIClient
 	pachClientReady chan struct{}
@@ -238,6 +252,20 @@ type Full struct {
 	kubeClient kubernetes.Interface
 }
 
+// etcdDLocks returns a constructor of distributed locks that are kept in etcd.
+func etcdDLocks(client *clientv3.Client) func(string) dlock.DLock {
+	return func(prefix string) dlock.DLock {
+		return dlock.NewDLock(client, prefix)
+	}
+}
+
+// etcdRings returns a constructor of consistent hashing rings that are kept in etcd.
+func etcdRings(client *clientv3.Client) func(context.Context, string, func(context.Context, *consistenthashing.Ring) error) error {
+	return func(ctx context.Context, prefix string, cb func(context.Context, *consistenthashing.Ring) error) error {
+		return consistenthashing.WithRing(ctx, client, prefix, cb)
+	}
+}
+
 // NewFull sets up a new Full pachd and returns it.
 func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption) *Full {
 	pd := &Full{
@@ -254,6 +282,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 	pd.authInterceptor = auth_interceptor.NewInterceptor(func() auth_iface.APIServer {
 		return pd.authServer.(auth_iface.APIServer)
 	})
+	pd.auditInterceptor = audit_interceptor.NewInterceptor(func() *pachsql.DB { return env.DB })
 	pd.debugWorker = debug_server.NewWorker(debug_server.WorkerEnv{
 		PFS:         pfs.NewAPIClient(pd.selfGRPC),
 		TaskService: task.NewEtcdService(env.EtcdClient, "debug"),
@@ -314,6 +343,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 				EtcdClient: env.EtcdClient,
 				Listener:   pd.dbListener,
 				TxnEnv:     pd.txnEnv,
+				NewDLock:   etcdDLocks(env.EtcdClient),
 				Config: pachconfig.Configuration{
 					GlobalConfiguration:             &config.GlobalConfiguration,
 					PachdSpecificConfiguration:      &config.PachdSpecificConfiguration,
@@ -340,6 +370,8 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 				EtcdClient:    env.EtcdClient,
 				EtcdPrefix:    etcdPrefix,
 				TaskService:   task.NewEtcdService(env.EtcdClient, etcdPrefix),
+				NewDLock:      etcdDLocks(env.EtcdClient),
+				WithRing:      etcdRings(env.EtcdClient),
 				TxnEnv:        pd.txnEnv,
 				StorageConfig: config.StorageConfiguration,
 				Auth:          pd.authServer.(pfs_server.PFSAuth),
@@ -375,6 +407,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 				EtcdClient:        env.EtcdClient,
 				EtcdPrefix:        path.Join(config.EtcdPrefix, config.PPSEtcdPrefix),
 				TaskService:       task.NewEtcdService(env.EtcdClient, path.Join(config.EtcdPrefix, config.PPSEtcdPrefix)),
+				NewDLock:          etcdDLocks(env.EtcdClient),
 				GetLokiClient:     env.GetLokiClient,
 				GetPachClient:     pd.mustGetPachClient,
 				Config: pachconfig.Configuration{
@@ -515,6 +548,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 				pd.logsServer, err = logs_server.NewAPIServer(logs_server.Env{
 					GetLokiClient: env.GetLokiClient,
 					AuthServer:    pd.authServer.(auth_server.APIServer),
+					DB:            env.DB,
 				})
 				if err != nil {
 					return errors.Wrap(err, "logs_server.NewAPIServer")
@@ -549,6 +583,20 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 				return nil
 			},
 		},
+		setupStep{
+			Name: "initWebhookServer",
+			Fn: func(ctx context.Context) error {
+				pd.webhookServer = &webhook_server.APIServer{DB: env.DB}
+				pd.webhookMaster = webhook_server.NewMaster(webhook_server.Env{
+					DB:         env.DB,
+					Listener:   pd.dbListener,
+					EtcdClient: env.EtcdClient,
+					EtcdPrefix: config.EtcdPrefix,
+					NewDLock:   etcdDLocks(env.EtcdClient),
+				})
+				return nil
+			},
+		},
 		setupStep{
 			Name: "initPJSWorkerAuthToken",
 			Fn: func(ctx context.Context) error {
@@ -582,13 +630,16 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 	pd.addBackground("pfsMaster", func(ctx context.Context) error {
 		return pd.pfsMaster.Run(ctx)
 	})
+	pd.addBackground("webhookMaster", func(ctx context.Context) error {
+		return pd.webhookMaster.Run(ctx)
+	})
 	pd.addBackground("ppsWorker", func(ctx context.Context) error {
 		return pd.ppsWorker.Run(ctx)
 	})
 	pd.addBackground("debugWorker", func(ctx context.Context) error {
 		return pd.debugWorker.Run(ctx)
 	})
-	pd.addBackground("grpc", newServeGRPC(pd.authInterceptor, env.Listener, func(gs grpc.ServiceRegistrar) {
+	pd.addBackground("grpc", newServeGRPC(pd.authInterceptor, pd.auditInterceptor, env.Listener, func(gs grpc.ServiceRegistrar) {
 		admin.RegisterAPIServer(gs, pd.adminServer)
 		auth.RegisterAPIServer(gs, pd.authServer)
 		debug.RegisterDebugServer(gs, pd.debugServer)
@@ -605,6 +656,7 @@ func NewFull(env Env, config pachconfig.PachdFullConfiguration, opt *FullOption)
 		pps.RegisterAPIServer(gs, pd.ppsServer)
 		proxy.RegisterAPIServer(gs, pd.proxyServer)
 		version.RegisterAPIServer(gs, pd.version)
+		webhook.RegisterAPIServer(gs, pd.webhookServer)
 	}))
 	pd.addBackground("connect", func(ctx context.Context) error {
 		addr, err := grpcutil.ParsePachdAddress("http://" + pd.env.Listener.Addr().String())
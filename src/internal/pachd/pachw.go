@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 )
 
 // pachwBuilder builds a pachw-mode pachd instance.
@@ -99,6 +100,11 @@ func (pachwb *pachwBuilder) attemptPJSAuth(ctx context.Context) error {
 	return nil
 }
 
+func (pachwb *pachwBuilder) startContinuousProfiler(ctx context.Context) error {
+	continuous.Start(ctx, pachwb.env.GetPachClient(ctx), continuous.ServicePachw, pachwb.env.Config().PachdPodName, pachwb.env.Config())
+	return nil
+}
+
 // buildAndRun builds & starts a pachw-mode pachd.
 func (pachwb *pachwBuilder) buildAndRun(ctx context.Context) error {
 	return pachwb.apply(ctx,
@@ -123,6 +129,7 @@ func (pachwb *pachwBuilder) buildAndRun(ctx context.Context) error {
 		pachwb.startPFSWorker,
 		pachwb.startPPSWorker,
 		pachwb.startDebugWorker,
+		pachwb.startContinuousProfiler,
 		pachwb.attemptPJSAuth,
 		pachwb.daemon.serve,
 	)
@@ -103,7 +103,7 @@ func (pb *pausedBuilder) buildAndRun(ctx context.Context) error {
 		pb.internallyListen,
 		pb.externallyListen,
 		pb.resumeHealth,
-		pb.daemon.serve,
+		pb.serve,
 	)
 }
 
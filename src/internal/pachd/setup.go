@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	audit_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	auth_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
 	log_interceptor "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
@@ -250,7 +251,7 @@ func initMetadataServer(out *metadata.APIServer, env func() metadata_server.Env)
 
 // newServeGRPC returns a background runner which servers gRPC on l.
 // reg is called to register functions with the server.
-func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener, reg func(gs grpc.ServiceRegistrar)) func(ctx context.Context) error {
+func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, auditInterceptor *audit_interceptor.Interceptor, l net.Listener, reg func(gs grpc.ServiceRegistrar)) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		baseContextInterceptor := log_interceptor.NewBaseContextInterceptor(ctx)
 		loggingInterceptor := log_interceptor.NewLoggingInterceptor(ctx)
@@ -259,6 +260,7 @@ func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener,
 			grpc.ChainUnaryInterceptor(
 				baseContextInterceptor.UnaryServerInterceptor,
 				loggingInterceptor.UnarySetup,
+				auditInterceptor.InterceptUnary,
 				errorsmw.UnaryServerInterceptor,
 				version_middleware.UnaryServerInterceptor,
 				tracing.UnaryServerInterceptor(),
@@ -270,6 +272,7 @@ func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener,
 			grpc.ChainStreamInterceptor(
 				baseContextInterceptor.StreamServerInterceptor,
 				loggingInterceptor.StreamSetup,
+				auditInterceptor.InterceptStream,
 				errorsmw.StreamServerInterceptor,
 				version_middleware.StreamServerInterceptor,
 				tracing.StreamServerInterceptor(),
@@ -285,6 +288,12 @@ func newServeGRPC(authInterceptor *auth_interceptor.Interceptor, l net.Listener,
 			log.Info(ctx, "stopping grpc server")
 			gs.Stop()
 		}()
-		return errors.EnsureStack(gs.Serve(l))
+		err := gs.Serve(l)
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditCloseTimeout)
+		defer cancel()
+		if err := auditInterceptor.Close(closeCtx); err != nil {
+			log.Error(ctx, "could not insert queued audit events", zap.Error(err))
+		}
+		return errors.EnsureStack(err)
 	}
 }
@@ -0,0 +1,168 @@
+package pfsdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ShareLinkNotFoundError is returned when a share link doesn't exist or has expired.  The two
+// aren't distinguished, so that the error says nothing about tokens that were never valid.
+type ShareLinkNotFoundError struct{}
+
+// Error satisfies the error interface.
+func (err *ShareLinkNotFoundError) Error() string {
+	return "share link not found; it may have expired"
+}
+
+func (err *ShareLinkNotFoundError) Is(other error) bool {
+	_, ok := other.(*ShareLinkNotFoundError)
+	return ok
+}
+
+func (err *ShareLinkNotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, err.Error())
+}
+
+// ShareLinkExhaustedError is returned by UseShareLink when a share link has been downloaded as many
+// times as it allows.
+type ShareLinkExhaustedError struct {
+	MaxDownloads int64
+}
+
+// Error satisfies the error interface.
+func (err *ShareLinkExhaustedError) Error() string {
+	return fmt.Sprintf("share link has already been downloaded %d times, its limit", err.MaxDownloads)
+}
+
+func (err *ShareLinkExhaustedError) Is(other error) bool {
+	_, ok := other.(*ShareLinkExhaustedError)
+	return ok
+}
+
+func (err *ShareLinkExhaustedError) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, err.Error())
+}
+
+type shareLinkRow struct {
+	TokenHash    string    `db:"token_hash"`
+	Project      string    `db:"project"`
+	Repo         string    `db:"repo"`
+	RepoType     string    `db:"repo_type"`
+	CommitID     string    `db:"commit_id"`
+	Path         string    `db:"path"`
+	FileType     int32     `db:"file_type"`
+	SizeBytes    int64     `db:"size_bytes"`
+	Issuer       string    `db:"issuer"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+	MaxDownloads int64     `db:"max_downloads"`
+	Downloads    int64     `db:"downloads"`
+}
+
+func (row *shareLinkRow) PbInfo() *pfs.ShareLinkInfo {
+	repo := &pfs.Repo{
+		Name:    row.Repo,
+		Type:    row.RepoType,
+		Project: &pfs.Project{Name: row.Project},
+	}
+	return &pfs.ShareLinkInfo{
+		File: &pfs.File{
+			Commit: &pfs.Commit{Repo: repo, Id: row.CommitID},
+			Path:   row.Path,
+		},
+		FileType:     pfs.FileType(row.FileType),
+		SizeBytes:    row.SizeBytes,
+		Issuer:       row.Issuer,
+		Created:      timestamppb.New(row.CreatedAt),
+		Expires:      timestamppb.New(row.ExpiresAt),
+		MaxDownloads: row.MaxDownloads,
+		Downloads:    row.Downloads,
+	}
+}
+
+// CreateShareLink stores a share link, identified by the hash of its token.  The link's commit must
+// be given by ID.
+func CreateShareLink(ctx context.Context, tx *pachsql.Tx, tokenHash string, info *pfs.ShareLinkInfo) error {
+	file := info.GetFile()
+	repo := file.GetCommit().GetRepo()
+	if file.GetCommit().GetId() == "" {
+		return errors.New("share link commit must be given by ID")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.share_links
+			(token_hash, project, repo, repo_type, commit_id, path, file_type, size_bytes, issuer, created_at, expires_at, max_downloads)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		tokenHash, repo.GetProject().GetName(), repo.GetName(), repo.GetType(), file.Commit.Id, file.GetPath(),
+		int32(info.GetFileType()), info.GetSizeBytes(), info.GetIssuer(), info.GetCreated().AsTime(), info.GetExpires().AsTime(),
+		info.GetMaxDownloads()); err != nil {
+		return errors.Wrap(err, "insert share link")
+	}
+	return nil
+}
+
+// GetShareLink returns the unexpired share link with the given token hash.
+func GetShareLink(ctx context.Context, tx *pachsql.Tx, tokenHash string) (*pfs.ShareLinkInfo, error) {
+	row := &shareLinkRow{}
+	if err := tx.GetContext(ctx, row, `
+		SELECT * FROM pfs.share_links
+		WHERE token_hash = $1 AND expires_at > CURRENT_TIMESTAMP`, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &ShareLinkNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "get share link")
+	}
+	return row.PbInfo(), nil
+}
+
+// UseShareLink counts a download of the unexpired share link with the given token hash, and returns
+// the link.  It returns a ShareLinkExhaustedError if the link has no downloads left.
+func UseShareLink(ctx context.Context, tx *pachsql.Tx, tokenHash string) (*pfs.ShareLinkInfo, error) {
+	row := &shareLinkRow{}
+	if err := tx.GetContext(ctx, row, `
+		UPDATE pfs.share_links SET downloads = downloads + 1
+		WHERE token_hash = $1 AND expires_at > CURRENT_TIMESTAMP AND (max_downloads = 0 OR downloads < max_downloads)
+		RETURNING *`, tokenHash); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "count share link download")
+		}
+		// Either the link doesn't exist, or it's used up; say which.
+		info, err := GetShareLink(ctx, tx, tokenHash)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &ShareLinkExhaustedError{MaxDownloads: info.MaxDownloads}
+	}
+	return row.PbInfo(), nil
+}
+
+// DeleteShareLink deletes the unexpired share link with the given token hash, and returns it.
+func DeleteShareLink(ctx context.Context, tx *pachsql.Tx, tokenHash string) (*pfs.ShareLinkInfo, error) {
+	row := &shareLinkRow{}
+	if err := tx.GetContext(ctx, row, `
+		DELETE FROM pfs.share_links
+		WHERE token_hash = $1 AND expires_at > CURRENT_TIMESTAMP
+		RETURNING *`, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &ShareLinkNotFoundError{}
+		}
+		return nil, errors.Wrap(err, "delete share link")
+	}
+	return row.PbInfo(), nil
+}
+
+// DeleteExpiredShareLinks deletes share links that have expired.
+func DeleteExpiredShareLinks(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pfs.share_links WHERE expires_at <= CURRENT_TIMESTAMP`); err != nil {
+		return errors.Wrap(err, "delete expired share links")
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package pfsdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The kinds of entities whose storage usage is accounted.  Usage rows are keyed by kind and by the
+// entity's row ID in its table.
+const (
+	StorageUsageProject = "project"
+	StorageUsageRepo    = "repo"
+	StorageUsageBranch  = "branch"
+	StorageUsageCommit  = "commit"
+)
+
+// StorageUsage is the accounted storage usage of one project, repo, branch, or commit.
+type StorageUsage struct {
+	Kind        string
+	ID          uint64
+	TotalBytes  int64
+	UniqueBytes int64
+}
+
+// ReplaceStorageUsage replaces all accounted storage usage with usage, computed at computedAt.
+func ReplaceStorageUsage(ctx context.Context, tx *pachsql.Tx, usage []StorageUsage, computedAt time.Time) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pfs.storage_usage`); err != nil {
+		return errors.Wrap(err, "delete storage usage")
+	}
+	if len(usage) == 0 {
+		return nil
+	}
+	kinds := make([]string, len(usage))
+	ids := make([]int64, len(usage))
+	totals := make([]int64, len(usage))
+	uniques := make([]int64, len(usage))
+	for i, u := range usage {
+		kinds[i], ids[i], totals[i], uniques[i] = u.Kind, int64(u.ID), u.TotalBytes, u.UniqueBytes
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO pfs.storage_usage (kind, id, total_bytes, unique_bytes, computed_at)
+		SELECT unnest($1::TEXT[]), unnest($2::BIGINT[]), unnest($3::BIGINT[]), unnest($4::BIGINT[]), $5`,
+		kinds, ids, totals, uniques, computedAt); err != nil {
+		return errors.Wrap(err, "insert storage usage")
+	}
+	return nil
+}
+
+// StorageUsageFilter restricts the storage usage listed by ListStorageUsage.  Unset fields match
+// everything.
+type StorageUsageFilter struct {
+	// Projects matches the named projects and the entities in them.
+	Projects []string
+	// Repo matches the repo and the entities in it.
+	Repo *pfs.Repo
+}
+
+type storageUsageRow struct {
+	Project     string    `db:"project"`
+	Repo        string    `db:"repo"`
+	RepoType    string    `db:"repo_type"`
+	Name        string    `db:"name"`
+	TotalBytes  int64     `db:"total_bytes"`
+	UniqueBytes int64     `db:"unique_bytes"`
+	ComputedAt  time.Time `db:"computed_at"`
+}
+
+func (row *storageUsageRow) pbResponse(kind string) *pfs.StorageUsageResponse {
+	resp := &pfs.StorageUsageResponse{
+		Usage: &pfs.StorageUsage{
+			TotalBytes:  row.TotalBytes,
+			UniqueBytes: row.UniqueBytes,
+			SharedBytes: row.TotalBytes - row.UniqueBytes,
+		},
+		ComputedAt: timestamppb.New(row.ComputedAt),
+	}
+	project := &pfs.Project{Name: row.Project}
+	repo := &pfs.Repo{Name: row.Repo, Type: row.RepoType, Project: project}
+	switch kind {
+	case StorageUsageProject:
+		resp.Entity = &pfs.StorageUsageResponse_Project{Project: project}
+	case StorageUsageRepo:
+		resp.Entity = &pfs.StorageUsageResponse_Repo{Repo: repo}
+	case StorageUsageBranch:
+		resp.Entity = &pfs.StorageUsageResponse_Branch{Branch: &pfs.Branch{Repo: repo, Name: row.Name}}
+	case StorageUsageCommit:
+		resp.Entity = &pfs.StorageUsageResponse_Commit{Commit: &pfs.Commit{Repo: repo, Id: row.Name}}
+	}
+	return resp
+}
+
+// storageUsageQueries select the usage of each kind of entity along with the names that identify
+// it.  Each joins core.projects as project, and all but the project query join pfs.repos as repo.
+var storageUsageQueries = map[string]string{
+	StorageUsageProject: `
+		SELECT project.name AS project, '' AS repo, '' AS repo_type, '' AS name,
+			storage.total_bytes, storage.unique_bytes, storage.computed_at
+		FROM pfs.storage_usage storage
+			JOIN core.projects project ON project.id = storage.id
+		WHERE storage.kind = 'project'`,
+	StorageUsageRepo: `
+		SELECT project.name AS project, repo.name AS repo, repo.type AS repo_type, '' AS name,
+			storage.total_bytes, storage.unique_bytes, storage.computed_at
+		FROM pfs.storage_usage storage
+			JOIN pfs.repos repo ON repo.id = storage.id
+			JOIN core.projects project ON project.id = repo.project_id
+		WHERE storage.kind = 'repo'`,
+	StorageUsageBranch: `
+		SELECT project.name AS project, repo.name AS repo, repo.type AS repo_type, branch.name AS name,
+			storage.total_bytes, storage.unique_bytes, storage.computed_at
+		FROM pfs.storage_usage storage
+			JOIN pfs.branches branch ON branch.id = storage.id
+			JOIN pfs.repos repo ON repo.id = branch.repo_id
+			JOIN core.projects project ON project.id = repo.project_id
+		WHERE storage.kind = 'branch'`,
+	StorageUsageCommit: `
+		SELECT project.name AS project, repo.name AS repo, repo.type AS repo_type, commit.commit_set_id AS name,
+			storage.total_bytes, storage.unique_bytes, storage.computed_at
+		FROM pfs.storage_usage storage
+			JOIN pfs.commits commit ON commit.int_id = storage.id
+			JOIN pfs.repos repo ON repo.id = commit.repo_id
+			JOIN core.projects project ON project.id = repo.project_id
+		WHERE storage.kind = 'commit'`,
+}
+
+// ListStorageUsage returns the accounted storage usage of the entities of the given kind that
+// match filter, ordered by name.  Entities that haven't been accounted yet are left out.
+func ListStorageUsage(ctx context.Context, tx *pachsql.Tx, kind string, filter StorageUsageFilter) ([]*pfs.StorageUsageResponse, error) {
+	query, ok := storageUsageQueries[kind]
+	if !ok {
+		return nil, errors.Errorf("unknown storage usage kind %q", kind)
+	}
+	var conditions []string
+	var args []any
+	if len(filter.Projects) > 0 {
+		args = append(args, filter.Projects)
+		conditions = append(conditions, "project.name = ANY($1)")
+	}
+	if filter.Repo != nil {
+		if kind == StorageUsageProject {
+			return nil, errors.Errorf("cannot filter project storage usage by repo")
+		}
+		args = append(args, filter.Repo.GetProject().GetName(), filter.Repo.Name, filter.Repo.Type)
+		n := len(args)
+		conditions = append(conditions, fmt.Sprintf("project.name = $%d AND repo.name = $%d AND repo.type = $%d", n-2, n-1, n))
+	}
+	if len(conditions) > 0 {
+		query += " AND " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY project, repo, repo_type, name"
+	var rows []storageUsageRow
+	if err := tx.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "list %s storage usage", kind)
+	}
+	resps := make([]*pfs.StorageUsageResponse, len(rows))
+	for i := range rows {
+		resps[i] = rows[i].pbResponse(kind)
+	}
+	return resps, nil
+}
@@ -0,0 +1,392 @@
+// Package pfsreplicate copies the commits on a branch from one Pachyderm cluster to another.  Files
+// are copied by reference: the destination receives the data refs of each file, along with only
+// those chunks that it doesn't already have.
+package pfsreplicate
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/metadata"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/storage"
+)
+
+// SourceCommitKey is the metadata key that holds, on each replicated commit, the ID of the commit
+// that it was replicated from.  Replication resumes after the source commit of the destination
+// branch's head.
+const SourceCommitKey = "pachyderm.io/replicated-from"
+
+const (
+	// batchSize is the number of files whose chunks are looked for and copied together.
+	batchSize = 1000
+	// chunkParallelism is the number of chunks that are copied at once.
+	chunkParallelism = 8
+)
+
+// CommitStats describes the replication of one commit.
+type CommitStats struct {
+	Source      *pfs.Commit
+	Destination *pfs.Commit
+	// Files is the number of files in the commit.
+	Files int64
+	// Chunks and Bytes are the number and size of the chunks that were copied for the commit; the
+	// rest were already in the destination.
+	Chunks int64
+	Bytes  int64
+}
+
+// Replicator copies the commits on a branch of one cluster to a branch of another.
+type Replicator struct {
+	src, dst *client.APIClient
+	from, to *pfs.Branch
+	onCommit func(*CommitStats)
+}
+
+// New returns a Replicator that copies commits from the branch from, in the cluster that src is
+// connected to, to the branch to in the cluster that dst is connected to.  onCommit, if not nil, is
+// called after each commit is replicated.
+func New(src, dst *client.APIClient, from, to *pfs.Branch, onCommit func(*CommitStats)) *Replicator {
+	if onCommit == nil {
+		onCommit = func(*CommitStats) {}
+	}
+	return &Replicator{
+		src:      src,
+		dst:      dst,
+		from:     from,
+		to:       to,
+		onCommit: onCommit,
+	}
+}
+
+// Replicate copies the finished commits on the source branch that haven't been replicated yet to
+// the destination branch, oldest first, and returns the number that it copied.  It stops at the
+// first commit that isn't finished.
+func (r *Replicator) Replicate(ctx context.Context) (int, error) {
+	if err := r.ensureRepo(ctx); err != nil {
+		return 0, err
+	}
+	last, err := r.lastReplicated(ctx)
+	if err != nil {
+		return 0, err
+	}
+	cis, err := r.pending(ctx, last)
+	if err != nil {
+		return 0, err
+	}
+	for i, ci := range cis {
+		if ci.Finished == nil {
+			return i, nil
+		}
+		if err := r.replicateCommit(ctx, ci); err != nil {
+			return i, err
+		}
+	}
+	return len(cis), nil
+}
+
+// Follow is like Replicate, but after catching up it keeps replicating commits as they're finished
+// on the source branch, until ctx is done.
+func (r *Replicator) Follow(ctx context.Context) error {
+	if _, err := r.Replicate(ctx); err != nil {
+		return err
+	}
+	last, err := r.lastReplicated(ctx)
+	if err != nil {
+		return err
+	}
+	err = r.src.WithCtx(ctx).SubscribeCommit(r.from.Repo, r.from.Name, last, pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+		if ci.Commit.Id == last {
+			return nil
+		}
+		return r.replicateCommit(ctx, ci)
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// ensureRepo creates the destination repo if it doesn't exist.
+func (r *Replicator) ensureRepo(ctx context.Context) error {
+	_, err := r.dst.PfsAPIClient.InspectRepo(ctx, &pfs.InspectRepoRequest{Repo: r.to.Repo})
+	if err == nil {
+		return nil
+	}
+	if !errutil.IsNotFoundError(err) {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "inspect destination repo %v", r.to.Repo)
+	}
+	ri, err := r.src.PfsAPIClient.InspectRepo(ctx, &pfs.InspectRepoRequest{Repo: r.from.Repo})
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "inspect source repo %v", r.from.Repo)
+	}
+	if _, err := r.dst.PfsAPIClient.CreateRepo(ctx, &pfs.CreateRepoRequest{
+		Repo:        r.to.Repo,
+		Description: ri.Description,
+	}); err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "create destination repo %v", r.to.Repo)
+	}
+	return nil
+}
+
+// lastReplicated returns the ID of the source commit that the destination branch's head was
+// replicated from, or "" if the destination branch doesn't exist yet.
+func (r *Replicator) lastReplicated(ctx context.Context) (string, error) {
+	bi, err := r.dst.PfsAPIClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: r.to})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(grpcutil.ScrubGRPC(err), "inspect destination branch %v", r.to)
+	}
+	ci, err := r.dst.PfsAPIClient.InspectCommit(ctx, &pfs.InspectCommitRequest{Commit: bi.Head})
+	if err != nil {
+		return "", errors.Wrapf(grpcutil.ScrubGRPC(err), "inspect destination commit %v", bi.Head)
+	}
+	if ci.Finished == nil {
+		return "", errors.Errorf("destination branch %v has an open commit %v; finish or delete it first", r.to, ci.Commit.Id)
+	}
+	if id, ok := ci.Metadata[SourceCommitKey]; ok {
+		return id, nil
+	}
+	if ci.ParentCommit == nil && ci.SizeBytesUpperBound == 0 {
+		// An empty branch, as created by create branch.
+		return "", nil
+	}
+	return "", errors.Errorf("destination branch %v has commits that weren't replicated from %v", r.to, r.from)
+}
+
+// pending returns the commits on the source branch after the commit with ID last, oldest first.
+func (r *Replicator) pending(ctx context.Context, last string) ([]*pfs.CommitInfo, error) {
+	bi, err := r.src.PfsAPIClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: r.from})
+	if err != nil {
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "inspect source branch %v", r.from)
+	}
+	if bi.Head.Id == last {
+		return nil, nil
+	}
+	var from *pfs.Commit
+	if last != "" {
+		from = r.from.Repo.NewCommit("", last)
+	}
+	var cis []*pfs.CommitInfo
+	if err := r.src.WithCtx(ctx).ListCommitF(r.from.Repo, bi.Head, from, 0, false, func(ci *pfs.CommitInfo) error {
+		cis = append(cis, ci)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "list commits on source branch %v", r.from)
+	}
+	if last != "" && len(cis) > 0 && cis[len(cis)-1].ParentCommit == nil {
+		return nil, errors.Errorf("commit %v, which %v was last replicated from, isn't on source branch %v", last, r.to, r.from)
+	}
+	// ListCommit returns the newest commit first.
+	for i, j := 0, len(cis)-1; i < j; i, j = i+1, j-1 {
+		cis[i], cis[j] = cis[j], cis[i]
+	}
+	return cis, nil
+}
+
+// replicateCommit copies a finished source commit to a new commit on the destination branch.
+func (r *Replicator) replicateCommit(ctx context.Context, ci *pfs.CommitInfo) error {
+	stats := &CommitStats{Source: ci.Commit}
+	srcFileset, err := client.GetFileSet(ctx, r.src.PfsAPIClient, ci.Commit.Repo.Project.GetName(), ci.Commit.Repo.Name, "", ci.Commit.Id)
+	if err != nil {
+		return errors.Wrapf(err, "get file set of source commit %v", ci.Commit)
+	}
+	var dstFileset string
+	if err := client.WithRenewer(ctx, r.src.PfsAPIClient, func(ctx context.Context, renewer *renew.StringSet) error {
+		if err := renewer.Add(ctx, srcFileset); err != nil {
+			return err
+		}
+		var err error
+		dstFileset, err = r.copyFileset(ctx, srcFileset, stats)
+		return err
+	}); err != nil {
+		return errors.Wrapf(err, "copy source commit %v", ci.Commit)
+	}
+	commit, err := r.dst.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
+		Branch:      r.to,
+		Description: ci.Description,
+	})
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "start commit on destination branch %v", r.to)
+	}
+	stats.Destination = commit
+	// The new commit starts with the content of its parent; replace it with the source commit's.
+	if err := client.DeleteFile(ctx, r.dst.PfsAPIClient, commit, "/"); err != nil {
+		return errors.Wrapf(err, "clear destination commit %v", commit)
+	}
+	if err := client.AddFileSet(ctx, r.dst.PfsAPIClient, r.to.Repo.Project.GetName(), r.to.Repo.Name, "", commit.Id, dstFileset); err != nil {
+		return errors.Wrapf(err, "add file set to destination commit %v", commit)
+	}
+	md := map[string]string{SourceCommitKey: ci.Commit.Id}
+	for k, v := range ci.Metadata {
+		if k != SourceCommitKey {
+			md[k] = v
+		}
+	}
+	if _, err := r.dst.MetadataClient.EditMetadata(ctx, &metadata.EditMetadataRequest{
+		Edits: []*metadata.Edit{{
+			Target: &metadata.Edit_Commit{Commit: commitPicker(commit)},
+			Op:     &metadata.Edit_Replace_{Replace: &metadata.Edit_Replace{Replacement: md}},
+		}},
+	}); err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "set metadata of destination commit %v", commit)
+	}
+	if _, err := r.dst.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{Commit: commit}); err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "finish destination commit %v", commit)
+	}
+	r.onCommit(stats)
+	return nil
+}
+
+func commitPicker(commit *pfs.Commit) *pfs.CommitPicker {
+	return &pfs.CommitPicker{
+		Picker: &pfs.CommitPicker_Id{
+			Id: &pfs.CommitPicker_CommitByGlobalId{
+				Repo: &pfs.RepoPicker{
+					Picker: &pfs.RepoPicker_Name{
+						Name: &pfs.RepoPicker_RepoName{
+							Project: &pfs.ProjectPicker{
+								Picker: &pfs.ProjectPicker_Name{Name: commit.Repo.Project.GetName()},
+							},
+							Name: commit.Repo.Name,
+							Type: commit.Repo.Type,
+						},
+					},
+				},
+				Id: commit.Id,
+			},
+		},
+	}
+}
+
+// copyFileset recreates a source file set in the destination, copying the chunks that the
+// destination doesn't have, and returns the ID of the new file set.
+func (r *Replicator) copyFileset(ctx context.Context, id string, stats *CommitStats) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	refs, err := r.src.FilesetClient.ReadFilesetRefs(ctx, &storage.ReadFilesetRequest{FilesetId: id})
+	if err != nil {
+		return "", errors.Wrap(grpcutil.ScrubGRPC(err), "read source file set")
+	}
+	w, err := r.dst.FilesetClient.CreateFilesetFromRefs(ctx)
+	if err != nil {
+		return "", errors.Wrap(grpcutil.ScrubGRPC(err), "create destination file set")
+	}
+	var batch []*storage.FileRefs
+	flush := func() error {
+		if err := r.copyChunks(ctx, batch, stats); err != nil {
+			return err
+		}
+		for _, f := range batch {
+			if err := w.Send(f); err != nil {
+				return errors.Wrapf(grpcutil.ScrubGRPC(err), "send %s", f.Path)
+			}
+		}
+		stats.Files += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+	for {
+		f, err := refs.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", errors.Wrap(grpcutil.ScrubGRPC(err), "read source file set")
+		}
+		batch = append(batch, f)
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return "", err
+	}
+	resp, err := w.CloseAndRecv()
+	if err != nil {
+		return "", errors.Wrap(grpcutil.ScrubGRPC(err), "create destination file set")
+	}
+	return resp.FilesetId, nil
+}
+
+// copyChunks copies the chunks that a batch of files refer to, and that the destination doesn't
+// have, from the source.  Every batch looks for all of its chunks, rather than remembering those
+// that earlier batches found, because looking for a chunk is what keeps the destination from
+// garbage collecting it until the file set refers to it.
+func (r *Replicator) copyChunks(ctx context.Context, batch []*storage.FileRefs, stats *CommitStats) error {
+	var ids [][]byte
+	seen := make(map[string]struct{})
+	for _, f := range batch {
+		for _, id := range f.ChunkIds {
+			if _, ok := seen[string(id)]; ok {
+				continue
+			}
+			seen[string(id)] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	resp, err := r.dst.FilesetClient.FindMissingChunks(ctx, &storage.FindMissingChunksRequest{ChunkIds: ids})
+	if err != nil {
+		return errors.Wrap(grpcutil.ScrubGRPC(err), "find missing chunks")
+	}
+	var eg errgroup.Group
+	eg.SetLimit(chunkParallelism)
+	sizes := make([]int64, len(resp.ChunkIds))
+	for i, id := range resp.ChunkIds {
+		eg.Go(func() error {
+			size, err := r.copyChunk(ctx, id)
+			sizes[i] = size
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	for _, size := range sizes {
+		stats.Chunks++
+		stats.Bytes += size
+	}
+	return nil
+}
+
+// copyChunk copies a chunk from the source to the destination and returns its size.
+func (r *Replicator) copyChunk(ctx context.Context, id []byte) (int64, error) {
+	rc, err := r.src.FilesetClient.ReadChunk(ctx, &storage.ReadChunkRequest{ChunkId: id})
+	if err != nil {
+		return 0, errors.Wrap(grpcutil.ScrubGRPC(err), "read chunk")
+	}
+	wc, err := r.dst.FilesetClient.WriteChunk(ctx)
+	if err != nil {
+		return 0, errors.Wrap(grpcutil.ScrubGRPC(err), "write chunk")
+	}
+	var size int64
+	if err := grpcutil.ForEach[*storage.ReadChunkResponse](rc, func(msg *storage.ReadChunkResponse) error {
+		size += int64(len(msg.Data))
+		return errors.EnsureStack(wc.Send(&storage.WriteChunkRequest{Data: msg.Data}))
+	}); err != nil {
+		return 0, errors.Wrap(grpcutil.ScrubGRPC(err), "copy chunk")
+	}
+	resp, err := wc.CloseAndRecv()
+	if err != nil {
+		return 0, errors.Wrap(grpcutil.ScrubGRPC(err), "write chunk")
+	}
+	if !bytes.Equal(resp.ChunkId, id) {
+		return 0, errors.Errorf("chunk %x was written as %x", id, resp.ChunkId)
+	}
+	return size, nil
+}
@@ -0,0 +1,113 @@
+package pfsreplicate_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/docker/go-units"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsreplicate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/storage"
+)
+
+func TestReplicate(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	src := pachd.NewTestPachd(t)
+	dst := pachd.NewTestPachd(t)
+	branch := client.NewBranch(pfs.DefaultProjectName, "images", "master")
+	require.NoError(t, src.CreateRepo(pfs.DefaultProjectName, "images"))
+
+	putFiles := func(files map[string][]byte, deletes ...string) *pfs.Commit {
+		commit, err := src.StartCommit(pfs.DefaultProjectName, "images", "master")
+		require.NoError(t, err)
+		for _, path := range deletes {
+			require.NoError(t, src.DeleteFile(commit, path))
+		}
+		for path, data := range files {
+			require.NoError(t, src.PutFile(commit, path, bytes.NewReader(data)))
+		}
+		require.NoError(t, src.FinishCommit(pfs.DefaultProjectName, "images", "master", commit.Id))
+		return commit
+	}
+	checkFiles := func(commit *pfs.Commit, files map[string][]byte) {
+		fis, err := dst.ListFileAll(commit, "/")
+		require.NoError(t, err)
+		require.Equal(t, len(files), len(fis))
+		for path, data := range files {
+			buf := &bytes.Buffer{}
+			require.NoError(t, dst.GetFile(commit, path, buf))
+			require.True(t, bytes.Equal(data, buf.Bytes()), "content of %s", path)
+		}
+	}
+
+	// chunks returns the chunks that a commit's files refer to.
+	chunks := func(c *client.APIClient, commit *pfs.Commit) []string {
+		id, err := client.GetFileSet(ctx, c.PfsAPIClient, pfs.DefaultProjectName, "images", "", commit.Id)
+		require.NoError(t, err)
+		refs, err := c.FilesetClient.ReadFilesetRefs(ctx, &storage.ReadFilesetRequest{FilesetId: id})
+		require.NoError(t, err)
+		var ids []string
+		require.NoError(t, grpcutil.ForEach[*storage.FileRefs](refs, func(f *storage.FileRefs) error {
+			for _, id := range f.ChunkIds {
+				ids = append(ids, hex.EncodeToString(id))
+			}
+			return nil
+		}))
+		return ids
+	}
+
+	big := randutil.Bytes(rand.New(rand.NewSource(0)), 30*units.MB)
+	first := putFiles(map[string][]byte{"/a": []byte("a"), "/b": []byte("b"), "/big": big})
+	second := putFiles(map[string][]byte{"/c": []byte("c")}, "/a")
+
+	var stats []*pfsreplicate.CommitStats
+	r := pfsreplicate.New(src, dst, branch, branch, func(s *pfsreplicate.CommitStats) {
+		stats = append(stats, s)
+	})
+	n, err := r.Replicate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, first.Id, stats[0].Source.Id)
+	require.Equal(t, second.Id, stats[1].Source.Id)
+	checkFiles(stats[0].Destination, map[string][]byte{"/a": []byte("a"), "/b": []byte("b"), "/big": big})
+	checkFiles(stats[1].Destination, map[string][]byte{"/b": []byte("b"), "/big": big, "/c": []byte("c")})
+	ci, err := dst.InspectCommit(pfs.DefaultProjectName, "images", "", stats[1].Destination.Id)
+	require.NoError(t, err)
+	require.Equal(t, second.Id, ci.Metadata[pfsreplicate.SourceCommitKey])
+	// Files are copied by reference, even small ones, which the destination doesn't rechunk.
+	require.ElementsEqual(t, chunks(src, second), chunks(dst, stats[1].Destination))
+
+	// Nothing has changed, so there's nothing to do.
+	n, err = r.Replicate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// The destination already has the chunks of the big file, so copying it sends little data.
+	third := putFiles(map[string][]byte{"/big2": big})
+	stats = nil
+	n, err = r.Replicate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, third.Id, stats[0].Source.Id)
+	require.True(t, stats[0].Bytes < int64(len(big))/2, "copied %d bytes", stats[0].Bytes)
+	checkFiles(stats[0].Destination, map[string][]byte{"/b": []byte("b"), "/big": big, "/big2": big, "/c": []byte("c")})
+
+	// A destination branch with commits of its own can't be replicated to.
+	commit, err := dst.StartCommit(pfs.DefaultProjectName, "images", "master")
+	require.NoError(t, err)
+	require.NoError(t, dst.PutFile(commit, "/local", strings.NewReader("local")))
+	require.NoError(t, dst.FinishCommit(pfs.DefaultProjectName, "images", "master", commit.Id))
+	putFiles(map[string][]byte{"/d": []byte("d")})
+	_, err = r.Replicate(ctx)
+	require.YesError(t, err)
+}
@@ -21,6 +21,7 @@ const (
 	jobsCollectionName            = "jobs"
 	clusterDefaultsCollectionName = "cluster_defaults"
 	projectDefaultsCollectionName = "project_defaults"
+	projectQuotasCollectionName   = "project_quotas"
 )
 
 // PipelinesVersionIndex records the version numbers of pipelines
@@ -186,6 +187,18 @@ func ProjectDefaults(db *pachsql.DB, listener col.PostgresListener) col.Postgres
 	)
 }
 
+// ProjectQuotas returns a PostgresCollection of project quotas, keyed by
+// project.
+func ProjectQuotas(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		projectQuotasCollectionName,
+		db,
+		listener,
+		&pps.ProjectQuota{},
+		nil,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -219,3 +232,14 @@ func CollectionsV2_8_0() []col.PostgresCollection {
 		col.NewPostgresCollection(projectDefaultsCollectionName, nil, nil, nil, nil),
 	}
 }
+
+// CollectionsV2_12_0 returns a list of collections for postgres-initialization
+// purposes.  These collections are not usable for querying.
+//
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func CollectionsV2_12_0() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(projectQuotasCollectionName, nil, nil, nil, nil),
+	}
+}
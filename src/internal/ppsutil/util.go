@@ -292,6 +292,7 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Autoscaling:             pipelineInfo.Details.Autoscaling,
 		Tolerations:             pipelineInfo.Details.Tolerations,
 		Determined:              det,
+		PersistDatumLogs:        pipelineInfo.Details.PersistDatumLogs,
 	}
 }
 
@@ -366,16 +367,17 @@ func FinishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.Job
 
 func WriteJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
 	_, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
-		Job:           jobInfo.Job,
-		State:         jobInfo.State,
-		Reason:        jobInfo.Reason,
-		Restart:       jobInfo.Restart,
-		DataProcessed: jobInfo.DataProcessed,
-		DataSkipped:   jobInfo.DataSkipped,
-		DataTotal:     jobInfo.DataTotal,
-		DataFailed:    jobInfo.DataFailed,
-		DataRecovered: jobInfo.DataRecovered,
-		Stats:         jobInfo.Stats,
+		Job:            jobInfo.Job,
+		State:          jobInfo.State,
+		Reason:         jobInfo.Reason,
+		Restart:        jobInfo.Restart,
+		DataProcessed:  jobInfo.DataProcessed,
+		DataSkipped:    jobInfo.DataSkipped,
+		DataTotal:      jobInfo.DataTotal,
+		DataFailed:     jobInfo.DataFailed,
+		DataRecovered:  jobInfo.DataRecovered,
+		Stats:          jobInfo.Stats,
+		AggregateStats: jobInfo.AggregateStats,
 	})
 	return errors.EnsureStack(err)
 }
@@ -0,0 +1,303 @@
+// Package continuous periodically captures profiles of a running process and commits them to
+// PFS, so that a slowdown can be investigated after it's over.
+//
+// Profiles are kept in the __profiles__ system repo (of type "profiles") in the default project,
+// one directory per capture:
+//
+//	/<time>/<service>/<pod>/<profile>.pb.gz
+//
+// where <time> is the UTC time the capture started, formatted as 20060102T150405Z, and each file
+// is a gzipped pprof protobuf.
+//
+// pachd creates the repo when it starts, along with a token for the Principal robot, which is
+// stored in a Kubernetes secret and passed to pachw and workers as
+// CONTINUOUS_PROFILING_AUTH_TOKEN.  When auth is active, the robot is a repoWriter on the repo
+// and nothing else, and only cluster admins, owners of the default project and anyone granted a
+// role on the repo can read it.  Like other system repos, it isn't listed by ListRepo, and so is
+// left alone by declarative apply.
+package continuous
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// RepoName is the name of the repo, in the default project, that profiles are committed to.
+const RepoName = "__profiles__"
+
+// Principal is the robot that commits profiles.
+const Principal = auth.RobotPrefix + "continuous-profiler"
+
+// SecretName is the Kubernetes secret that holds Principal's token, under SecretKey.
+const (
+	SecretName = "pachyderm-continuous-profiling-authentication"
+	SecretKey  = "continuousProfilingAuthToken"
+)
+
+// Repo returns the repo that profiles are committed to.
+func Repo() *pfs.Repo {
+	return client.NewSystemRepo(pfs.DefaultProjectName, RepoName, pfs.ProfilesRepoType)
+}
+
+// The services that profile themselves.  Only pachd applies the retention policy.
+const (
+	ServicePachd  = "pachd"
+	ServicePachw  = "pachw"
+	ServiceWorker = "worker"
+)
+
+// Profiles are the profiles captured each time, in order; "cpu" is captured over
+// ContinuousProfilingCPUSeconds, and the rest are snapshots taken after it.
+var Profiles = []string{"cpu", "heap", "goroutine", "mutex"}
+
+// mutexProfileFraction is the mutex profiling rate set if the process hasn't set one; see
+// runtime.SetMutexProfileFraction.
+const mutexProfileFraction = 100
+
+const timeFormat = "20060102T150405Z"
+
+// Start captures profiles of this process every ContinuousProfilingIntervalSeconds, if it's set in
+// the provided configuration, and commits them with c, as ContinuousProfilingAuthToken if that is
+// set, until ctx is done.  Service is the name of this binary (one of the Service constants) and
+// pod is the name of its pod.  The repo must already exist.
+//
+// A capture that can't be committed is logged and skipped; the next one is tried as usual.
+func Start(ctx context.Context, c *client.APIClient, service, pod string, config *pachconfig.Configuration) {
+	if config == nil || config.GlobalConfiguration == nil {
+		log.Error(ctx, "nil configuration passed to continuous.Start; continuous profiling not enabled")
+		return
+	}
+	if config.ContinuousProfilingIntervalSeconds <= 0 {
+		return
+	}
+	c = c.WithCtx(ctx)
+	if t := config.ContinuousProfilingAuthToken; t != "" {
+		c.SetAuthToken(t)
+	}
+	p := &profiler{
+		c:         c,
+		service:   service,
+		pod:       pod,
+		interval:  time.Duration(config.ContinuousProfilingIntervalSeconds) * time.Second,
+		cpu:       time.Duration(config.ContinuousProfilingCPUSeconds) * time.Second,
+		retention: time.Duration(config.ContinuousProfilingRetentionHours) * time.Hour,
+	}
+	if p.cpu >= p.interval {
+		p.cpu = p.interval / 2
+	}
+	if runtime.SetMutexProfileFraction(-1) == 0 {
+		runtime.SetMutexProfileFraction(mutexProfileFraction)
+	}
+	log.Info(ctx, "enabling continuous profiling",
+		zap.Duration("interval", p.interval), zap.Duration("cpuDuration", p.cpu), zap.Duration("retention", p.retention))
+	go p.run(pctx.Child(ctx, "continuous-profiler"))
+}
+
+type profiler struct {
+	c                        *client.APIClient
+	service, pod             string
+	interval, cpu, retention time.Duration
+}
+
+func (p *profiler) run(ctx context.Context) {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		if err := p.capture(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Error(ctx, "problem capturing profiles", zap.Error(err))
+		}
+		if p.service == ServicePachd && p.retention > 0 {
+			if err := Prune(p.c.WithCtx(ctx), time.Now().Add(-p.retention)); err != nil {
+				log.Error(ctx, "problem pruning profiles", zap.Error(err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// capture captures each of Profiles and commits them together.
+func (p *profiler) capture(ctx context.Context, start time.Time) error {
+	profiles, err := p.collect(ctx)
+	if err != nil {
+		return err
+	}
+	dir := path.Join("/", start.UTC().Format(timeFormat), p.service, p.pod)
+	return errors.Wrap(p.c.WithCtx(ctx).WithModifyFileClient(Repo().NewCommit("master", ""), func(mf client.ModifyFile) error {
+		for name, content := range profiles {
+			if err := mf.PutFile(path.Join(dir, name+".pb.gz"), bytes.NewReader(content)); err != nil {
+				return errors.Wrapf(err, "put %v profile", name)
+			}
+		}
+		return nil
+	}), "commit profiles")
+}
+
+// collect returns each of Profiles, by name.
+func (p *profiler) collect(ctx context.Context) (map[string][]byte, error) {
+	profiles := make(map[string][]byte)
+	for _, name := range Profiles {
+		buf := new(bytes.Buffer)
+		if name == "cpu" {
+			if err := pprof.StartCPUProfile(buf); err != nil {
+				// Someone's running `pachctl debug profile cpu`.
+				log.Info(ctx, "skipping cpu profile", zap.Error(err))
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				pprof.StopCPUProfile()
+				return nil, errors.EnsureStack(context.Cause(ctx))
+			case <-time.After(p.cpu):
+			}
+			pprof.StopCPUProfile()
+		} else if err := pprof.Lookup(name).WriteTo(buf, 0); err != nil {
+			return nil, errors.Wrapf(err, "write %v profile", name)
+		}
+		profiles[name] = buf.Bytes()
+	}
+	return profiles, nil
+}
+
+// Prune deletes the profiles captured before cutoff, and squashes the commits started before it so
+// that their storage can be reclaimed.
+func Prune(c *client.APIClient, cutoff time.Time) error {
+	master := Repo().NewCommit("master", "")
+	dirs, err := c.ListFileAll(master, "/")
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			// Nothing has been captured yet.
+			return nil
+		}
+		return errors.Wrap(err, "list profiles")
+	}
+	var expired []string
+	for _, fi := range dirs {
+		if t, ok := captureTime(fi.File.Path); ok && t.Before(cutoff) {
+			expired = append(expired, fi.File.Path)
+		}
+	}
+	if len(expired) > 0 {
+		if err := c.WithModifyFileClient(master, func(mf client.ModifyFile) error {
+			for _, dir := range expired {
+				if err := mf.DeleteFile(dir); err != nil {
+					return errors.Wrapf(err, "delete %v", dir)
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "delete expired profiles")
+		}
+	}
+	commits, err := c.ListCommit(master.Repo, master, nil, 0)
+	if err != nil {
+		return errors.Wrap(err, "list commits")
+	}
+	// Commits are listed newest first; the head is kept.
+	for i := len(commits) - 1; i > 0; i-- {
+		ci := commits[i]
+		if ci.Started == nil || !ci.Started.AsTime().Before(cutoff) {
+			break
+		}
+		if _, err := c.PfsAPIClient.SquashCommit(c.Ctx(), &pfs.SquashCommitRequest{Commit: ci.Commit}); err != nil {
+			return errors.Wrapf(err, "squash commit %v", ci.Commit.Id)
+		}
+	}
+	return nil
+}
+
+// captureTime returns the time a capture directory was captured.
+func captureTime(dir string) (time.Time, bool) {
+	t, err := time.Parse(timeFormat, strings.Trim(dir, "/"))
+	return t, err == nil
+}
+
+// A Query selects the profiles to Fetch.
+type Query struct {
+	// Profile is one of Profiles.
+	Profile string
+	// Service and Pod restrict the profiles to those of a service or pod; "" matches all of them.
+	Service, Pod string
+	// Since and Until bound the times the profiles were captured.  A zero Until is now.
+	Since, Until time.Time
+}
+
+// Fetch merges the profiles that match q, and returns the merged profile and how many were merged.
+func Fetch(c *client.APIClient, q Query) (*profile.Profile, int, error) {
+	if !isProfile(q.Profile) {
+		return nil, 0, errors.Errorf("unknown profile %q; must be one of %v", q.Profile, strings.Join(Profiles, ", "))
+	}
+	if q.Until.IsZero() {
+		q.Until = time.Now()
+	}
+	master := Repo().NewCommit("master", "")
+	dirs, err := c.ListFileAll(master, "/")
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "list profiles")
+	}
+	var profiles []*profile.Profile
+	for _, fi := range dirs {
+		t, ok := captureTime(fi.File.Path)
+		if !ok || t.Before(q.Since) || t.After(q.Until) {
+			continue
+		}
+		if err := c.GlobFile(master, path.Join(fi.File.Path, orAny(q.Service), orAny(q.Pod), q.Profile+".pb.gz"), func(fi *pfs.FileInfo) error {
+			buf := new(bytes.Buffer)
+			if err := c.GetFile(master, fi.File.Path, buf); err != nil {
+				return errors.Wrapf(err, "get %v", fi.File.Path)
+			}
+			p, err := profile.Parse(buf)
+			if err != nil {
+				return errors.Wrapf(err, "parse %v", fi.File.Path)
+			}
+			profiles = append(profiles, p)
+			return nil
+		}); err != nil {
+			return nil, 0, errors.EnsureStack(err)
+		}
+	}
+	if len(profiles) == 0 {
+		return nil, 0, errors.Errorf("no %v profiles were captured between %v and %v", q.Profile, q.Since.Format(time.RFC3339), q.Until.Format(time.RFC3339))
+	}
+	merged, err := profile.Merge(profiles)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "merge profiles")
+	}
+	return merged, len(profiles), nil
+}
+
+func isProfile(name string) bool {
+	for _, p := range Profiles {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+func orAny(s string) string {
+	if s == "" {
+		return "*"
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package continuous
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+func TestCollect(t *testing.T) {
+	p := &profiler{cpu: 100 * time.Millisecond}
+	profiles, err := p.collect(pctx.TestContext(t))
+	if err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	var parsed []*profile.Profile
+	for _, name := range Profiles {
+		content, ok := profiles[name]
+		if !ok {
+			t.Fatalf("no %v profile", name)
+		}
+		prof, err := profile.Parse(bytes.NewReader(content))
+		if err != nil {
+			t.Fatalf("parse %v profile: %v", name, err)
+		}
+		if name == "goroutine" {
+			parsed = append(parsed, prof, prof.Copy())
+		}
+	}
+	merged, err := profile.Merge(parsed)
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if got, want := len(merged.Sample), len(parsed[0].Sample); got != want {
+		t.Errorf("merged samples:\n  got: %v\n want: %v", got, want)
+	}
+}
+
+func TestCaptureTime(t *testing.T) {
+	want := time.Date(2024, 5, 1, 13, 4, 5, 0, time.UTC)
+	for dir, ok := range map[string]bool{
+		"/20240501T130405Z/": true,
+		"20240501T130405Z":   true,
+		"/pachd/":            false,
+	} {
+		got, gotOK := captureTime(dir)
+		if gotOK != ok {
+			t.Errorf("captureTime(%q) ok:\n  got: %v\n want: %v", dir, gotOK, ok)
+		} else if ok && !got.Equal(want) {
+			t.Errorf("captureTime(%q):\n  got: %v\n want: %v", dir, got, want)
+		}
+	}
+}
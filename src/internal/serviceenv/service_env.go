@@ -35,7 +35,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	mlc "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
@@ -75,6 +77,8 @@ type ServiceEnv interface {
 	GetPachClient(ctx context.Context) *client.APIClient
 	GetEtcdClient() *etcd.Client
 	GetTaskService(string) task.Service
+	NewDLock(prefix string) dlock.DLock
+	WithRing(ctx context.Context, prefix string, cb func(context.Context, *consistenthashing.Ring) error) error
 	GetKubeClient() kube.Interface
 	GetDynamicKubeClient() dynamic.Interface
 	GetLokiClient() (*loki.Client, error)
@@ -478,9 +482,30 @@ func (env *NonblockingServiceEnv) GetEtcdClient() *etcd.Client {
 }
 
 func (env *NonblockingServiceEnv) GetTaskService(prefix string) task.Service {
+	if env.config.CoordinationBackend == pachconfig.CoordinationBackendPostgres {
+		return task.NewPostgresService(env.GetDBClient(), env.GetPostgresListener(), prefix)
+	}
 	return task.NewEtcdService(env.GetEtcdClient(), prefix)
 }
 
+// NewDLock returns a distributed lock on the given prefix, kept in etcd or postgres according to
+// the configured coordination backend.
+func (env *NonblockingServiceEnv) NewDLock(prefix string) dlock.DLock {
+	if env.config.CoordinationBackend == pachconfig.CoordinationBackendPostgres {
+		return dlock.NewPostgresDLock(env.GetDBClient(), prefix)
+	}
+	return dlock.NewDLock(env.GetEtcdClient(), prefix)
+}
+
+// WithRing calls cb with a consistent hashing ring on the given prefix, kept in etcd or postgres
+// according to the configured coordination backend.
+func (env *NonblockingServiceEnv) WithRing(ctx context.Context, prefix string, cb func(context.Context, *consistenthashing.Ring) error) error {
+	if env.config.CoordinationBackend == pachconfig.CoordinationBackendPostgres {
+		return consistenthashing.WithPostgresRing(ctx, env.GetDBClient(), prefix, cb)
+	}
+	return consistenthashing.WithRing(ctx, env.GetEtcdClient(), prefix, cb)
+}
+
 // GetKubeClient returns the already connected Kubernetes API client without
 // modification.
 func (env *NonblockingServiceEnv) GetKubeClient() kube.Interface {
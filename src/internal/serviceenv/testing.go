@@ -7,6 +7,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
@@ -76,6 +78,12 @@ func (s *TestServiceEnv) GetEtcdClient() *etcd.Client {
 func (s *TestServiceEnv) GetTaskService(prefix string) task.Service {
 	return task.NewEtcdService(s.EtcdClient, prefix)
 }
+func (s *TestServiceEnv) NewDLock(prefix string) dlock.DLock {
+	return dlock.NewDLock(s.EtcdClient, prefix)
+}
+func (s *TestServiceEnv) WithRing(ctx context.Context, prefix string, cb func(context.Context, *consistenthashing.Ring) error) error {
+	return consistenthashing.WithRing(ctx, s.EtcdClient, prefix, cb)
+}
 func (s *TestServiceEnv) GetKubeClient() kube.Interface {
 	return s.KubeClient
 }
@@ -66,6 +66,61 @@ func TestList(t *testing.T) {
 	require.True(t, count > 0)
 }
 
+func TestFindMissing(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	_, chunks := NewTestStorage(t, db, tr)
+	present, err := chunks.WriteRaw(ctx, []byte("present"))
+	require.NoError(t, err)
+	_, err = tr.SetTTL(ctx, present.TrackerID(), time.Second)
+	require.NoError(t, err)
+	missing := Hash([]byte("missing"))
+
+	got, err := chunks.FindMissing(ctx, []ID{present, missing, present})
+	require.NoError(t, err)
+	require.Equal(t, []ID{missing}, got)
+	// Finding the chunk keeps it from being garbage collected until it's referenced.
+	expiresAt, err := tr.GetExpiresAt(ctx, present.TrackerID())
+	require.NoError(t, err)
+	require.True(t, time.Until(expiresAt) > defaultChunkTTL/2)
+}
+
+func TestReaderRange(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	_, chunks := newTestStorage(t)
+	data := randutil.Bytes(rand.New(rand.NewSource(10)), 50*units.MB)
+	var dataRefs []*DataRef
+	u := chunks.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	require.True(t, len(dataRefs) > 1)
+
+	for _, test := range []struct {
+		offset, length int64
+	}{
+		{0, 0},
+		{0, 1},
+		{1, 100},
+		{units.MB, 3 * units.MB},
+		{dataRefs[0].SizeBytes, dataRefs[1].SizeBytes},
+		{9 * units.MB, 5 * units.MB},
+		{45 * units.MB, 10 * units.MB},
+	} {
+		buf := &bytes.Buffer{}
+		r := chunks.NewReader(ctx, dataRefs, WithOffsetBytes(test.offset), WithLengthBytes(test.length))
+		require.NoError(t, r.Get(buf))
+		end := int64(len(data))
+		if test.length > 0 && test.offset+test.length < end {
+			end = test.offset + test.length
+		}
+		require.True(t, bytes.Equal(data[test.offset:end], buf.Bytes()), "offset %d, length %d", test.offset, test.length)
+	}
+}
+
 func BenchmarkRollingHash(b *testing.B) {
 	seed := time.Now().UTC().UnixNano()
 	random := rand.New(rand.NewSource(seed))
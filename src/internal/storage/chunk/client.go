@@ -199,6 +199,66 @@ func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback
 	return errors.EnsureStack(c.pool.GetF(ctx, c.store, key, cb))
 }
 
+// exists reports whether there is an uploaded object for a chunk.
+func (c *trackedClient) exists(ctx context.Context, chunkID ID) (bool, error) {
+	var exists bool
+	if err := c.db.GetContext(ctx, &exists, `
+	SELECT EXISTS (
+		SELECT 1
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+	)
+	`, chunkID); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return exists, nil
+}
+
+// findMissing returns the IDs, from ids, of the chunks that aren't in storage.  The tracker objects
+// of the chunks that are in storage are kept for at least ttl, so that they can't be garbage
+// collected before the caller references them.
+func (c *trackedClient) findMissing(ctx context.Context, ids []ID, ttl time.Duration) ([]ID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	chunkIDs := make([][]byte, len(ids))
+	trackerIDs := make([]string, len(ids))
+	for i, id := range ids {
+		chunkIDs[i], trackerIDs[i] = id, id.TrackerID()
+	}
+	// The tracker objects are renewed first, in the same statement, so a chunk whose tracker object
+	// was deleted by the garbage collector is reported missing.
+	var present [][]byte
+	if err := c.db.SelectContext(ctx, &present, `
+	WITH renewed AS (
+		UPDATE storage.tracker_objects
+		SET expires_at = CASE WHEN expires_at IS NULL THEN NULL ELSE greatest(
+			expires_at,
+			(CURRENT_TIMESTAMP + $2 * interval '1 microsecond')
+		) END
+		WHERE str_id = ANY($1)
+		RETURNING str_id
+	)
+	SELECT DISTINCT chunk_id
+	FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = ANY($3)
+	AND $4 || encode(chunk_id, 'hex') IN (SELECT str_id FROM renewed)
+	`, trackerIDs, ttl.Microseconds(), chunkIDs, TrackerPrefix); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	found := make(map[string]struct{}, len(present))
+	for _, id := range present {
+		found[string(id)] = struct{}{}
+	}
+	var missing []ID
+	for _, id := range ids {
+		if _, ok := found[string(id)]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
 // Close closes the client, stopping the background renewal of created objects
 func (c *trackedClient) Close() error {
 	if c.renewer != nil {
@@ -57,6 +57,14 @@ func WithOffsetBytes(offsetBytes int64) ReaderOption {
 	}
 }
 
+// WithLengthBytes limits a reader to the given number of bytes, following the offset.  Data past
+// the limit is not fetched.  A length of 0 means no limit.
+func WithLengthBytes(lengthBytes int64) ReaderOption {
+	return func(r *Reader) {
+		r.lengthBytes = lengthBytes
+	}
+}
+
 func WithPrefetchLimit(limit int) ReaderOption {
 	return func(r *Reader) {
 		r.prefetchLimit = limit
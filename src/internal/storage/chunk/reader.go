@@ -28,6 +28,7 @@ type Reader struct {
 	client        Client
 	dataRefs      []*DataRef
 	offsetBytes   int64
+	lengthBytes   int64
 	prefetchLimit int
 }
 
@@ -48,6 +49,17 @@ func newReader(ctx context.Context, s *Storage, client Client, dataRefs []*DataR
 		r.offsetBytes -= r.dataRefs[0].SizeBytes
 		r.dataRefs = r.dataRefs[1:]
 	}
+	if r.lengthBytes > 0 {
+		// Drop the data references that start past the end of the range.
+		end := r.offsetBytes + r.lengthBytes
+		for i, dataRef := range r.dataRefs {
+			if end <= 0 {
+				r.dataRefs = r.dataRefs[:i]
+				break
+			}
+			end -= dataRef.SizeBytes
+		}
+	}
 	return r
 }
 
@@ -56,6 +68,9 @@ func (r *Reader) Get(w io.Writer) (retErr error) {
 	if len(r.dataRefs) == 0 {
 		return nil
 	}
+	if r.lengthBytes > 0 {
+		w = &truncateWriter{w: w, n: r.lengthBytes}
+	}
 	if len(r.dataRefs) == 1 {
 		_, err := io.Copy(w, newDataReader(r.ctx, r.storage, r.client, r.dataRefs[0], r.offsetBytes))
 		return errors.EnsureStack(err)
@@ -160,3 +175,25 @@ func (dr *DataReader) fetchData() error {
 	dr.r = bytes.NewReader(data)
 	return nil
 }
+
+// truncateWriter writes the first n bytes written to it to w, and discards the rest.
+type truncateWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (tw *truncateWriter) Write(data []byte) (int, error) {
+	if tw.n <= 0 {
+		return len(data), nil
+	}
+	buf := data
+	if int64(len(buf)) > tw.n {
+		buf = buf[:tw.n]
+	}
+	n, err := tw.w.Write(buf)
+	tw.n -= int64(n)
+	if err != nil {
+		return n, errors.EnsureStack(err)
+	}
+	return len(data), nil
+}
@@ -10,6 +10,7 @@ import (
 	"gocloud.dev/blob"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
@@ -96,6 +97,37 @@ func (s *Storage) ListStore(ctx context.Context, cb func(id ID, gen uint64) erro
 	})
 }
 
+// Exists reports whether the chunk with the given ID is in storage.
+func (s *Storage) Exists(ctx context.Context, id ID) (bool, error) {
+	c := NewClient(s.store, s.db, s.tracker, nil, s.pool).(*trackedClient)
+	return c.exists(ctx, id)
+}
+
+// FindMissing returns the IDs, from ids, of the chunks that aren't in storage, with one query.  The
+// chunks that are in storage are kept for at least the default chunk TTL, by which time they should
+// be referenced by a file set.
+func (s *Storage) FindMissing(ctx context.Context, ids []ID) ([]ID, error) {
+	c := NewClient(s.store, s.db, s.tracker, nil, s.pool).(*trackedClient)
+	return c.findMissing(ctx, ids, defaultChunkTTL)
+}
+
+// ReadRaw calls cb with the chunk with the given ID as it is stored: compressed and encrypted.  The
+// data refs that point into the chunk hold what is needed to read it, so a raw chunk can be copied
+// to another storage with WriteRaw and read there through the same data refs.
+func (s *Storage) ReadRaw(ctx context.Context, id ID, cb kv.ValueCallback) error {
+	client := NewClient(s.store, s.db, s.tracker, nil, s.pool)
+	return client.Get(ctx, id, cb)
+}
+
+// WriteRaw writes a chunk that was read with ReadRaw and returns its ID.  The chunk is kept for at
+// least the default chunk TTL, by which time it should be referenced by a file set.
+func (s *Storage) WriteRaw(ctx context.Context, data []byte) (_ ID, retErr error) {
+	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, "chunk-raw-writer", defaultChunkTTL), s.pool)
+	defer errors.Close(&retErr, client, "close chunk client")
+	id, err := client.Create(ctx, Metadata{Size: len(data)}, data)
+	return id, errors.EnsureStack(err)
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
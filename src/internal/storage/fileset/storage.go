@@ -114,6 +114,12 @@ func (s *Storage) newWriter(ctx context.Context, opts ...WriterOption) *Writer {
 	return newWriter(ctx, s, opts...)
 }
 
+// NewFile returns a file with the content that idx refers to, which may have been read from another
+// storage's file set.  Copying the file to a writer rechunks it as if it were a merged file.
+func (s *Storage) NewFile(idx *index.Index) File {
+	return newMergeFileReader(s.chunks, idx)
+}
+
 func (s *Storage) newReader(handle *Handle) *Reader {
 	return newReader(s.store, s.chunks, s.idxCache, handle)
 }
@@ -158,6 +158,23 @@ func (w *Writer) Copy(file File, datum string) error {
 	})
 }
 
+// CopyByReference writes a file whose content is the data that dataRefs refer to.  The data refs are
+// used as they are, rather than being read and rechunked like they are by Copy, so every chunk that
+// they refer to must already be in storage.
+func (w *Writer) CopyByReference(path, datum string, dataRefs []*chunk.DataRef) error {
+	idx := &index.Index{
+		Path: path,
+		File: &index.File{
+			Datum: datum,
+		},
+	}
+	if err := w.checkIndex(w.idx, idx); err != nil {
+		return err
+	}
+	w.idx = idx
+	return w.uploader.CopyByReference(idx, dataRefs)
+}
+
 // Close closes the writer.
 func (w *Writer) Close() (*Handle, error) {
 	// The uploader writes to the additive index, so close it first.
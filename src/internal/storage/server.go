@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
+	"encoding/binary"
 	"io"
 	"path"
 	"path/filepath"
@@ -23,6 +24,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
 	"github.com/pachyderm/pachyderm/v2/src/internal/protoutil"
@@ -34,6 +37,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/storage"
 	"go.uber.org/zap"
 	"gocloud.dev/blob"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -309,6 +313,170 @@ func (s *Server) ReadFilesetCDR(request *storage.ReadFilesetRequest, server stor
 	return taskChain.Wait()
 }
 
+// ReadFilesetRanges reads the ranges that the content of each file in a fileset is stored in.  Each
+// range is a file's data ref, identified by a hash of the chunk and the part of it that the data
+// ref refers to, so that clients can cache content without being able to read chunks directly.
+func (s *Server) ReadFilesetRanges(request *storage.ReadFilesetRequest, server storage.Fileset_ReadFilesetRangesServer) error {
+	ctx := server.Context()
+	return s.readFileset(ctx, request, func(f fileset.File) error {
+		idx := f.Index()
+		resp := &storage.ReadFilesetRangesResponse{Path: idx.Path}
+		for _, dataRef := range idx.File.DataRefs {
+			resp.Ranges = append(resp.Ranges, &storage.ContentRange{
+				Id:        contentRangeID(dataRef),
+				SizeBytes: dataRef.SizeBytes,
+			})
+		}
+		return server.Send(resp)
+	})
+}
+
+func contentRangeID(dataRef *chunk.DataRef) []byte {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(dataRef.OffsetBytes))
+	binary.BigEndian.PutUint64(buf[8:], uint64(dataRef.SizeBytes))
+	h := pachhash.New()
+	h.Write(dataRef.Ref.Id)
+	h.Write(buf[:])
+	return h.Sum(nil)
+}
+
+// ReadFilesetRefs reads the data refs of the files in a fileset, so that the fileset can be
+// recreated elsewhere with CreateFilesetFromRefs.
+func (s *Server) ReadFilesetRefs(request *storage.ReadFilesetRequest, server storage.Fileset_ReadFilesetRefsServer) error {
+	ctx := server.Context()
+	return s.readFileset(ctx, request, func(f fileset.File) error {
+		idx := f.Index()
+		refs := &storage.FileRefs{
+			Path:  idx.Path,
+			Datum: idx.File.Datum,
+		}
+		seen := make(map[string]struct{})
+		for _, dataRef := range idx.File.DataRefs {
+			data, err := proto.Marshal(dataRef)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			refs.DataRefs = append(refs.DataRefs, data)
+			id := dataRef.Ref.Id
+			if _, ok := seen[string(id)]; ok {
+				continue
+			}
+			seen[string(id)] = struct{}{}
+			refs.ChunkIds = append(refs.ChunkIds, id)
+		}
+		return server.Send(refs)
+	})
+}
+
+func (s *Server) FindMissingChunks(ctx context.Context, request *storage.FindMissingChunksRequest) (*storage.FindMissingChunksResponse, error) {
+	ids := make([]chunk.ID, len(request.ChunkIds))
+	for i, id := range request.ChunkIds {
+		ids[i] = chunk.ID(id)
+	}
+	missing, err := s.Chunks.FindMissing(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	response := &storage.FindMissingChunksResponse{}
+	for _, id := range missing {
+		response.ChunkIds = append(response.ChunkIds, id)
+	}
+	return response, nil
+}
+
+func (s *Server) ReadChunk(request *storage.ReadChunkRequest, server storage.Fileset_ReadChunkServer) error {
+	return s.Chunks.ReadRaw(server.Context(), chunk.ID(request.ChunkId), func(data []byte) error {
+		for _, data := range grpcutil.Chunk(data) {
+			if err := server.Send(&storage.ReadChunkResponse{Data: data}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Server) WriteChunk(server storage.Fileset_WriteChunkServer) error {
+	ctx := server.Context()
+	buf := &bytes.Buffer{}
+	for {
+		msg, err := server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if buf.Len()+len(msg.Data) > chunk.DefaultMaxChunkSize {
+			return errors.Errorf("chunk exceeds max chunk size %d", chunk.DefaultMaxChunkSize)
+		}
+		buf.Write(msg.Data)
+	}
+	id, err := s.Chunks.WriteRaw(ctx, buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(&storage.WriteChunkResponse{ChunkId: id})
+}
+
+// CreateFilesetFromRefs creates a fileset from a stream of files' data refs, in path order.  Every
+// chunk that the files refer to must already be in storage.
+func (s *Server) CreateFilesetFromRefs(server storage.Fileset_CreateFilesetFromRefsServer) error {
+	ctx := server.Context()
+	// checked remembers recently checked chunks, as consecutive small files often share a chunk.
+	checked, err := lru.New[string, struct{}](cacheSize)
+	if err != nil {
+		return err
+	}
+	w := s.Filesets.NewWriter(ctx, fileset.WithTTL(defaultTTL))
+	for {
+		msg, err := server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if err := ValidateFilename(msg.Path); err != nil {
+			return err
+		}
+		idx := &index.Index{
+			Path: msg.Path,
+			File: &index.File{Datum: msg.Datum},
+		}
+		for _, data := range msg.DataRefs {
+			dataRef := &chunk.DataRef{}
+			if err := proto.Unmarshal(data, dataRef); err != nil {
+				return errors.Wrapf(err, "unmarshal data ref for %s", msg.Path)
+			}
+			if dataRef.Ref == nil {
+				return errors.Errorf("data ref for %s has no chunk", msg.Path)
+			}
+			if !checked.Contains(string(dataRef.Ref.Id)) {
+				exists, err := s.Chunks.Exists(ctx, dataRef.Ref.Id)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					return pacherr.NewNotExist("chunk", chunk.ID(dataRef.Ref.Id).HexString())
+				}
+				checked.Add(string(dataRef.Ref.Id), struct{}{})
+			}
+			idx.File.DataRefs = append(idx.File.DataRefs, dataRef)
+		}
+		if err := w.CopyByReference(idx.Path, idx.File.Datum, idx.File.DataRefs); err != nil {
+			return err
+		}
+	}
+	handle, err := w.Close()
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(&storage.CreateFilesetResponse{
+		FilesetId: handle.HexString(),
+	})
+}
+
 // RenewFileset is not properly documented.
 //
 //   - TODO: We should be able to use this and potentially others directly in PFS.
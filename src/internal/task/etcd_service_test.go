@@ -43,19 +43,37 @@ func deserializeTestTask(any *anypb.Any) (*TestTask, error) {
 	return testTask, nil
 }
 
+type newServiceFunc = func(ctx context.Context, t *testing.T) Service
+
 func newTestEtcdService(ctx context.Context, t *testing.T) Service {
 	env := testetcd.NewEnv(ctx, t)
 	return NewEtcdService(env.EtcdClient, "")
 }
 
+// forEachService runs a test against each implementation of Service.
+func forEachService(t *testing.T, f func(t *testing.T, newService newServiceFunc)) {
+	for _, impl := range []struct {
+		name       string
+		newService newServiceFunc
+	}{
+		{"etcd", newTestEtcdService},
+		{"postgres", newTestPostgresService},
+	} {
+		t.Run(impl.name, func(t *testing.T) {
+			t.Parallel()
+			f(t, impl.newService)
+		})
+	}
+}
+
 func seedRand() string {
 	seed := time.Now().UTC().UnixNano()
 	rand.Seed(seed) //nolint:SA1019 // CORE-1512
 	return fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
 }
 
-func test(ctx context.Context, t *testing.T, workerFailProb, groupCancelProb, taskFailProb float64, msg ...string) {
-	s := newTestEtcdService(ctx, t)
+func test(ctx context.Context, t *testing.T, newService newServiceFunc, workerFailProb, groupCancelProb, taskFailProb float64, msg ...string) {
+	s := newService(ctx, t)
 	numGroups := 10
 	numTasks := 10
 	numWorkers := 5
@@ -153,51 +171,65 @@ func test(ctx context.Context, t *testing.T, workerFailProb, groupCancelProb, ta
 
 func TestBasic(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	test(ctx, t, 0, 0, 0, seedRand())
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		test(ctx, t, newService, 0, 0, 0, seedRand())
+	})
 }
 
 func TestWorkerCrashes(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	test(ctx, t, 0.1, 0, 0, seedRand())
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		test(ctx, t, newService, 0.1, 0, 0, seedRand())
+	})
 }
 
 func TestCancelGroups(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	test(ctx, t, 0, 0.05, 0, seedRand())
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		test(ctx, t, newService, 0, 0.05, 0, seedRand())
+	})
 }
 
 func TestTaskFailures(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	test(ctx, t, 0, 0, 0.1, seedRand())
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		test(ctx, t, newService, 0, 0, 0.1, seedRand())
+	})
 }
 
 func TestEverything(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	test(ctx, t, 0.1, 0.2, 0.1, seedRand())
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		test(ctx, t, newService, 0.1, 0.2, 0.1, seedRand())
+	})
 }
 
 func TestRunZeroTasks(t *testing.T) {
 	t.Parallel()
-	ctx := pctx.TestContext(t)
-	env := testetcd.NewEnv(ctx, t)
-	s := NewEtcdService(env.EtcdClient, "")
-	d := s.NewDoer("", "", nil)
-	require.NoError(t, DoBatch(ctx, d, nil, func(_ int64, _ *anypb.Any, _ error) error {
-		return errors.New("no tasks should exist")
-	}))
+	forEachService(t, func(t *testing.T, newService newServiceFunc) {
+		ctx := pctx.TestContext(t)
+		s := newService(ctx, t)
+		d := s.NewDoer("", "", nil)
+		require.NoError(t, DoBatch(ctx, d, nil, func(_ int64, _ *anypb.Any, _ error) error {
+			return errors.New("no tasks should exist")
+		}))
+	})
 }
 
 func TestListTask(t *testing.T) {
 	t.Parallel()
+	forEachService(t, testListTask)
+}
+
+func testListTask(t *testing.T, newService newServiceFunc) {
 	rctx := pctx.TestContext(t)
-	env := testetcd.NewEnv(rctx, t)
 	testNamespace := tu.UniqueString(t.Name())
-	s := NewEtcdService(env.EtcdClient, "")
+	s := newService(rctx, t)
 
 	numGroups := 10
 	numTasks := 10
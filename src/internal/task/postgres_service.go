@@ -0,0 +1,657 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"path"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+)
+
+const (
+	// postgresLeaseTTL is how long a doer or a claim on a task lives without being renewed.  Leases
+	// are renewed three times per TTL.
+	postgresLeaseTTL = 30 * time.Second
+	// postgresPollInterval is how often doers and sources look for work when they haven't been
+	// notified of any.  Polling picks up tasks whose claims have expired, and covers for lost
+	// notifications.
+	postgresPollInterval = time.Second
+	// postgresCleanupInterval is how often doers whose leases have expired are deleted.  Their
+	// tasks can't be claimed once they've expired, so deleting them only reclaims space.
+	postgresCleanupInterval = time.Minute
+)
+
+// SetupPostgresV0 creates the tables of the postgres task service.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, postgresSchema)
+	return errors.EnsureStack(err)
+}
+
+var postgresSchema = `
+	CREATE SCHEMA task;
+
+	CREATE TABLE task.doers (
+		id TEXT PRIMARY KEY,
+		service TEXT NOT NULL,
+		namespace TEXT NOT NULL,
+		"group" TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	);
+
+	CREATE INDEX ON task.doers (service, namespace, "group");
+
+	CREATE INDEX ON task.doers (expires_at);
+
+	CREATE TABLE task.tasks (
+		doer TEXT NOT NULL REFERENCES task.doers(id) ON DELETE CASCADE,
+		id TEXT NOT NULL,
+		idx BIGINT NOT NULL,
+		state INT NOT NULL,
+		input BYTEA NOT NULL,
+		output BYTEA,
+		reason TEXT NOT NULL DEFAULT '',
+		claimed_by TEXT,
+		claim_expires_at TIMESTAMPTZ,
+		collected BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT CLOCK_TIMESTAMP(),
+		PRIMARY KEY (doer, id)
+	);
+
+	CREATE INDEX ON task.tasks (doer, state, claim_expires_at);
+`
+
+// postgresService is a Service that keeps its tasks in postgres.  Each call to Doer.Do holds a
+// lease on a row in task.doers, and the tasks that it creates are deleted along with that row.
+// Sources claim tasks with SELECT ... FOR UPDATE SKIP LOCKED, and hold a lease on each claim while
+// they process the task.  Doers and sources are woken by LISTEN/NOTIFY, and poll as a fallback.
+type postgresService struct {
+	db       *pachsql.DB
+	listener col.PostgresListener
+	service  string
+	channel  string
+}
+
+// NewPostgresService returns a Service that keeps its tasks in postgres, in the tables created by
+// SetupPostgresV0.  Services with different prefixes don't share tasks.
+func NewPostgresService(db *pachsql.DB, listener col.PostgresListener, prefix string) Service {
+	service := path.Join(prefix, version.PrettyVersion())
+	sum := pachhash.Sum([]byte(service))
+	return &postgresService{
+		db:       db,
+		listener: listener,
+		service:  service,
+		channel:  "task_" + pachhash.EncodeHash(sum[:])[:16],
+	}
+}
+
+func (ps *postgresService) NewDoer(namespace, group string, cache Cache) Doer {
+	if group == "" {
+		group = uuid.NewWithoutDashes()
+	}
+	return &postgresDoer{
+		postgresService: ps,
+		namespace:       namespace,
+		group:           group,
+		cache:           cache,
+	}
+}
+
+func (ps *postgresService) NewSource(namespace string) Source {
+	return &postgresSource{
+		postgresService: ps,
+		namespace:       namespace,
+	}
+}
+
+// namespaceCondition matches the doers of a service under the namespace in $2.  Like the etcd
+// service, a namespace includes the namespaces nested under it, and the empty namespace includes
+// everything.
+const namespaceCondition = `d.service = $1 AND ($2 = '' OR d.namespace = $2 OR starts_with(d.namespace, $2 || '/'))`
+
+func (ps *postgresService) List(ctx context.Context, namespace, group string, cb func(string, string, *Task, bool) error) (retErr error) {
+	if namespace == "" && group != "" {
+		return errors.New("must provide a task namespace to list a group")
+	}
+	rows, err := ps.db.QueryContext(ctx, `
+		SELECT d.namespace, d."group", t.id, t.idx, t.state, t.input, t.output, t.reason,
+			t.claimed_by IS NOT NULL AND t.claim_expires_at > now()
+		FROM task.tasks t JOIN task.doers d ON d.id = t.doer
+		WHERE `+namespaceCondition+` AND ($3 = '' OR d."group" = $3)
+		ORDER BY t.created_at DESC, t.idx DESC
+	`, ps.service, namespace, group)
+	if err != nil {
+		return errors.Wrap(err, "list tasks")
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	for rows.Next() {
+		var taskNamespace, taskGroup string
+		var input, output []byte
+		var state int32
+		var claimed bool
+		task := &Task{}
+		if err := rows.Scan(&taskNamespace, &taskGroup, &task.Id, &task.Index, &state, &input, &output, &task.Reason, &claimed); err != nil {
+			return errors.Wrap(err, "scan task")
+		}
+		task.State = State(state)
+		if task.Input, err = unmarshalAny(input); err != nil {
+			return err
+		}
+		if task.Output, err = unmarshalAny(output); err != nil {
+			return err
+		}
+		if err := cb(taskNamespace, taskGroup, task, claimed && task.State == State_RUNNING); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(rows.Err(), "list tasks")
+}
+
+func (ps *postgresService) Count(ctx context.Context, namespace string) (int64, error) {
+	var count int64
+	if err := ps.db.GetContext(ctx, &count, `
+		SELECT count(*) FROM task.tasks t JOIN task.doers d ON d.id = t.doer
+		WHERE `+namespaceCondition, ps.service, namespace); err != nil {
+		return 0, errors.Wrap(err, "count tasks")
+	}
+	return count, nil
+}
+
+// RunCleanup implements Cleaner.  It deletes the doers of every service whose leases have expired,
+// and their tasks.
+func (ps *postgresService) RunCleanup(ctx context.Context) error {
+	ticker := time.NewTicker(postgresCleanupInterval)
+	defer ticker.Stop()
+	for {
+		if err := ps.deleteExpiredDoers(ctx); err != nil && ctx.Err() == nil {
+			log.Info(ctx, "errored deleting expired doers", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+// deleteExpiredDoers deletes the doers whose leases have expired, and their tasks.
+func (ps *postgresService) deleteExpiredDoers(ctx context.Context) error {
+	_, err := ps.db.ExecContext(ctx, `DELETE FROM task.doers WHERE expires_at < now()`)
+	return errors.Wrap(err, "delete expired doers")
+}
+
+func doerChannel(id string) string {
+	return "task_doer_" + id
+}
+
+type postgresDoer struct {
+	*postgresService
+	namespace, group string
+	cache            Cache
+}
+
+func (pd *postgresDoer) Do(ctx context.Context, inputChan chan *anypb.Any, cb CollectFunc) error {
+	id := uuid.NewWithoutDashes()
+	notifier := newPostgresNotifier(doerChannel(id))
+	if err := pd.listener.Register(notifier); err != nil {
+		return errors.Wrap(err, "listen for task results")
+	}
+	defer pd.listener.Unregister(notifier) //nolint:errcheck
+	if _, err := pd.db.ExecContext(ctx, `
+		INSERT INTO task.doers (id, service, namespace, "group", expires_at)
+		VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5))
+	`, id, pd.service, pd.namespace, pd.group, postgresLeaseTTL.Seconds()); err != nil {
+		return errors.Wrap(err, "create doer")
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), postgresLeaseTTL)
+		defer cancel()
+		if _, err := pd.db.ExecContext(ctx, `DELETE FROM task.doers WHERE id = $1`, id); err != nil {
+			log.Info(ctx, "errored deleting doer", zap.String("doer", id), zap.Error(err))
+		}
+	}()
+	var eg errgroup.Group
+	done := make(chan struct{})
+	renewed := make(chan struct{})
+	var count int64
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer func() {
+		cancel(nil)
+		eg.Wait() //nolint:errcheck
+		<-renewed
+	}()
+	go func() {
+		defer close(renewed)
+		err := renewLease(ctx, func(ctx context.Context) (bool, error) {
+			res, err := pd.db.ExecContext(ctx, `
+				UPDATE task.doers SET expires_at = now() + make_interval(secs => $2) WHERE id = $1
+			`, id, postgresLeaseTTL.Seconds())
+			if err != nil {
+				return false, errors.EnsureStack(err)
+			}
+			n, err := res.RowsAffected()
+			return n > 0, errors.EnsureStack(err)
+		})
+		if err != nil {
+			cancel(errors.Wrap(err, "doer lease"))
+		}
+	}()
+	eg.Go(func() error {
+		ticker := time.NewTicker(postgresPollInterval)
+		defer ticker.Stop()
+		for {
+			tasks, err := pd.collect(ctx, id)
+			if err != nil {
+				return err
+			}
+			for _, task := range tasks {
+				var err error
+				if task.State == State_FAILURE {
+					err = errors.New(task.Reason)
+				}
+				if pd.cache != nil && err == nil {
+					if err := pd.cache.Put(ctx, task.Id, task.Output); err != nil {
+						log.Info(ctx, "errored putting task in cache",
+							zap.String("taskType", task.GetInput().GetTypeUrl()),
+							zap.String("taskID", task.GetId()),
+							zap.Error(err))
+					}
+				}
+				if err := cb(task.Index, task.Output, err); err != nil {
+					log.Debug(ctx, "task callback errored",
+						zap.String("taskType", task.GetInput().GetTypeUrl()),
+						zap.String("taskID", task.GetId()),
+						zap.Error(err))
+					return err
+				}
+				if atomic.AddInt64(&count, -1) == 0 {
+					select {
+					case <-done:
+						return nil
+					default:
+					}
+				}
+			}
+			select {
+			case <-notifier.ch:
+			case <-ticker.C:
+			case <-ctx.Done():
+				return errors.EnsureStack(context.Cause(ctx))
+			}
+		}
+	})
+	var index int64
+	for {
+		select {
+		case input, more := <-inputChan:
+			if !more {
+				close(done)
+				// If the tasks have already been collected (or there were none), then just return.
+				if atomic.LoadInt64(&count) == 0 {
+					return nil
+				}
+				return errors.EnsureStack(eg.Wait())
+			}
+			taskID, err := computeTaskID(input)
+			if err != nil {
+				return err
+			}
+			if pd.cache != nil {
+				output, err := pd.cache.Get(ctx, taskID)
+				if err == nil {
+					log.Debug(ctx, "result cached",
+						zap.String("taskType", input.GetTypeUrl()),
+						zap.String("taskID", taskID))
+					if err := cb(index, output, nil); err != nil {
+						return err
+					}
+					index++
+					continue
+				}
+			}
+			data, err := proto.Marshal(input)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			atomic.AddInt64(&count, 1)
+			if err := dbutil.WithTx(ctx, pd.db, func(ctx context.Context, tx *pachsql.Tx) error {
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO task.tasks (doer, id, idx, state, input) VALUES ($1, $2, $3, $4, $5)
+					ON CONFLICT (doer, id) DO UPDATE SET idx = excluded.idx, state = excluded.state,
+						input = excluded.input, output = NULL, reason = '', claimed_by = NULL,
+						claim_expires_at = NULL, collected = FALSE
+				`, id, taskID, index, int32(State_RUNNING), data); err != nil {
+					return errors.Wrap(err, "create task")
+				}
+				_, err := tx.ExecContext(ctx, `SELECT pg_notify($1, '')`, pd.channel)
+				return errors.Wrap(err, "notify sources")
+			}); err != nil {
+				return err
+			}
+			index++
+			log.Debug(ctx, "task submitted",
+				zap.String("taskType", input.GetTypeUrl()),
+				zap.String("taskID", taskID))
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+// collect returns the finished tasks of a doer that haven't been returned before.
+func (pd *postgresDoer) collect(ctx context.Context, doer string) (_ []*Task, retErr error) {
+	rows, err := pd.db.QueryContext(ctx, `
+		UPDATE task.tasks SET collected = TRUE
+		WHERE doer = $1 AND state <> $2 AND NOT collected
+		RETURNING id, idx, state, input, output, reason
+	`, doer, int32(State_RUNNING))
+	if err != nil {
+		return nil, errors.Wrap(err, "collect tasks")
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	var tasks []*Task
+	for rows.Next() {
+		var input, output []byte
+		var state int32
+		task := &Task{}
+		if err := rows.Scan(&task.Id, &task.Index, &state, &input, &output, &task.Reason); err != nil {
+			return nil, errors.Wrap(err, "scan task")
+		}
+		task.State = State(state)
+		if task.Input, err = unmarshalAny(input); err != nil {
+			return nil, err
+		}
+		if task.Output, err = unmarshalAny(output); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, errors.Wrap(rows.Err(), "collect tasks")
+}
+
+type postgresSource struct {
+	*postgresService
+	namespace string
+}
+
+func (ps *postgresSource) Iterate(ctx context.Context, cb ProcessFunc) error {
+	notifier := newPostgresNotifier(ps.channel)
+	if err := ps.listener.Register(notifier); err != nil {
+		return errors.Wrap(err, "listen for tasks")
+	}
+	defer ps.listener.Unregister(notifier) //nolint:errcheck
+	ticker := time.NewTicker(postgresPollInterval)
+	defer ticker.Stop()
+	// Groups take turns, in the order that they were last served by this source.
+	served := make(map[groupKey]int64)
+	var turn int64
+	for {
+		for {
+			group, claimed, err := ps.next(ctx, served, cb)
+			if err != nil {
+				if errors.Is(context.Cause(ctx), context.Canceled) {
+					return errors.EnsureStack(context.Cause(ctx))
+				}
+				log.Info(ctx, "errored processing task", zap.Error(err))
+				break
+			}
+			if !claimed {
+				break
+			}
+			turn++
+			served[group] = turn
+		}
+		select {
+		case <-notifier.ch:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		}
+	}
+}
+
+// groupKey identifies a group of tasks.
+type groupKey struct {
+	namespace, group string
+}
+
+// next claims and processes a task from the group that was least recently served, returning the
+// group and whether there was a task to claim.
+func (ps *postgresSource) next(ctx context.Context, served map[groupKey]int64, cb ProcessFunc) (groupKey, bool, error) {
+	groups, err := ps.claimableGroups(ctx)
+	if err != nil {
+		return groupKey{}, false, err
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return served[groups[i]] < served[groups[j]] })
+	for _, group := range groups {
+		claimed, err := ps.claim(ctx, group, cb)
+		if err != nil {
+			return groupKey{}, false, err
+		}
+		if claimed {
+			return group, true, nil
+		}
+	}
+	return groupKey{}, false, nil
+}
+
+// claimableGroups returns the groups that have unclaimed tasks.
+func (ps *postgresSource) claimableGroups(ctx context.Context) (_ []groupKey, retErr error) {
+	rows, err := ps.db.QueryContext(ctx, `
+		SELECT DISTINCT d.namespace, d."group" FROM task.doers d JOIN task.tasks t ON t.doer = d.id
+		WHERE `+namespaceCondition+` AND d.expires_at > now() AND t.state = $3
+			AND (t.claim_expires_at IS NULL OR t.claim_expires_at < now())
+	`, ps.service, ps.namespace, int32(State_RUNNING))
+	if err != nil {
+		return nil, errors.Wrap(err, "list groups")
+	}
+	defer errors.Close(&retErr, rows, "close rows")
+	var groups []groupKey
+	for rows.Next() {
+		var group groupKey
+		if err := rows.Scan(&group.namespace, &group.group); err != nil {
+			return nil, errors.Wrap(err, "scan group")
+		}
+		groups = append(groups, group)
+	}
+	return groups, errors.Wrap(rows.Err(), "list groups")
+}
+
+// claim claims a task of a group, if another source hasn't claimed them all, and processes it.
+func (ps *postgresSource) claim(ctx context.Context, group groupKey, cb ProcessFunc) (bool, error) {
+	claim := uuid.NewWithoutDashes()
+	var doer, taskID string
+	var data []byte
+	if err := ps.db.QueryRowContext(ctx, `
+		UPDATE task.tasks SET claimed_by = $4, claim_expires_at = now() + make_interval(secs => $5)
+		WHERE (doer, id) = (
+			SELECT t.doer, t.id FROM task.tasks t JOIN task.doers d ON d.id = t.doer
+			WHERE d.service = $1 AND d.namespace = $2 AND d."group" = $3 AND d.expires_at > now()
+				AND t.state = $6 AND (t.claim_expires_at IS NULL OR t.claim_expires_at < now())
+			ORDER BY t.created_at
+			LIMIT 1
+			FOR UPDATE OF t SKIP LOCKED
+		)
+		RETURNING doer, id, input
+	`, ps.service, group.namespace, group.group, claim, postgresLeaseTTL.Seconds(), int32(State_RUNNING)).Scan(&doer, &taskID, &data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "claim task")
+	}
+	input, err := unmarshalAny(data)
+	if err != nil {
+		return true, err
+	}
+	return true, ps.process(ctx, doer, taskID, claim, input, cb)
+}
+
+// process processes a claimed task, renewing the claim until the task is done, and records the
+// result.
+func (ps *postgresSource) process(ctx context.Context, doer, taskID, claim string, input *anypb.Any, cb ProcessFunc) error {
+	taskCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		err := renewLease(taskCtx, func(ctx context.Context) (bool, error) {
+			res, err := ps.db.ExecContext(ctx, `
+				UPDATE task.tasks SET claim_expires_at = now() + make_interval(secs => $4)
+				WHERE doer = $1 AND id = $2 AND claimed_by = $3 AND state = $5
+			`, doer, taskID, claim, postgresLeaseTTL.Seconds(), int32(State_RUNNING))
+			if err != nil {
+				return false, errors.EnsureStack(err)
+			}
+			n, err := res.RowsAffected()
+			return n > 0, errors.EnsureStack(err)
+		})
+		if err != nil {
+			cancel(errors.Join(context.Canceled, errors.Wrap(err, "claim lost")))
+		}
+	}()
+	log.Debug(ctx, "task received",
+		zap.String("taskType", input.GetTypeUrl()),
+		zap.String("taskID", taskID))
+	output, taskErr := cb(taskCtx, input)
+	log.Debug(ctx, "task completed",
+		zap.String("taskType", input.GetTypeUrl()),
+		zap.String("taskID", taskID),
+		zap.Error(taskErr))
+	canceled := errors.Is(context.Cause(taskCtx), context.Canceled)
+	cancel(nil)
+	<-renewed
+	// If the task context was canceled or the claim was lost, give up the claim so that another
+	// source can take the task right away.
+	if canceled {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), postgresLeaseTTL)
+		defer cancel()
+		_, err := ps.db.ExecContext(ctx, `
+			UPDATE task.tasks SET claimed_by = NULL, claim_expires_at = NULL
+			WHERE doer = $1 AND id = $2 AND claimed_by = $3
+		`, doer, taskID, claim)
+		if err != nil {
+			log.Info(ctx, "errored releasing task claim", zap.String("taskID", taskID), zap.Error(err))
+		}
+		return nil
+	}
+	state, reason := State_SUCCESS, ""
+	if taskErr != nil {
+		state, reason, output = State_FAILURE, taskErr.Error(), nil
+	}
+	var data []byte
+	if output != nil {
+		var err error
+		if data, err = proto.Marshal(output); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return dbutil.WithTx(ctx, ps.db, func(ctx context.Context, tx *pachsql.Tx) error {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE task.tasks SET state = $4, output = $5, reason = $6, claimed_by = NULL, claim_expires_at = NULL
+			WHERE doer = $1 AND id = $2 AND claimed_by = $3 AND state = $7
+		`, doer, taskID, claim, int32(state), data, reason, int32(State_RUNNING))
+		if err != nil {
+			return errors.Wrap(err, "finish task")
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "finish task")
+		}
+		if n == 0 {
+			// The claim was lost or the task was deleted, so the result isn't wanted.
+			return nil
+		}
+		_, err = tx.ExecContext(ctx, `SELECT pg_notify($1, '')`, doerChannel(doer))
+		return errors.Wrap(err, "notify doer")
+	})
+}
+
+// renewLease calls renew three times per lease TTL until the context is done.  It returns an error
+// if renew reports that the lease is gone, or if the lease can't be renewed before it expires.
+func renewLease(ctx context.Context, renew func(context.Context) (bool, error)) error {
+	ticker := time.NewTicker(postgresLeaseTTL / 3)
+	defer ticker.Stop()
+	renewed := time.Now()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+		ok, err := renew(ctx)
+		switch {
+		case errors.Is(context.Cause(ctx), context.Canceled):
+			return nil
+		case err != nil:
+			if time.Since(renewed) > postgresLeaseTTL {
+				return errors.Wrap(err, "renew lease")
+			}
+			log.Info(ctx, "errored renewing lease; will retry", zap.Error(err))
+		case !ok:
+			return errors.New("lease not found")
+		default:
+			renewed = time.Now()
+		}
+	}
+}
+
+func unmarshalAny(data []byte) (*anypb.Any, error) {
+	if data == nil {
+		return nil, nil
+	}
+	any := &anypb.Any{}
+	if err := proto.Unmarshal(data, any); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return any, nil
+}
+
+// postgresNotifier is a col.Notifier that wakes up a doer or source when it's notified.
+type postgresNotifier struct {
+	id, channel string
+	ch          chan struct{}
+}
+
+func newPostgresNotifier(channel string) *postgresNotifier {
+	return &postgresNotifier{
+		id:      uuid.NewWithoutDashes(),
+		channel: channel,
+		ch:      make(chan struct{}, 1),
+	}
+}
+
+func (pn *postgresNotifier) ID() string {
+	return pn.id
+}
+
+func (pn *postgresNotifier) Channel() string {
+	return pn.channel
+}
+
+func (pn *postgresNotifier) Notify(*col.Notification) {
+	select {
+	case pn.ch <- struct{}{}:
+	default:
+	}
+}
+
+func (pn *postgresNotifier) Error(error) {
+	// Wake up to poll; the listener reconnects on its own.
+	pn.Notify(nil)
+}
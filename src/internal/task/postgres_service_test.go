@@ -0,0 +1,50 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func newTestPostgresService(ctx context.Context, t *testing.T) Service {
+	cfg := dockertestenv.NewTestDBConfig(t)
+	db := testutil.OpenDB(t, cfg.PGBouncer.DBOptions()...)
+	require.NoError(t, dbutil.WithTx(ctx, db, SetupPostgresV0))
+	listener := col.NewPostgresListener(dbutil.GetDSN(ctx, cfg.Direct.DBOptions()...))
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	return NewPostgresService(db, listener, "")
+}
+
+func TestPostgresCleanup(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	ps := newTestPostgresService(ctx, t).(*postgresService)
+	_, err := ps.db.ExecContext(ctx, `
+		INSERT INTO task.doers (id, service, namespace, "group", expires_at) VALUES
+			('live', $1, '', '', now() + interval '1 hour'),
+			('dead', $1, '', '', now() - interval '1 second')
+	`, ps.service)
+	require.NoError(t, err)
+	ctx, cancel := pctx.WithCancel(ctx)
+	defer cancel()
+	go ps.RunCleanup(ctx) //nolint:errcheck
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		var ids []string
+		if err := ps.db.SelectContext(ctx, &ids, `SELECT id FROM task.doers ORDER BY id`); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(ids) != 1 || ids[0] != "live" {
+			return errors.Errorf("doers: %v; want only the live one", ids)
+		}
+		return nil
+	})
+}
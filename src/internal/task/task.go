@@ -47,6 +47,14 @@ type Service interface {
 	Count(ctx context.Context, namespace string) (int64, error)
 }
 
+// A Cleaner is a Service that keeps state which can outlive the processes that created it.  One
+// process in the cluster should run its cleanup.
+type Cleaner interface {
+	// RunCleanup periodically deletes state left behind by processes that have died, until ctx
+	// is done.
+	RunCleanup(ctx context.Context) error
+}
+
 // Doer is a doer of tasks.
 // Refer to the DoOne and DoBatch helper functions if a simpler interface is desired.
 type Doer interface {
@@ -89,6 +89,12 @@ type getPermissionsFunc func(context.Context, *auth.GetPermissionsRequest) (*aut
 type getPermissionsForPrincipalFunc func(context.Context, *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error)
 type whoAmIFunc func(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error)
 type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermissionRequest) (*auth.GetRolesForPermissionResponse, error)
+type explainAuthorizationFunc func(context.Context, *auth.ExplainAuthorizationRequest) (*auth.ExplainAuthorizationResponse, error)
+type createRoleFunc func(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error)
+type updateRoleFunc func(context.Context, *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error)
+type deleteRoleFunc func(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error)
+type listRolesFunc func(context.Context, *auth.ListRolesRequest) (*auth.ListRolesResponse, error)
+type listAuditEventsFunc func(*auth.ListAuditEventsRequest, auth.API_ListAuditEventsServer) error
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
@@ -137,6 +143,12 @@ type mockGetPermissionsForPrincipal struct {
 }
 type mockWhoAmI struct{ handler whoAmIFunc }
 type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
+type mockExplainAuthorization struct{ handler explainAuthorizationFunc }
+type mockCreateRole struct{ handler createRoleFunc }
+type mockUpdateRole struct{ handler updateRoleFunc }
+type mockDeleteRole struct{ handler deleteRoleFunc }
+type mockListRoles struct{ handler listRolesFunc }
+type mockListAuditEvents struct{ handler listAuditEventsFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
@@ -216,6 +228,12 @@ func (mock *mockAuthenticate) Use(cb authenticateFunc)
 func (mock *mockAuthorize) Use(cb authorizeFunc)                                   { mock.handler = cb }
 func (mock *mockWhoAmI) Use(cb whoAmIFunc)                                         { mock.handler = cb }
 func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)           { mock.handler = cb }
+func (mock *mockExplainAuthorization) Use(cb explainAuthorizationFunc)             { mock.handler = cb }
+func (mock *mockCreateRole) Use(cb createRoleFunc)                                 { mock.handler = cb }
+func (mock *mockUpdateRole) Use(cb updateRoleFunc)                                 { mock.handler = cb }
+func (mock *mockDeleteRole) Use(cb deleteRoleFunc)                                 { mock.handler = cb }
+func (mock *mockListRoles) Use(cb listRolesFunc)                                   { mock.handler = cb }
+func (mock *mockListAuditEvents) Use(cb listAuditEventsFunc)                       { mock.handler = cb }
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
@@ -306,6 +324,12 @@ type mockAuthServer struct {
 	GetPermissionsForPrincipal                 mockGetPermissionsForPrincipal
 	WhoAmI                                     mockWhoAmI
 	GetRolesForPermission                      mockGetRolesForPermission
+	ExplainAuthorization                       mockExplainAuthorization
+	CreateRole                                 mockCreateRole
+	UpdateRole                                 mockUpdateRole
+	DeleteRole                                 mockDeleteRole
+	ListRoles                                  mockListRoles
+	ListAuditEvents                            mockListAuditEvents
 	GetOIDCLogin                               mockGetOIDCLogin
 	GetRobotToken                              mockGetRobotToken
 	RevokeAuthToken                            mockRevokeAuthToken
@@ -411,6 +435,42 @@ func (api *authServerAPI) GetRolesForPermission(ctx context.Context, req *auth.G
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetRolesForPermission")
 }
+func (api *authServerAPI) ExplainAuthorization(ctx context.Context, req *auth.ExplainAuthorizationRequest) (*auth.ExplainAuthorizationResponse, error) {
+	if api.mock.ExplainAuthorization.handler != nil {
+		return api.mock.ExplainAuthorization.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ExplainAuthorization")
+}
+func (api *authServerAPI) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	if api.mock.CreateRole.handler != nil {
+		return api.mock.CreateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRole")
+}
+func (api *authServerAPI) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error) {
+	if api.mock.UpdateRole.handler != nil {
+		return api.mock.UpdateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.UpdateRole")
+}
+func (api *authServerAPI) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	if api.mock.DeleteRole.handler != nil {
+		return api.mock.DeleteRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRole")
+}
+func (api *authServerAPI) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (*auth.ListRolesResponse, error) {
+	if api.mock.ListRoles.handler != nil {
+		return api.mock.ListRoles.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRoles")
+}
+func (api *authServerAPI) ListAuditEvents(req *auth.ListAuditEventsRequest, serv auth.API_ListAuditEventsServer) error {
+	if api.mock.ListAuditEvents.handler != nil {
+		return api.mock.ListAuditEvents.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock auth.ListAuditEvents")
+}
 func (api *authServerAPI) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error) {
 	if api.mock.GetOIDCLogin.handler != nil {
 		return api.mock.GetOIDCLogin.handler(ctx, req)
@@ -1030,6 +1090,11 @@ type clearCacheFunc func(context.Context, *pfs.ClearCacheRequest) (*emptypb.Empt
 type listTaskPFSFunc func(*task.ListTaskRequest, pfs.API_ListTaskServer) error
 type egressFunc func(context.Context, *pfs.EgressRequest) (*pfs.EgressResponse, error)
 type reposSummaryFunc func(context.Context, *pfs.ReposSummaryRequest) (*pfs.ReposSummaryResponse, error)
+type storageUsageFunc func(*pfs.StorageUsageRequest, pfs.API_StorageUsageServer) error
+type createShareLinkFunc func(context.Context, *pfs.CreateShareLinkRequest) (*pfs.CreateShareLinkResponse, error)
+type inspectShareLinkFunc func(context.Context, *pfs.InspectShareLinkRequest) (*pfs.ShareLinkInfo, error)
+type getShareLinkFunc func(*pfs.GetShareLinkRequest, pfs.API_GetShareLinkServer) error
+type revokeShareLinkFunc func(context.Context, *pfs.RevokeShareLinkRequest) (*pfs.RevokeShareLinkResponse, error)
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
@@ -1087,6 +1152,11 @@ type mockClearCache struct{ handler clearCacheFunc }
 type mockListTaskPFS struct{ handler listTaskPFSFunc }
 type mockEgress struct{ handler egressFunc }
 type mockReposSummary struct{ handler reposSummaryFunc }
+type mockStorageUsage struct{ handler storageUsageFunc }
+type mockCreateShareLink struct{ handler createShareLinkFunc }
+type mockInspectShareLink struct{ handler inspectShareLinkFunc }
+type mockGetShareLink struct{ handler getShareLinkFunc }
+type mockRevokeShareLink struct{ handler revokeShareLinkFunc }
 
 func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)           { mock.handler = cb }
 func (mock *mockCreateRepo) Use(cb createRepoFunc)                     { mock.handler = cb }
@@ -1144,6 +1214,11 @@ func (mock *mockClearCache) Use(cb clearCacheFunc)                     { mock.ha
 func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)                   { mock.handler = cb }
 func (mock *mockEgress) Use(cb egressFunc)                             { mock.handler = cb }
 func (mock *mockReposSummary) Use(cb reposSummaryFunc)                 { mock.handler = cb }
+func (mock *mockStorageUsage) Use(cb storageUsageFunc)                 { mock.handler = cb }
+func (mock *mockCreateShareLink) Use(cb createShareLinkFunc)           { mock.handler = cb }
+func (mock *mockInspectShareLink) Use(cb inspectShareLinkFunc)         { mock.handler = cb }
+func (mock *mockGetShareLink) Use(cb getShareLinkFunc)                 { mock.handler = cb }
+func (mock *mockRevokeShareLink) Use(cb revokeShareLinkFunc)           { mock.handler = cb }
 
 type pfsServerAPI struct {
 	pfs.UnsafeAPIServer
@@ -1208,6 +1283,11 @@ type mockPFSServer struct {
 	ListTask             mockListTaskPFS
 	Egress               mockEgress
 	ReposSummary         mockReposSummary
+	StorageUsage         mockStorageUsage
+	CreateShareLink      mockCreateShareLink
+	InspectShareLink     mockInspectShareLink
+	GetShareLink         mockGetShareLink
+	RevokeShareLink      mockRevokeShareLink
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -1551,25 +1631,75 @@ func (api *pfsServerAPI) ReposSummary(ctx context.Context, req *pfs.ReposSummary
 	return nil, errors.Errorf("unhandled pachd mock pfs.ReposSummary")
 }
 
+func (api *pfsServerAPI) StorageUsage(req *pfs.StorageUsageRequest, serv pfs.API_StorageUsageServer) error {
+	if api.mock.StorageUsage.handler != nil {
+		return api.mock.StorageUsage.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.StorageUsage")
+}
+
+func (api *pfsServerAPI) CreateShareLink(ctx context.Context, req *pfs.CreateShareLinkRequest) (*pfs.CreateShareLinkResponse, error) {
+	if api.mock.CreateShareLink.handler != nil {
+		return api.mock.CreateShareLink.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateShareLink")
+}
+
+func (api *pfsServerAPI) InspectShareLink(ctx context.Context, req *pfs.InspectShareLinkRequest) (*pfs.ShareLinkInfo, error) {
+	if api.mock.InspectShareLink.handler != nil {
+		return api.mock.InspectShareLink.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectShareLink")
+}
+
+func (api *pfsServerAPI) GetShareLink(req *pfs.GetShareLinkRequest, serv pfs.API_GetShareLinkServer) error {
+	if api.mock.GetShareLink.handler != nil {
+		return api.mock.GetShareLink.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.GetShareLink")
+}
+
+func (api *pfsServerAPI) RevokeShareLink(ctx context.Context, req *pfs.RevokeShareLinkRequest) (*pfs.RevokeShareLinkResponse, error) {
+	if api.mock.RevokeShareLink.handler != nil {
+		return api.mock.RevokeShareLink.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RevokeShareLink")
+}
+
 /* Storage Server Mocks */
 
 type createFilesetFunc func(storage.Fileset_CreateFilesetServer) error
 type readFilesetFunc func(request *storage.ReadFilesetRequest, server storage.Fileset_ReadFilesetServer) error
+type readFilesetRefsFunc func(request *storage.ReadFilesetRequest, server storage.Fileset_ReadFilesetRefsServer) error
+type findMissingChunksFunc func(context.Context, *storage.FindMissingChunksRequest) (*storage.FindMissingChunksResponse, error)
+type readChunkFunc func(request *storage.ReadChunkRequest, server storage.Fileset_ReadChunkServer) error
+type writeChunkFunc func(storage.Fileset_WriteChunkServer) error
+type createFilesetFromRefsFunc func(storage.Fileset_CreateFilesetFromRefsServer) error
 type renewFilesetFunc func(context.Context, *storage.RenewFilesetRequest) (*emptypb.Empty, error)
 type composeFilesetFunc func(context.Context, *storage.ComposeFilesetRequest) (*storage.ComposeFilesetResponse, error)
 type shardFilesetFunc func(context.Context, *storage.ShardFilesetRequest) (*storage.ShardFilesetResponse, error)
 
 type mockCreateFileset struct{ handler createFilesetFunc }
 type mockReadFileset struct{ handler readFilesetFunc }
+type mockReadFilesetRefs struct{ handler readFilesetRefsFunc }
+type mockFindMissingChunks struct{ handler findMissingChunksFunc }
+type mockReadChunk struct{ handler readChunkFunc }
+type mockWriteChunk struct{ handler writeChunkFunc }
+type mockCreateFilesetFromRefs struct{ handler createFilesetFromRefsFunc }
 type mockRenewFileset struct{ handler renewFilesetFunc }
 type mockComposeFileset struct{ handler composeFilesetFunc }
 type mockShardFileset struct{ handler shardFilesetFunc }
 
-func (mock *mockCreateFileset) Use(cb createFilesetFunc)   { mock.handler = cb }
-func (mock *mockReadFileset) Use(cb readFilesetFunc)       { mock.handler = cb }
-func (mock *mockRenewFileset) Use(cb renewFilesetFunc)     { mock.handler = cb }
-func (mock *mockComposeFileset) Use(cb composeFilesetFunc) { mock.handler = cb }
-func (mock *mockShardFileset) Use(cb shardFilesetFunc)     { mock.handler = cb }
+func (mock *mockCreateFileset) Use(cb createFilesetFunc)                 { mock.handler = cb }
+func (mock *mockReadFileset) Use(cb readFilesetFunc)                     { mock.handler = cb }
+func (mock *mockReadFilesetRefs) Use(cb readFilesetRefsFunc)             { mock.handler = cb }
+func (mock *mockFindMissingChunks) Use(cb findMissingChunksFunc)         { mock.handler = cb }
+func (mock *mockReadChunk) Use(cb readChunkFunc)                         { mock.handler = cb }
+func (mock *mockWriteChunk) Use(cb writeChunkFunc)                       { mock.handler = cb }
+func (mock *mockCreateFilesetFromRefs) Use(cb createFilesetFromRefsFunc) { mock.handler = cb }
+func (mock *mockRenewFileset) Use(cb renewFilesetFunc)                   { mock.handler = cb }
+func (mock *mockComposeFileset) Use(cb composeFilesetFunc)               { mock.handler = cb }
+func (mock *mockShardFileset) Use(cb shardFilesetFunc)                   { mock.handler = cb }
 
 type storageServerAPI struct {
 	storage.UnimplementedFilesetServer
@@ -1577,12 +1707,17 @@ type storageServerAPI struct {
 }
 
 type mockStorageServer struct {
-	api            storageServerAPI
-	CreateFileset  mockCreateFileset
-	ReadFileset    mockReadFileset
-	RenewFileset   mockRenewFileset
-	ComposeFileset mockComposeFileset
-	ShardFileset   mockShardFileset
+	api                   storageServerAPI
+	CreateFileset         mockCreateFileset
+	ReadFileset           mockReadFileset
+	ReadFilesetRefs       mockReadFilesetRefs
+	FindMissingChunks     mockFindMissingChunks
+	ReadChunk             mockReadChunk
+	WriteChunk            mockWriteChunk
+	CreateFilesetFromRefs mockCreateFilesetFromRefs
+	RenewFileset          mockRenewFileset
+	ComposeFileset        mockComposeFileset
+	ShardFileset          mockShardFileset
 }
 
 func (api *storageServerAPI) CreateFileset(server storage.Fileset_CreateFilesetServer) error {
@@ -1599,6 +1734,41 @@ func (api *storageServerAPI) ReadFileset(request *storage.ReadFilesetRequest, se
 	return errors.Errorf("unhandled pachd mock storage.ReadFileset")
 }
 
+func (api *storageServerAPI) ReadFilesetRefs(request *storage.ReadFilesetRequest, server storage.Fileset_ReadFilesetRefsServer) error {
+	if api.mock.ReadFilesetRefs.handler != nil {
+		return api.mock.ReadFilesetRefs.handler(request, server)
+	}
+	return errors.Errorf("unhandled pachd mock storage.ReadFilesetRefs")
+}
+
+func (api *storageServerAPI) FindMissingChunks(ctx context.Context, request *storage.FindMissingChunksRequest) (*storage.FindMissingChunksResponse, error) {
+	if api.mock.FindMissingChunks.handler != nil {
+		return api.mock.FindMissingChunks.handler(ctx, request)
+	}
+	return nil, errors.Errorf("unhandled pachd mock storage.FindMissingChunks")
+}
+
+func (api *storageServerAPI) ReadChunk(request *storage.ReadChunkRequest, server storage.Fileset_ReadChunkServer) error {
+	if api.mock.ReadChunk.handler != nil {
+		return api.mock.ReadChunk.handler(request, server)
+	}
+	return errors.Errorf("unhandled pachd mock storage.ReadChunk")
+}
+
+func (api *storageServerAPI) WriteChunk(server storage.Fileset_WriteChunkServer) error {
+	if api.mock.WriteChunk.handler != nil {
+		return api.mock.WriteChunk.handler(server)
+	}
+	return errors.Errorf("unhandled pachd mock storage.WriteChunk")
+}
+
+func (api *storageServerAPI) CreateFilesetFromRefs(server storage.Fileset_CreateFilesetFromRefsServer) error {
+	if api.mock.CreateFilesetFromRefs.handler != nil {
+		return api.mock.CreateFilesetFromRefs.handler(server)
+	}
+	return errors.Errorf("unhandled pachd mock storage.CreateFilesetFromRefs")
+}
+
 func (api *storageServerAPI) RenewFileset(ctx context.Context, request *storage.RenewFilesetRequest) (*emptypb.Empty, error) {
 	if api.mock.RenewFileset.handler != nil {
 		return api.mock.RenewFileset.handler(ctx, request)
@@ -1665,6 +1835,8 @@ type setClusterDefaultsFunc func(context.Context, *pps.SetClusterDefaultsRequest
 type getProjectDefaultsFunc func(context.Context, *pps.GetProjectDefaultsRequest) (*pps.GetProjectDefaultsResponse, error)
 type setProjectDefaultsFunc func(context.Context, *pps.SetProjectDefaultsRequest) (*pps.SetProjectDefaultsResponse, error)
 type pipelinesSummaryFunc func(context.Context, *pps.PipelinesSummaryRequest) (*pps.PipelinesSummaryResponse, error)
+type getProjectQuotaFunc func(context.Context, *pps.GetProjectQuotaRequest) (*pps.GetProjectQuotaResponse, error)
+type setProjectQuotaFunc func(context.Context, *pps.SetProjectQuotaRequest) (*pps.SetProjectQuotaResponse, error)
 
 type mockInspectJob struct{ handler inspectJobFunc }
 type mockListJob struct{ handler listJobFunc }
@@ -1711,6 +1883,8 @@ type mockSetClusterDefaults struct{ handler setClusterDefaultsFunc }
 type mockGetProjectDefaults struct{ handler getProjectDefaultsFunc }
 type mockSetProjectDefaults struct{ handler setProjectDefaultsFunc }
 type mockPipelinesSummary struct{ handler pipelinesSummaryFunc }
+type mockGetProjectQuota struct{ handler getProjectQuotaFunc }
+type mockSetProjectQuota struct{ handler setProjectQuotaFunc }
 
 func (mock *mockInspectJob) Use(cb inspectJobFunc)                       { mock.handler = cb }
 func (mock *mockListJob) Use(cb listJobFunc)                             { mock.handler = cb }
@@ -1757,6 +1931,8 @@ func (mock *mockSetClusterDefaults) Use(cb setClusterDefaultsFunc) { mock.handle
 func (mock *mockGetProjectDefaults) Use(cb getProjectDefaultsFunc) { mock.handler = cb }
 func (mock *mockSetProjectDefaults) Use(cb setProjectDefaultsFunc) { mock.handler = cb }
 func (mock *mockPipelinesSummary) Use(cb pipelinesSummaryFunc)     { mock.handler = cb }
+func (mock *mockGetProjectQuota) Use(cb getProjectQuotaFunc)       { mock.handler = cb }
+func (mock *mockSetProjectQuota) Use(cb setProjectQuotaFunc)       { mock.handler = cb }
 
 type ppsServerAPI struct {
 	pps.UnsafeAPIServer
@@ -1808,6 +1984,8 @@ type mockPPSServer struct {
 	GetProjectDefaults           mockGetProjectDefaults
 	SetProjectDefaults           mockSetProjectDefaults
 	PipelinesSummary             mockPipelinesSummary
+	GetProjectQuota              mockGetProjectQuota
+	SetProjectQuota              mockSetProjectQuota
 }
 
 func (api *ppsServerAPI) InspectJob(ctx context.Context, req *pps.InspectJobRequest) (*pps.JobInfo, error) {
@@ -2070,6 +2248,18 @@ func (api *ppsServerAPI) PipelinesSummary(ctx context.Context, req *pps.Pipeline
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.PipelinesSummary")
 }
+func (api *ppsServerAPI) GetProjectQuota(ctx context.Context, req *pps.GetProjectQuotaRequest) (*pps.GetProjectQuotaResponse, error) {
+	if api.mock.GetProjectQuota.handler != nil {
+		return api.mock.GetProjectQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.GetProjectQuota")
+}
+func (api *ppsServerAPI) SetProjectQuota(ctx context.Context, req *pps.SetProjectQuotaRequest) (*pps.SetProjectQuotaResponse, error) {
+	if api.mock.SetProjectQuota.handler != nil {
+		return api.mock.SetProjectQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.SetProjectQuota")
+}
 
 /* Transaction Server Mocks */
 
@@ -2213,13 +2403,31 @@ type mockLogsGetLogs struct{ handler logs_GetLogsFunc }
 
 func (mock *mockLogsGetLogs) Use(cb logs_GetLogsFunc) { mock.handler = cb }
 
+type logs_CreateLogRuleFunc func(context.Context, *logs.CreateLogRuleRequest) (*logs.CreateLogRuleResponse, error)
+type mockLogsCreateLogRule struct{ handler logs_CreateLogRuleFunc }
+
+func (mock *mockLogsCreateLogRule) Use(cb logs_CreateLogRuleFunc) { mock.handler = cb }
+
+type logs_ListLogRuleFunc func(*logs.ListLogRuleRequest, logs.API_ListLogRuleServer) error
+type mockLogsListLogRule struct{ handler logs_ListLogRuleFunc }
+
+func (mock *mockLogsListLogRule) Use(cb logs_ListLogRuleFunc) { mock.handler = cb }
+
+type logs_DeleteLogRuleFunc func(context.Context, *logs.DeleteLogRuleRequest) (*logs.DeleteLogRuleResponse, error)
+type mockLogsDeleteLogRule struct{ handler logs_DeleteLogRuleFunc }
+
+func (mock *mockLogsDeleteLogRule) Use(cb logs_DeleteLogRuleFunc) { mock.handler = cb }
+
 type logsServerAPI struct {
 	logs.UnsafeAPIServer
 	mock *mockLogsServer
 }
 type mockLogsServer struct {
-	api     logsServerAPI
-	GetLogs mockLogsGetLogs
+	api           logsServerAPI
+	GetLogs       mockLogsGetLogs
+	CreateLogRule mockLogsCreateLogRule
+	ListLogRule   mockLogsListLogRule
+	DeleteLogRule mockLogsDeleteLogRule
 }
 
 func (api *logsServerAPI) GetLogs(req *logs.GetLogsRequest, srv logs.API_GetLogsServer) error {
@@ -2229,6 +2437,27 @@ func (api *logsServerAPI) GetLogs(req *logs.GetLogsRequest, srv logs.API_GetLogs
 	return errors.Errorf("unhandled pachd mock logs.GetLogs")
 }
 
+func (api *logsServerAPI) CreateLogRule(ctx context.Context, req *logs.CreateLogRuleRequest) (*logs.CreateLogRuleResponse, error) {
+	if api.mock.CreateLogRule.handler != nil {
+		return api.mock.CreateLogRule.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock logs.CreateLogRule")
+}
+
+func (api *logsServerAPI) ListLogRule(req *logs.ListLogRuleRequest, srv logs.API_ListLogRuleServer) error {
+	if api.mock.ListLogRule.handler != nil {
+		return api.mock.ListLogRule.handler(req, srv)
+	}
+	return errors.Errorf("unhandled pachd mock logs.ListLogRule")
+}
+
+func (api *logsServerAPI) DeleteLogRule(ctx context.Context, req *logs.DeleteLogRuleRequest) (*logs.DeleteLogRuleResponse, error) {
+	if api.mock.DeleteLogRule.handler != nil {
+		return api.mock.DeleteLogRule.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock logs.DeleteLogRule")
+}
+
 /* Metadata Server Mocks */
 type metadataServerAPI struct {
 	metadata.UnsafeAPIServer
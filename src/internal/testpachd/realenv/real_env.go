@@ -343,6 +343,7 @@ func newRealEnv(ctx context.Context, t testing.TB, mockPPSTransactionServer bool
 	realEnv.LogsServer, err = logsserver.NewAPIServer(logsserver.Env{
 		GetLokiClient: realEnv.ServiceEnv.GetLokiClient,
 		AuthServer:    realEnv.AuthServer,
+		DB:            realEnv.ServiceEnv.GetDBClient(),
 	})
 	require.NoError(t, err)
 
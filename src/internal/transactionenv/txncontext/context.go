@@ -24,6 +24,7 @@ import (
 // objects, which will be threaded through to every API call:
 type TransactionContext struct {
 	username string
+	scope    *auth.TokenScope
 	// SqlTx is the ongoing database transaction.
 	SqlTx *pachsql.Tx
 	// CommitSetID is the ID of the CommitSet corresponding to PFS changes in this transaction.
@@ -59,12 +60,14 @@ type identifier interface {
 
 func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*TransactionContext, error) {
 	var username string
+	var scope *auth.TokenScope
 	// check auth once now so that we can refer to it later
 	if authServer != nil {
 		if me, err := authServer.WhoAmI(ctx, &auth.WhoAmIRequest{}); err != nil && !auth.IsErrNotActivated(err) {
 			return nil, errors.EnsureStack(err)
 		} else if err == nil {
 			username = me.Username
+			scope = me.Scope
 		}
 	}
 	var currTime time.Time
@@ -77,6 +80,7 @@ func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*Transa
 		CommitSetID: uuid.NewWithoutDashes(),
 		Timestamp:   timestamppb.New(currTime),
 		username:    username,
+		scope:       scope,
 	}, nil
 }
 
@@ -84,7 +88,7 @@ func (t *TransactionContext) WhoAmI() (*auth.WhoAmIResponse, error) {
 	if t.username == "" {
 		return nil, auth.ErrNotActivated
 	}
-	return &auth.WhoAmIResponse{Username: t.username}, nil
+	return &auth.WhoAmIResponse{Username: t.username, Scope: t.scope}, nil
 }
 
 // Username returns the current user, returning the empty string if auth is disabled.
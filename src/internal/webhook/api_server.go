@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type APIServer struct {
+	webhookpb.UnimplementedAPIServer
+	DB *pachsql.DB
+}
+
+var _ webhookpb.APIServer = &APIServer{}
+
+func (a *APIServer) CreateWebhook(ctx context.Context, req *webhookpb.CreateWebhookRequest) (*webhookpb.CreateWebhookResponse, error) {
+	u, err := url.Parse(req.Url)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parse url: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, status.Errorf(codes.InvalidArgument, "url must be http or https, not %q", u.Scheme)
+	}
+	if err := dbutil.WithTx(ctx, a.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return CreateWebhook(ctx, tx, req)
+	}); err != nil {
+		return nil, errors.Wrap(err, "create webhook")
+	}
+	return &webhookpb.CreateWebhookResponse{}, nil
+}
+
+func (a *APIServer) InspectWebhook(ctx context.Context, req *webhookpb.InspectWebhookRequest) (*webhookpb.InspectWebhookResponse, error) {
+	var info *webhookpb.WebhookInfo
+	if err := dbutil.WithTx(ctx, a.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		info, err = GetWebhook(ctx, tx, req.Name)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return nil, errors.Wrap(err, "inspect webhook")
+	}
+	return &webhookpb.InspectWebhookResponse{Info: info}, nil
+}
+
+func (a *APIServer) ListWebhook(req *webhookpb.ListWebhookRequest, srv webhookpb.API_ListWebhookServer) (retErr error) {
+	ctx, done := log.SpanContext(srv.Context(), "list webhook")
+	defer done(log.Errorp(&retErr))
+
+	var infos []*webhookpb.WebhookInfo
+	if err := dbutil.WithTx(ctx, a.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		infos, err = ListWebhooks(ctx, tx)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return errors.Wrap(err, "list webhooks")
+	}
+	for _, info := range infos {
+		if err := srv.Send(&webhookpb.ListWebhookResponse{Info: info}); err != nil {
+			return errors.Wrap(err, "send")
+		}
+	}
+	return nil
+}
+
+func (a *APIServer) DeleteWebhook(ctx context.Context, req *webhookpb.DeleteWebhookRequest) (*webhookpb.DeleteWebhookResponse, error) {
+	if err := dbutil.WithTx(ctx, a.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return DeleteWebhook(ctx, tx, req.Name)
+	}); err != nil {
+		return nil, errors.Wrap(err, "delete webhook")
+	}
+	return &webhookpb.DeleteWebhookResponse{}, nil
+}
+
+func (a *APIServer) ListDelivery(req *webhookpb.ListDeliveryRequest, srv webhookpb.API_ListDeliveryServer) (retErr error) {
+	ctx, done := log.SpanContext(srv.Context(), "list delivery")
+	defer done(log.Errorp(&retErr))
+
+	var infos []*webhookpb.DeliveryInfo
+	if err := dbutil.WithTx(ctx, a.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if req.Webhook != "" {
+			if _, err := GetWebhook(ctx, tx, req.Webhook); err != nil {
+				return err
+			}
+		}
+		var err error
+		infos, err = ListDeliveries(ctx, tx, req.Webhook, req.Limit)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return errors.Wrap(err, "list deliveries")
+	}
+	for _, info := range infos {
+		if err := srv.Send(&webhookpb.ListDeliveryResponse{Info: info}); err != nil {
+			return errors.Wrap(err, "send")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+// Package cmds implements commands for webhooks
+package cmds
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/internal/webhook/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	webhooks   = "webhooks"
+	deliveries = "deliveries"
+)
+
+func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	createOrUpdate := func(update bool) *cobra.Command {
+		var url, secret string
+		var types, projects, pipelines, repos, branches, states []string
+		verb, short, long := "create", "Create a new webhook.", "This command registers a webhook, which receives an HTTP POST for each job, pipeline, or commit state change that matches its filter."
+		if update {
+			verb, short, long = "update", "Update an existing webhook.", "This command replaces the URL, secret, and filter of an existing webhook."
+		}
+		cmd := &cobra.Command{
+			Use:   "{{alias}} <name>",
+			Short: short,
+			Long: long + "\n\n" +
+				"Each delivery is a JSON-encoded event.  If a secret is set, deliveries carry an X-Pachyderm-Signature header of the form `sha256=<hex HMAC-SHA256 of the body>`.  " +
+				"Failed deliveries are retried with exponential backoff; use `pachctl list delivery` to see the delivery history.",
+			Example: "\t- {{alias}} ci --url https://example.com/hook --secret s3cr3t\n" +
+				"\t- {{alias}} failures --url https://example.com/hook --type job --state JOB_FAILURE --state JOB_KILLED\n" +
+				"\t- {{alias}} images --url https://example.com/hook --type commit --repo images --branch master --state FINISHED\n",
+			Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+				filter, err := parseFilter(types, projects, pipelines, repos, branches, states)
+				if err != nil {
+					return err
+				}
+				c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+				if err != nil {
+					return err
+				}
+				defer errors.Close(&retErr, c, "close client")
+				_, err = c.WebhookAPIClient.CreateWebhook(c.Ctx(), &webhook.CreateWebhookRequest{
+					Name:   args[0],
+					Url:    url,
+					Secret: secret,
+					Filter: filter,
+					Update: update,
+				})
+				return grpcutil.ScrubGRPC(err)
+			}),
+		}
+		cmd.Flags().StringVar(&url, "url", "", "The URL to POST events to.")
+		cmd.Flags().StringVar(&secret, "secret", "", "The secret used to sign deliveries.")
+		cmd.Flags().StringSliceVar(&types, "type", nil, "Only deliver events of this type (job, pipeline, commit, or log).  May be repeated.")
+		cmd.Flags().StringSliceVar(&projects, "project", nil, "Only deliver events in this project.  May be repeated.")
+		cmd.Flags().StringSliceVar(&pipelines, "pipeline", nil, "Only deliver events for this pipeline, including commits to its output repo and log rule alerts.  May be repeated.")
+		cmd.Flags().StringSliceVar(&repos, "repo", nil, "Only deliver commit events in this repo.  May be repeated.")
+		cmd.Flags().StringSliceVar(&branches, "branch", nil, "Only deliver commit events on this branch.  May be repeated.")
+		cmd.Flags().StringSliceVar(&states, "state", nil, "Only deliver events for this job, pipeline, or commit state, e.g. JOB_FAILURE, PIPELINE_CRASHING or FINISHED.  May be repeated.")
+		if err := cmd.MarkFlagRequired("url"); err != nil {
+			panic(err)
+		}
+		return cmdutil.CreateAliases(cmd, verb+" webhook", webhooks)
+	}
+	commands = append(commands, createOrUpdate(false), createOrUpdate(true))
+
+	inspectWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Return info about a webhook.",
+		Long:  "This command returns details of a webhook, including its URL and filter.  The secret is never returned.",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			resp, err := c.WebhookAPIClient.InspectWebhook(c.Ctx(), &webhook.InspectWebhookRequest{Name: args[0]})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.Wrap(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp.Info), "encoder")
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintDetailedWebhookInfo(resp.Info)
+		}),
+	}
+	inspectWebhook.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(inspectWebhook, "inspect webhook", webhooks))
+
+	listWebhook := &cobra.Command{
+		Short: "Return all webhooks.",
+		Long:  "This command returns all webhooks.",
+		Run: cmdutil.RunFixedArgs(0, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			client, err := c.WebhookAPIClient.ListWebhook(c.Ctx(), &webhook.ListWebhookRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *webhook.ListWebhookResponse) error {
+					return errors.Wrap(encoder.EncodeProto(res.Info), "encode proto")
+				}))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.WebhookHeader)
+			defer errors.Invoke(&retErr, writer.Flush, "flush output")
+			return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *webhook.ListWebhookResponse) error {
+				pretty.PrintWebhookInfo(writer, res.Info)
+				return nil
+			}))
+		}),
+	}
+	listWebhook.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(listWebhook, "list webhook", webhooks))
+
+	deleteWebhook := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a webhook.",
+		Long:  "This command deletes a webhook and its delivery history.",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			_, err = c.WebhookAPIClient.DeleteWebhook(c.Ctx(), &webhook.DeleteWebhookRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteWebhook, "delete webhook", webhooks))
+
+	var limit int32
+	listDelivery := &cobra.Command{
+		Use:   "{{alias}} [<webhook>]",
+		Short: "Return the delivery history of webhooks.",
+		Long:  "This command returns deliveries to a webhook, or to all webhooks, newest first.  Pending deliveries show when they will next be attempted in --raw output.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(cmd *cobra.Command, args []string) (retErr error) {
+			req := &webhook.ListDeliveryRequest{Limit: limit}
+			if len(args) > 0 {
+				req.Webhook = args[0]
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			client, err := c.WebhookAPIClient.ListDelivery(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *webhook.ListDeliveryResponse) error {
+					return errors.Wrap(encoder.EncodeProto(res.Info), "encode proto")
+				}))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.DeliveryHeader)
+			defer errors.Invoke(&retErr, writer.Flush, "flush output")
+			return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *webhook.ListDeliveryResponse) error {
+				pretty.PrintDeliveryInfo(writer, res.Info)
+				return nil
+			}))
+		}),
+	}
+	listDelivery.Flags().Int32Var(&limit, "limit", 50, "Return at most this many deliveries; 0 returns all of them.")
+	listDelivery.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(listDelivery, "list delivery", deliveries))
+
+	return commands
+}
+
+// parseFilter builds a filter from command-line flags.  States are matched
+// against the job, pipeline, and commit state names.
+func parseFilter(types, projects, pipelines, repos, branches, states []string) (*webhook.Filter, error) {
+	f := &webhook.Filter{
+		Projects:  projects,
+		Pipelines: pipelines,
+		Repos:     repos,
+		Branches:  branches,
+	}
+	for _, t := range types {
+		v, ok := webhook.EventType_value[strings.ToUpper(t)]
+		if !ok || v == 0 {
+			return nil, errors.Errorf("unknown event type %q; expected job, pipeline, commit, or log", t)
+		}
+		f.Types = append(f.Types, webhook.EventType(v))
+	}
+	for _, s := range states {
+		s = strings.ToUpper(s)
+		if v, ok := pps.JobState_value[s]; ok && v != 0 {
+			f.JobStates = append(f.JobStates, pps.JobState(v))
+		} else if v, ok := pps.PipelineState_value[s]; ok && v != 0 {
+			f.PipelineStates = append(f.PipelineStates, pps.PipelineState(v))
+		} else if v, ok := pfs.CommitState_value[s]; ok && v != 0 {
+			f.CommitStates = append(f.CommitStates, pfs.CommitState(v))
+		} else {
+			return nil, errors.Errorf("unknown state %q", s)
+		}
+	}
+	return f, nil
+}
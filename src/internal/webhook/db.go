@@ -0,0 +1,284 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	insertWebhook = `insert into webhook.webhooks (name, url, secret, filter) values ($1, $2, $3, $4::jsonb)`
+	upsertWebhook = insertWebhook + ` on conflict (name) do update
+		set url = excluded.url, secret = excluded.secret, filter = excluded.filter, updated_at = now()`
+	selectWebhooks = `select name, url, secret, filter, created_at, updated_at from webhook.webhooks`
+	deleteWebhook  = `delete from webhook.webhooks where name = $1`
+
+	insertDelivery = `insert into webhook.deliveries (webhook, event) values ($1, $2::jsonb)`
+	selectDelivery = `select id, webhook, event, state, attempts, last_status_code, last_error,
+		created_at, last_attempt_at, next_attempt_at from webhook.deliveries`
+	// Only the webhook master sends deliveries, so due deliveries don't need
+	// to be locked while they're in flight.
+	selectDueDeliveries = `select d.id, d.event, d.attempts, w.name, w.url, w.secret
+		from webhook.deliveries d join webhook.webhooks w on d.webhook = w.name
+		where d.state = 'PENDING' and d.next_attempt_at <= now()
+		order by d.next_attempt_at limit $1`
+	updateDelivery = `update webhook.deliveries set state = $2, attempts = $3, last_status_code = $4,
+		last_error = $5, last_attempt_at = now(), next_attempt_at = now() + $6 * interval '1 second'
+		where id = $1`
+	deleteOldDeliveries = `delete from webhook.deliveries where state <> 'PENDING' and created_at < $1`
+)
+
+// WebhookNotFoundError is returned when a webhook does not exist.
+type WebhookNotFoundError struct {
+	Name string
+}
+
+func (err *WebhookNotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, err.Error())
+}
+
+func (err *WebhookNotFoundError) Error() string {
+	return fmt.Sprintf("webhook %q not found", err.Name)
+}
+
+// WebhookExistsError is returned when creating a webhook whose name is taken.
+type WebhookExistsError struct {
+	Name string
+}
+
+func (err *WebhookExistsError) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, err.Error())
+}
+
+func (err *WebhookExistsError) Error() string {
+	return fmt.Sprintf("webhook %q already exists", err.Name)
+}
+
+type webhookRecord struct {
+	Name      string    `db:"name"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+	Filter    []byte    `db:"filter"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (r *webhookRecord) toInfo() (*webhookpb.WebhookInfo, error) {
+	filter := &webhookpb.Filter{}
+	if err := protojson.Unmarshal(r.Filter, filter); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal filter of webhook %q", r.Name)
+	}
+	return &webhookpb.WebhookInfo{
+		Name:      r.Name,
+		Url:       r.URL,
+		Filter:    filter,
+		HasSecret: r.Secret != "",
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}, nil
+}
+
+type deliveryRecord struct {
+	ID             int64        `db:"id"`
+	Webhook        string       `db:"webhook"`
+	Event          []byte       `db:"event"`
+	State          string       `db:"state"`
+	Attempts       int32        `db:"attempts"`
+	LastStatusCode int32        `db:"last_status_code"`
+	LastError      string       `db:"last_error"`
+	CreatedAt      time.Time    `db:"created_at"`
+	LastAttemptAt  sql.NullTime `db:"last_attempt_at"`
+	NextAttemptAt  time.Time    `db:"next_attempt_at"`
+}
+
+func (r *deliveryRecord) toInfo() (*webhookpb.DeliveryInfo, error) {
+	event := &webhookpb.Event{}
+	if err := protojson.Unmarshal(r.Event, event); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal event of delivery %d", r.ID)
+	}
+	info := &webhookpb.DeliveryInfo{
+		Id:             r.ID,
+		Webhook:        r.Webhook,
+		Event:          event,
+		State:          webhookpb.DeliveryState(webhookpb.DeliveryState_value[r.State]),
+		Attempts:       r.Attempts,
+		LastStatusCode: r.LastStatusCode,
+		LastError:      r.LastError,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+	}
+	if r.LastAttemptAt.Valid {
+		info.LastAttemptAt = timestamppb.New(r.LastAttemptAt.Time)
+	}
+	if info.State == webhookpb.DeliveryState_PENDING {
+		info.NextAttemptAt = timestamppb.New(r.NextAttemptAt)
+	}
+	return info, nil
+}
+
+// CreateWebhook stores a webhook, replacing an existing one of the same name
+// if req.Update is set.
+func CreateWebhook(ctx context.Context, tx *pachsql.Tx, req *webhookpb.CreateWebhookRequest) error {
+	filter, err := protojson.Marshal(req.GetFilter())
+	if err != nil {
+		return errors.Wrap(err, "marshal filter")
+	}
+	query := insertWebhook
+	if req.Update {
+		query = upsertWebhook
+	}
+	if _, err := tx.ExecContext(ctx, query, req.Name, req.Url, req.Secret, string(filter)); err != nil {
+		if dbutil.IsUniqueViolation(err) {
+			return &WebhookExistsError{Name: req.Name}
+		}
+		return errors.Wrap(err, "insert webhook")
+	}
+	return nil
+}
+
+// GetWebhook returns the webhook with the given name.
+func GetWebhook(ctx context.Context, tx *pachsql.Tx, name string) (*webhookpb.WebhookInfo, error) {
+	var r webhookRecord
+	if err := sqlx.GetContext(ctx, tx, &r, selectWebhooks+` where name = $1`, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &WebhookNotFoundError{Name: name}
+		}
+		return nil, errors.Wrap(err, "get webhook")
+	}
+	return r.toInfo()
+}
+
+// ListWebhooks returns all webhooks, ordered by name.
+func ListWebhooks(ctx context.Context, tx *pachsql.Tx) ([]*webhookpb.WebhookInfo, error) {
+	records, err := listWebhookRecords(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*webhookpb.WebhookInfo
+	for _, r := range records {
+		info, err := r.toInfo()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+func listWebhookRecords(ctx context.Context, tx *pachsql.Tx) ([]webhookRecord, error) {
+	var records []webhookRecord
+	if err := sqlx.SelectContext(ctx, tx, &records, selectWebhooks+` order by name`); err != nil {
+		return nil, errors.Wrap(err, "list webhooks")
+	}
+	return records, nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery history.
+func DeleteWebhook(ctx context.Context, tx *pachsql.Tx, name string) error {
+	res, err := tx.ExecContext(ctx, deleteWebhook, name)
+	if err != nil {
+		return errors.Wrap(err, "delete webhook")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "rows affected")
+	}
+	if n == 0 {
+		return &WebhookNotFoundError{Name: name}
+	}
+	return nil
+}
+
+// ListDeliveries returns the deliveries to a webhook (or to all webhooks, if
+// webhook is empty), newest first.
+func ListDeliveries(ctx context.Context, tx *pachsql.Tx, webhook string, limit int32) ([]*webhookpb.DeliveryInfo, error) {
+	query := selectDelivery + ` where ($1 = '' or webhook = $1) order by id desc`
+	args := []any{webhook}
+	if limit > 0 {
+		query += ` limit $2`
+		args = append(args, limit)
+	}
+	var records []deliveryRecord
+	if err := sqlx.SelectContext(ctx, tx, &records, query, args...); err != nil {
+		return nil, errors.Wrap(err, "list deliveries")
+	}
+	var ret []*webhookpb.DeliveryInfo
+	for _, r := range records {
+		info, err := r.toInfo()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+// Enqueue creates a pending delivery of event to each webhook whose filter it
+// matches, and returns how many were created.
+func Enqueue(ctx context.Context, tx *pachsql.Tx, event *webhookpb.Event) (int, error) {
+	records, err := listWebhookRecords(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	var body []byte
+	var n int
+	for _, r := range records {
+		info, err := r.toInfo()
+		if err != nil {
+			return 0, err
+		}
+		if !Match(info.Filter, event) {
+			continue
+		}
+		if body == nil {
+			if body, err = protojson.Marshal(event); err != nil {
+				return 0, errors.Wrap(err, "marshal event")
+			}
+		}
+		if _, err := tx.ExecContext(ctx, insertDelivery, r.Name, string(body)); err != nil {
+			return 0, errors.Wrapf(err, "insert delivery to %q", r.Name)
+		}
+		n++
+	}
+	return n, nil
+}
+
+type pendingDelivery struct {
+	ID       int64  `db:"id"`
+	Event    []byte `db:"event"`
+	Attempts int32  `db:"attempts"`
+	Webhook  string `db:"name"`
+	URL      string `db:"url"`
+	Secret   string `db:"secret"`
+}
+
+func listDueDeliveries(ctx context.Context, tx *pachsql.Tx, limit int) ([]pendingDelivery, error) {
+	var ds []pendingDelivery
+	if err := sqlx.SelectContext(ctx, tx, &ds, selectDueDeliveries, limit); err != nil {
+		return nil, errors.Wrap(err, "list due deliveries")
+	}
+	return ds, nil
+}
+
+func recordAttempt(ctx context.Context, tx *pachsql.Tx, id int64, state webhookpb.DeliveryState, attempts int32, statusCode int, lastErr string, retryAfter time.Duration) error {
+	if _, err := tx.ExecContext(ctx, updateDelivery, id, state.String(), attempts, statusCode, lastErr, retryAfter.Seconds()); err != nil {
+		return errors.Wrapf(err, "update delivery %d", id)
+	}
+	return nil
+}
+
+func deleteDeliveriesBefore(ctx context.Context, tx *pachsql.Tx, t time.Time) error {
+	_, err := tx.ExecContext(ctx, deleteOldDeliveries, t)
+	return errors.Wrap(err, "delete old deliveries")
+}
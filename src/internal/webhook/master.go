@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	etcd "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	masterLockPath = "webhook-master-lock"
+	// terminalRetention is how long the master remembers that an object
+	// reached a terminal state.  Later writes to the object that don't change
+	// its state (e.g. updated job stats) are not notified.
+	terminalRetention = time.Hour
+)
+
+// Env contains the dependencies of the webhook master.
+type Env struct {
+	DB         *pachsql.DB
+	Listener   col.PostgresListener
+	EtcdClient *etcd.Client
+	EtcdPrefix string
+	NewDLock   func(prefix string) dlock.DLock
+	// Sender delivers events; a Sender with default settings is used if nil.
+	Sender *Sender
+}
+
+// Master watches jobs, pipelines, and commits for state changes, and
+// delivers them to the registered webhooks.  Only one master is active in a
+// cluster at a time.
+type Master struct {
+	env       Env
+	jobs      col.PostgresCollection
+	pipelines col.PostgresCollection
+}
+
+func NewMaster(env Env) *Master {
+	if env.Sender == nil {
+		env.Sender = &Sender{DB: env.DB}
+	}
+	return &Master{
+		env:       env,
+		jobs:      ppsdb.Jobs(env.DB, env.Listener),
+		pipelines: ppsdb.Pipelines(env.DB, env.Listener),
+	}
+}
+
+func (m *Master) Run(ctx context.Context) error {
+	return backoff.RetryUntilCancel(ctx, func() error {
+		lock := m.env.NewDLock(path.Join(m.env.EtcdPrefix, masterLockPath))
+		ctx, err := lock.Lock(ctx)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer func() {
+			if err := lock.Unlock(ctx); err != nil {
+				log.Error(ctx, "error unlocking in webhook master", zap.Error(err))
+			}
+		}()
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.Go(func() error { return m.watchJobs(pctx.Child(ctx, "watchJobs")) })
+		eg.Go(func() error { return m.watchPipelines(pctx.Child(ctx, "watchPipelines")) })
+		eg.Go(func() error { return m.watchCommits(pctx.Child(ctx, "watchCommits")) })
+		eg.Go(func() error { return m.env.Sender.Run(pctx.Child(ctx, "sender")) })
+		return errors.EnsureStack(eg.Wait())
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Error(ctx, "error in webhook master; restarting", zap.Error(err), zap.Duration("retryAfter", d))
+		return nil
+	})
+}
+
+func (m *Master) newTracker(ctx context.Context) (*tracker, error) {
+	var now time.Time
+	if err := m.env.DB.GetContext(ctx, &now, `select now()`); err != nil {
+		return nil, errors.Wrap(err, "get database time")
+	}
+	return newTracker(now), nil
+}
+
+func (m *Master) watchJobs(ctx context.Context) error {
+	t, err := m.newTracker(ctx)
+	if err != nil {
+		return err
+	}
+	jobInfo := &pps.JobInfo{}
+	return errors.EnsureStack(m.jobs.ReadOnly().WatchF(ctx, func(e *watch.Event) error {
+		switch e.Type {
+		case watch.EventError:
+			return e.Err
+		case watch.EventDelete:
+			t.forget(string(e.Key))
+			return nil
+		}
+		var key string
+		if err := e.Unmarshal(&key, jobInfo); err != nil {
+			return errors.Wrap(err, "unmarshal job info")
+		}
+		changedAt := jobInfo.GetCreated().AsTime()
+		if jobInfo.Finished != nil {
+			changedAt = jobInfo.Finished.AsTime()
+		} else if jobInfo.Started != nil {
+			changedAt = jobInfo.Started.AsTime()
+		}
+		state := jobInfo.State.String()
+		if !t.observe(key, state, changedAt, pps.IsTerminal(jobInfo.State)) {
+			return nil
+		}
+		return m.notify(ctx, &webhookpb.Event{
+			Type:     webhookpb.EventType_JOB,
+			Time:     timestamppb.New(changedAt),
+			Project:  jobInfo.Job.GetPipeline().GetProject().GetName(),
+			Pipeline: jobInfo.Job.GetPipeline().GetName(),
+			Id:       jobInfo.Job.GetId(),
+			State:    state,
+			Reason:   jobInfo.Reason,
+		})
+	}))
+}
+
+func (m *Master) watchPipelines(ctx context.Context) error {
+	t, err := m.newTracker(ctx)
+	if err != nil {
+		return err
+	}
+	pipelineInfo := &pps.PipelineInfo{}
+	return errors.EnsureStack(m.pipelines.ReadOnly().WatchF(ctx, func(e *watch.Event) error {
+		if e.Type == watch.EventError {
+			return e.Err
+		}
+		if e.Type == watch.EventDelete {
+			// The key of a pipeline row includes its version, and there's
+			// no value to recover the pipeline from, so deleted pipelines
+			// are left to be overwritten if they're recreated.
+			return nil
+		}
+		var key string
+		if err := e.Unmarshal(&key, pipelineInfo); err != nil {
+			return errors.Wrap(err, "unmarshal pipeline info")
+		}
+		// Every version of a pipeline is a separate row; track the pipeline
+		// as a whole so that a new version doesn't look like a state change.
+		changedAt := time.Unix(e.Rev, 0)
+		state := pipelineInfo.State.String()
+		if !t.observe(pipelineInfo.Pipeline.String(), state, changedAt, false) {
+			return nil
+		}
+		return m.notify(ctx, &webhookpb.Event{
+			Type:     webhookpb.EventType_PIPELINE,
+			Time:     timestamppb.New(changedAt),
+			Project:  pipelineInfo.Pipeline.GetProject().GetName(),
+			Pipeline: pipelineInfo.Pipeline.GetName(),
+			State:    state,
+			Reason:   pipelineInfo.Reason,
+		})
+	}))
+}
+
+func (m *Master) watchCommits(ctx context.Context) error {
+	t, err := m.newTracker(ctx)
+	if err != nil {
+		return err
+	}
+	return errors.EnsureStack(pfsdb.WatchCommits(ctx, m.env.DB, m.env.Listener, func(commit pfsdb.Commit) error {
+		ci := commit.CommitInfo
+		if ci.GetCommit().GetRepo().GetType() != pfs.UserRepoType {
+			return nil
+		}
+		state := commitState(ci)
+		changedAt := ci.GetStarted().AsTime()
+		for _, ts := range []*timestamppb.Timestamp{ci.Finishing, ci.Finished} {
+			if ts != nil && ts.AsTime().After(changedAt) {
+				changedAt = ts.AsTime()
+			}
+		}
+		if !t.observe(commitKey(commit.ID), state.String(), changedAt, state == pfs.CommitState_FINISHED) {
+			return nil
+		}
+		return m.notify(ctx, &webhookpb.Event{
+			Type:    webhookpb.EventType_COMMIT,
+			Time:    timestamppb.New(changedAt),
+			Project: ci.Commit.Repo.GetProject().GetName(),
+			Repo:    ci.Commit.Repo.GetName(),
+			Branch:  ci.Commit.GetBranch().GetName(),
+			Id:      ci.Commit.GetId(),
+			State:   state.String(),
+			Reason:  ci.Error,
+		})
+	}, func(id pfsdb.CommitID) error {
+		t.forget(commitKey(id))
+		return nil
+	}))
+}
+
+func commitKey(id pfsdb.CommitID) string {
+	return fmt.Sprintf("commit-%d", id)
+}
+
+func (m *Master) notify(ctx context.Context, event *webhookpb.Event) error {
+	var n int
+	if err := dbutil.WithTx(ctx, m.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		n, err = Enqueue(ctx, tx, event)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "enqueue deliveries")
+	}
+	if n > 0 {
+		log.Debug(ctx, "enqueued webhook deliveries", zap.Stringer("type", event.Type), zap.String("id", event.Id), zap.String("state", event.State), zap.Int("deliveries", n))
+	}
+	return nil
+}
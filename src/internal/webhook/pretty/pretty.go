@@ -0,0 +1,102 @@
+// Package pretty implements pretty-printing for webhooks
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	WebhookHeader  = "NAME\tURL\tFILTER\tCREATED\t\n"
+	DeliveryHeader = "ID\tWEBHOOK\tEVENT\tSTATE\tATTEMPTS\tSTATUS\tCREATED\t\n"
+)
+
+func PrintWebhookInfo(w io.Writer, info *webhook.WebhookInfo) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", info.GetName(), info.GetUrl(), Filter(info.GetFilter()), pretty.Ago(info.GetCreatedAt()))
+}
+
+func PrintDetailedWebhookInfo(info *webhook.WebhookInfo) error {
+	t, err := template.New("WebhookInfo").Funcs(funcMap).Parse(
+		`Name: {{.Name}}
+URL: {{.Url}}
+Signed: {{.HasSecret}}
+Filter: {{prettyFilter .Filter}}
+Created: {{prettyAgo .CreatedAt}}
+Updated: {{prettyAgo .UpdatedAt}}
+`)
+	if err != nil {
+		return errors.Wrap(err, "parse template")
+	}
+	return errors.Wrap(t.Execute(os.Stdout, info), "execute template")
+}
+
+func PrintDeliveryInfo(w io.Writer, info *webhook.DeliveryInfo) {
+	status := "-"
+	if info.GetLastStatusCode() != 0 {
+		status = fmt.Sprint(info.GetLastStatusCode())
+	} else if info.GetLastError() != "" {
+		status = "error"
+	}
+	fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\t\n", info.GetId(), info.GetWebhook(), Event(info.GetEvent()), info.GetState(), info.GetAttempts(), status, pretty.Ago(info.GetCreatedAt()))
+}
+
+// Filter returns a one-line summary of a webhook filter.
+func Filter(f *webhook.Filter) string {
+	var parts []string
+	add := func(name string, vals []string) {
+		if len(vals) > 0 {
+			parts = append(parts, name+"="+strings.Join(vals, ","))
+		}
+	}
+	add("types", stringers(f.GetTypes()))
+	add("projects", f.GetProjects())
+	add("pipelines", f.GetPipelines())
+	add("repos", f.GetRepos())
+	add("branches", f.GetBranches())
+	add("job_states", stringers(f.GetJobStates()))
+	add("pipeline_states", stringers(f.GetPipelineStates()))
+	add("commit_states", stringers(f.GetCommitStates()))
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Event returns a one-line summary of a webhook event.
+func Event(e *webhook.Event) string {
+	switch e.GetType() {
+	case webhook.EventType_JOB:
+		return fmt.Sprintf("job %s/%s@%s %s", e.GetProject(), e.GetPipeline(), e.GetId(), e.GetState())
+	case webhook.EventType_PIPELINE:
+		return fmt.Sprintf("pipeline %s/%s %s", e.GetProject(), e.GetPipeline(), e.GetState())
+	case webhook.EventType_COMMIT:
+		branch := ""
+		if e.GetBranch() != "" {
+			branch = e.GetBranch() + "="
+		}
+		return fmt.Sprintf("commit %s/%s@%s%s %s", e.GetProject(), e.GetRepo(), branch, e.GetId(), e.GetState())
+	case webhook.EventType_LOG:
+		return fmt.Sprintf("log %s/%s %s", e.GetProject(), e.GetPipeline(), e.GetRule())
+	}
+	return e.GetType().String()
+}
+
+func stringers[T fmt.Stringer](xs []T) []string {
+	var ret []string
+	for _, x := range xs {
+		ret = append(ret, x.String())
+	}
+	return ret
+}
+
+var funcMap = template.FuncMap{
+	"prettyAgo":    pretty.Ago,
+	"prettyFilter": Filter,
+}
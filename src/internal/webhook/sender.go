@@ -0,0 +1,164 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	defaultPollInterval     = time.Second
+	defaultTimeout          = 10 * time.Second
+	defaultMaxAttempts      = 8
+	defaultHistoryRetention = 7 * 24 * time.Hour
+	sendBatchSize           = 100
+	sendParallelism         = 10
+	minRetryDelay           = 10 * time.Second
+	maxRetryDelay           = time.Hour
+)
+
+// Sender POSTs pending deliveries to their webhooks, retrying failures with
+// exponential backoff.  Zero-valued fields take their defaults.
+type Sender struct {
+	DB     *pachsql.DB
+	Client *http.Client
+	// PollInterval is how often the sender looks for due deliveries.
+	PollInterval time.Duration
+	// Timeout bounds each attempt.
+	Timeout time.Duration
+	// MaxAttempts is the number of attempts after which a delivery fails.
+	MaxAttempts int32
+	// HistoryRetention is how long finished deliveries are kept.
+	HistoryRetention time.Duration
+}
+
+// Run sends deliveries until ctx is done.
+func (s *Sender) Run(ctx context.Context) error {
+	ticker := time.NewTicker(orDefault(s.PollInterval, defaultPollInterval))
+	defer ticker.Stop()
+	var lastCleanup time.Time
+	for {
+		if err := s.SendDue(ctx); err != nil {
+			return err
+		}
+		if time.Since(lastCleanup) > time.Hour {
+			if err := dbutil.WithTx(ctx, s.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+				return deleteDeliveriesBefore(ctx, tx, time.Now().Add(-orDefault(s.HistoryRetention, defaultHistoryRetention)))
+			}); err != nil {
+				return err
+			}
+			lastCleanup = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue makes one attempt at each delivery that is due.
+func (s *Sender) SendDue(ctx context.Context) error {
+	var due []pendingDelivery
+	if err := dbutil.WithTx(ctx, s.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		due, err = listDueDeliveries(ctx, tx, sendBatchSize)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return err
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(sendParallelism)
+	for _, d := range due {
+		eg.Go(func() error {
+			return s.attempt(ctx, d)
+		})
+	}
+	return errors.EnsureStack(eg.Wait())
+}
+
+func (s *Sender) attempt(ctx context.Context, d pendingDelivery) error {
+	attempts := d.Attempts + 1
+	code, err := s.post(ctx, d)
+	state := webhookpb.DeliveryState_PENDING
+	var lastErr string
+	switch {
+	case err == nil:
+		state = webhookpb.DeliveryState_DELIVERED
+	case attempts >= orDefault(s.MaxAttempts, defaultMaxAttempts):
+		state = webhookpb.DeliveryState_FAILED
+		fallthrough
+	default:
+		lastErr = err.Error()
+		log.Info(ctx, "webhook delivery failed", zap.String("webhook", d.Webhook), zap.Int64("delivery", d.ID), zap.Int32("attempts", attempts), zap.Error(err))
+	}
+	return dbutil.WithTx(ctx, s.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		return recordAttempt(ctx, tx, d.ID, state, attempts, code, lastErr, retryDelay(attempts))
+	})
+}
+
+func (s *Sender) post(ctx context.Context, d pendingDelivery) (_ int, retErr error) {
+	var event webhookpb.Event
+	if err := protojson.Unmarshal(d.Event, &event); err != nil {
+		return 0, errors.Wrap(err, "unmarshal event")
+	}
+	ctx, cancel := context.WithTimeout(ctx, orDefault(s.Timeout, defaultTimeout))
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Event))
+	if err != nil {
+		return 0, errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(EventHeader, event.Type.String())
+	if d.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(d.Secret, d.Event))
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "post")
+	}
+	defer errors.Close(&retErr, resp.Body, "close response body")
+	if _, err := io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16)); err != nil {
+		return resp.StatusCode, errors.Wrap(err, "read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// retryDelay returns how long to wait after the given number of failed
+// attempts.
+func retryDelay(attempts int32) time.Duration {
+	d := minRetryDelay
+	for i := int32(1); i < attempts && d < maxRetryDelay; i++ {
+		d *= 2
+	}
+	return min(d, maxRetryDelay)
+}
+
+func orDefault[T comparable](v, def T) T {
+	var zero T
+	if v == zero {
+		return def
+	}
+	return v
+}
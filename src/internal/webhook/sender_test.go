@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestSender(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	db := dockertestenv.NewMigratedTestDB(t, clusterstate.DesiredClusterState)
+
+	var gotBody []byte
+	var gotHeader http.Header
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header
+	}))
+	t.Cleanup(srv.Close)
+
+	withTx := func(f func(ctx context.Context, tx *pachsql.Tx) error) {
+		t.Helper()
+		require.NoError(t, dbutil.WithTx(ctx, db, f))
+	}
+	withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		if err := CreateWebhook(ctx, tx, &webhookpb.CreateWebhookRequest{Name: "jobs", Url: srv.URL, Secret: "secret", Filter: &webhookpb.Filter{Types: []webhookpb.EventType{webhookpb.EventType_JOB}}}); err != nil {
+			return err
+		}
+		return CreateWebhook(ctx, tx, &webhookpb.CreateWebhookRequest{Name: "commits", Url: srv.URL, Filter: &webhookpb.Filter{Types: []webhookpb.EventType{webhookpb.EventType_COMMIT}}})
+	})
+	withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		n, err := Enqueue(ctx, tx, &webhookpb.Event{Type: webhookpb.EventType_JOB, Project: "default", Pipeline: "edges", Id: "abc", State: "JOB_SUCCESS"})
+		require.Equal(t, 1, n)
+		return err
+	})
+	listDeliveries := func() []*webhookpb.DeliveryInfo {
+		t.Helper()
+		var ds []*webhookpb.DeliveryInfo
+		withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+			var err error
+			ds, err = ListDeliveries(ctx, tx, "", 0)
+			return err
+		})
+		return ds
+	}
+
+	s := &Sender{DB: db, MaxAttempts: 2}
+	require.NoError(t, s.SendDue(ctx))
+	ds := listDeliveries()
+	require.Equal(t, 1, len(ds))
+	require.Equal(t, webhookpb.DeliveryState_PENDING, ds[0].State)
+	require.Equal(t, int32(1), ds[0].Attempts)
+	require.Equal(t, int32(http.StatusServiceUnavailable), ds[0].LastStatusCode)
+
+	// Make the retry due now.
+	withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `update webhook.deliveries set next_attempt_at = now()`)
+		return err
+	})
+	fail = false
+	require.NoError(t, s.SendDue(ctx))
+	ds = listDeliveries()
+	require.Equal(t, webhookpb.DeliveryState_DELIVERED, ds[0].State)
+	require.Equal(t, int32(2), ds[0].Attempts)
+	require.Equal(t, "jobs", ds[0].Webhook)
+	require.Equal(t, "edges", ds[0].Event.Pipeline)
+	require.True(t, Verify("secret", gotBody, gotHeader.Get(SignatureHeader)))
+	require.Equal(t, "JOB", gotHeader.Get(EventHeader))
+
+	withTx(func(ctx context.Context, tx *pachsql.Tx) error {
+		return DeleteWebhook(ctx, tx, "jobs")
+	})
+	require.Equal(t, 0, len(listDeliveries()))
+}
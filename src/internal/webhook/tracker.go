@@ -0,0 +1,73 @@
+package webhook
+
+import "time"
+
+// tracker remembers the last state seen for each watched object, so that only
+// state changes are notified.
+type tracker struct {
+	// cutoff is when the watch started.  Changes before it were either
+	// notified by a previous master or happened while no master was running,
+	// and are not notified.
+	cutoff    time.Time
+	states    map[string]trackedState
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+type trackedState struct {
+	state     string
+	terminal  bool
+	changedAt time.Time
+}
+
+func newTracker(cutoff time.Time) *tracker {
+	return &tracker{
+		// The watches report times with second precision.
+		cutoff:    cutoff.Truncate(time.Second),
+		states:    make(map[string]trackedState),
+		lastPrune: cutoff,
+		now:       time.Now,
+	}
+}
+
+// observe records that the object identified by key is in state as of
+// changedAt, and reports whether that is a change that should be notified.
+func (t *tracker) observe(key, state string, changedAt time.Time, terminal bool) bool {
+	now := t.now()
+	t.prune(now)
+	prev, ok := t.states[key]
+	if ok && prev.state == state {
+		return false
+	}
+	if changedAt.Before(t.cutoff) || (terminal && now.Sub(changedAt) > terminalRetention) {
+		// An old change, either from the watch's initial listing or a write
+		// to an object that finished long ago.
+		if terminal {
+			delete(t.states, key)
+		} else {
+			t.states[key] = trackedState{state: state, changedAt: changedAt}
+		}
+		return false
+	}
+	t.states[key] = trackedState{state: state, terminal: terminal, changedAt: changedAt}
+	return true
+}
+
+// forget drops an object that was deleted.
+func (t *tracker) forget(key string) {
+	delete(t.states, key)
+}
+
+// prune drops objects that reached a terminal state long enough ago that
+// observe will ignore them anyway.
+func (t *tracker) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < terminalRetention {
+		return
+	}
+	t.lastPrune = now
+	for key, s := range t.states {
+		if s.terminal && now.Sub(s.changedAt) > terminalRetention {
+			delete(t.states, key)
+		}
+	}
+}
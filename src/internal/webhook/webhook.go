@@ -0,0 +1,92 @@
+// Package webhook implements webhook notifications for job, pipeline, and
+// commit state changes.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the request body,
+	// if the webhook has a secret.
+	SignatureHeader = "X-Pachyderm-Signature"
+	// DeliveryHeader carries the delivery ID, which is the same across retries.
+	DeliveryHeader = "X-Pachyderm-Delivery"
+	// EventHeader carries the event type, e.g. "JOB".
+	EventHeader = "X-Pachyderm-Event"
+)
+
+// Sign returns the value of the signature header for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is a valid signature of body.  Receivers
+// written in Go can use it to check deliveries.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Match reports whether event passes filter.
+func Match(filter *webhookpb.Filter, event *webhookpb.Event) bool {
+	if len(filter.GetTypes()) > 0 && !slices.Contains(filter.GetTypes(), event.GetType()) {
+		return false
+	}
+	if len(filter.GetProjects()) > 0 && !slices.Contains(filter.GetProjects(), event.GetProject()) {
+		return false
+	}
+	if len(filter.GetPipelines()) > 0 {
+		// Commit events match on their repo, since a pipeline's output repo
+		// shares its name.
+		name := event.GetPipeline()
+		if event.GetType() == webhookpb.EventType_COMMIT {
+			name = event.GetRepo()
+		}
+		if !slices.Contains(filter.GetPipelines(), name) {
+			return false
+		}
+	}
+	if len(filter.GetRepos()) > 0 && (event.GetType() != webhookpb.EventType_COMMIT || !slices.Contains(filter.GetRepos(), event.GetRepo())) {
+		return false
+	}
+	if len(filter.GetBranches()) > 0 && (event.GetType() != webhookpb.EventType_COMMIT || !slices.Contains(filter.GetBranches(), event.GetBranch())) {
+		return false
+	}
+	switch event.GetType() {
+	case webhookpb.EventType_JOB:
+		if len(filter.GetJobStates()) > 0 && !slices.Contains(filter.GetJobStates(), pps.JobState(pps.JobState_value[event.GetState()])) {
+			return false
+		}
+	case webhookpb.EventType_PIPELINE:
+		if len(filter.GetPipelineStates()) > 0 && !slices.Contains(filter.GetPipelineStates(), pps.PipelineState(pps.PipelineState_value[event.GetState()])) {
+			return false
+		}
+	case webhookpb.EventType_COMMIT:
+		if len(filter.GetCommitStates()) > 0 && !slices.Contains(filter.GetCommitStates(), pfs.CommitState(pfs.CommitState_value[event.GetState()])) {
+			return false
+		}
+	}
+	return true
+}
+
+// commitState returns the most specific state of a commit that can be
+// determined from its timestamps.
+func commitState(ci *pfs.CommitInfo) pfs.CommitState {
+	switch {
+	case ci.GetFinished() != nil:
+		return pfs.CommitState_FINISHED
+	case ci.GetFinishing() != nil:
+		return pfs.CommitState_FINISHING
+	default:
+		return pfs.CommitState_STARTED
+	}
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	webhookpb "github.com/pachyderm/pachyderm/v2/src/webhook"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"JOB"}`)
+	sig := Sign("secret", body)
+	// echo -n '{"type":"JOB"}' | openssl dgst -sha256 -hmac secret
+	require.Equal(t, "sha256=acbbf819dbe59ef71fcfd3b83de8158403e5e47f2bdd024c3f3e73f66bf5c88d", sig)
+	require.True(t, Verify("secret", body, sig))
+	require.False(t, Verify("other", body, sig))
+	require.False(t, Verify("secret", []byte(`{"type":"COMMIT"}`), sig))
+}
+
+func TestMatch(t *testing.T) {
+	job := &webhookpb.Event{Type: webhookpb.EventType_JOB, Project: "default", Pipeline: "edges", Id: "abc", State: pps.JobState_JOB_FAILURE.String()}
+	pipeline := &webhookpb.Event{Type: webhookpb.EventType_PIPELINE, Project: "default", Pipeline: "edges", State: pps.PipelineState_PIPELINE_CRASHING.String()}
+	commit := &webhookpb.Event{Type: webhookpb.EventType_COMMIT, Project: "default", Repo: "edges", Branch: "master", Id: "abc", State: pfs.CommitState_FINISHED.String()}
+	logRule := &webhookpb.Event{Type: webhookpb.EventType_LOG, Project: "default", Pipeline: "edges", Rule: "accuracy", Message: "ACCURACY=0.93"}
+	testData := []struct {
+		name   string
+		filter *webhookpb.Filter
+		want   []bool // job, pipeline, commit, log
+	}{
+		{
+			name: "empty",
+			want: []bool{true, true, true, true},
+		},
+		{
+			name:   "types",
+			filter: &webhookpb.Filter{Types: []webhookpb.EventType{webhookpb.EventType_JOB, webhookpb.EventType_COMMIT}},
+			want:   []bool{true, false, true, false},
+		},
+		{
+			name:   "other project",
+			filter: &webhookpb.Filter{Projects: []string{"other"}},
+			want:   []bool{false, false, false, false},
+		},
+		{
+			name:   "pipelines match output repo",
+			filter: &webhookpb.Filter{Pipelines: []string{"edges"}},
+			want:   []bool{true, true, true, true},
+		},
+		{
+			name:   "repos only match commits",
+			filter: &webhookpb.Filter{Repos: []string{"edges"}},
+			want:   []bool{false, false, true, false},
+		},
+		{
+			name:   "other branch",
+			filter: &webhookpb.Filter{Branches: []string{"dev"}},
+			want:   []bool{false, false, false, false},
+		},
+		{
+			name:   "job states",
+			filter: &webhookpb.Filter{JobStates: []pps.JobState{pps.JobState_JOB_FAILURE}},
+			want:   []bool{true, true, true, true},
+		},
+		{
+			name: "unmatched states",
+			filter: &webhookpb.Filter{
+				JobStates:      []pps.JobState{pps.JobState_JOB_SUCCESS},
+				PipelineStates: []pps.PipelineState{pps.PipelineState_PIPELINE_RUNNING},
+				CommitStates:   []pfs.CommitState{pfs.CommitState_STARTED},
+			},
+			want: []bool{false, false, false, true},
+		},
+	}
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			for i, e := range []*webhookpb.Event{job, pipeline, commit, logRule} {
+				require.Equal(t, test.want[i], Match(test.filter, e), "event %v", e.Type)
+			}
+		})
+	}
+}
+
+func TestTracker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	tr := newTracker(start)
+	tr.now = func() time.Time { return now }
+
+	// Objects from before the watch started are recorded but not notified.
+	require.False(t, tr.observe("a", "RUNNING", start.Add(-time.Minute), false))
+	require.False(t, tr.observe("b", "SUCCESS", start.Add(-time.Minute), true))
+
+	now = start.Add(time.Minute)
+	require.False(t, tr.observe("a", "RUNNING", now, false), "unchanged state")
+	require.True(t, tr.observe("a", "SUCCESS", now, true))
+	require.False(t, tr.observe("a", "SUCCESS", now, true), "repeated terminal state")
+	require.False(t, tr.observe("b", "SUCCESS", start.Add(-time.Minute), true), "old terminal state")
+	require.True(t, tr.observe("c", "CREATED", now, false), "new object")
+
+	// Long-finished objects are pruned, and not notified again.
+	now = start.Add(2 * terminalRetention)
+	require.False(t, tr.observe("a", "SUCCESS", start.Add(time.Minute), true))
+	_, ok := tr.states["a"]
+	require.False(t, ok)
+
+	tr.forget("c")
+	require.True(t, tr.observe("c", "CREATED", now, false), "recreated object")
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, minRetryDelay, retryDelay(1))
+	require.Equal(t, 2*minRetryDelay, retryDelay(2))
+	require.Equal(t, 8*minRetryDelay, retryDelay(4))
+	require.Equal(t, maxRetryDelay, retryDelay(100))
+}
@@ -1322,6 +1322,369 @@ func (x *LogMessage) GetPpsLogMessage() *pps.LogMessage {
 	return nil
 }
 
+// A LogRule is evaluated continuously against the logs of a pipeline, or of every pipeline in a
+// project.  Each matching line increments the pachyderm_log_rule_matches_total counter, labeled
+// with the rule's name and the line's project and pipeline.
+type LogRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The project whose logs are matched.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// The pipeline whose logs are matched.  If empty, every pipeline in the project is matched.
+	Pipeline string `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Lines that match this regular expression match the rule.  If it has a capture group, the
+	// first group of each matching line is parsed as a number and exported as the
+	// pachyderm_log_rule_value gauge.
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Only lines of this level or greater match the rule.  If unset, INFO.
+	Level LogLevel `protobuf:"varint,5,opt,name=level,proto3,enum=logs.LogLevel" json:"level,omitempty"`
+	// If true, matching lines are sent to the webhooks that accept LOG events, at most once a
+	// minute per rule.
+	Alert bool `protobuf:"varint,6,opt,name=alert,proto3" json:"alert,omitempty"`
+}
+
+func (x *LogRule) Reset() {
+	*x = LogRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRule) ProtoMessage() {}
+
+func (x *LogRule) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRule.ProtoReflect.Descriptor instead.
+func (*LogRule) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LogRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogRule) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *LogRule) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *LogRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *LogRule) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_UNSET
+}
+
+func (x *LogRule) GetAlert() bool {
+	if x != nil {
+		return x.Alert
+	}
+	return false
+}
+
+type CreateLogRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *LogRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Update replaces an existing rule with the same name.
+	Update bool `protobuf:"varint,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *CreateLogRuleRequest) Reset() {
+	*x = CreateLogRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLogRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLogRuleRequest) ProtoMessage() {}
+
+func (x *CreateLogRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLogRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateLogRuleRequest) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateLogRuleRequest) GetRule() *LogRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *CreateLogRuleRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type CreateLogRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLogRuleResponse) Reset() {
+	*x = CreateLogRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLogRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLogRuleResponse) ProtoMessage() {}
+
+func (x *CreateLogRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLogRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateLogRuleResponse) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{18}
+}
+
+type ListLogRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLogRuleRequest) Reset() {
+	*x = ListLogRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLogRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogRuleRequest) ProtoMessage() {}
+
+func (x *ListLogRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogRuleRequest.ProtoReflect.Descriptor instead.
+func (*ListLogRuleRequest) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{19}
+}
+
+type ListLogRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *LogRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *ListLogRuleResponse) Reset() {
+	*x = ListLogRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLogRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogRuleResponse) ProtoMessage() {}
+
+func (x *ListLogRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogRuleResponse.ProtoReflect.Descriptor instead.
+func (*ListLogRuleResponse) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListLogRuleResponse) GetRule() *LogRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteLogRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteLogRuleRequest) Reset() {
+	*x = DeleteLogRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLogRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogRuleRequest) ProtoMessage() {}
+
+func (x *DeleteLogRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLogRuleRequest) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteLogRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteLogRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLogRuleResponse) Reset() {
+	*x = DeleteLogRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logs_logs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLogRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogRuleResponse) ProtoMessage() {}
+
+func (x *DeleteLogRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_logs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLogRuleResponse) Descriptor() ([]byte, []int) {
+	return file_logs_logs_proto_rawDescGZIP(), []int{22}
+}
+
 var File_logs_logs_proto protoreflect.FileDescriptor
 
 var file_logs_logs_proto_rawDesc = []byte{
@@ -1475,21 +1838,62 @@ var file_logs_logs_proto_rawDesc = []byte{
 	0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x70, 0x73, 0x5f,
 	0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x70,
-	0x70, 0x73, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x5d, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f,
-	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x32, 0x41, 0x0a, 0x03, 0x41,
-	0x50, 0x49, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e,
-	0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2c,
-	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63,
-	0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d,
-	0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x73, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa9, 0x01, 0x0a,
+	0x07, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6c, 0x6f,
+	0x67, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f,
+	0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5d, 0x0a, 0x08, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f,
+	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46,
+	0x4f, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x32, 0xa1, 0x02, 0x0a, 0x03, 0x41, 0x50, 0x49,
+	0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6c, 0x6f,
+	0x67, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e,
+	0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x67, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79,
+	0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76,
+	0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1505,7 +1909,7 @@ func file_logs_logs_proto_rawDescGZIP() []byte {
 }
 
 var file_logs_logs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_logs_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_logs_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_logs_logs_proto_goTypes = []interface{}{
 	(LogLevel)(0),                 // 0: logs.LogLevel
 	(*LogQuery)(nil),              // 1: logs.LogQuery
@@ -1524,9 +1928,16 @@ var file_logs_logs_proto_goTypes = []interface{}{
 	(*PagingHint)(nil),            // 14: logs.PagingHint
 	(*VerbatimLogMessage)(nil),    // 15: logs.VerbatimLogMessage
 	(*LogMessage)(nil),            // 16: logs.LogMessage
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 18: google.protobuf.Struct
-	(*pps.LogMessage)(nil),        // 19: pps_v2.LogMessage
+	(*LogRule)(nil),               // 17: logs.LogRule
+	(*CreateLogRuleRequest)(nil),  // 18: logs.CreateLogRuleRequest
+	(*CreateLogRuleResponse)(nil), // 19: logs.CreateLogRuleResponse
+	(*ListLogRuleRequest)(nil),    // 20: logs.ListLogRuleRequest
+	(*ListLogRuleResponse)(nil),   // 21: logs.ListLogRuleResponse
+	(*DeleteLogRuleRequest)(nil),  // 22: logs.DeleteLogRuleRequest
+	(*DeleteLogRuleResponse)(nil), // 23: logs.DeleteLogRuleResponse
+	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 25: google.protobuf.Struct
+	(*pps.LogMessage)(nil),        // 26: pps_v2.LogMessage
 }
 var file_logs_logs_proto_depIdxs = []int32{
 	4,  // 0: logs.LogQuery.user:type_name -> logs.UserLogQuery
@@ -1544,26 +1955,35 @@ var file_logs_logs_proto_depIdxs = []int32{
 	10, // 12: logs.LogFilter.time_range:type_name -> logs.TimeRangeLogFilter
 	11, // 13: logs.LogFilter.regex:type_name -> logs.RegexLogFilter
 	0,  // 14: logs.LogFilter.level:type_name -> logs.LogLevel
-	17, // 15: logs.TimeRangeLogFilter.from:type_name -> google.protobuf.Timestamp
-	17, // 16: logs.TimeRangeLogFilter.until:type_name -> google.protobuf.Timestamp
+	24, // 15: logs.TimeRangeLogFilter.from:type_name -> google.protobuf.Timestamp
+	24, // 16: logs.TimeRangeLogFilter.until:type_name -> google.protobuf.Timestamp
 	1,  // 17: logs.GetLogsRequest.query:type_name -> logs.LogQuery
 	9,  // 18: logs.GetLogsRequest.filter:type_name -> logs.LogFilter
 	14, // 19: logs.GetLogsResponse.paging_hint:type_name -> logs.PagingHint
 	16, // 20: logs.GetLogsResponse.log:type_name -> logs.LogMessage
 	12, // 21: logs.PagingHint.older:type_name -> logs.GetLogsRequest
 	12, // 22: logs.PagingHint.newer:type_name -> logs.GetLogsRequest
-	17, // 23: logs.VerbatimLogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	24, // 23: logs.VerbatimLogMessage.timestamp:type_name -> google.protobuf.Timestamp
 	15, // 24: logs.LogMessage.verbatim:type_name -> logs.VerbatimLogMessage
-	18, // 25: logs.LogMessage.object:type_name -> google.protobuf.Struct
-	17, // 26: logs.LogMessage.native_timestamp:type_name -> google.protobuf.Timestamp
-	19, // 27: logs.LogMessage.pps_log_message:type_name -> pps_v2.LogMessage
-	12, // 28: logs.API.GetLogs:input_type -> logs.GetLogsRequest
-	13, // 29: logs.API.GetLogs:output_type -> logs.GetLogsResponse
-	29, // [29:30] is the sub-list for method output_type
-	28, // [28:29] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	25, // 25: logs.LogMessage.object:type_name -> google.protobuf.Struct
+	24, // 26: logs.LogMessage.native_timestamp:type_name -> google.protobuf.Timestamp
+	26, // 27: logs.LogMessage.pps_log_message:type_name -> pps_v2.LogMessage
+	0,  // 28: logs.LogRule.level:type_name -> logs.LogLevel
+	17, // 29: logs.CreateLogRuleRequest.rule:type_name -> logs.LogRule
+	17, // 30: logs.ListLogRuleResponse.rule:type_name -> logs.LogRule
+	12, // 31: logs.API.GetLogs:input_type -> logs.GetLogsRequest
+	18, // 32: logs.API.CreateLogRule:input_type -> logs.CreateLogRuleRequest
+	20, // 33: logs.API.ListLogRule:input_type -> logs.ListLogRuleRequest
+	22, // 34: logs.API.DeleteLogRule:input_type -> logs.DeleteLogRuleRequest
+	13, // 35: logs.API.GetLogs:output_type -> logs.GetLogsResponse
+	19, // 36: logs.API.CreateLogRule:output_type -> logs.CreateLogRuleResponse
+	21, // 37: logs.API.ListLogRule:output_type -> logs.ListLogRuleResponse
+	23, // 38: logs.API.DeleteLogRule:output_type -> logs.DeleteLogRuleResponse
+	35, // [35:39] is the sub-list for method output_type
+	31, // [31:35] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_logs_logs_proto_init() }
@@ -1764,6 +2184,90 @@ func file_logs_logs_proto_init() {
 				return nil
 			}
 		}
+		file_logs_logs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLogRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLogRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLogRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLogRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLogRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logs_logs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLogRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_logs_logs_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*LogQuery_User)(nil),
@@ -1797,7 +2301,7 @@ func file_logs_logs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_logs_logs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2689,3 +2689,787 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = LogMessageValidationError{}
+
+// Validate checks the field values on LogRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogRuleMultiError, or nil if none found.
+func (m *LogRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Project
+
+	// no validation rules for Pipeline
+
+	// no validation rules for Pattern
+
+	// no validation rules for Level
+
+	// no validation rules for Alert
+
+	if len(errors) > 0 {
+		return LogRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogRuleMultiError is an error wrapping multiple validation errors returned
+// by LogRule.ValidateAll() if the designated constraints aren't met.
+type LogRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogRuleMultiError) AllErrors() []error { return m }
+
+// LogRuleValidationError is the validation error returned by LogRule.Validate
+// if the designated constraints aren't met.
+type LogRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogRuleValidationError) ErrorName() string { return "LogRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogRuleValidationError{}
+
+// Validate checks the field values on CreateLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLogRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLogRuleRequestMultiError, or nil if none found.
+func (m *CreateLogRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLogRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLogRuleRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLogRuleRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLogRuleRequestValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Update
+
+	if len(errors) > 0 {
+		return CreateLogRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLogRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateLogRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateLogRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLogRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLogRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreateLogRuleRequestValidationError is the validation error returned by
+// CreateLogRuleRequest.Validate if the designated constraints aren't met.
+type CreateLogRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLogRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLogRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLogRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLogRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLogRuleRequestValidationError) ErrorName() string {
+	return "CreateLogRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLogRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLogRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLogRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLogRuleRequestValidationError{}
+
+// Validate checks the field values on CreateLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateLogRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateLogRuleResponseMultiError, or nil if none found.
+func (m *CreateLogRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateLogRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateLogRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateLogRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateLogRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateLogRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateLogRuleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateLogRuleResponseMultiError) AllErrors() []error { return m }
+
+// CreateLogRuleResponseValidationError is the validation error returned by
+// CreateLogRuleResponse.Validate if the designated constraints aren't met.
+type CreateLogRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLogRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLogRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLogRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLogRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLogRuleResponseValidationError) ErrorName() string {
+	return "CreateLogRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLogRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLogRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLogRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLogRuleResponseValidationError{}
+
+// Validate checks the field values on ListLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogRuleRequestMultiError, or nil if none found.
+func (m *ListLogRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLogRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLogRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLogRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogRuleRequestMultiError) AllErrors() []error { return m }
+
+// ListLogRuleRequestValidationError is the validation error returned by
+// ListLogRuleRequest.Validate if the designated constraints aren't met.
+type ListLogRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogRuleRequestValidationError) ErrorName() string {
+	return "ListLogRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogRuleRequestValidationError{}
+
+// Validate checks the field values on ListLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLogRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogRuleResponseMultiError, or nil if none found.
+func (m *ListLogRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLogRuleResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLogRuleResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLogRuleResponseValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListLogRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLogRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogRuleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogRuleResponseMultiError) AllErrors() []error { return m }
+
+// ListLogRuleResponseValidationError is the validation error returned by
+// ListLogRuleResponse.Validate if the designated constraints aren't met.
+type ListLogRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogRuleResponseValidationError) ErrorName() string {
+	return "ListLogRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogRuleResponseValidationError{}
+
+// Validate checks the field values on DeleteLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLogRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLogRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLogRuleRequestMultiError, or nil if none found.
+func (m *DeleteLogRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLogRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteLogRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteLogRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteLogRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteLogRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLogRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLogRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteLogRuleRequestValidationError is the validation error returned by
+// DeleteLogRuleRequest.Validate if the designated constraints aren't met.
+type DeleteLogRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLogRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLogRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLogRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLogRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLogRuleRequestValidationError) ErrorName() string {
+	return "DeleteLogRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLogRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLogRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLogRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLogRuleRequestValidationError{}
+
+// Validate checks the field values on DeleteLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLogRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLogRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLogRuleResponseMultiError, or nil if none found.
+func (m *DeleteLogRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLogRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteLogRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteLogRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteLogRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteLogRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLogRuleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLogRuleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteLogRuleResponseValidationError is the validation error returned by
+// DeleteLogRuleResponse.Validate if the designated constraints aren't met.
+type DeleteLogRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLogRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLogRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLogRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLogRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLogRuleResponseValidationError) ErrorName() string {
+	return "DeleteLogRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLogRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLogRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLogRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLogRuleResponseValidationError{}
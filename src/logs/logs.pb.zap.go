@@ -258,3 +258,70 @@ func (x *LogMessage) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddObject("pps_log_message", x.PpsLogMessage)
 	return nil
 }
+
+func (x *LogRule) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	enc.AddString("project", x.Project)
+	enc.AddString("pipeline", x.Pipeline)
+	enc.AddString("pattern", x.Pattern)
+	enc.AddString("level", x.Level.String())
+	enc.AddBool("alert", x.Alert)
+	return nil
+}
+
+func (x *CreateLogRuleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	if obj, ok := interface{}(x.Rule).(zapcore.ObjectMarshaler); ok {
+		enc.AddObject("rule", obj)
+	} else {
+		enc.AddReflected("rule", x.Rule)
+	}
+	enc.AddBool("update", x.Update)
+	return nil
+}
+
+func (x *CreateLogRuleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListLogRuleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
+
+func (x *ListLogRuleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	if obj, ok := interface{}(x.Rule).(zapcore.ObjectMarshaler); ok {
+		enc.AddObject("rule", obj)
+	} else {
+		enc.AddReflected("rule", x.Rule)
+	}
+	return nil
+}
+
+func (x *DeleteLogRuleRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("name", x.Name)
+	return nil
+}
+
+func (x *DeleteLogRuleResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	return nil
+}
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	API_GetLogs_FullMethodName = "/logs.API/GetLogs"
+	API_GetLogs_FullMethodName       = "/logs.API/GetLogs"
+	API_CreateLogRule_FullMethodName = "/logs.API/CreateLogRule"
+	API_ListLogRule_FullMethodName   = "/logs.API/ListLogRule"
+	API_DeleteLogRule_FullMethodName = "/logs.API/DeleteLogRule"
 )
 
 // APIClient is the client API for API service.
@@ -27,6 +30,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type APIClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
+	CreateLogRule(ctx context.Context, in *CreateLogRuleRequest, opts ...grpc.CallOption) (*CreateLogRuleResponse, error)
+	ListLogRule(ctx context.Context, in *ListLogRuleRequest, opts ...grpc.CallOption) (API_ListLogRuleClient, error)
+	DeleteLogRule(ctx context.Context, in *DeleteLogRuleRequest, opts ...grpc.CallOption) (*DeleteLogRuleResponse, error)
 }
 
 type aPIClient struct {
@@ -69,11 +75,64 @@ func (x *aPIGetLogsClient) Recv() (*GetLogsResponse, error) {
 	return m, nil
 }
 
+func (c *aPIClient) CreateLogRule(ctx context.Context, in *CreateLogRuleRequest, opts ...grpc.CallOption) (*CreateLogRuleResponse, error) {
+	out := new(CreateLogRuleResponse)
+	err := c.cc.Invoke(ctx, API_CreateLogRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListLogRule(ctx context.Context, in *ListLogRuleRequest, opts ...grpc.CallOption) (API_ListLogRuleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[1], API_ListLogRule_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListLogRuleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListLogRuleClient interface {
+	Recv() (*ListLogRuleResponse, error)
+	grpc.ClientStream
+}
+
+type aPIListLogRuleClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListLogRuleClient) Recv() (*ListLogRuleResponse, error) {
+	m := new(ListLogRuleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DeleteLogRule(ctx context.Context, in *DeleteLogRuleRequest, opts ...grpc.CallOption) (*DeleteLogRuleResponse, error) {
+	out := new(DeleteLogRuleResponse)
+	err := c.cc.Invoke(ctx, API_DeleteLogRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
 type APIServer interface {
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
+	CreateLogRule(context.Context, *CreateLogRuleRequest) (*CreateLogRuleResponse, error)
+	ListLogRule(*ListLogRuleRequest, API_ListLogRuleServer) error
+	DeleteLogRule(context.Context, *DeleteLogRuleRequest) (*DeleteLogRuleResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -84,6 +143,15 @@ type UnimplementedAPIServer struct {
 func (UnimplementedAPIServer) GetLogs(*GetLogsRequest, API_GetLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
+func (UnimplementedAPIServer) CreateLogRule(context.Context, *CreateLogRuleRequest) (*CreateLogRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLogRule not implemented")
+}
+func (UnimplementedAPIServer) ListLogRule(*ListLogRuleRequest, API_ListLogRuleServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListLogRule not implemented")
+}
+func (UnimplementedAPIServer) DeleteLogRule(context.Context, *DeleteLogRuleRequest) (*DeleteLogRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogRule not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -118,19 +186,90 @@ func (x *aPIGetLogsServer) Send(m *GetLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_CreateLogRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLogRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateLogRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateLogRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateLogRule(ctx, req.(*CreateLogRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListLogRule_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLogRuleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListLogRule(m, &aPIListLogRuleServer{stream})
+}
+
+type API_ListLogRuleServer interface {
+	Send(*ListLogRuleResponse) error
+	grpc.ServerStream
+}
+
+type aPIListLogRuleServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListLogRuleServer) Send(m *ListLogRuleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteLogRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteLogRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteLogRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteLogRule(ctx, req.(*DeleteLogRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var API_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "logs.API",
 	HandlerType: (*APIServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLogRule",
+			Handler:    _API_CreateLogRule_Handler,
+		},
+		{
+			MethodName: "DeleteLogRule",
+			Handler:    _API_DeleteLogRule_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetLogs",
 			Handler:       _API_GetLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListLogRule",
+			Handler:       _API_ListLogRule_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "logs/logs.proto",
 }
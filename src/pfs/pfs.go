@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
@@ -28,6 +29,9 @@ const (
 	UserRepoType = "user"
 	MetaRepoType = "meta"
 	SpecRepoType = "spec"
+	// ProfilesRepoType is the type of the repo that continuous profiles are committed to.
+	// Unlike the other system types, it has no corresponding user repo.
+	ProfilesRepoType = "profiles"
 
 	DefaultProjectName = "default"
 
@@ -132,6 +136,16 @@ func (c *Commit) AccessRepo() *Repo {
 	return c.GetBranch().GetRepo()
 }
 
+// Shares returns true if file is the file or directory that the share link shares.  Only files in
+// the shared commit, given by ID, match.
+func (s *ShareLinkInfo) Shares(file *File) bool {
+	shared, repo := s.GetFile(), file.GetCommit().AccessRepo()
+	return repo.GetProject().GetName() == shared.GetCommit().GetRepo().GetProject().GetName() &&
+		repo.GetName() == shared.GetCommit().GetRepo().GetName() &&
+		file.GetCommit().GetId() == shared.GetCommit().GetId() &&
+		path.Join("/", file.GetPath()) == path.Join("/", shared.GetPath())
+}
+
 func (c *Commit) NilBranch() {
 	if c != nil {
 		c.Branch = nil
@@ -8585,6 +8585,8 @@ func (m *InspectProjectV2Request) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IncludeQuota
+
 	if len(errors) > 0 {
 		return InspectProjectV2RequestMultiError(errors)
 	}
@@ -8718,6 +8720,8 @@ func (m *InspectProjectV2Response) validate(all bool) error {
 
 	// no validation rules for DefaultsJson
 
+	// no validation rules for QuotaJson
+
 	if len(errors) > 0 {
 		return InspectProjectV2ResponseMultiError(errors)
 	}
@@ -9869,6 +9873,17 @@ func (m *GetFileRequest) validate(all bool) error {
 		}
 	}
 
+	if m.GetSizeBytes() < 0 {
+		err := GetFileRequestValidationError{
+			field:  "SizeBytes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetFileRequestMultiError(errors)
 	}
@@ -14028,71 +14043,47 @@ var _ interface {
 	ErrorName() string
 } = ReposSummaryResponseValidationError{}
 
-// Validate checks the field values on ForgetCommitRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ForgetCommitRequest) Validate() error {
+// Validate checks the field values on StorageUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageUsage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForgetCommitRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ForgetCommitRequestMultiError, or nil if none found.
-func (m *ForgetCommitRequest) ValidateAll() error {
+// ValidateAll checks the field values on StorageUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageUsageMultiError, or
+// nil if none found.
+func (m *StorageUsage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForgetCommitRequest) validate(all bool) error {
+func (m *StorageUsage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommit()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ForgetCommitRequestValidationError{
-					field:  "Commit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ForgetCommitRequestValidationError{
-					field:  "Commit",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ForgetCommitRequestValidationError{
-				field:  "Commit",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TotalBytes
+
+	// no validation rules for UniqueBytes
+
+	// no validation rules for SharedBytes
 
 	if len(errors) > 0 {
-		return ForgetCommitRequestMultiError(errors)
+		return StorageUsageMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForgetCommitRequestMultiError is an error wrapping multiple validation
-// errors returned by ForgetCommitRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ForgetCommitRequestMultiError []error
+// StorageUsageMultiError is an error wrapping multiple validation errors
+// returned by StorageUsage.ValidateAll() if the designated constraints aren't met.
+type StorageUsageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForgetCommitRequestMultiError) Error() string {
+func (m StorageUsageMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14101,11 +14092,11 @@ func (m ForgetCommitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForgetCommitRequestMultiError) AllErrors() []error { return m }
+func (m StorageUsageMultiError) AllErrors() []error { return m }
 
-// ForgetCommitRequestValidationError is the validation error returned by
-// ForgetCommitRequest.Validate if the designated constraints aren't met.
-type ForgetCommitRequestValidationError struct {
+// StorageUsageValidationError is the validation error returned by
+// StorageUsage.Validate if the designated constraints aren't met.
+type StorageUsageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14113,24 +14104,22 @@ type ForgetCommitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForgetCommitRequestValidationError) Field() string { return e.field }
+func (e StorageUsageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForgetCommitRequestValidationError) Reason() string { return e.reason }
+func (e StorageUsageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForgetCommitRequestValidationError) Cause() error { return e.cause }
+func (e StorageUsageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForgetCommitRequestValidationError) Key() bool { return e.key }
+func (e StorageUsageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForgetCommitRequestValidationError) ErrorName() string {
-	return "ForgetCommitRequestValidationError"
-}
+func (e StorageUsageValidationError) ErrorName() string { return "StorageUsageValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ForgetCommitRequestValidationError) Error() string {
+func (e StorageUsageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14142,14 +14131,14 @@ func (e ForgetCommitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForgetCommitRequest.%s: %s%s",
+		"invalid %sStorageUsage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForgetCommitRequestValidationError{}
+var _ error = StorageUsageValidationError{}
 
 var _ interface {
 	Field() string
@@ -14157,44 +14146,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForgetCommitRequestValidationError{}
+} = StorageUsageValidationError{}
 
-// Validate checks the field values on ForgetCommitResponse with the rules
+// Validate checks the field values on StorageUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ForgetCommitResponse) Validate() error {
+func (m *StorageUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForgetCommitResponse with the rules
+// ValidateAll checks the field values on StorageUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ForgetCommitResponseMultiError, or nil if none found.
-func (m *ForgetCommitResponse) ValidateAll() error {
+// StorageUsageRequestMultiError, or nil if none found.
+func (m *StorageUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForgetCommitResponse) validate(all bool) error {
+func (m *StorageUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageUsageRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageUsageRequestValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageUsageRequestValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Commits
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageUsageRequestValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageUsageRequestValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageUsageRequestValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ForgetCommitResponseMultiError(errors)
+		return StorageUsageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForgetCommitResponseMultiError is an error wrapping multiple validation
-// errors returned by ForgetCommitResponse.ValidateAll() if the designated
+// StorageUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageUsageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ForgetCommitResponseMultiError []error
+type StorageUsageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForgetCommitResponseMultiError) Error() string {
+func (m StorageUsageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14203,11 +14257,11 @@ func (m ForgetCommitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForgetCommitResponseMultiError) AllErrors() []error { return m }
+func (m StorageUsageRequestMultiError) AllErrors() []error { return m }
 
-// ForgetCommitResponseValidationError is the validation error returned by
-// ForgetCommitResponse.Validate if the designated constraints aren't met.
-type ForgetCommitResponseValidationError struct {
+// StorageUsageRequestValidationError is the validation error returned by
+// StorageUsageRequest.Validate if the designated constraints aren't met.
+type StorageUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14215,24 +14269,24 @@ type ForgetCommitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForgetCommitResponseValidationError) Field() string { return e.field }
+func (e StorageUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForgetCommitResponseValidationError) Reason() string { return e.reason }
+func (e StorageUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForgetCommitResponseValidationError) Cause() error { return e.cause }
+func (e StorageUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForgetCommitResponseValidationError) Key() bool { return e.key }
+func (e StorageUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForgetCommitResponseValidationError) ErrorName() string {
-	return "ForgetCommitResponseValidationError"
+func (e StorageUsageRequestValidationError) ErrorName() string {
+	return "StorageUsageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ForgetCommitResponseValidationError) Error() string {
+func (e StorageUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14244,14 +14298,14 @@ func (e ForgetCommitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForgetCommitResponse.%s: %s%s",
+		"invalid %sStorageUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForgetCommitResponseValidationError{}
+var _ error = StorageUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14259,7 +14313,1538 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForgetCommitResponseValidationError{}
+} = StorageUsageRequestValidationError{}
+
+// Validate checks the field values on StorageUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageUsageResponseMultiError, or nil if none found.
+func (m *StorageUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageUsageResponseValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetComputedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageUsageResponseValidationError{
+				field:  "ComputedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Entity.(type) {
+	case *StorageUsageResponse_Project:
+		if v == nil {
+			err := StorageUsageResponseValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageUsageResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StorageUsageResponse_Repo:
+		if v == nil {
+			err := StorageUsageResponseValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRepo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Repo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageUsageResponseValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StorageUsageResponse_Branch:
+		if v == nil {
+			err := StorageUsageResponseValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBranch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Branch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBranch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageUsageResponseValidationError{
+					field:  "Branch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StorageUsageResponse_Commit:
+		if v == nil {
+			err := StorageUsageResponseValidationError{
+				field:  "Entity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCommit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Commit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StorageUsageResponseValidationError{
+						field:  "Commit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorageUsageResponseValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StorageUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by StorageUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StorageUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUsageResponseMultiError) AllErrors() []error { return m }
+
+// StorageUsageResponseValidationError is the validation error returned by
+// StorageUsageResponse.Validate if the designated constraints aren't met.
+type StorageUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUsageResponseValidationError) ErrorName() string {
+	return "StorageUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUsageResponseValidationError{}
+
+// Validate checks the field values on ForgetCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForgetCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForgetCommitRequestMultiError, or nil if none found.
+func (m *ForgetCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForgetCommitRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ForgetCommitRequestValidationError{
+					field:  "Commit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForgetCommitRequestValidationError{
+				field:  "Commit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForgetCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetCommitRequestMultiError is an error wrapping multiple validation
+// errors returned by ForgetCommitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForgetCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetCommitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetCommitRequestMultiError) AllErrors() []error { return m }
+
+// ForgetCommitRequestValidationError is the validation error returned by
+// ForgetCommitRequest.Validate if the designated constraints aren't met.
+type ForgetCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetCommitRequestValidationError) ErrorName() string {
+	return "ForgetCommitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetCommitRequestValidationError{}
+
+// Validate checks the field values on ForgetCommitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForgetCommitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetCommitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForgetCommitResponseMultiError, or nil if none found.
+func (m *ForgetCommitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetCommitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ForgetCommitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetCommitResponseMultiError is an error wrapping multiple validation
+// errors returned by ForgetCommitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ForgetCommitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetCommitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetCommitResponseMultiError) AllErrors() []error { return m }
+
+// ForgetCommitResponseValidationError is the validation error returned by
+// ForgetCommitResponse.Validate if the designated constraints aren't met.
+type ForgetCommitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetCommitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetCommitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetCommitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetCommitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetCommitResponseValidationError) ErrorName() string {
+	return "ForgetCommitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetCommitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetCommitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetCommitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetCommitResponseValidationError{}
+
+// Validate checks the field values on ShareLinkInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShareLinkInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareLinkInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShareLinkInfoMultiError, or
+// nil if none found.
+func (m *ShareLinkInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareLinkInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkInfoValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FileType
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Issuer
+
+	if all {
+		switch v := interface{}(m.GetCreated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkInfoValidationError{
+				field:  "Created",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpires()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkInfoValidationError{
+					field:  "Expires",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpires()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkInfoValidationError{
+				field:  "Expires",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxDownloads
+
+	// no validation rules for Downloads
+
+	if len(errors) > 0 {
+		return ShareLinkInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareLinkInfoMultiError is an error wrapping multiple validation errors
+// returned by ShareLinkInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ShareLinkInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareLinkInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareLinkInfoMultiError) AllErrors() []error { return m }
+
+// ShareLinkInfoValidationError is the validation error returned by
+// ShareLinkInfo.Validate if the designated constraints aren't met.
+type ShareLinkInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareLinkInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareLinkInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareLinkInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareLinkInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareLinkInfoValidationError) ErrorName() string { return "ShareLinkInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShareLinkInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareLinkInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareLinkInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareLinkInfoValidationError{}
+
+// Validate checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareLinkRequestMultiError, or nil if none found.
+func (m *CreateShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFile() == nil {
+		err := CreateShareLinkRequestValidationError{
+			field:  "File",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareLinkRequestValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTtl() == nil {
+		err := CreateShareLinkRequestValidationError{
+			field:  "Ttl",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateShareLinkRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateShareLinkRequestValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetMaxDownloads() < 0 {
+		err := CreateShareLinkRequestValidationError{
+			field:  "MaxDownloads",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// CreateShareLinkRequestValidationError is the validation error returned by
+// CreateShareLinkRequest.Validate if the designated constraints aren't met.
+type CreateShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareLinkRequestValidationError) ErrorName() string {
+	return "CreateShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareLinkRequestValidationError{}
+
+// Validate checks the field values on CreateShareLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareLinkResponseMultiError, or nil if none found.
+func (m *CreateShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareLinkResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareLinkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// CreateShareLinkResponseValidationError is the validation error returned by
+// CreateShareLinkResponse.Validate if the designated constraints aren't met.
+type CreateShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareLinkResponseValidationError) ErrorName() string {
+	return "CreateShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareLinkResponseValidationError{}
+
+// Validate checks the field values on InspectShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InspectShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InspectShareLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InspectShareLinkRequestMultiError, or nil if none found.
+func (m *InspectShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InspectShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return InspectShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InspectShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by InspectShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InspectShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InspectShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InspectShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// InspectShareLinkRequestValidationError is the validation error returned by
+// InspectShareLinkRequest.Validate if the designated constraints aren't met.
+type InspectShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InspectShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InspectShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InspectShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InspectShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InspectShareLinkRequestValidationError) ErrorName() string {
+	return "InspectShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InspectShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInspectShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InspectShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InspectShareLinkRequestValidationError{}
+
+// Validate checks the field values on GetShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetShareLinkRequestMultiError, or nil if none found.
+func (m *GetShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return GetShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by GetShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// GetShareLinkRequestValidationError is the validation error returned by
+// GetShareLinkRequest.Validate if the designated constraints aren't met.
+type GetShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetShareLinkRequestValidationError) ErrorName() string {
+	return "GetShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetShareLinkRequestValidationError{}
+
+// Validate checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeShareLinkRequestMultiError, or nil if none found.
+func (m *RevokeShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RevokeShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// RevokeShareLinkRequestValidationError is the validation error returned by
+// RevokeShareLinkRequest.Validate if the designated constraints aren't met.
+type RevokeShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeShareLinkRequestValidationError) ErrorName() string {
+	return "RevokeShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeShareLinkRequestValidationError{}
+
+// Validate checks the field values on RevokeShareLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeShareLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeShareLinkResponseMultiError, or nil if none found.
+func (m *RevokeShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeShareLinkResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeShareLinkResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeShareLinkResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeShareLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeShareLinkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// RevokeShareLinkResponseValidationError is the validation error returned by
+// RevokeShareLinkResponse.Validate if the designated constraints aren't met.
+type RevokeShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeShareLinkResponseValidationError) ErrorName() string {
+	return "RevokeShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeShareLinkResponseValidationError{}
 
 // Validate checks the field values on RepoPicker_RepoName with the rules
 // defined in the proto definition for this message. If any rules are
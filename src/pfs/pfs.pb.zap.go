@@ -768,6 +768,7 @@ func (x *InspectProjectV2Request) MarshalLogObject(enc zapcore.ObjectEncoder) er
 		return nil
 	}
 	enc.AddObject("project", x.Project)
+	enc.AddBool("include_quota", x.IncludeQuota)
 	return nil
 }
 
@@ -777,6 +778,7 @@ func (x *InspectProjectV2Response) MarshalLogObject(enc zapcore.ObjectEncoder) e
 	}
 	enc.AddObject("info", x.Info)
 	enc.AddString("defaults_json", x.DefaultsJson)
+	enc.AddString("quota_json", x.QuotaJson)
 	return nil
 }
 
@@ -856,6 +858,7 @@ func (x *GetFileRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("URL", x.URL)
 	enc.AddInt64("offset", x.Offset)
 	enc.AddObject("path_range", x.PathRange)
+	enc.AddInt64("size_bytes", x.SizeBytes)
 	return nil
 }
 
@@ -1214,6 +1217,45 @@ func (x *ReposSummaryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	return nil
 }
 
+func (x *StorageUsage) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("total_bytes", x.TotalBytes)
+	enc.AddInt64("unique_bytes", x.UniqueBytes)
+	enc.AddInt64("shared_bytes", x.SharedBytes)
+	return nil
+}
+
+func (x *StorageUsageRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("repo", x.Repo)
+	enc.AddBool("commits", x.Commits)
+	projectsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Projects {
+			enc.AppendObject(v)
+		}
+		return nil
+	}
+	enc.AddArray("projects", zapcore.ArrayMarshalerFunc(projectsArrMarshaller))
+	return nil
+}
+
+func (x *StorageUsageResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.GetProject())
+	enc.AddObject("repo", x.GetRepo())
+	enc.AddObject("branch", x.GetBranch())
+	enc.AddObject("commit", x.GetCommit())
+	enc.AddObject("usage", x.Usage)
+	protoextensions.AddTimestamp(enc, "computed_at", x.ComputedAt)
+	return nil
+}
+
 func (x *ForgetCommitRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -1228,3 +1270,70 @@ func (x *ForgetCommitResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	}
 	return nil
 }
+
+func (x *ShareLinkInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("file", x.File)
+	enc.AddString("file_type", x.FileType.String())
+	enc.AddInt64("size_bytes", x.SizeBytes)
+	enc.AddString("issuer", x.Issuer)
+	protoextensions.AddTimestamp(enc, "created", x.Created)
+	protoextensions.AddTimestamp(enc, "expires", x.Expires)
+	enc.AddInt64("max_downloads", x.MaxDownloads)
+	enc.AddInt64("downloads", x.Downloads)
+	return nil
+}
+
+func (x *CreateShareLinkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("file", x.File)
+	protoextensions.AddDuration(enc, "ttl", x.Ttl)
+	enc.AddInt64("max_downloads", x.MaxDownloads)
+	return nil
+}
+
+func (x *CreateShareLinkResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("token", "[MASKED]")
+	enc.AddString("path", "[MASKED]")
+	enc.AddObject("info", x.Info)
+	return nil
+}
+
+func (x *InspectShareLinkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("token", "[MASKED]")
+	return nil
+}
+
+func (x *GetShareLinkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("token", "[MASKED]")
+	return nil
+}
+
+func (x *RevokeShareLinkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("token", "[MASKED]")
+	return nil
+}
+
+func (x *RevokeShareLinkResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("info", x.Info)
+	return nil
+}
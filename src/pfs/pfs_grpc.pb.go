@@ -77,7 +77,12 @@ const (
 	API_ListProject_FullMethodName          = "/pfs_v2.API/ListProject"
 	API_DeleteProject_FullMethodName        = "/pfs_v2.API/DeleteProject"
 	API_ReposSummary_FullMethodName         = "/pfs_v2.API/ReposSummary"
+	API_StorageUsage_FullMethodName         = "/pfs_v2.API/StorageUsage"
 	API_ForgetCommit_FullMethodName         = "/pfs_v2.API/ForgetCommit"
+	API_CreateShareLink_FullMethodName      = "/pfs_v2.API/CreateShareLink"
+	API_InspectShareLink_FullMethodName     = "/pfs_v2.API/InspectShareLink"
+	API_GetShareLink_FullMethodName         = "/pfs_v2.API/GetShareLink"
+	API_RevokeShareLink_FullMethodName      = "/pfs_v2.API/RevokeShareLink"
 )
 
 // APIClient is the client API for API service.
@@ -200,8 +205,25 @@ type APIClient interface {
 	// Summary API
 	// ReposSummary returns a list of summaries about the repos for each of the requested projects.
 	ReposSummary(ctx context.Context, in *ReposSummaryRequest, opts ...grpc.CallOption) (*ReposSummaryResponse, error)
+	// StorageUsage returns the object storage used by projects, repos, branches,
+	// and commits, after deduplication.
+	StorageUsage(ctx context.Context, in *StorageUsageRequest, opts ...grpc.CallOption) (API_StorageUsageClient, error)
 	// Forget API
 	ForgetCommit(ctx context.Context, in *ForgetCommitRequest, opts ...grpc.CallOption) (*ForgetCommitResponse, error)
+	// Share Link API
+	// CreateShareLink creates a link that downloads a file or directory without
+	// authentication.  The caller must be able to read the file.
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	// InspectShareLink returns info about a share link.  The token is the only
+	// authentication required.
+	InspectShareLink(ctx context.Context, in *InspectShareLinkRequest, opts ...grpc.CallOption) (*ShareLinkInfo, error)
+	// GetShareLink counts a download of a share link, and returns a TAR stream
+	// of the shared file or directory, like GetFileTAR.  The token is the only
+	// authentication required.
+	GetShareLink(ctx context.Context, in *GetShareLinkRequest, opts ...grpc.CallOption) (API_GetShareLinkClient, error)
+	// RevokeShareLink deletes a share link before it expires, so that it can't
+	// be used again.  The token is the only authentication required.
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
 }
 
 type aPIClient struct {
@@ -1217,6 +1239,38 @@ func (c *aPIClient) ReposSummary(ctx context.Context, in *ReposSummaryRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) StorageUsage(ctx context.Context, in *StorageUsageRequest, opts ...grpc.CallOption) (API_StorageUsageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[22], API_StorageUsage_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIStorageUsageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_StorageUsageClient interface {
+	Recv() (*StorageUsageResponse, error)
+	grpc.ClientStream
+}
+
+type aPIStorageUsageClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIStorageUsageClient) Recv() (*StorageUsageResponse, error) {
+	m := new(StorageUsageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) ForgetCommit(ctx context.Context, in *ForgetCommitRequest, opts ...grpc.CallOption) (*ForgetCommitResponse, error) {
 	out := new(ForgetCommitResponse)
 	err := c.cc.Invoke(ctx, API_ForgetCommit_FullMethodName, in, out, opts...)
@@ -1226,6 +1280,65 @@ func (c *aPIClient) ForgetCommit(ctx context.Context, in *ForgetCommitRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, API_CreateShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectShareLink(ctx context.Context, in *InspectShareLinkRequest, opts ...grpc.CallOption) (*ShareLinkInfo, error) {
+	out := new(ShareLinkInfo)
+	err := c.cc.Invoke(ctx, API_InspectShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetShareLink(ctx context.Context, in *GetShareLinkRequest, opts ...grpc.CallOption) (API_GetShareLinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[23], API_GetShareLink_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetShareLinkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_GetShareLinkClient interface {
+	Recv() (*wrapperspb.BytesValue, error)
+	grpc.ClientStream
+}
+
+type aPIGetShareLinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetShareLinkClient) Recv() (*wrapperspb.BytesValue, error) {
+	m := new(wrapperspb.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, API_RevokeShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
@@ -1346,8 +1459,25 @@ type APIServer interface {
 	// Summary API
 	// ReposSummary returns a list of summaries about the repos for each of the requested projects.
 	ReposSummary(context.Context, *ReposSummaryRequest) (*ReposSummaryResponse, error)
+	// StorageUsage returns the object storage used by projects, repos, branches,
+	// and commits, after deduplication.
+	StorageUsage(*StorageUsageRequest, API_StorageUsageServer) error
 	// Forget API
 	ForgetCommit(context.Context, *ForgetCommitRequest) (*ForgetCommitResponse, error)
+	// Share Link API
+	// CreateShareLink creates a link that downloads a file or directory without
+	// authentication.  The caller must be able to read the file.
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	// InspectShareLink returns info about a share link.  The token is the only
+	// authentication required.
+	InspectShareLink(context.Context, *InspectShareLinkRequest) (*ShareLinkInfo, error)
+	// GetShareLink counts a download of a share link, and returns a TAR stream
+	// of the shared file or directory, like GetFileTAR.  The token is the only
+	// authentication required.
+	GetShareLink(*GetShareLinkRequest, API_GetShareLinkServer) error
+	// RevokeShareLink deletes a share link before it expires, so that it can't
+	// be used again.  The token is the only authentication required.
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -1520,9 +1650,24 @@ func (UnimplementedAPIServer) DeleteProject(context.Context, *DeleteProjectReque
 func (UnimplementedAPIServer) ReposSummary(context.Context, *ReposSummaryRequest) (*ReposSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReposSummary not implemented")
 }
+func (UnimplementedAPIServer) StorageUsage(*StorageUsageRequest, API_StorageUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method StorageUsage not implemented")
+}
 func (UnimplementedAPIServer) ForgetCommit(context.Context, *ForgetCommitRequest) (*ForgetCommitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForgetCommit not implemented")
 }
+func (UnimplementedAPIServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedAPIServer) InspectShareLink(context.Context, *InspectShareLinkRequest) (*ShareLinkInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectShareLink not implemented")
+}
+func (UnimplementedAPIServer) GetShareLink(*GetShareLinkRequest, API_GetShareLinkServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetShareLink not implemented")
+}
+func (UnimplementedAPIServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -2602,6 +2747,27 @@ func _API_ReposSummary_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_StorageUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).StorageUsage(m, &aPIStorageUsageServer{stream})
+}
+
+type API_StorageUsageServer interface {
+	Send(*StorageUsageResponse) error
+	grpc.ServerStream
+}
+
+type aPIStorageUsageServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIStorageUsageServer) Send(m *StorageUsageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_ForgetCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForgetCommitRequest)
 	if err := dec(in); err != nil {
@@ -2620,6 +2786,81 @@ func _API_ForgetCommit_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectShareLink(ctx, req.(*InspectShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetShareLink_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetShareLinkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GetShareLink(m, &aPIGetShareLinkServer{stream})
+}
+
+type API_GetShareLinkServer interface {
+	Send(*wrapperspb.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetShareLinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetShareLinkServer) Send(m *wrapperspb.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_RevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2763,6 +3004,18 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForgetCommit",
 			Handler:    _API_ForgetCommit_Handler,
 		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _API_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "InspectShareLink",
+			Handler:    _API_InspectShareLink_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _API_RevokeShareLink_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2875,6 +3128,16 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListProject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StorageUsage",
+			Handler:       _API_StorageUsage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetShareLink",
+			Handler:       _API_GetShareLink_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pfs/pfs.proto",
 }
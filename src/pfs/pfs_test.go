@@ -22,6 +22,19 @@ func TestCommit_NilBranch(t *testing.T) {
 	require.Nil(t, c2.Branch)
 }
 
+func TestShareLinkInfo_Shares(t *testing.T) {
+	repo := &Repo{Name: "images", Type: UserRepoType, Project: &Project{Name: DefaultProjectName}}
+	commit := repo.NewCommit("", "44444444444444444444444444444444")
+	info := &ShareLinkInfo{File: commit.NewFile("/a/b.png")}
+	require.True(t, info.Shares(commit.NewFile("/a/b.png")))
+	require.True(t, info.Shares(commit.NewFile("a/b.png")))
+	require.False(t, info.Shares(commit.NewFile("/a")))
+	require.False(t, info.Shares(repo.NewCommit("master", "").NewFile("/a/b.png")))
+	require.False(t, info.Shares(repo.NewCommit("", "55555555555555555555555555555555").NewFile("/a/b.png")))
+	other := &Repo{Name: "images", Type: UserRepoType, Project: &Project{Name: "other"}}
+	require.False(t, info.Shares(other.NewCommit("", commit.Id).NewFile("/a/b.png")))
+}
+
 func TestProject_ValidateName(t *testing.T) {
 	var p = &Project{Name: "0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF"}
 	err := p.ValidateName()
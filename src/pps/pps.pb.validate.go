@@ -214,6 +214,8 @@ func (m *Transform) validate(all bool) error {
 
 	// no validation rules for DatumBatching
 
+	// no validation rules for DatumCache
+
 	if len(errors) > 0 {
 		return TransformMultiError(errors)
 	}
@@ -2374,6 +2376,35 @@ func (m *DatumInfo) validate(all bool) error {
 
 	// no validation rules for ImageId
 
+	if all {
+		switch v := interface{}(m.GetLogs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DatumInfoValidationError{
+					field:  "Logs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DatumInfoValidationError{
+					field:  "Logs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLogs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DatumInfoValidationError{
+				field:  "Logs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DatumInfoMultiError(errors)
 	}
@@ -2673,6 +2704,41 @@ func (m *ProcessStats) validate(all bool) error {
 
 	// no validation rules for UploadBytes
 
+	if all {
+		switch v := interface{}(m.GetCpuTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProcessStatsValidationError{
+					field:  "CpuTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProcessStatsValidationError{
+					field:  "CpuTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCpuTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProcessStatsValidationError{
+				field:  "CpuTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PeakMemoryBytes
+
+	// no validation rules for ReadBytes
+
+	// no validation rules for WriteBytes
+
 	if len(errors) > 0 {
 		return ProcessStatsMultiError(errors)
 	}
@@ -2917,6 +2983,122 @@ func (m *AggregateProcessStats) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetCpuTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "CpuTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "CpuTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCpuTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateProcessStatsValidationError{
+				field:  "CpuTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeakMemoryBytes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "PeakMemoryBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "PeakMemoryBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeakMemoryBytes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateProcessStatsValidationError{
+				field:  "PeakMemoryBytes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadBytes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "ReadBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "ReadBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadBytes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateProcessStatsValidationError{
+				field:  "ReadBytes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWriteBytes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "WriteBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateProcessStatsValidationError{
+					field:  "WriteBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWriteBytes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateProcessStatsValidationError{
+				field:  "WriteBytes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AggregateProcessStatsMultiError(errors)
 	}
@@ -3933,6 +4115,35 @@ func (m *JobInfo) validate(all bool) error {
 
 	// no validation rules for AuthToken
 
+	if all {
+		switch v := interface{}(m.GetAggregateStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobInfoValidationError{
+					field:  "AggregateStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobInfoValidationError{
+					field:  "AggregateStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAggregateStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobInfoValidationError{
+				field:  "AggregateStats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return JobInfoMultiError(errors)
 	}
@@ -6019,6 +6230,35 @@ func (m *UpdateJobStateRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAggregateStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobStateRequestValidationError{
+					field:  "AggregateStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobStateRequestValidationError{
+					field:  "AggregateStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAggregateStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobStateRequestValidationError{
+				field:  "AggregateStats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateJobStateRequestMultiError(errors)
 	}
@@ -8424,6 +8664,8 @@ func (m *CreatePipelineRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PersistDatumLogs
+
 	if len(errors) > 0 {
 		return CreatePipelineRequestMultiError(errors)
 	}
@@ -13914,6 +14156,64 @@ func (m *PipelinesSummary) validate(all bool) error {
 
 	// no validation rules for UnhealthyPipelines
 
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelinesSummaryValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelinesSummaryValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelinesSummaryValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuotaUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PipelinesSummaryValidationError{
+					field:  "QuotaUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PipelinesSummaryValidationError{
+					field:  "QuotaUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotaUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PipelinesSummaryValidationError{
+				field:  "QuotaUsage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PipelinesSummaryMultiError(errors)
 	}
@@ -13992,31 +14292,809 @@ var _ interface {
 	ErrorName() string
 } = PipelinesSummaryValidationError{}
 
-// Validate checks the field values on PipelinePicker with the rules defined in
+// Validate checks the field values on ProjectQuota with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *PipelinePicker) Validate() error {
+func (m *ProjectQuota) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PipelinePicker with the rules defined
+// ValidateAll checks the field values on ProjectQuota with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PipelinePickerMultiError,
-// or nil if none found.
-func (m *PipelinePicker) ValidateAll() error {
+// result is a list of violation errors wrapped in ProjectQuotaMultiError, or
+// nil if none found.
+func (m *ProjectQuota) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PipelinePicker) validate(all bool) error {
+func (m *ProjectQuota) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Picker.(type) {
-	case *PipelinePicker_Name:
-		if v == nil {
+	// no validation rules for Pipelines
+
+	// no validation rules for Workers
+
+	// no validation rules for Cpu
+
+	// no validation rules for Memory
+
+	// no validation rules for Gpus
+
+	// no validation rules for ConcurrentJobs
+
+	if len(errors) > 0 {
+		return ProjectQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectQuotaMultiError is an error wrapping multiple validation errors
+// returned by ProjectQuota.ValidateAll() if the designated constraints aren't met.
+type ProjectQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectQuotaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectQuotaMultiError) AllErrors() []error { return m }
+
+// ProjectQuotaValidationError is the validation error returned by
+// ProjectQuota.Validate if the designated constraints aren't met.
+type ProjectQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectQuotaValidationError) ErrorName() string { return "ProjectQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectQuotaValidationError{}
+
+// Validate checks the field values on ProjectQuotaUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProjectQuotaUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectQuotaUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectQuotaUsageMultiError, or nil if none found.
+func (m *ProjectQuotaUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectQuotaUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pipelines
+
+	// no validation rules for Workers
+
+	// no validation rules for Cpu
+
+	// no validation rules for Memory
+
+	// no validation rules for Gpus
+
+	// no validation rules for ConcurrentJobs
+
+	if len(errors) > 0 {
+		return ProjectQuotaUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectQuotaUsageMultiError is an error wrapping multiple validation errors
+// returned by ProjectQuotaUsage.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectQuotaUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectQuotaUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectQuotaUsageMultiError) AllErrors() []error { return m }
+
+// ProjectQuotaUsageValidationError is the validation error returned by
+// ProjectQuotaUsage.Validate if the designated constraints aren't met.
+type ProjectQuotaUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectQuotaUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectQuotaUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectQuotaUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectQuotaUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectQuotaUsageValidationError) ErrorName() string {
+	return "ProjectQuotaUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectQuotaUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectQuotaUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectQuotaUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectQuotaUsageValidationError{}
+
+// Validate checks the field values on GetProjectQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectQuotaRequestMultiError, or nil if none found.
+func (m *GetProjectQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectQuotaRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectQuotaRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectQuotaRequestValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProjectQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProjectQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectQuotaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectQuotaRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectQuotaRequestValidationError is the validation error returned by
+// GetProjectQuotaRequest.Validate if the designated constraints aren't met.
+type GetProjectQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectQuotaRequestValidationError) ErrorName() string {
+	return "GetProjectQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectQuotaRequestValidationError{}
+
+// Validate checks the field values on GetProjectQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectQuotaResponseMultiError, or nil if none found.
+func (m *GetProjectQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectQuotaResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectQuotaResponseValidationError{
+					field:  "Usage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectQuotaResponseValidationError{
+				field:  "Usage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProjectQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProjectQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectQuotaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectQuotaResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectQuotaResponseValidationError is the validation error returned by
+// GetProjectQuotaResponse.Validate if the designated constraints aren't met.
+type GetProjectQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectQuotaResponseValidationError) ErrorName() string {
+	return "GetProjectQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectQuotaResponseValidationError{}
+
+// Validate checks the field values on SetProjectQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectQuotaRequestMultiError, or nil if none found.
+func (m *SetProjectQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProjectQuotaRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProjectQuotaRequestValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProjectQuotaRequestValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProjectQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProjectQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProjectQuotaRequestValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetProjectQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by SetProjectQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectQuotaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectQuotaRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectQuotaRequestValidationError is the validation error returned by
+// SetProjectQuotaRequest.Validate if the designated constraints aren't met.
+type SetProjectQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectQuotaRequestValidationError) ErrorName() string {
+	return "SetProjectQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectQuotaRequestValidationError{}
+
+// Validate checks the field values on SetProjectQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectQuotaResponseMultiError, or nil if none found.
+func (m *SetProjectQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetProjectQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by SetProjectQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectQuotaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectQuotaResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectQuotaResponseValidationError is the validation error returned by
+// SetProjectQuotaResponse.Validate if the designated constraints aren't met.
+type SetProjectQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectQuotaResponseValidationError) ErrorName() string {
+	return "SetProjectQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectQuotaResponseValidationError{}
+
+// Validate checks the field values on PipelinePicker with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PipelinePicker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PipelinePicker with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PipelinePickerMultiError,
+// or nil if none found.
+func (m *PipelinePicker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PipelinePicker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Picker.(type) {
+	case *PipelinePicker_Name:
+		if v == nil {
 			err := PipelinePickerValidationError{
 				field:  "Picker",
 				reason: "oneof value cannot be a typed-nil",
@@ -15383,6 +16461,8 @@ func (m *PipelineInfo_Details) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PersistDatumLogs
+
 	if len(errors) > 0 {
 		return PipelineInfo_DetailsMultiError(errors)
 	}
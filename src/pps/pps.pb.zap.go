@@ -87,6 +87,7 @@ func (x *Transform) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("dockerfile", x.Dockerfile)
 	enc.AddBool("memory_volume", x.MemoryVolume)
 	enc.AddBool("datum_batching", x.DatumBatching)
+	enc.AddBool("datum_cache", x.DatumCache)
 	return nil
 }
 
@@ -296,6 +297,7 @@ func (x *DatumInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	}
 	enc.AddArray("data", zapcore.ArrayMarshalerFunc(dataArrMarshaller))
 	enc.AddString("image_id", x.ImageId)
+	enc.AddObject("logs", x.Logs)
 	return nil
 }
 
@@ -320,6 +322,10 @@ func (x *ProcessStats) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	protoextensions.AddDuration(enc, "upload_time", x.UploadTime)
 	enc.AddInt64("download_bytes", x.DownloadBytes)
 	enc.AddInt64("upload_bytes", x.UploadBytes)
+	protoextensions.AddDuration(enc, "cpu_time", x.CpuTime)
+	enc.AddInt64("peak_memory_bytes", x.PeakMemoryBytes)
+	enc.AddInt64("read_bytes", x.ReadBytes)
+	enc.AddInt64("write_bytes", x.WriteBytes)
 	return nil
 }
 
@@ -332,6 +338,10 @@ func (x *AggregateProcessStats) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddObject("upload_time", x.UploadTime)
 	enc.AddObject("download_bytes", x.DownloadBytes)
 	enc.AddObject("upload_bytes", x.UploadBytes)
+	enc.AddObject("cpu_time", x.CpuTime)
+	enc.AddObject("peak_memory_bytes", x.PeakMemoryBytes)
+	enc.AddObject("read_bytes", x.ReadBytes)
+	enc.AddObject("write_bytes", x.WriteBytes)
 	return nil
 }
 
@@ -416,6 +426,7 @@ func (x *JobInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	protoextensions.AddTimestamp(enc, "finished", x.Finished)
 	enc.AddObject("details", x.Details)
 	enc.AddString("auth_token", x.AuthToken)
+	enc.AddObject("aggregate_stats", x.AggregateStats)
 	return nil
 }
 
@@ -555,6 +566,7 @@ func (x *PipelineInfo_Details) MarshalLogObject(enc zapcore.ObjectEncoder) error
 	enc.AddObject("determined", x.Determined)
 	protoextensions.AddDuration(enc, "maximum_expected_uptime", x.MaximumExpectedUptime)
 	protoextensions.AddTimestamp(enc, "workers_started_at", x.WorkersStartedAt)
+	enc.AddBool("persist_datum_logs", x.PersistDatumLogs)
 	return nil
 }
 
@@ -687,6 +699,7 @@ func (x *UpdateJobStateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddInt64("data_recovered", x.DataRecovered)
 	enc.AddInt64("data_total", x.DataTotal)
 	enc.AddObject("stats", x.Stats)
+	enc.AddObject("aggregate_stats", x.AggregateStats)
 	return nil
 }
 
@@ -887,6 +900,7 @@ func (x *CreatePipelineRequest) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddBool("dry_run", x.DryRun)
 	enc.AddObject("determined", x.Determined)
 	protoextensions.AddDuration(enc, "maximum_expected_uptime", x.MaximumExpectedUptime)
+	enc.AddBool("persist_datum_logs", x.PersistDatumLogs)
 	return nil
 }
 
@@ -1343,6 +1357,67 @@ func (x *PipelinesSummary) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt64("paused_pipelines", x.PausedPipelines)
 	enc.AddInt64("failed_pipelines", x.FailedPipelines)
 	enc.AddInt64("unhealthy_pipelines", x.UnhealthyPipelines)
+	enc.AddObject("quota", x.Quota)
+	enc.AddObject("quota_usage", x.QuotaUsage)
+	return nil
+}
+
+func (x *ProjectQuota) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("pipelines", x.Pipelines)
+	enc.AddInt64("workers", x.Workers)
+	enc.AddString("cpu", x.Cpu)
+	enc.AddString("memory", x.Memory)
+	enc.AddInt64("gpus", x.Gpus)
+	enc.AddInt64("concurrent_jobs", x.ConcurrentJobs)
+	return nil
+}
+
+func (x *ProjectQuotaUsage) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddInt64("pipelines", x.Pipelines)
+	enc.AddInt64("workers", x.Workers)
+	enc.AddString("cpu", x.Cpu)
+	enc.AddString("memory", x.Memory)
+	enc.AddInt64("gpus", x.Gpus)
+	enc.AddInt64("concurrent_jobs", x.ConcurrentJobs)
+	return nil
+}
+
+func (x *GetProjectQuotaRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.Project)
+	return nil
+}
+
+func (x *GetProjectQuotaResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("quota", x.Quota)
+	enc.AddObject("usage", x.Usage)
+	return nil
+}
+
+func (x *SetProjectQuotaRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddObject("project", x.Project)
+	enc.AddObject("quota", x.Quota)
+	return nil
+}
+
+func (x *SetProjectQuotaResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
 	return nil
 }
 
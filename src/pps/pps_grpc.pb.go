@@ -63,6 +63,8 @@ const (
 	API_GetProjectDefaults_FullMethodName = "/pps_v2.API/GetProjectDefaults"
 	API_SetProjectDefaults_FullMethodName = "/pps_v2.API/SetProjectDefaults"
 	API_PipelinesSummary_FullMethodName   = "/pps_v2.API/PipelinesSummary"
+	API_GetProjectQuota_FullMethodName    = "/pps_v2.API/GetProjectQuota"
+	API_SetProjectQuota_FullMethodName    = "/pps_v2.API/SetProjectQuota"
 )
 
 // APIClient is the client API for API service.
@@ -131,6 +133,10 @@ type APIClient interface {
 	SetProjectDefaults(ctx context.Context, in *SetProjectDefaultsRequest, opts ...grpc.CallOption) (*SetProjectDefaultsResponse, error)
 	// PipelinesSummary summarizes the pipelines for each requested project.
 	PipelinesSummary(ctx context.Context, in *PipelinesSummaryRequest, opts ...grpc.CallOption) (*PipelinesSummaryResponse, error)
+	// GetProjectQuota returns the quota of a project and its current usage.
+	GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error)
+	// SetProjectQuota sets or removes the quota of a project.
+	SetProjectQuota(ctx context.Context, in *SetProjectQuotaRequest, opts ...grpc.CallOption) (*SetProjectQuotaResponse, error)
 }
 
 type aPIClient struct {
@@ -794,6 +800,24 @@ func (c *aPIClient) PipelinesSummary(ctx context.Context, in *PipelinesSummaryRe
 	return out, nil
 }
 
+func (c *aPIClient) GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error) {
+	out := new(GetProjectQuotaResponse)
+	err := c.cc.Invoke(ctx, API_GetProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetProjectQuota(ctx context.Context, in *SetProjectQuotaRequest, opts ...grpc.CallOption) (*SetProjectQuotaResponse, error) {
+	out := new(SetProjectQuotaResponse)
+	err := c.cc.Invoke(ctx, API_SetProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
@@ -860,6 +884,10 @@ type APIServer interface {
 	SetProjectDefaults(context.Context, *SetProjectDefaultsRequest) (*SetProjectDefaultsResponse, error)
 	// PipelinesSummary summarizes the pipelines for each requested project.
 	PipelinesSummary(context.Context, *PipelinesSummaryRequest) (*PipelinesSummaryResponse, error)
+	// GetProjectQuota returns the quota of a project and its current usage.
+	GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error)
+	// SetProjectQuota sets or removes the quota of a project.
+	SetProjectQuota(context.Context, *SetProjectQuotaRequest) (*SetProjectQuotaResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -993,6 +1021,12 @@ func (UnimplementedAPIServer) SetProjectDefaults(context.Context, *SetProjectDef
 func (UnimplementedAPIServer) PipelinesSummary(context.Context, *PipelinesSummaryRequest) (*PipelinesSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PipelinesSummary not implemented")
 }
+func (UnimplementedAPIServer) GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectQuota not implemented")
+}
+func (UnimplementedAPIServer) SetProjectQuota(context.Context, *SetProjectQuotaRequest) (*SetProjectQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectQuota not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -1803,6 +1837,42 @@ func _API_PipelinesSummary_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetProjectQuota(ctx, req.(*GetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetProjectQuota(ctx, req.(*SetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1930,6 +2000,14 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PipelinesSummary",
 			Handler:    _API_PipelinesSummary_Handler,
 		},
+		{
+			MethodName: "GetProjectQuota",
+			Handler:    _API_GetProjectQuota_Handler,
+		},
+		{
+			MethodName: "SetProjectQuota",
+			Handler:    _API_SetProjectQuota_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
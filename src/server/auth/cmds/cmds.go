@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pkg/browser"
@@ -25,6 +26,8 @@ import (
 	"golang.org/x/text/message"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func requestOIDCLogin(c *client.APIClient, openBrowser bool) (string, error) {
@@ -57,10 +60,25 @@ func printRoleBinding(b *auth.RoleBinding) {
 		for r := range roles.Roles {
 			roleList = append(roleList, r)
 		}
+		if e := roles.Expiration; e != nil {
+			fmt.Printf("%v: %v (expires %v)\n", principal, roleList, e.AsTime().Format(time.RFC822))
+			continue
+		}
 		fmt.Printf("%v: %v\n", principal, roleList)
 	}
 }
 
+// expirationFromFlag returns the expiration of a role binding that lasts for
+// expiresIn, or nil if expiresIn is zero.
+func expirationFromFlag(expiresIn time.Duration) *timestamppb.Timestamp {
+	if expiresIn == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Now().Add(expiresIn))
+}
+
+const expiresInHelp = "If set, the roles stop applying after this long, e.g. \"8h\"."
+
 // ActivateCmd returns a cobra.Command to activate Pachyderm's auth system
 func ActivateCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 	var enterprise, supplyRootToken, onlyActivate bool
@@ -276,17 +294,61 @@ func DeactivateCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 	return cmdutil.CreateAlias(deactivate, "auth deactivate")
 }
 
+// scopeFromFlags builds the scope of a new token from command-line flags.
+// Permissions are named as in the auth API and are case-insensitive, and repos
+// are given as <project>/<repo>.  It returns nil if no flag narrows the token.
+func scopeFromFlags(readOnly bool, permissions, projects, repos []string) (*auth.TokenScope, error) {
+	if !readOnly && len(permissions) == 0 && len(projects) == 0 && len(repos) == 0 {
+		return nil, nil
+	}
+	scope := &auth.TokenScope{ReadOnly: readOnly}
+	for _, p := range permissions {
+		permission, ok := auth.Permission_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, errors.Errorf("unknown permission %q", p)
+		}
+		scope.Permissions = append(scope.Permissions, auth.Permission(permission))
+	}
+	for _, p := range projects {
+		scope.Resources = append(scope.Resources, client.NewProject(p).AuthResource())
+	}
+	for _, r := range repos {
+		project, repo, ok := strings.Cut(r, "/")
+		if !ok {
+			return nil, errors.Errorf("repo %q must be given as <project>/<repo>", r)
+		}
+		scope.Resources = append(scope.Resources, client.NewRepo(project, repo).AuthResource())
+	}
+	return scope, nil
+}
+
+// addScopeFlags adds the flags read by scopeFromFlags to cmd.
+func addScopeFlags(cmd *cobra.Command, readOnly *bool, permissions, projects, repos *[]string) {
+	cmd.PersistentFlags().BoolVar(readOnly, "read-only", false, "If set, the token can only be used for calls that don't change the state of the cluster.")
+	cmd.PersistentFlags().StringSliceVar(permissions, "permissions", nil, "Specify a comma-separated list of the only permissions the token may use, e.g. repo_read,repo_write.")
+	cmd.PersistentFlags().StringSliceVar(projects, "projects", nil, "Specify a comma-separated list of the only projects, and the repos in them, the token may be used on.")
+	cmd.PersistentFlags().StringSliceVar(repos, "repos", nil, "Specify a comma-separated list of the only repos, as <project>/<repo>, the token may be used on.")
+}
+
 // LoginCmd returns a cobra.Command to login to a Pachyderm cluster with your
 // GitHub account. Any resources that have been restricted to the email address
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd(pachctlCfg *pachctl.Config) *cobra.Command {
-	var noBrowser, enterprise, idToken bool
+	var noBrowser, enterprise, idToken, readOnly bool
+	var permissions, projects, repos []string
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "This command Logs in to Pachyderm. Any resources that have been restricted to " +
 			"the account you have with your ID provider (e.g. GitHub, Okta) " +
-			"account will subsequently be accessible.",
+			"account will subsequently be accessible. The session's token can be narrowed " +
+			"with --read-only, --permissions, --projects and --repos.",
+		Example: "\t- {{alias}}" +
+			"\t- {{alias}} --read-only --projects default",
 		Run: cmdutil.Run(func(cmd *cobra.Command, args []string) (retErr error) {
+			scope, err := scopeFromFlags(readOnly, permissions, projects, repos)
+			if err != nil {
+				return err
+			}
 			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), enterprise)
 			if err != nil {
 				return errors.Wrapf(err, "could not connect")
@@ -303,7 +365,7 @@ func LoginCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 				}
 				resp, authErr = c.Authenticate(
 					c.Ctx(),
-					&auth.AuthenticateRequest{IdToken: strings.TrimSpace(token)})
+					&auth.AuthenticateRequest{IdToken: strings.TrimSpace(token), Scope: scope})
 				if authErr != nil {
 					return errors.Wrapf(grpcutil.ScrubGRPC(authErr),
 						"authorization failed (Pachyderm logs may contain more information)")
@@ -314,7 +376,7 @@ func LoginCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 					fmt.Println("Retrieving Pachyderm token...")
 					resp, authErr = c.Authenticate(
 						c.Ctx(),
-						&auth.AuthenticateRequest{OidcState: state})
+						&auth.AuthenticateRequest{OidcState: state, Scope: scope})
 					if authErr != nil {
 						return errors.Wrapf(grpcutil.ScrubGRPC(authErr),
 							"authorization failed (OIDC state token: %q; Pachyderm logs may "+
@@ -337,6 +399,7 @@ func LoginCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 	login.PersistentFlags().BoolVarP(&idToken, "id-token", "t", false,
 		"If set, read an ID token on stdin to authenticate the user")
 	login.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Login for the active enterprise context.")
+	addScopeFlags(login, &readOnly, &permissions, &projects, &repos)
 	return cmdutil.CreateAlias(login, "auth login")
 }
 
@@ -402,6 +465,25 @@ func WhoamiCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 			if e := resp.Expiration; e != nil {
 				fmt.Printf("session expires: %v\n", e.AsTime().Format(time.RFC822))
 			}
+			if s := resp.Scope; s != nil {
+				if s.ReadOnly {
+					fmt.Println("token is read-only")
+				}
+				if len(s.Permissions) > 0 {
+					var names []string
+					for _, p := range s.Permissions {
+						names = append(names, p.String())
+					}
+					fmt.Printf("token is limited to permissions: %s\n", strings.Join(names, ", "))
+				}
+				if len(s.Resources) > 0 {
+					var names []string
+					for _, r := range s.Resources {
+						names = append(names, strings.TrimSuffix(r.Type.String()+":"+r.Name, ":"))
+					}
+					fmt.Printf("token is limited to resources: %s\n", strings.Join(names, ", "))
+				}
+			}
 			return nil
 		}),
 	}
@@ -412,9 +494,9 @@ func WhoamiCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 // GetRobotTokenCmd returns a cobra command that lets a user get a pachyderm
 // token on behalf of themselves or another user
 func GetRobotTokenCmd(pachctlCfg *pachctl.Config) *cobra.Command {
-	var enterprise bool
-	var quiet bool
+	var enterprise, quiet, readOnly bool
 	var ttl string
+	var permissions, projects, repos []string
 	getAuthToken := &cobra.Command{
 		Use:   "{{alias}} [username]",
 		Short: "Get an auth token for a robot user with the specified name.",
@@ -423,8 +505,14 @@ func GetRobotTokenCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 			"\t- {{alias}} my-robot --ttl 1h" +
 			"\t- {{alias}} my-robot --quiet" +
 			"\t- {{alias}} my-robot --quiet --ttl 1h" +
-			"\t- {{alias}} my-robot --enterprise",
+			"\t- {{alias}} my-robot --enterprise" +
+			"\t- {{alias}} my-robot --read-only --repos default/images" +
+			"\t- {{alias}} my-robot --permissions repo_read,repo_write --projects ci",
 		Run: cmdutil.RunBoundedArgs(1, 1, func(cmd *cobra.Command, args []string) (retErr error) {
+			scope, err := scopeFromFlags(readOnly, permissions, projects, repos)
+			if err != nil {
+				return err
+			}
 			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), enterprise)
 			if err != nil {
 				return errors.Wrapf(err, "could not connect")
@@ -433,6 +521,7 @@ func GetRobotTokenCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 
 			req := &auth.GetRobotTokenRequest{
 				Robot: args[0],
+				Scope: scope,
 			}
 			if ttl != "" {
 				d, err := time.ParseDuration(ttl)
@@ -460,6 +549,7 @@ func GetRobotTokenCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 		"resulting auth token will have the given lifetime. If not set, the token does not expire."+
 		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
 	getAuthToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a robot token for the enterprise context.")
+	addScopeFlags(getAuthToken, &readOnly, &permissions, &projects, &repos)
 	return cmdutil.CreateAlias(getAuthToken, "auth get-robot-token")
 }
 
@@ -572,6 +662,113 @@ func UseAuthTokenCmd() *cobra.Command {
 	return cmdutil.CreateAlias(useAuthToken, "auth use-auth-token")
 }
 
+// CheckCmd returns a cobra command that groups the commands for checking a
+// subject's permissions, and with --explain sends an ExplainAuthorization
+// request to pachd to show which role bindings grant each permission.
+func CheckCmd(pachCtx *config.Context, pachctlCfg *pachctl.Config) *cobra.Command {
+	var explain bool
+	var permissions []string
+	check := &cobra.Command{
+		Use:   "{{alias}} --explain <subject> <resource>",
+		Short: "Check whether a subject has a permission on a resource",
+		Long: "Check whether a subject has a permission on a resource.  With --explain, this command lists, for each permission, " +
+			"the role bindings on the cluster, the project, and the resource itself that grant it to the subject, either directly " +
+			"or through the subject's groups, and the roles that would grant it otherwise.  The resource is given as `cluster`, " +
+			"`project:<project>`, or `repo:[<project>/]<repo>`.",
+		Example: "\t- {{alias}} --explain user:alice@domain.com repo:images" +
+			"\t- {{alias}} --explain user:alice@domain.com repo:bar/images --permissions repo_write" +
+			"\t- {{alias}} --explain robot:my-robot project:bar",
+		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) (retErr error) {
+			if !explain {
+				return errors.New("use --explain, or one of the subcommands, to check a subject's permissions")
+			}
+			resource, err := resourceFromArg(pachCtx.Project, args[1])
+			if err != nil {
+				return err
+			}
+			req := &auth.ExplainAuthorizationRequest{Resource: resource, Principal: args[0]}
+			for _, p := range permissions {
+				permission, ok := auth.Permission_value[strings.ToUpper(p)]
+				if !ok {
+					return errors.Errorf("unknown permission %q", p)
+				}
+				req.Permissions = append(req.Permissions, auth.Permission(permission))
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+			resp, err := c.ExplainAuthorization(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printExplanation(resp)
+			return nil
+		}),
+	}
+	check.Flags().BoolVar(&explain, "explain", false, "Explain which role bindings grant the subject each permission on the resource.")
+	check.Flags().StringSliceVar(&permissions, "permissions", nil, "Specify a comma-separated list of permissions to explain, e.g. repo_read,repo_write; by default every permission that applies to the resource is explained.")
+	return cmdutil.CreateAlias(check, "auth check")
+}
+
+// resourceFromArg parses a resource given as `cluster`, `project:<project>`,
+// or `repo:[<project>/]<repo>`, where the project defaults to project.
+func resourceFromArg(project, arg string) (*auth.Resource, error) {
+	kind, name, _ := strings.Cut(arg, ":")
+	switch strings.ToLower(kind) {
+	case "cluster":
+		return &auth.Resource{Type: auth.ResourceType_CLUSTER}, nil
+	case "project":
+		if name == "" {
+			return nil, errors.Errorf("resource %q must name a project", arg)
+		}
+		return client.NewProject(name).AuthResource(), nil
+	case "repo":
+		if name == "" {
+			return nil, errors.Errorf("resource %q must name a repo", arg)
+		}
+		if p, r, ok := strings.Cut(name, "/"); ok {
+			project, name = p, r
+		}
+		return client.NewRepo(project, name).AuthResource(), nil
+	default:
+		return nil, errors.Errorf("resource %q must be given as cluster, project:<project>, or repo:[<project>/]<repo>", arg)
+	}
+}
+
+// printExplanation prints the response to an ExplainAuthorization request.
+func printExplanation(resp *auth.ExplainAuthorizationResponse) {
+	fmt.Printf("Principal: %v\n", resp.Principal)
+	if len(resp.Groups) > 0 {
+		fmt.Printf("Groups: %v\n", strings.Join(resp.Groups, ", "))
+	}
+	for _, e := range resp.Permissions {
+		status := "denied"
+		if e.Granted {
+			status = "granted"
+		}
+		fmt.Printf("%v: %v\n", e.Permission, status)
+		for _, g := range e.Grants {
+			r := strings.TrimSuffix(g.Resource.Type.String()+":"+g.Resource.Name, ":")
+			var note string
+			switch {
+			case g.Expired:
+				note = fmt.Sprintf(" (expired %v)", g.Expiration.AsTime().Format(time.RFC822))
+			case g.Expiration != nil:
+				note = fmt.Sprintf(" (expires %v)", g.Expiration.AsTime().Format(time.RFC822))
+			}
+			fmt.Printf("  %v grants %v to %v%v\n", r, g.Role, g.Subject, note)
+		}
+		if e.DeniedByScope {
+			fmt.Println("  denied by the scope of the caller's token")
+		}
+		if !e.Granted && len(e.Roles) > 0 {
+			fmt.Printf("  would be granted by: %v\n", strings.Join(e.Roles, ", "))
+		}
+	}
+}
+
 // CheckRepoCmd returns a cobra command that sends a GetPermissions request to
 // pachd to determine what permissions a user has on the repo.
 func CheckRepoCmd(pachCtx *config.Context, pachctlCfg *pachctl.Config) *cobra.Command {
@@ -616,13 +813,15 @@ func CheckRepoCmd(pachCtx *config.Context, pachctlCfg *pachctl.Config) *cobra.Co
 // SetRepoRoleBindingCmd returns a cobra command that sets the roles for a user on a repo
 func SetRepoRoleBindingCmd(pachCtx *config.Context, pachctlCfg *pachctl.Config) *cobra.Command {
 	project := pachCtx.Project
+	var expiresIn time.Duration
 	setScope := &cobra.Command{
 		Use:   "{{alias}} <repo> [role1,role2 | none ] <subject>",
 		Short: "Set the roles that a subject has on repo",
 		Long:  "This command sets the roles (`repoReader`, `repoWriter`, `repoOwner`) that a subject (user, robot) has on a given repo.",
 		Example: "\t- {{alias}} foo repoOwner user:alan.watts@domain.com" +
 			"\t- {{alias}} foo repoWriter, repoReader robot:my-robot" +
-			"\t- {{alias}} foo none robot:my-robot --project foobar",
+			"\t- {{alias}} foo none robot:my-robot --project foobar" +
+			"\t- {{alias}} foo repoWriter user:contractor@domain.com --expires-in 72h",
 		Run: cmdutil.RunFixedArgs(3, func(cmd *cobra.Command, args []string) (retErr error) {
 			ctx := cmd.Context()
 			var roles []string
@@ -638,11 +837,17 @@ func SetRepoRoleBindingCmd(pachCtx *config.Context, pachctlCfg *pachctl.Config)
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer errors.Close(&retErr, c, "close client")
-			err = c.ModifyRepoRoleBinding(c.Ctx(), project, repo, subject, roles)
+			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+				Resource:   client.NewRepo(project, repo).AuthResource(),
+				Principal:  subject,
+				Roles:      roles,
+				Expiration: expirationFromFlag(expiresIn),
+			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
 	setScope.Flags().StringVar(&project, "project", project, "The project containing the repo.")
+	setScope.Flags().DurationVar(&expiresIn, "expires-in", 0, expiresInHelp)
 	return cmdutil.CreateAliases(setScope, "auth set repo", "repos")
 }
 
@@ -714,12 +919,14 @@ func CheckProjectCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 
 // SetProjectRoleBindingCmd returns a cobra command that sets the roles for a user on a project
 func SetProjectRoleBindingCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	var expiresIn time.Duration
 	cmd := &cobra.Command{
 		Use:   "{{alias}} <project> [role1,role2 | none ] <subject>",
 		Short: "Set the roles that a subject has on a project ",
 		Long:  "This command sets the roles that a given subject has on a given project (`projectViewer`, `projectWriter`, `projectOwner`, `projectCreator`).",
 		Example: "\t- {{alias}} foo projectOwner user:alan.watts@domain.com" +
-			"\t- {{alias}} foo projectWriter, projectReader robot:my-robot",
+			"\t- {{alias}} foo projectWriter, projectReader robot:my-robot" +
+			"\t- {{alias}} foo projectOwner user:oncall@domain.com --expires-in 4h",
 		Run: cmdutil.RunFixedArgs(3, func(cmd *cobra.Command, args []string) (retErr error) {
 			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
 			if err != nil {
@@ -739,13 +946,15 @@ func SetProjectRoleBindingCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 			}
 
 			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
-				Resource:  project,
-				Principal: user,
-				Roles:     roles,
+				Resource:   project,
+				Principal:  user,
+				Roles:      roles,
+				Expiration: expirationFromFlag(expiresIn),
 			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, expiresInHelp)
 	return cmdutil.CreateAliases(cmd, "auth set project")
 }
 
@@ -776,12 +985,14 @@ func GetProjectRoleBindingCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 
 // SetClusterRoleBindingCmd returns a cobra command that sets the roles for a user on a resource
 func SetClusterRoleBindingCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	var expiresIn time.Duration
 	setScope := &cobra.Command{
 		Use:   "{{alias}} [role1,role2 | none ] subject",
 		Short: "Set the roles that a subject has on the cluster",
 		Long:  "This command sets the roles that a given subject has on the cluster.",
 		Example: "\t- {{alias}} clusterOwner user:alan.watts@domain.com" +
-			"\t- {{alias}} clusterWriter, clusterReader robot:my-robot",
+			"\t- {{alias}} clusterWriter, clusterReader robot:my-robot" +
+			"\t- {{alias}} clusterAdmin user:oncall@domain.com --expires-in 1h",
 		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) (retErr error) {
 			var roles []string
 			if args[0] == "none" {
@@ -796,10 +1007,16 @@ func SetClusterRoleBindingCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer errors.Close(&retErr, c, "close client")
-			err = c.ModifyClusterRoleBinding(c.Ctx(), subject, roles)
+			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+				Resource:   &auth.Resource{Type: auth.ResourceType_CLUSTER},
+				Principal:  subject,
+				Roles:      roles,
+				Expiration: expirationFromFlag(expiresIn),
+			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	setScope.Flags().DurationVar(&expiresIn, "expires-in", 0, expiresInHelp)
 	return cmdutil.CreateAlias(setScope, "auth set cluster")
 }
 
@@ -953,6 +1170,234 @@ func RolesForPermissionCmd(pachctlCfg *pachctl.Config) *cobra.Command {
 	return cmdutil.CreateAlias(rotateRootToken, "auth roles-for-permission")
 }
 
+// roleFromFlags builds a custom role from the names of its permissions and
+// resource types, which are case-insensitive.
+func roleFromFlags(name string, permissions, canBeBoundTo, returnedFor []string) (*auth.Role, error) {
+	role := &auth.Role{Name: name}
+	for _, p := range permissions {
+		permission, ok := auth.Permission_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, errors.Errorf("unknown permission %q", p)
+		}
+		role.Permissions = append(role.Permissions, auth.Permission(permission))
+	}
+	resourceTypes := func(names []string) ([]auth.ResourceType, error) {
+		var result []auth.ResourceType
+		for _, n := range names {
+			rt, ok := auth.ResourceType_value[strings.ToUpper(n)]
+			if !ok {
+				return nil, errors.Errorf("unknown resource type %q", n)
+			}
+			result = append(result, auth.ResourceType(rt))
+		}
+		return result, nil
+	}
+	var err error
+	if role.CanBeBoundTo, err = resourceTypes(canBeBoundTo); err != nil {
+		return nil, err
+	}
+	if role.ReturnedFor, err = resourceTypes(returnedFor); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateRoleCmd returns a cobra command that creates a custom role
+func CreateRoleCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	var permissions, canBeBoundTo, returnedFor []string
+	createRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Create a custom role",
+		Long: "This command creates a custom role with the given permissions, which can then be used in role bindings like the built-in roles. " +
+			"Permissions and resource types are named as in 'pachctl auth roles-for-permission', e.g. REPO_READ and PROJECT.",
+		Example: "	- {{alias}} branchCreator --permissions repo_read,repo_list_branch,repo_create_branch --can-be-bound-to cluster,project,repo" +
+			"	- {{alias}} branchCreator --permissions repo_create_branch --can-be-bound-to project --returned-for project,repo",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			role, err := roleFromFlags(args[0], permissions, canBeBoundTo, returnedFor)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+
+			_, err = c.CreateRole(c.Ctx(), &auth.CreateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createRole.Flags().StringSliceVar(&permissions, "permissions", nil, "Specify a comma-separated list of the permissions the role grants.")
+	createRole.Flags().StringSliceVar(&canBeBoundTo, "can-be-bound-to", nil, "Specify a comma-separated list of the resource types the role can be bound to.")
+	createRole.Flags().StringSliceVar(&returnedFor, "returned-for", nil, "Specify a comma-separated list of the resource types the role is returned for; defaults to --can-be-bound-to.")
+	return cmdutil.CreateAlias(createRole, "auth create role")
+}
+
+// UpdateRoleCmd returns a cobra command that replaces the definition of a
+// custom role
+func UpdateRoleCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	var permissions, canBeBoundTo, returnedFor []string
+	updateRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Update a custom role",
+		Long: "This command replaces the permissions and resource types of a custom role. " +
+			"The change applies immediately to every role binding that uses the role.",
+		Example: "	- {{alias}} branchCreator --permissions repo_read,repo_list_branch,repo_create_branch --can-be-bound-to project,repo",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			role, err := roleFromFlags(args[0], permissions, canBeBoundTo, returnedFor)
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+
+			_, err = c.UpdateRole(c.Ctx(), &auth.UpdateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateRole.Flags().StringSliceVar(&permissions, "permissions", nil, "Specify a comma-separated list of the permissions the role grants.")
+	updateRole.Flags().StringSliceVar(&canBeBoundTo, "can-be-bound-to", nil, "Specify a comma-separated list of the resource types the role can be bound to.")
+	updateRole.Flags().StringSliceVar(&returnedFor, "returned-for", nil, "Specify a comma-separated list of the resource types the role is returned for; defaults to --can-be-bound-to.")
+	return cmdutil.CreateAlias(updateRole, "auth update role")
+}
+
+// DeleteRoleCmd returns a cobra command that deletes a custom role
+func DeleteRoleCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	deleteRole := &cobra.Command{
+		Use:     "{{alias}} <role>",
+		Short:   "Delete a custom role",
+		Long:    "This command deletes a custom role. A role can't be deleted while it's in a role binding.",
+		Example: "	- {{alias}} branchCreator",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+
+			_, err = c.DeleteRole(c.Ctx(), &auth.DeleteRoleRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRole, "auth delete role")
+}
+
+// ListRoleCmd returns a cobra command that lists the built-in and custom roles
+func ListRoleCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	listRole := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List the built-in and custom roles",
+		Long:  "This command lists the built-in and custom roles, the resource types they can be bound to, and their permissions.",
+		Run: cmdutil.RunFixedArgs(0, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+
+			resp, err := c.ListRoles(c.Ctx(), &auth.ListRolesRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "NAME\tCUSTOM\tBOUND TO\tPERMISSIONS\t\n")
+			for _, r := range resp.Roles {
+				boundTo := make([]string, len(r.CanBeBoundTo))
+				for i, rt := range r.CanBeBoundTo {
+					boundTo[i] = rt.String()
+				}
+				permissions := make([]string, len(r.Permissions))
+				for i, p := range r.Permissions {
+					permissions[i] = p.String()
+				}
+				fmt.Fprintf(writer, "%s\t%t\t%s\t%s\t\n", r.Name, r.Custom, strings.Join(boundTo, ","), strings.Join(permissions, ","))
+			}
+			return writer.Flush()
+		}),
+	}
+	return cmdutil.CreateAliases(listRole, "auth list role", "roles")
+}
+
+// AuditCmd returns a cobra command that lists the events in the audit log.
+func AuditCmd(pachctlCfg *pachctl.Config) *cobra.Command {
+	var since, until cmdutil.TimeFlag
+	var principal, method, resource, output string
+	var limit int64
+	audit := &cobra.Command{
+		Short: "List the calls that changed the state of the cluster",
+		Long: "This command lists the calls to APIs that changed the state of the cluster, newest first, " +
+			"with the principal that made them, the resource they acted on, and their outcome.\n\n" +
+			"Use '--output jsonl' to export the events as JSON lines.",
+		Example: "\t- {{alias}}\n" +
+			"\t- {{alias}} --since 24h --principal user:alice@example.com\n" +
+			"\t- {{alias}} --method pfs_v2. --resource default/images\n" +
+			"\t- {{alias}} --since 2024-01-01T00:00:00Z --output jsonl > audit.jsonl",
+		Run: cmdutil.RunFixedArgs(0, func(cmd *cobra.Command, args []string) (retErr error) {
+			if output != "" && output != "table" && output != "jsonl" {
+				return errors.Errorf("invalid output format %q; must be \"table\" or \"jsonl\"", output)
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer errors.Close(&retErr, c, "close client")
+
+			req := &auth.ListAuditEventsRequest{
+				Principal: principal,
+				Method:    method,
+				Resource:  resource,
+				Limit:     limit,
+			}
+			if t := time.Time(since); !t.IsZero() {
+				req.Since = timestamppb.New(t)
+			}
+			if t := time.Time(until); !t.IsZero() {
+				req.Until = timestamppb.New(t)
+			}
+			events, err := c.ListAuditEvents(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if output == "jsonl" {
+				w := bufio.NewWriter(os.Stdout)
+				if err := grpcutil.ForEach[*auth.AuditEvent](events, func(ev *auth.AuditEvent) error {
+					js, err := protojson.Marshal(ev)
+					if err != nil {
+						return errors.Wrap(err, "marshal audit event")
+					}
+					if _, err := w.Write(append(js, '\n')); err != nil {
+						return errors.EnsureStack(err)
+					}
+					return nil
+				}); err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				return errors.EnsureStack(w.Flush())
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "TIME\tPRINCIPAL\tMETHOD\tRESOURCE\tCODE\tDURATION\t\n")
+			if err := grpcutil.ForEach[*auth.AuditEvent](events, func(ev *auth.AuditEvent) error {
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+					ev.Time.AsTime().Local().Format(time.RFC3339), ev.Principal, ev.Method, ev.Resource,
+					ev.Code, ev.Duration.AsDuration().Round(time.Millisecond))
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	audit.Flags().Var(&since, "since", "Only list events at or after this time, given as an RFC 3339 date or a duration ago, e.g. 24h.")
+	audit.Flags().Var(&until, "until", "Only list events before this time, given as an RFC 3339 date or a duration ago, e.g. 1h.")
+	audit.Flags().StringVar(&principal, "principal", "", "Only list events for calls made by this principal, e.g. user:alice@example.com.")
+	audit.Flags().StringVar(&method, "method", "", "Only list events whose method contains this string, e.g. CreateRepo or pps_v2.")
+	audit.Flags().StringVar(&resource, "resource", "", "Only list events whose resource contains this string, e.g. a repo or pipeline name.")
+	audit.Flags().Int64Var(&limit, "limit", 0, "The maximum number of events to list, or 0 for no limit.")
+	audit.Flags().StringVarP(&output, "output", "o", "table", "Output format: \"table\" or \"jsonl\".")
+	return cmdutil.CreateAlias(audit, "auth audit")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
@@ -973,15 +1418,34 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 		Long:  "Set the role bindings for a resource",
 	}
 
-	check := &cobra.Command{
-		Short: "Check whether a subject has a permission on a resource",
-		Long:  "Check whether a subject has a permission on a resource",
+	create := &cobra.Command{
+		Short: "Create a custom role",
+		Long:  "Create a custom role",
+	}
+
+	update := &cobra.Command{
+		Short: "Update a custom role",
+		Long:  "Update a custom role",
+	}
+
+	del := &cobra.Command{
+		Short: "Delete a custom role",
+		Long:  "Delete a custom role",
+	}
+
+	list := &cobra.Command{
+		Short: "List roles",
+		Long:  "List roles",
 	}
 
 	commands = append(commands, cmdutil.CreateAlias(auth, "auth"))
 	commands = append(commands, cmdutil.CreateAlias(get, "auth get"))
 	commands = append(commands, cmdutil.CreateAlias(set, "auth set"))
-	commands = append(commands, cmdutil.CreateAlias(check, "auth check"))
+	commands = append(commands, CheckCmd(pachCtx, pachctlCfg))
+	commands = append(commands, cmdutil.CreateAlias(create, "auth create"))
+	commands = append(commands, cmdutil.CreateAlias(update, "auth update"))
+	commands = append(commands, cmdutil.CreateAlias(del, "auth delete"))
+	commands = append(commands, cmdutil.CreateAlias(list, "auth list"))
 	commands = append(commands, ActivateCmd(pachctlCfg))
 	commands = append(commands, DeactivateCmd(pachctlCfg))
 	commands = append(commands, LoginCmd(pachctlCfg))
@@ -1005,5 +1469,10 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	commands = append(commands, SetEnterpriseRoleBindingCmd(pachctlCfg))
 	commands = append(commands, RotateRootToken(pachctlCfg))
 	commands = append(commands, RolesForPermissionCmd(pachctlCfg))
+	commands = append(commands, CreateRoleCmd(pachctlCfg))
+	commands = append(commands, UpdateRoleCmd(pachctlCfg))
+	commands = append(commands, DeleteRoleCmd(pachctlCfg))
+	commands = append(commands, ListRoleCmd(pachctlCfg))
+	commands = append(commands, AuditCmd(pachctlCfg))
 	return commands
 }
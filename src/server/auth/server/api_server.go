@@ -8,10 +8,12 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"sigs.k8s.io/yaml"
 
 	"go.uber.org/zap"
@@ -76,6 +78,9 @@ type apiServer struct {
 	members col.PostgresCollection
 	// groups is a collection of group -> usernames mappings.
 	groups col.PostgresCollection
+	// customRoles is a collection of role name -> role mappings for the roles
+	// created at runtime. Built-in roles are defined in roles.go.
+	customRoles col.PostgresCollection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
@@ -111,6 +116,7 @@ func NewAuthServer(env Env, public, requireNoncriticalServers, watchesEnabled bo
 		roleBindings:   authdb.RoleBindingCollection(env.DB, env.Listener),
 		members:        authdb.MembersCollection(env.DB, env.Listener),
 		groups:         authdb.GroupsCollection(env.DB, env.Listener),
+		customRoles:    authdb.RolesCollection(env.DB, env.Listener),
 		oidcStates:     oidcStates,
 		public:         public,
 		watchesEnabled: watchesEnabled,
@@ -135,6 +141,8 @@ func NewAuthServer(env Env, public, requireNoncriticalServers, watchesEnabled bo
 	if err := s.deleteExpiredTokensRoutine(env.BackgroundContext); err != nil {
 		return nil, err
 	}
+	s.deleteExpiredRoleBindingsRoutine(env.BackgroundContext)
+	s.deleteOldAuditEventsRoutine(env.BackgroundContext)
 	return s, nil
 }
 
@@ -469,7 +477,7 @@ func (a *apiServer) hasClusterRole(ctx context.Context, username string, role st
 	if err != nil {
 		return false, err
 	}
-	if roles, ok := bindings.Entries[username]; ok {
+	if roles, ok := bindings.Entries[username]; ok && !rolesExpired(nil, roles) {
 		for r := range roles.Roles {
 			if r == role {
 				return true, nil
@@ -542,7 +550,7 @@ func (a *apiServer) activateInTransaction(ctx context.Context, txCtx *txncontext
 	}); err != nil {
 		return nil, errors.Wrap(err, "add cluster role binding")
 	}
-	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txCtx, auth.HashToken(pachToken), auth.RootUser); err != nil {
+	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txCtx, auth.HashToken(pachToken), auth.RootUser, nil); err != nil {
 		return nil, errors.Wrap(err, "insert root token")
 	}
 	txCtx.AuthBeingActivated.Store(true)
@@ -577,7 +585,7 @@ func (a *apiServer) rotateRootTokenInTransaction(ctx context.Context, txCtx *txn
 	if rootToken == "" {
 		rootToken = uuid.NewWithoutDashes()
 	}
-	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txCtx, auth.HashToken(rootToken), auth.RootUser); err != nil {
+	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txCtx, auth.HashToken(rootToken), auth.RootUser, nil); err != nil {
 		return nil, err
 	}
 
@@ -602,6 +610,9 @@ func (a *apiServer) Deactivate(ctx context.Context, req *auth.DeactivateRequest)
 		if err := a.authConfig.ReadWrite(sqlTx).DeleteAll(ctx); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if err := a.customRoles.ReadWrite(sqlTx).DeleteAll(ctx); err != nil {
+			return errors.EnsureStack(err)
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -626,6 +637,9 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 	if err := a.isActive(ctx); err != nil {
 		return nil, err
 	}
+	if err := validateScope(req.Scope); err != nil {
+		return nil, err
+	}
 
 	// verify whatever credential the user has presented, and write a new
 	// Pachyderm token for the user that their credential belongs to
@@ -645,7 +659,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		}
 
 		// Generate a new Pachyderm token and write it
-		t, err := a.generateAndInsertAuthToken(ctx, username, int64(60*a.env.Config.SessionDurationMinutes))
+		t, err := a.generateAndInsertAuthToken(ctx, username, int64(60*a.env.Config.SessionDurationMinutes), req.Scope)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
 		}
@@ -678,7 +692,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 			expirationSecs = int64(60 * a.env.Config.SessionDurationMinutes)
 		}
 
-		t, err := a.generateAndInsertAuthToken(ctx, username, expirationSecs)
+		t, err := a.generateAndInsertAuthToken(ctx, username, expirationSecs, req.Scope)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
 		}
@@ -697,7 +711,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 }
 
 func (a *apiServer) evaluateRoleBindingInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, principal string, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
-	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction)
+	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction, a.getRoleInTransaction)
 
 	// Special-case making spec repos world-readable, because the alternative breaks reading pipelines.
 	// TOOD: 2.0 - should we make this a user-configurable cluster binding instead of hard-coding it?
@@ -797,6 +811,7 @@ func (a *apiServer) AuthorizeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	request.restrictToScope(me.Scope, req.Resource)
 
 	return &auth.AuthorizeResponse{
 		Principal:  me.Username,
@@ -895,6 +910,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	return &auth.WhoAmIResponse{
 		Username:   callerInfo.Subject,
 		Expiration: callerInfo.Expiration,
+		Scope:      callerInfo.Scope,
 	}, nil
 }
 
@@ -920,23 +936,27 @@ func (a *apiServer) DeleteRoleBindingInTransaction(ctx context.Context, txnCtx *
 }
 
 // rolesFromRoleSlice converts a slice of strings into *auth.Roles,
-// validating that each role name is valid.
-func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
-	if len(rs) == 0 {
-		return nil, nil
-	}
-
+// validating that each role name is a built-in or custom role.
+func (a *apiServer) rolesFromRoleSlice(ctx context.Context, txnCtx *txncontext.TransactionContext, rs []string) (*auth.Roles, error) {
 	for _, r := range rs {
-		if _, err := getRole(r); err != nil {
+		if _, err := a.getRoleInTransaction(ctx, txnCtx, r); err != nil {
 			return nil, err
 		}
 	}
+	return roleSet(rs), nil
+}
+
+// roleSet converts a slice of strings into *auth.Roles without validating them.
+func roleSet(rs []string) *auth.Roles {
+	if len(rs) == 0 {
+		return nil
+	}
 
 	roles := &auth.Roles{Roles: make(map[string]bool)}
 	for _, r := range rs {
 		roles.Roles[r] = true
 	}
-	return roles, nil
+	return roles
 }
 
 // CreateRoleBindingInTransaction is an internal-only API to create a role binding for a new resource.
@@ -953,7 +973,7 @@ func (a *apiServer) CreateRoleBindingInTransaction(ctx context.Context, txnCtx *
 			return err
 		}
 
-		roles, err := rolesFromRoleSlice(roleSlice)
+		roles, err := a.rolesFromRoleSlice(ctx, txnCtx, roleSlice)
 		if err != nil {
 			return err
 		}
@@ -985,7 +1005,7 @@ func (a *apiServer) AddPipelineReaderToRepoInTransaction(ctx context.Context, tx
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoReaderRole})
+	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoReaderRole}, nil)
 }
 
 // AddPipelineWriterToSourceRepoInTransaction gives a pipeline access to write data to the specified source repo.
@@ -1003,7 +1023,7 @@ func (a *apiServer) AddPipelineWriterToSourceRepoInTransaction(ctx context.Conte
 	if err := a.CheckRepoIsAuthorizedInTransaction(ctx, txnCtx, r, auth.Permission_REPO_ADD_PIPELINE_WRITER); err != nil {
 		return err
 	}
-	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoWriterRole})
+	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoWriterRole}, nil)
 }
 
 // AddPipelineWriterToRepoInTransaction gives a pipeline access to write to it's own output repo.
@@ -1021,7 +1041,7 @@ func (a *apiServer) AddPipelineWriterToRepoInTransaction(ctx context.Context, tx
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(ctx, txnCtx, r.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoWriterRole})
+	return a.setUserRoleBindingInTransaction(ctx, txnCtx, r.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{auth.RepoWriterRole}, nil)
 }
 
 // RemovePipelineReaderFromRepo revokes a pipeline's access to read data from the specified source repo.
@@ -1041,7 +1061,7 @@ func (a *apiServer) RemovePipelineReaderFromRepoInTransaction(ctx context.Contex
 		return err
 	}
 
-	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{})
+	return a.setUserRoleBindingInTransaction(ctx, txnCtx, sourceRepo.AuthResource(), auth.PipelinePrefix+pipeline.String(), []string{}, nil)
 }
 
 // ModifyRoleBindingInTransaction is identical to ModifyRoleBinding except that it can run inside
@@ -1080,18 +1100,27 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 		return nil, err
 	}
 
-	if err := a.setUserRoleBindingInTransaction(ctx, txnCtx, req.Resource, req.Principal, req.Roles); err != nil {
+	if req.Expiration != nil && !req.Expiration.AsTime().After(txnCtx.Timestamp.AsTime()) {
+		return nil, errors.Errorf("role binding expiration %v is not in the future", req.Expiration.AsTime())
+	}
+
+	if err := a.setUserRoleBindingInTransaction(ctx, txnCtx, req.Resource, req.Principal, req.Roles, req.Expiration); err != nil {
 		return nil, err
 	}
 
 	return &auth.ModifyRoleBindingResponse{}, nil
 }
 
-func (a *apiServer) setUserRoleBindingInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string) error {
-	roles, err := rolesFromRoleSlice(roleSlice)
+// setUserRoleBindingInTransaction replaces principal's roles on resource.  If
+// expiration is set, the roles stop applying at that time.
+func (a *apiServer) setUserRoleBindingInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string, expiration *timestamppb.Timestamp) error {
+	roles, err := a.rolesFromRoleSlice(ctx, txnCtx, roleSlice)
 	if err != nil {
 		return err
 	}
+	if roles != nil {
+		roles.Expiration = expiration
+	}
 
 	key := authdb.ResourceKey(resource)
 	roleBindings := a.roleBindings.ReadWrite(txnCtx.SqlTx)
@@ -1130,9 +1159,9 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 
 	// If the request is not in a transaction, block until the cache is updated
 	if req.Resource.Type == auth.ResourceType_CLUSTER {
-		expected, err := rolesFromRoleSlice(req.Roles)
-		if err != nil {
-			return nil, err
+		expected := roleSet(req.Roles)
+		if expected != nil {
+			expected.Expiration = req.Expiration
 		}
 		if err := backoff.Retry(func() error {
 			bindings, ok := a.clusterRoleBindingCache.Load().(*auth.RoleBinding)
@@ -1197,14 +1226,17 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 	}
 
 	subject = auth.RobotPrefix + subject
+	if err := validateScope(req.Scope); err != nil {
+		return nil, err
+	}
 
 	// generate new token, and write to postgres
 	var token string
 	var err error
 	if req.Ttl > 0 {
-		token, err = a.generateAndInsertAuthToken(ctx, subject, req.Ttl)
+		token, err = a.generateAndInsertAuthToken(ctx, subject, req.Ttl, req.Scope)
 	} else {
-		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject)
+		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject, req.Scope)
 	}
 	if err != nil {
 		return nil, err
@@ -1222,7 +1254,7 @@ func (a *apiServer) GetPipelineAuthTokenInTransaction(ctx context.Context, txnCt
 	}
 
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline.String()); err != nil {
+	if err := a.insertAuthTokenNoTTLInTransaction(ctx, txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline.String(), nil); err != nil {
 		return "", errors.Wrapf(err, "error storing token")
 	} else {
 		return token, nil
@@ -1481,7 +1513,101 @@ func (a *apiServer) GetUsers(ctx context.Context, req *auth.GetUsersRequest) (re
 
 // GetRolesForPermission implements the protobuf auth.GetRolesForPermission RPC
 func (a *apiServer) GetRolesForPermission(ctx context.Context, req *auth.GetRolesForPermissionRequest) (resp *auth.GetRolesForPermissionResponse, retErr error) {
-	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission)}, nil
+	roles, err := a.rolesForPermission(ctx, req.Permission)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetRolesForPermissionResponse{Roles: roles}, nil
+}
+
+// CreateRole implements the protobuf auth.CreateRole RPC
+func (a *apiServer) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (resp *auth.CreateRoleResponse, retErr error) {
+	if err := validateCustomRole(req.Role); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		if err := a.customRoles.ReadWrite(sqlTx).Create(ctx, req.Role.Name, req.Role); err != nil {
+			if col.IsErrExists(err) {
+				return errors.Errorf("role %q already exists", req.Role.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRoleResponse{}, nil
+}
+
+// UpdateRole implements the protobuf auth.UpdateRole RPC. The new definition
+// of the role takes effect immediately for everyone it's bound to.
+func (a *apiServer) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (resp *auth.UpdateRoleResponse, retErr error) {
+	if err := validateCustomRole(req.Role); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		customRoles := a.customRoles.ReadWrite(sqlTx)
+		if err := customRoles.Get(ctx, req.Role.Name, &auth.Role{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("unknown role %q", req.Role.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return errors.EnsureStack(customRoles.Put(ctx, req.Role.Name, req.Role))
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.UpdateRoleResponse{}, nil
+}
+
+// DeleteRole implements the protobuf auth.DeleteRole RPC. Roles that are
+// still in a role binding can't be deleted, so that bindings never refer to
+// unknown roles.
+func (a *apiServer) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (resp *auth.DeleteRoleResponse, retErr error) {
+	if _, ok := roles[req.Name]; ok {
+		return nil, errors.Errorf("cannot delete built-in role %q", req.Name)
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		var boundTo []string
+		binding := &auth.RoleBinding{}
+		if err := a.roleBindings.ReadWrite(sqlTx).List(ctx, binding, col.DefaultOptions(), func(key string) error {
+			for _, roles := range binding.Entries {
+				if roles.Roles[req.Name] {
+					boundTo = append(boundTo, key)
+					break
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(boundTo) > 0 {
+			return errors.Errorf("role %q is still bound to %s; remove it from those role bindings first", req.Name, strings.Join(boundTo, ", "))
+		}
+		if err := a.customRoles.ReadWrite(sqlTx).Delete(ctx, req.Name); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("unknown role %q", req.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRoleResponse{}, nil
+}
+
+// ListRoles implements the protobuf auth.ListRoles RPC
+func (a *apiServer) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (resp *auth.ListRolesResponse, retErr error) {
+	result, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		result = append(result, r.role)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return &auth.ListRolesResponse{Roles: result}, nil
 }
 
 func setToList(set map[string]bool) []string {
@@ -1505,6 +1631,7 @@ func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*auth.TokenInfo,
 	if subject := internalauth.GetWhoAmI(ctx); subject != "" {
 		return &auth.TokenInfo{
 			Subject: subject,
+			Scope:   internalauth.GetTokenScope(ctx),
 		}, nil
 	}
 
@@ -1655,9 +1782,9 @@ func (a *apiServer) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthT
 
 	if err := func() error {
 		if ttl > 0 {
-			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl)
+			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl, req.Token.Scope)
 		} else {
-			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject)
+			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject, req.Token.Scope)
 		}
 	}(); err != nil {
 		return nil, errors.Wrapf(err, "error restoring auth token")
@@ -1720,53 +1847,62 @@ type dbTokenInfo struct {
 	Subject     string     `db:"subject"`
 	Expiration  *time.Time `db:"expiration"`
 	HashedToken string     `db:"token_hash"`
+	Scope       []byte     `db:"scope"`
 }
 
-func (ti dbTokenInfo) ToProto() *auth.TokenInfo {
+func (ti dbTokenInfo) ToProto() (*auth.TokenInfo, error) {
+	scope, err := unmarshalScope(ti.Scope)
+	if err != nil {
+		return nil, err
+	}
 	return &auth.TokenInfo{
 		Subject:     ti.Subject,
 		Expiration:  protoutil.MustTimestampFromPointer(ti.Expiration),
 		HashedToken: ti.HashedToken,
-	}
+		Scope:       scope,
+	}, nil
 }
 
 // we interpret an expiration value of NULL as "lives forever".
 func (a *apiServer) lookupAuthTokenInfo(ctx context.Context, tokenHash string) (*auth.TokenInfo, error) {
 	var tokenInfo dbTokenInfo
-	err := a.env.DB.GetContext(ctx, &tokenInfo, `SELECT subject, expiration FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
+	err := a.env.DB.GetContext(ctx, &tokenInfo, `SELECT subject, expiration, scope FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
 	if err != nil {
 		return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
 	}
-	return tokenInfo.ToProto(), nil
+	return tokenInfo.ToProto()
 }
 
 // we will sometimes have expiration values set in the past, since we only remove those values in the deleteExpiredTokensRoutine() goroutine
 func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, error) {
 	var robotTokens []dbTokenInfo
 	if err := a.env.DB.SelectContext(ctx, &robotTokens,
-		`SELECT token_hash, subject, expiration
+		`SELECT token_hash, subject, expiration, scope
 		FROM auth.auth_tokens
 		WHERE subject LIKE $1 || '%'`, auth.RobotPrefix); err != nil {
 		return nil, errors.Wrapf(err, "error querying token")
 	}
 	result := make([]*auth.TokenInfo, len(robotTokens))
 	for i, t := range robotTokens {
-		result[i] = t.ToProto()
+		var err error
+		if result[i], err = t.ToProto(); err != nil {
+			return nil, err
+		}
 	}
 	return result, nil
 }
 
-func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject string, ttlSeconds int64) (string, error) {
+func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject string, ttlSeconds int64, scope *auth.TokenScope) (string, error) {
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds); err != nil {
+	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds, scope); err != nil {
 		return "", errors.Wrap(err, "could not generate and insert auth token")
 	}
 	return token, nil
 }
 
-func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string) (string, error) {
+func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string, scope *auth.TokenScope) (string, error) {
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject); err != nil {
+	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject, scope); err != nil {
 		return "", err
 	}
 
@@ -1774,14 +1910,18 @@ func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject
 }
 
 // generates a token, and stores it's hash and supporting data in postgres
-func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64) error {
+func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64, scope *auth.TokenScope) error {
+	scopeJSON, err := marshalScope(scope)
+	if err != nil {
+		return err
+	}
 	return dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
 		if err := authdb.EnsurePrincipal(ctx, tx, subject); err != nil {
 			return errors.Wrapf(err, "ensure principal %v", subject)
 		}
 		if _, err := tx.ExecContext(ctx,
-			`INSERT INTO auth.auth_tokens (token_hash, subject, expiration)
-		VALUES ($1, $2, NOW() + $3 * interval '1 sec')`, tokenHash, subject, ttlSeconds); err != nil {
+			`INSERT INTO auth.auth_tokens (token_hash, subject, expiration, scope)
+		VALUES ($1, $2, NOW() + $3 * interval '1 sec', $4::jsonb)`, tokenHash, subject, ttlSeconds, scopeJSON); err != nil {
 			if dbutil.IsUniqueViolation(err) {
 				return errors.New("cannot overwrite existing token with same hash")
 			}
@@ -1792,20 +1932,24 @@ func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subje
 }
 
 // TODO(acohen4): replace this function with what's implemented in postgres-integration once it lands
-func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string, scope *auth.TokenScope) error {
 	return a.env.TxnEnv.WithWriteContext(ctx, func(ctx context.Context, txnCtx *txncontext.TransactionContext) error {
-		err := a.insertAuthTokenNoTTLInTransaction(ctx, txnCtx, tokenHash, subject)
+		err := a.insertAuthTokenNoTTLInTransaction(ctx, txnCtx, tokenHash, subject, scope)
 		return err
 	})
 }
 
-func (a *apiServer) insertAuthTokenNoTTLInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTLInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, tokenHash string, subject string, scope *auth.TokenScope) error {
+	scopeJSON, err := marshalScope(scope)
+	if err != nil {
+		return err
+	}
 	if err := authdb.EnsurePrincipal(ctx, txnCtx.SqlTx, subject); err != nil {
 		return errors.Wrapf(err, "ensure principal %v", subject)
 	}
 	if _, err := txnCtx.SqlTx.ExecContext(ctx,
-		`INSERT INTO auth.auth_tokens (token_hash, subject)
-		VALUES ($1, $2)`, tokenHash, subject); err != nil {
+		`INSERT INTO auth.auth_tokens (token_hash, subject, scope)
+		VALUES ($1, $2, $3::jsonb)`, tokenHash, subject, scopeJSON); err != nil {
 		if dbutil.IsUniqueViolation(err) {
 			return errors.New("cannot overwrite existing token with same hash")
 		}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+// ListAuditEvents implements the protobuf auth.ListAuditEvents RPC.  Events
+// are recorded by the audit middleware, whether or not auth is active.
+func (a *apiServer) ListAuditEvents(req *auth.ListAuditEventsRequest, srv auth.API_ListAuditEventsServer) error {
+	return authdb.ListAuditEvents(srv.Context(), a.env.DB, req, srv.Send)
+}
+
+// deleteOldAuditEventsRoutine periodically deletes the events in the audit log
+// that are older than the configured retention period.
+func (a *apiServer) deleteOldAuditEventsRoutine(ctx context.Context) {
+	retention := time.Duration(a.env.Config.AuditLogRetentionDays) * 24 * time.Hour
+	if retention <= 0 {
+		return
+	}
+	ctx = pctx.Child(ctx, "deleteOldAuditEventsRoutine")
+	go func(ctx context.Context) {
+		ticker := backoff.NewTicker(backoff.NewConstantBackOff(time.Duration(cleanupIntervalHours) * time.Hour))
+		defer ticker.Stop()
+		for {
+			n, err := authdb.DeleteAuditEventsBefore(ctx, a.env.DB, time.Now().Add(-retention))
+			if err != nil {
+				log.Error(ctx, "could not delete old audit events", zap.Error(err))
+			} else if n > 0 {
+				log.Info(ctx, "deleted old audit events", zap.Int64("count", n))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}(ctx)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 
@@ -11,6 +12,8 @@ import (
 
 type groupLookupFn func(ctx context.Context, txnCtx *txncontext.TransactionContext, subject string) ([]string, error)
 
+type roleLookupFn func(ctx context.Context, txnCtx *txncontext.TransactionContext, name string) (*auth.Role, error)
+
 // authorizeRequest is a helper struct used to evaluate an incoming Authorize request.
 // It's initialized with the subject and set of permissions required for an Operation,
 // and it looks at role bindings to figure out which permissions are satisfied.
@@ -24,14 +27,16 @@ type authorizeRequest struct {
 	satisfiedPermissions []auth.Permission
 	groupsForSubject     groupLookupFn
 	groups               []string
+	getRole              roleLookupFn
 }
 
-func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn) *authorizeRequest {
+func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn, getRole roleLookupFn) *authorizeRequest {
 	return &authorizeRequest{
 		subject:              subject,
 		roleMap:              make(map[string]*auth.Role),
 		permissions:          permissions,
 		groupsForSubject:     groupsForSubject,
+		getRole:              getRole,
 		satisfiedPermissions: make([]auth.Permission, 0),
 	}
 }
@@ -75,7 +80,7 @@ func (r *authorizeRequest) missing() []auth.Permission {
 // - role bindings that refer to allClusterUsers
 // - role bindings that refer to any group the subject belongs to
 func (r *authorizeRequest) evaluateRoleBinding(ctx context.Context, txnCtx *txncontext.TransactionContext, binding *auth.RoleBinding) error {
-	if err := r.evaluateRoleBindingForSubject(r.subject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(ctx, txnCtx, r.subject, binding); err != nil {
 		return err
 	}
 
@@ -83,7 +88,7 @@ func (r *authorizeRequest) evaluateRoleBinding(ctx context.Context, txnCtx *txnc
 		return nil
 	}
 
-	if err := r.evaluateRoleBindingForSubject(auth.AllClusterUsersSubject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(ctx, txnCtx, auth.AllClusterUsersSubject, binding); err != nil {
 		return err
 	}
 
@@ -103,7 +108,7 @@ func (r *authorizeRequest) evaluateRoleBinding(ctx context.Context, txnCtx *txnc
 	}
 
 	for _, g := range r.groups {
-		if err := r.evaluateRoleBindingForSubject(g, binding); err != nil {
+		if err := r.evaluateRoleBindingForSubject(ctx, txnCtx, g, binding); err != nil {
 			return err
 		}
 	}
@@ -111,26 +116,29 @@ func (r *authorizeRequest) evaluateRoleBinding(ctx context.Context, txnCtx *txnc
 	return nil
 }
 
-func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding *auth.RoleBinding) error {
+func (r *authorizeRequest) evaluateRoleBindingForSubject(ctx context.Context, txnCtx *txncontext.TransactionContext, subject string, binding *auth.RoleBinding) error {
 	if binding.Entries == nil {
 		return nil
 	}
 
 	if entry, ok := binding.Entries[subject]; ok {
+		if rolesExpired(txnCtx, entry) {
+			return nil
+		}
 		for role := range entry.Roles {
 			// Don't look up permissions for a role we already saw in another binding
 			if _, ok := r.roleMap[role]; ok {
 				continue
 			}
 
-			roleDefinition, err := getRole(role)
+			roleDefinition, err := r.getRole(ctx, txnCtx, role)
 			if err != nil {
 				return err
 			}
 
-			r.roleMap[role] = roleDefinition.role
+			r.roleMap[role] = roleDefinition
 
-			for _, permission := range roleDefinition.role.Permissions {
+			for _, permission := range roleDefinition.Permissions {
 				if _, ok := r.permissions[permission]; ok {
 					r.satisfiedPermissions = append(r.satisfiedPermissions, permission)
 					delete(r.permissions, permission)
@@ -140,3 +148,16 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 	}
 	return nil
 }
+
+// rolesExpired returns true if roles have an expiration that has passed as of
+// the transaction's timestamp.
+func rolesExpired(txnCtx *txncontext.TransactionContext, roles *auth.Roles) bool {
+	if roles.Expiration == nil {
+		return false
+	}
+	now := time.Now()
+	if txnCtx != nil && txnCtx.Timestamp != nil {
+		now = txnCtx.Timestamp.AsTime()
+	}
+	return !now.Before(roles.Expiration.AsTime())
+}
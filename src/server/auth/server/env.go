@@ -5,6 +5,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -20,6 +21,7 @@ type Env struct {
 	EtcdClient *etcd.Client
 	Listener   col.PostgresListener
 	TxnEnv     *txnenv.TransactionEnv
+	NewDLock   func(prefix string) dlock.DLock
 
 	// circular dependency
 	GetEnterpriseServer func() enterprise.APIServer
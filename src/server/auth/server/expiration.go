@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+// roleBindingSweepInterval is how often expired roles are removed from role
+// bindings.  Expired roles are ignored when authorizing requests whether or
+// not they've been removed, so this only bounds how long they're listed.
+const roleBindingSweepInterval = time.Hour
+
+// roleBindingSweepLock is the lock that the pachd sweeping expired roles
+// holds, so that only one does.
+const roleBindingSweepLock = "auth-role-binding-sweeper-lock"
+
+// deleteExpiredRoleBindingsRoutine periodically removes expired roles from
+// role bindings.  Only the pachd that holds the sweeper's lock does so.
+func (a *apiServer) deleteExpiredRoleBindingsRoutine(ctx context.Context) {
+	ctx = pctx.Child(ctx, "deleteExpiredRoleBindingsRoutine")
+	go func(ctx context.Context) {
+		backoff.RetryUntilCancel(ctx, func() (retErr error) {
+			lock := a.env.NewDLock(path.Join(a.env.Config.EtcdPrefix, roleBindingSweepLock))
+			ctx, err := lock.Lock(ctx)
+			if err != nil {
+				return errors.Wrap(err, "locking role binding sweeper lock")
+			}
+			defer errors.Invoke1(&retErr, lock.Unlock, ctx, "error unlocking")
+			ticker := backoff.NewTicker(backoff.NewConstantBackOff(roleBindingSweepInterval))
+			defer ticker.Stop()
+			for {
+				n, err := a.deleteExpiredRoleBindings(ctx)
+				if err != nil {
+					log.Error(ctx, "could not delete expired role bindings", zap.Error(err))
+				} else if n > 0 {
+					log.Info(ctx, "deleted expired role bindings", zap.Int("count", n))
+				}
+				select {
+				case <-ctx.Done():
+					return errors.EnsureStack(context.Cause(ctx))
+				case <-ticker.C:
+				}
+			}
+		}, backoff.NewInfiniteBackOff(), backoff.NotifyCtx(ctx, "delete expired role bindings")) //nolint:errcheck
+	}(ctx)
+}
+
+// deleteExpiredRoleBindings removes the principals whose roles have expired
+// from every role binding, and returns the number removed.
+func (a *apiServer) deleteExpiredRoleBindings(ctx context.Context) (int, error) {
+	var n int
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, sqlTx *pachsql.Tx) error {
+		n = 0
+		roleBindings := a.roleBindings.ReadWrite(sqlTx)
+		var expired []string
+		binding := &auth.RoleBinding{}
+		if err := roleBindings.List(ctx, binding, col.DefaultOptions(), func(key string) error {
+			if removeExpiredRoles(binding) > 0 {
+				expired = append(expired, key)
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, key := range expired {
+			var b auth.RoleBinding
+			if err := roleBindings.Update(ctx, key, &b, func() error {
+				n += removeExpiredRoles(&b)
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// removeExpiredRoles deletes the entries of binding whose roles have expired,
+// and returns the number deleted.
+func removeExpiredRoles(binding *auth.RoleBinding) int {
+	var n int
+	for principal, roles := range binding.Entries {
+		if rolesExpired(nil, roles) {
+			delete(binding.Entries, principal)
+			n++
+		}
+	}
+	return n
+}
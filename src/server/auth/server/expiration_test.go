@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+)
+
+func TestExpiredRolesAreIgnored(t *testing.T) {
+	now := time.Now()
+	txnCtx := &txncontext.TransactionContext{Timestamp: timestamppb.New(now)}
+	getRole := func(_ context.Context, _ *txncontext.TransactionContext, name string) (*auth.Role, error) {
+		return roles[name].role, nil
+	}
+	noGroups := func(context.Context, *txncontext.TransactionContext, string) ([]string, error) {
+		return []string{}, nil
+	}
+	binding := &auth.RoleBinding{Entries: map[string]*auth.Roles{
+		"user:alice": {Roles: map[string]bool{auth.RepoWriterRole: true}, Expiration: timestamppb.New(now.Add(time.Hour))},
+		"user:bob":   {Roles: map[string]bool{auth.RepoWriterRole: true}, Expiration: timestamppb.New(now)},
+	}}
+	for subject, want := range map[string]bool{"user:alice": true, "user:bob": false} {
+		r := newAuthorizeRequest(subject, map[auth.Permission]bool{auth.Permission_REPO_WRITE: true}, noGroups, getRole)
+		require.NoError(t, r.evaluateRoleBinding(context.Background(), txnCtx, binding))
+		require.Equal(t, want, r.isSatisfied(), subject)
+	}
+
+	require.Equal(t, 1, removeExpiredRoles(binding))
+	require.Equal(t, 1, len(binding.Entries))
+	_, ok := binding.Entries["user:alice"]
+	require.True(t, ok)
+}
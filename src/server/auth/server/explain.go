@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/authdb"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+)
+
+// resourceBinding is a role binding along with the resource it's on.
+type resourceBinding struct {
+	resource *auth.Resource
+	binding  *auth.RoleBinding
+}
+
+// ExplainAuthorization implements the protobuf auth.ExplainAuthorization RPC
+func (a *apiServer) ExplainAuthorization(ctx context.Context, req *auth.ExplainAuthorizationRequest) (*auth.ExplainAuthorizationResponse, error) {
+	var resp *auth.ExplainAuthorizationResponse
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(ctx context.Context, txnCtx *txncontext.TransactionContext) error {
+		var err error
+		resp, err = a.explainAuthorizationInTransaction(ctx, txnCtx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *apiServer) explainAuthorizationInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, req *auth.ExplainAuthorizationRequest) (*auth.ExplainAuthorizationResponse, error) {
+	me, err := txnCtx.WhoAmI()
+	if err != nil {
+		return nil, err
+	}
+	principal, scope := me.Username, me.Scope
+	if req.Principal != "" && req.Principal != me.Username {
+		// Explaining someone else reveals as much as their permissions do.
+		if err := a.CheckClusterIsAuthorizedInTransaction(ctx, txnCtx, auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL); err != nil {
+			return nil, err
+		}
+		principal, scope = req.Principal, nil
+	}
+
+	groups, err := a.getGroupsInTransaction(ctx, txnCtx, principal)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(groups)
+	bindings, err := a.bindingsForResourceInTransaction(ctx, txnCtx, req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	permissions := req.Permissions
+	if len(permissions) == 0 {
+		permissions = permissionsForResourceType(req.Resource.Type)
+	}
+	subjects := append([]string{principal, auth.AllClusterUsersSubject}, groups...)
+	explanations, err := explainRoleBindings(ctx, txnCtx, subjects, bindings, permissions, a.getRoleInTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := scope == nil || scopeCoversResource(scope, req.Resource)
+	for _, e := range explanations {
+		if e.Granted && scope != nil && !(covered && scopeAllowsPermission(scope, e.Permission)) {
+			e.Granted = false
+			e.DeniedByScope = true
+		}
+		roles, err := a.rolesForPermission(ctx, e.Permission)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range roles {
+			e.Roles = append(e.Roles, r.Name)
+		}
+		sort.Strings(e.Roles)
+	}
+	return &auth.ExplainAuthorizationResponse{
+		Principal:   principal,
+		Groups:      groups,
+		Permissions: explanations,
+	}, nil
+}
+
+// bindingsForResourceInTransaction returns the role bindings that apply to
+// resource, in the order they're evaluated by Authorize: the cluster's, then
+// the project's for a repo, then the resource's own.
+func (a *apiServer) bindingsForResourceInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, resource *auth.Resource) ([]resourceBinding, error) {
+	var result []resourceBinding
+	if resource.Type == auth.ResourceType_SPEC_REPO {
+		// Spec repos are world-readable; see evaluateRoleBindingInTransaction.
+		result = append(result, resourceBinding{
+			resource: resource,
+			binding: &auth.RoleBinding{Entries: map[string]*auth.Roles{
+				auth.AllClusterUsersSubject: {Roles: map[string]bool{auth.RepoReaderRole: true}},
+			}},
+		})
+		resource = &auth.Resource{Type: auth.ResourceType_REPO, Name: resource.Name}
+	}
+
+	cluster, err := a.getClusterRoleBindingInTransaction(ctx, txnCtx)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, resourceBinding{resource: &auth.Resource{Type: auth.ResourceType_CLUSTER}, binding: cluster})
+	if resource.Type == auth.ResourceType_CLUSTER {
+		return result, nil
+	}
+
+	var toGet []*auth.Resource
+	if resource.Type == auth.ResourceType_REPO {
+		repo, err := authRepoResourceToRepo(resource)
+		if err != nil {
+			return nil, err
+		}
+		toGet = append(toGet, &auth.Resource{Type: auth.ResourceType_PROJECT, Name: repo.Project.Name})
+	}
+	toGet = append(toGet, resource)
+	for _, r := range toGet {
+		var binding auth.RoleBinding
+		if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(ctx, authdb.ResourceKey(r), &binding); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, &auth.ErrNoRoleBinding{Resource: r}
+			}
+			return nil, errors.Wrapf(err, "error getting role bindings for %s", authdb.ResourceKey(r))
+		}
+		result = append(result, resourceBinding{resource: r, binding: &binding})
+	}
+	return result, nil
+}
+
+// explainRoleBindings returns an explanation for each of permissions, listing
+// the entries in bindings for any of subjects whose roles include it.
+func explainRoleBindings(ctx context.Context, txnCtx *txncontext.TransactionContext, subjects []string, bindings []resourceBinding, permissions []auth.Permission, getRole roleLookupFn) ([]*auth.PermissionExplanation, error) {
+	var result []*auth.PermissionExplanation
+	byPermission := make(map[auth.Permission]*auth.PermissionExplanation)
+	for _, p := range permissions {
+		if _, ok := byPermission[p]; ok {
+			continue
+		}
+		e := &auth.PermissionExplanation{Permission: p}
+		byPermission[p] = e
+		result = append(result, e)
+	}
+	for _, rb := range bindings {
+		for _, subject := range subjects {
+			entry, ok := rb.binding.Entries[subject]
+			if !ok {
+				continue
+			}
+			expired := rolesExpired(txnCtx, entry)
+			roleNames := make([]string, 0, len(entry.Roles))
+			for name := range entry.Roles {
+				roleNames = append(roleNames, name)
+			}
+			sort.Strings(roleNames)
+			for _, name := range roleNames {
+				role, err := getRole(ctx, txnCtx, name)
+				if err != nil {
+					return nil, err
+				}
+				for _, p := range role.Permissions {
+					e, ok := byPermission[p]
+					if !ok {
+						continue
+					}
+					e.Grants = append(e.Grants, &auth.RoleGrant{
+						Resource:   rb.resource,
+						Subject:    subject,
+						Role:       name,
+						Expiration: entry.Expiration,
+						Expired:    expired,
+					})
+					if !expired {
+						e.Granted = true
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// permissionsForResourceType returns the permissions that are explained for a
+// resource of type rt by default.  Permissions are named after the type of
+// resource they apply to; every permission applies to the cluster.
+func permissionsForResourceType(rt auth.ResourceType) []auth.Permission {
+	var prefixes []string
+	switch rt {
+	case auth.ResourceType_CLUSTER:
+		prefixes = []string{""}
+	case auth.ResourceType_REPO, auth.ResourceType_SPEC_REPO:
+		prefixes = []string{"REPO_", "PIPELINE_"}
+	default:
+		prefixes = []string{rt.String() + "_"}
+	}
+	var result []auth.Permission
+	for v, name := range auth.Permission_name {
+		p := auth.Permission(v)
+		if p == auth.Permission_PERMISSION_UNKNOWN {
+			continue
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				result = append(result, p)
+				break
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
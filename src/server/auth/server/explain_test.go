@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+)
+
+func TestExplainRoleBindings(t *testing.T) {
+	now := time.Now()
+	txnCtx := &txncontext.TransactionContext{Timestamp: timestamppb.New(now)}
+	getRole := func(_ context.Context, _ *txncontext.TransactionContext, name string) (*auth.Role, error) {
+		return roles[name].role, nil
+	}
+	cluster := &auth.Resource{Type: auth.ResourceType_CLUSTER}
+	project := &auth.Resource{Type: auth.ResourceType_PROJECT, Name: "default"}
+	repo := &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"}
+	bindings := []resourceBinding{
+		{cluster, &auth.RoleBinding{Entries: map[string]*auth.Roles{
+			auth.AllClusterUsersSubject: {Roles: map[string]bool{auth.ProjectCreatorRole: true}},
+		}}},
+		{project, &auth.RoleBinding{Entries: map[string]*auth.Roles{
+			"group:eng": {Roles: map[string]bool{auth.RepoReaderRole: true}},
+		}}},
+		{repo, &auth.RoleBinding{Entries: map[string]*auth.Roles{
+			"user:alice": {Roles: map[string]bool{auth.RepoWriterRole: true}, Expiration: timestamppb.New(now.Add(-time.Minute))},
+		}}},
+	}
+	subjects := []string{"user:alice", auth.AllClusterUsersSubject, "group:eng"}
+	perms := []auth.Permission{auth.Permission_REPO_READ, auth.Permission_REPO_WRITE, auth.Permission_REPO_DELETE}
+	got, err := explainRoleBindings(context.Background(), txnCtx, subjects, bindings, perms, getRole)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+
+	read, write, del := got[0], got[1], got[2]
+	require.True(t, read.Granted)
+	require.Equal(t, 2, len(read.Grants))
+	require.Equal(t, "group:eng", read.Grants[0].Subject)
+	require.Equal(t, auth.RepoReaderRole, read.Grants[0].Role)
+	require.Equal(t, project.Name, read.Grants[0].Resource.Name)
+	require.True(t, read.Grants[1].Expired)
+
+	require.False(t, write.Granted)
+	require.Equal(t, 1, len(write.Grants))
+	require.Equal(t, auth.RepoWriterRole, write.Grants[0].Role)
+	require.True(t, write.Grants[0].Expired)
+
+	require.False(t, del.Granted)
+	require.Equal(t, 0, len(del.Grants))
+}
+
+func TestPermissionsForResourceType(t *testing.T) {
+	repo := permissionsForResourceType(auth.ResourceType_REPO)
+	require.OneOfEquals(t, auth.Permission_REPO_READ, repo)
+	require.OneOfEquals(t, auth.Permission_PIPELINE_LIST_JOB, repo)
+	require.NoneEquals(t, auth.Permission_PROJECT_CREATE_REPO, repo)
+	require.Equal(t, len(auth.Permission_name)-1, len(permissionsForResourceType(auth.ResourceType_CLUSTER)))
+}
@@ -1,8 +1,16 @@
 package server
 
 import (
+	"context"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 )
 
 type internalRole struct {
@@ -10,6 +18,8 @@ type internalRole struct {
 	permissionsIndex map[auth.Permission]bool
 }
 
+// roles holds the built-in roles.  Custom roles are stored in the roles
+// collection of the auth server.
 var roles = make(map[string]*internalRole)
 
 func registerRole(r *auth.Role) *auth.Role {
@@ -26,12 +36,70 @@ func registerRole(r *auth.Role) *auth.Role {
 	return r
 }
 
-func getRole(name string) (*internalRole, error) {
-	r, ok := roles[name]
-	if !ok {
-		return nil, errors.Errorf("unknown role %q", name)
+// getRoleInTransaction returns the built-in or custom role with the given name.
+func (a *apiServer) getRoleInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, name string) (*auth.Role, error) {
+	if r, ok := roles[name]; ok {
+		return r.role, nil
+	}
+	var role auth.Role
+	if err := a.customRoles.ReadWrite(txnCtx.SqlTx).Get(ctx, name, &role); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("unknown role %q", name)
+		}
+		return nil, errors.Wrapf(err, "error getting role %q", name)
+	}
+	return &role, nil
+}
+
+// listCustomRoles returns all of the custom roles.
+func (a *apiServer) listCustomRoles(ctx context.Context) ([]*auth.Role, error) {
+	var result []*auth.Role
+	role := &auth.Role{}
+	if err := a.customRoles.ReadOnly().List(ctx, role, col.DefaultOptions(), func(string) error {
+		result = append(result, proto.Clone(role).(*auth.Role))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return result, nil
+}
+
+// validateCustomRole checks that r may be stored as a custom role, and fills in
+// the fields that default from others.
+func validateCustomRole(r *auth.Role) error {
+	if r.Name == "" {
+		return errors.New("role name must be set")
+	}
+	if strings.IndexFunc(r.Name, func(c rune) bool { return c == ',' || unicode.IsSpace(c) }) >= 0 {
+		return errors.Errorf("role name %q must not contain commas or whitespace", r.Name)
+	}
+	if _, ok := roles[r.Name]; ok {
+		return errors.Errorf("%q is a built-in role", r.Name)
 	}
-	return r, nil
+	if len(r.Permissions) == 0 {
+		return errors.Errorf("role %q must have at least one permission", r.Name)
+	}
+	for _, p := range r.Permissions {
+		if _, ok := auth.Permission_name[int32(p)]; !ok || p == auth.Permission_PERMISSION_UNKNOWN {
+			return errors.Errorf("role %q has unknown permission %v", r.Name, p)
+		}
+	}
+	if len(r.CanBeBoundTo) == 0 {
+		return errors.Errorf("role %q must be bindable to at least one resource type", r.Name)
+	}
+	for _, types := range [][]auth.ResourceType{r.CanBeBoundTo, r.ReturnedFor} {
+		for _, rt := range types {
+			if _, ok := auth.ResourceType_name[int32(rt)]; !ok || rt == auth.ResourceType_RESOURCE_TYPE_UNKNOWN {
+				return errors.Errorf("role %q has unknown resource type %v", r.Name, rt)
+			}
+		}
+	}
+	if len(r.ReturnedFor) == 0 {
+		r.ReturnedFor = r.CanBeBoundTo
+	}
+	r.Permissions = combinePermissions(r.Permissions)
+	r.Custom = true
+	return nil
 }
 
 func init() {
@@ -277,6 +345,8 @@ func init() {
 				auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 				auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 				auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
+				auth.Permission_CLUSTER_AUTH_MODIFY_ROLES,
+				auth.Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS,
 				auth.Permission_CLUSTER_ENTERPRISE_ACTIVATE,
 				auth.Permission_CLUSTER_ENTERPRISE_HEARTBEAT,
 				auth.Permission_CLUSTER_ENTERPRISE_GET_CODE,
@@ -284,9 +354,12 @@ func init() {
 				auth.Permission_CLUSTER_DELETE_ALL,
 				auth.Permission_CLUSTER_ENTERPRISE_PAUSE,
 				auth.Permission_CLUSTER_SET_DEFAULTS,
+				auth.Permission_CLUSTER_SET_PROJECT_QUOTA,
 				auth.Permission_CLUSTER_EDIT_CLUSTER_METADATA,
 				auth.Permission_CLUSTER_SNAPSHOTTER,
 				auth.Permission_CLUSTER_RESTART_PACHYDERM,
+				auth.Permission_CLUSTER_MANAGE_WEBHOOKS,
+				auth.Permission_CLUSTER_REPLICATE,
 			}),
 	})
 }
@@ -315,12 +388,26 @@ func roleReturnedForResource(r *auth.Role, rt auth.ResourceType) bool {
 	return false
 }
 
-func rolesForPermission(permission auth.Permission) []*auth.Role {
+// rolesForPermission returns the built-in and custom roles that grant
+// permission.
+func (a *apiServer) rolesForPermission(ctx context.Context, permission auth.Permission) ([]*auth.Role, error) {
 	resp := make([]*auth.Role, 0)
 	for _, r := range roles {
 		if _, ok := r.permissionsIndex[permission]; ok {
 			resp = append(resp, r.role)
 		}
 	}
-	return resp
+	custom, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range custom {
+		for _, p := range r.Permissions {
+			if p == permission {
+				resp = append(resp, r)
+				break
+			}
+		}
+	}
+	return resp, nil
 }
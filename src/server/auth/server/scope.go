@@ -0,0 +1,157 @@
+package server
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// readOnlyPermissions are the permissions a read-only token may exercise.
+// They let a caller inspect the cluster without changing its state.
+// Permissions that reveal credentials, such as extracting auth tokens, are
+// left out.
+var readOnlyPermissions = map[auth.Permission]bool{
+	auth.Permission_CLUSTER_GET_BINDINGS:                       true,
+	auth.Permission_CLUSTER_GET_PACHD_LOGS:                     true,
+	auth.Permission_CLUSTER_GET_LOKI_LOGS:                      true,
+	auth.Permission_CLUSTER_AUTH_GET_CONFIG:                    true,
+	auth.Permission_CLUSTER_AUTH_GET_GROUPS:                    true,
+	auth.Permission_CLUSTER_AUTH_GET_GROUP_USERS:               true,
+	auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL: true,
+	auth.Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS:             true,
+	auth.Permission_CLUSTER_IDENTITY_GET_CONFIG:                true,
+	auth.Permission_CLUSTER_IDENTITY_LIST_IDPS:                 true,
+	auth.Permission_CLUSTER_IDENTITY_GET_IDP:                   true,
+	auth.Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS:         true,
+	auth.Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT:           true,
+	auth.Permission_CLUSTER_DEBUG_DUMP:                         true,
+	auth.Permission_CLUSTER_LICENSE_LIST_CLUSTERS:              true,
+	auth.Permission_CLUSTER_LIST_SECRETS:                       true,
+	auth.Permission_SECRET_INSPECT:                             true,
+	auth.Permission_REPO_READ:                                  true,
+	auth.Permission_REPO_INSPECT_COMMIT:                        true,
+	auth.Permission_REPO_LIST_COMMIT:                           true,
+	auth.Permission_REPO_LIST_BRANCH:                           true,
+	auth.Permission_REPO_INSPECT_FILE:                          true,
+	auth.Permission_REPO_LIST_FILE:                             true,
+	auth.Permission_PIPELINE_LIST_JOB:                          true,
+	auth.Permission_PROJECT_LIST_REPO:                          true,
+}
+
+// validateScope returns an error if a token can't be issued with scope.
+func validateScope(scope *auth.TokenScope) error {
+	if scope == nil {
+		return nil
+	}
+	for _, p := range scope.Permissions {
+		if p == auth.Permission_PERMISSION_UNKNOWN {
+			return errors.New("token scope cannot include an unknown permission")
+		}
+		if scope.ReadOnly && !readOnlyPermissions[p] {
+			return errors.Errorf("token scope is read-only, but includes %v, which is not", p)
+		}
+	}
+	for _, r := range scope.Resources {
+		switch r.GetType() {
+		case auth.ResourceType_CLUSTER:
+		case auth.ResourceType_PROJECT:
+			if r.Name == "" {
+				return errors.New("token scope cannot include a project without a name")
+			}
+		case auth.ResourceType_REPO:
+			if _, err := authRepoResourceToRepo(r); err != nil {
+				return errors.Wrap(err, "token scope")
+			}
+		default:
+			return errors.Errorf("token scope cannot be restricted to resources of type %v", r.GetType())
+		}
+	}
+	return nil
+}
+
+// scopeAllowsPermission returns true if scope lets a token exercise p.
+func scopeAllowsPermission(scope *auth.TokenScope, p auth.Permission) bool {
+	if scope.ReadOnly && !readOnlyPermissions[p] {
+		return false
+	}
+	if len(scope.Permissions) == 0 {
+		return true
+	}
+	for _, sp := range scope.Permissions {
+		if sp == p {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeCoversResource returns true if scope lets a token be used on resource.
+// A cluster covers every resource, and a project covers the repos in it.
+func scopeCoversResource(scope *auth.TokenScope, resource *auth.Resource) bool {
+	if len(scope.Resources) == 0 {
+		return true
+	}
+	rt := resource.Type
+	if rt == auth.ResourceType_SPEC_REPO {
+		rt = auth.ResourceType_REPO
+	}
+	for _, sr := range scope.Resources {
+		switch {
+		case sr.Type == auth.ResourceType_CLUSTER:
+			return true
+		case sr.Type == rt && sr.Name == resource.Name:
+			return true
+		case sr.Type == auth.ResourceType_PROJECT && rt == auth.ResourceType_REPO:
+			if project, _, ok := strings.Cut(resource.Name, "/"); ok && project == sr.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// restrictToScope marks the satisfied permissions that a token with scope may
+// not exercise on resource as missing.  A nil scope restricts nothing.
+func (r *authorizeRequest) restrictToScope(scope *auth.TokenScope, resource *auth.Resource) {
+	if scope == nil {
+		return
+	}
+	covered := scopeCoversResource(scope, resource)
+	satisfied := r.satisfiedPermissions[:0]
+	for _, p := range r.satisfiedPermissions {
+		if covered && scopeAllowsPermission(scope, p) {
+			satisfied = append(satisfied, p)
+		} else {
+			r.permissions[p] = true
+		}
+	}
+	r.satisfiedPermissions = satisfied
+}
+
+// marshalScope returns scope as it's stored in auth.auth_tokens, or nil if the
+// token isn't scoped.
+func marshalScope(scope *auth.TokenScope) (any, error) {
+	if scope == nil {
+		return nil, nil
+	}
+	js, err := protojson.Marshal(scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal token scope")
+	}
+	return string(js), nil
+}
+
+// unmarshalScope is the inverse of marshalScope.
+func unmarshalScope(js []byte) (*auth.TokenScope, error) {
+	if js == nil {
+		return nil, nil
+	}
+	scope := &auth.TokenScope{}
+	if err := protojson.Unmarshal(js, scope); err != nil {
+		return nil, errors.Wrap(err, "unmarshal token scope")
+	}
+	return scope, nil
+}
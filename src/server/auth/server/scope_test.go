@@ -0,0 +1,74 @@
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestRestrictToScope(t *testing.T) {
+	repo := &auth.Resource{Type: auth.ResourceType_REPO, Name: "default/images"}
+	perms := []auth.Permission{auth.Permission_REPO_READ, auth.Permission_REPO_WRITE}
+	for _, tc := range []struct {
+		name     string
+		scope    *auth.TokenScope
+		resource *auth.Resource
+		want     []auth.Permission
+	}{
+		{"unscoped", nil, repo, perms},
+		{"read-only", &auth.TokenScope{ReadOnly: true}, repo, []auth.Permission{auth.Permission_REPO_READ}},
+		{"permissions", &auth.TokenScope{Permissions: []auth.Permission{auth.Permission_REPO_WRITE}}, repo, []auth.Permission{auth.Permission_REPO_WRITE}},
+		{"repo", &auth.TokenScope{Resources: []*auth.Resource{repo}}, repo, perms},
+		{"other repo", &auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_REPO, Name: "default/edges"}}}, repo, nil},
+		{"project", &auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_PROJECT, Name: "default"}}}, repo, perms},
+		{"other project", &auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_PROJECT, Name: "defaults"}}}, repo, nil},
+		{"spec repo", &auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_PROJECT, Name: "default"}}},
+			&auth.Resource{Type: auth.ResourceType_SPEC_REPO, Name: "default/images"}, perms},
+		{"cluster", &auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_CLUSTER}}}, repo, perms},
+		{"cluster resource", &auth.TokenScope{Resources: []*auth.Resource{repo}}, &auth.Resource{Type: auth.ResourceType_CLUSTER}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newAuthorizeRequest("user:alice", map[auth.Permission]bool{}, nil, nil)
+			r.satisfiedPermissions = append(r.satisfiedPermissions, perms...)
+			r.restrictToScope(tc.scope, tc.resource)
+			require.ElementsEqual(t, tc.want, r.satisfiedPermissions)
+			require.Equal(t, len(perms)-len(tc.want), len(r.missing()))
+			require.Equal(t, len(tc.want) == len(perms), r.isSatisfied())
+		})
+	}
+}
+
+func TestValidateScope(t *testing.T) {
+	require.NoError(t, validateScope(nil))
+	require.NoError(t, validateScope(&auth.TokenScope{
+		ReadOnly:    true,
+		Permissions: []auth.Permission{auth.Permission_REPO_READ},
+		Resources: []*auth.Resource{
+			{Type: auth.ResourceType_PROJECT, Name: "default"},
+			{Type: auth.ResourceType_REPO, Name: "default/images"},
+		},
+	}))
+	require.YesError(t, validateScope(&auth.TokenScope{ReadOnly: true, Permissions: []auth.Permission{auth.Permission_REPO_WRITE}}))
+	require.YesError(t, validateScope(&auth.TokenScope{Permissions: []auth.Permission{auth.Permission_PERMISSION_UNKNOWN}}))
+	require.YesError(t, validateScope(&auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_REPO, Name: "images"}}}))
+	require.YesError(t, validateScope(&auth.TokenScope{Resources: []*auth.Resource{{Type: auth.ResourceType_JOB, Name: "x"}}}))
+}
+
+func TestScopeRoundTrip(t *testing.T) {
+	js, err := marshalScope(nil)
+	require.NoError(t, err)
+	require.Nil(t, js)
+	got, err := unmarshalScope(nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	scope := &auth.TokenScope{ReadOnly: true, Resources: []*auth.Resource{{Type: auth.ResourceType_PROJECT, Name: "default"}}}
+	js, err = marshalScope(scope)
+	require.NoError(t, err)
+	got, err = unmarshalScope([]byte(js.(string)))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(scope, got))
+}
@@ -37,6 +37,7 @@ import (
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	at "github.com/pachyderm/pachyderm/v2/src/server/auth/server/testing"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/storage"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -2016,6 +2017,81 @@ func TestRolesForPermission(t *testing.T) {
 	require.Equal(t, []string{"clusterAdmin", "projectOwner", "repoOwner", "repoReader", "repoWriter"}, names)
 }
 
+// TestCustomRoles tests that admins can create custom roles, bind them like
+// built-in roles, and delete them once they're no longer bound.
+func TestCustomRoles(t *testing.T) {
+	env := at.EnvWithAuth(t)
+	c := env.PachClient
+	alice := tu.Robot(uuid.UniqueString("alice"))
+	aliceClient, adminClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, auth.RootUser)
+
+	repo := uuid.UniqueString(t.Name())
+	require.NoError(t, adminClient.CreateRepo(pfs.DefaultProjectName, repo))
+	commit, err := adminClient.StartCommit(pfs.DefaultProjectName, repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, finishCommit(adminClient, repo, "master", commit.Id))
+
+	role := &auth.Role{
+		Name: uuid.UniqueString("branchCreator"),
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_REPO_INSPECT_COMMIT,
+			auth.Permission_REPO_LIST_BRANCH,
+			auth.Permission_REPO_CREATE_BRANCH,
+		},
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_PROJECT, auth.ResourceType_REPO},
+	}
+
+	// only admins can create roles, and custom roles can't shadow built-in ones
+	_, err = aliceClient.CreateRole(aliceClient.Ctx(), &auth.CreateRoleRequest{Role: role})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	_, err = adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{Role: &auth.Role{
+		Name:         auth.RepoReaderRole,
+		Permissions:  []auth.Permission{auth.Permission_REPO_READ},
+		CanBeBoundTo: []auth.ResourceType{auth.ResourceType_REPO},
+	}})
+	require.YesError(t, err)
+	require.Matches(t, "built-in role", err.Error())
+	_, err = adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{Role: role})
+	require.NoError(t, err)
+
+	resp, err := aliceClient.GetRolesForPermission(aliceClient.Ctx(), &auth.GetRolesForPermissionRequest{Permission: auth.Permission_REPO_CREATE_BRANCH})
+	require.NoError(t, err)
+	var names []string
+	for _, r := range resp.Roles {
+		names = append(names, r.Name)
+	}
+	require.OneOfEquals(t, role.Name, names)
+
+	// alice can create branches, but not delete them, once they have the role
+	err = aliceClient.CreateBranch(pfs.DefaultProjectName, repo, "feature", "master", "", nil)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(adminClient.Ctx(), pfs.DefaultProjectName, repo, alice, []string{role.Name}))
+	require.NoError(t, aliceClient.CreateBranch(pfs.DefaultProjectName, repo, "feature", "master", "", nil))
+	err = aliceClient.DeleteBranch(pfs.DefaultProjectName, repo, "feature", false)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// updating the role changes alice's permissions
+	role.Permissions = append(role.Permissions, auth.Permission_REPO_DELETE_BRANCH)
+	_, err = adminClient.UpdateRole(adminClient.Ctx(), &auth.UpdateRoleRequest{Role: role})
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.DeleteBranch(pfs.DefaultProjectName, repo, "feature", false))
+
+	// the role can't be deleted while it's bound
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.YesError(t, err)
+	require.Matches(t, "still bound", err.Error())
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(adminClient.Ctx(), pfs.DefaultProjectName, repo, alice, []string{}))
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.NoError(t, err)
+	err = adminClient.ModifyRepoRoleBinding(adminClient.Ctx(), pfs.DefaultProjectName, repo, alice, []string{role.Name})
+	require.YesError(t, err)
+	require.Matches(t, "unknown role", err.Error())
+}
+
 // TODO: This test mirrors TestLoad in src/server/pfs/server/testing/load_test.go.
 // Need to restructure testing such that we have the implementation of this
 // test in one place while still being able to test auth enabled and disabled clusters.
@@ -3019,3 +3095,44 @@ func TestListFileNils(t *testing.T) {
 		t.Errorf("server error: %v", err)
 	}
 }
+
+// TestReplicationRequiresClusterPermission checks that only users with the CLUSTER_REPLICATE
+// permission can use the storage RPCs behind push and pull, which read and write raw chunks and
+// data references without regard to repo permissions.
+func TestReplicationRequiresClusterPermission(t *testing.T) {
+	env := at.EnvWithAuth(t)
+	c := env.PachClient
+	alice := tu.Robot(uuid.UniqueString("alice"))
+	aliceClient, adminClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, auth.RootUser)
+	checkNotAuthorized := func(err error) {
+		t.Helper()
+		require.YesError(t, err)
+		require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	}
+
+	_, err := aliceClient.FindMissingChunks(aliceClient.Ctx(), &storage.FindMissingChunksRequest{})
+	checkNotAuthorized(err)
+	readChunk, err := aliceClient.ReadChunk(aliceClient.Ctx(), &storage.ReadChunkRequest{ChunkId: []byte("chunk")})
+	require.NoError(t, err)
+	_, err = readChunk.Recv()
+	checkNotAuthorized(err)
+	writeChunk, err := aliceClient.WriteChunk(aliceClient.Ctx())
+	require.NoError(t, err)
+	_, err = writeChunk.CloseAndRecv()
+	checkNotAuthorized(err)
+	readRefs, err := aliceClient.ReadFilesetRefs(aliceClient.Ctx(), &storage.ReadFilesetRequest{FilesetId: "fileset"})
+	require.NoError(t, err)
+	_, err = readRefs.Recv()
+	checkNotAuthorized(err)
+	createFromRefs, err := aliceClient.CreateFilesetFromRefs(aliceClient.Ctx())
+	require.NoError(t, err)
+	_, err = createFromRefs.CloseAndRecv()
+	checkNotAuthorized(err)
+
+	// Cluster admins have the permission.
+	_, err = adminClient.FindMissingChunks(adminClient.Ctx(), &storage.FindMissingChunksRequest{})
+	require.NoError(t, err)
+	require.NoError(t, adminClient.ModifyClusterRoleBinding(adminClient.Ctx(), alice, []string{auth.ClusterAdminRole}))
+	_, err = aliceClient.FindMissingChunks(aliceClient.Ctx(), &storage.FindMissingChunksRequest{})
+	require.NoError(t, err)
+}
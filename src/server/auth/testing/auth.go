@@ -121,6 +121,36 @@ func (a *InactiveAPIServer) GetRolesForPermission(context.Context, *auth.GetRole
 	return nil, auth.ErrNotActivated
 }
 
+// CreateRole implements the CreateRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateRole(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// UpdateRole implements the UpdateRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) UpdateRole(context.Context, *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteRole implements the DeleteRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteRole(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListRoles implements the ListRoles RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListRoles(context.Context, *auth.ListRolesRequest) (*auth.ListRolesResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ExplainAuthorization implements the ExplainAuthorization RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ExplainAuthorization(context.Context, *auth.ExplainAuthorizationRequest) (*auth.ExplainAuthorizationResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListAuditEvents implements the ListAuditEvents RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListAuditEvents(*auth.ListAuditEventsRequest, auth.API_ListAuditEventsServer) error {
+	return auth.ErrNotActivated
+}
+
 // GetRobotToken implements the GetRobotToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetRobotToken(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -29,9 +29,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	applycmds "github.com/pachyderm/pachyderm/v2/src/internal/pachapply/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
 	"github.com/pachyderm/pachyderm/v2/src/internal/signals"
 	taskcmds "github.com/pachyderm/pachyderm/v2/src/internal/task/cmds"
+	webhookcmds "github.com/pachyderm/pachyderm/v2/src/internal/webhook/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	admincmds "github.com/pachyderm/pachyderm/v2/src/server/admin/cmds"
 	authcmds "github.com/pachyderm/pachyderm/v2/src/server/auth/cmds"
@@ -888,7 +890,7 @@ This resets the cluster to its initial state.`,
 	subcommands = append(subcommands, licensecmds.Cmds(pachctlCfg)...)
 	subcommands = append(subcommands, identitycmds.Cmds(pachctlCfg)...)
 	subcommands = append(subcommands, admincmds.Cmds(pachctlCfg)...)
-	subcommands = append(subcommands, debugcmds.Cmds(pachctlCfg)...)
+	subcommands = append(subcommands, debugcmds.Cmds(pachCtx, pachctlCfg)...)
 	subcommands = append(subcommands, txncmds.Cmds(pachctlCfg)...)
 	subcommands = append(subcommands, configcmds.Cmds(pachctlCfg)...)
 	subcommands = append(subcommands, configcmds.ConnectCmds(pachctlCfg)...)
@@ -897,6 +899,8 @@ This resets the cluster to its initial state.`,
 	subcommands = append(subcommands, logscmds.Cmds(pachCtx, pachctlCfg)...)
 	subcommands = append(subcommands, metadatacmds.Cmds(pachCtx, pachctlCfg)...)
 	subcommands = append(subcommands, snapshotcmds.Cmds(pachctlCfg)...)
+	subcommands = append(subcommands, webhookcmds.Cmds(pachctlCfg)...)
+	subcommands = append(subcommands, applycmds.Cmds(pachCtx, pachctlCfg)...)
 
 	cmdutil.MergeCommands(rootCmd, subcommands)
 
@@ -933,6 +937,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"apply",
 			"copy",
 			"create",
 			"delete",
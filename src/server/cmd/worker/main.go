@@ -18,12 +18,14 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/logstore"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/proc"
 	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 	"github.com/pachyderm/pachyderm/v2/src/internal/restart"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
@@ -63,6 +65,18 @@ func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
 	// Enable cloud profilers if the configuration allows.
 	profileutil.StartCloudProfiler(ctx, "pachyderm-worker", env.Config())
 
+	// Copy our logs to the log store, if it's enabled.
+	if ok, err := logstore.Enabled(env.Config()); err != nil {
+		return err
+	} else if ok {
+		w, err := logstore.NewWriter(env.GetDBClient(), logstore.WorkerLabels(env.Config()))
+		if err != nil {
+			return errors.Wrap(err, "logstore.NewWriter")
+		}
+		log.AddOutput(w)
+		go w.Run(pctx.Child(ctx, "logstore"))
+	}
+
 	// Enable restart watcher.
 	r, err := restart.New(ctx, env.GetDBClient(), env.GetPostgresListener())
 	if err != nil {
@@ -91,6 +105,7 @@ func do(ctx context.Context, config *pachconfig.WorkerFullConfiguration) error {
 		return errors.Wrapf(err, "worker: get pipelineInfo for %q", p)
 	}
 	ctx = pachClient.AddMetadata(ctx)
+	continuous.Start(ctx, pachClient, continuous.ServiceWorker, env.Config().PodName, env.Config())
 
 	// Construct worker API server.
 	workerInstance, err := worker.NewWorker(pctx.Child(ctx, ""), env, pachClient, pipelineInfo, "/")
@@ -3,10 +3,12 @@ package cmds
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,15 +19,19 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/spf13/cobra"
 )
 
 // Cmds returns a slice containing debug commands.
-func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
+func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
 	var commands []*cobra.Command
 
 	var duration time.Duration
@@ -76,6 +82,50 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 	profile.Flags().StringVarP(&worker, "worker", "w", "", "Collect only the profile of a given worker pod.")
 	commands = append(commands, cmdutil.CreateAlias(profile, "debug profile"))
 
+	var since, until time.Duration
+	var service, pod string
+	profileHistory := &cobra.Command{
+		Use:   "{{alias}} <profile> <file>",
+		Short: "Merge the profiles captured by continuous profiling over a time window.",
+		Long: "This command fetches the profiles captured by continuous profiling between `--since` and `--until` ago, merges them, and writes them to a pprof file. " +
+			"The profile is one of cpu, heap, goroutine or mutex. \n\n" +
+			"Continuous profiling is enabled by setting CONTINUOUS_PROFILING_INTERVAL_SECONDS on pachd; profiles are kept in the `__profiles__.profiles` system repo of the default project, which, when auth is active, only cluster admins and owners of the default project can read unless they grant others access.",
+		Example: "\t- {{alias}} cpu cpu.pb.gz \n" +
+			"\t- {{alias}} heap heap.pb.gz --since 3h --until 2h \n" +
+			"\t- {{alias}} cpu cpu.pb.gz --service worker --pod pipeline-default-edges-v1-x5z7q \n",
+		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, client, "close client")
+			now := time.Now()
+			q := continuous.Query{
+				Profile: args[0],
+				Service: service,
+				Pod:     pod,
+				Since:   now.Add(-since),
+				Until:   now.Add(-until),
+			}
+			p, n, err := continuous.Fetch(client, q)
+			if err != nil {
+				return err
+			}
+			if err := withFile(args[1], func(f *os.File) error {
+				return errors.EnsureStack(p.Write(f))
+			}); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "merged %d %v profiles into %v\n", n, args[0], args[1])
+			return nil
+		}),
+	}
+	profileHistory.Flags().DurationVar(&since, "since", time.Hour, "Merge the profiles captured since this long ago.")
+	profileHistory.Flags().DurationVar(&until, "until", 0, "Merge the profiles captured until this long ago.")
+	profileHistory.Flags().StringVar(&service, "service", "", "Merge only the profiles of pachd, pachw or worker.")
+	profileHistory.Flags().StringVar(&pod, "pod", "", "Merge only the profiles of a given pod.")
+	commands = append(commands, cmdutil.CreateAlias(profileHistory, "debug profile-history"))
+
 	binary := &cobra.Command{
 		Use:   "{{alias}} <file>",
 		Short: "Collect a set of binaries.",
@@ -135,7 +185,10 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 		Use:   "{{alias}} <file>",
 		Short: "Collect a standard set of debugging information.",
 		Long: "This command collects a standard set of debugging information related to the version, database, source repos, helm, profiles, binaries, loki-logs, pipelines, describes, and logs. \n \n" +
-			"You can customize this output by passing in a customized template (made from `pachctl debug template` via the `--template` flag.",
+			"You can customize this output by passing in a customized template (made from `pachctl debug template` via the `--template` flag. \n \n" +
+			"The template's `redaction` section controls the redaction of credentials, URLs with passwords, JWTs and private keys from the dump; " +
+			"add `patterns` (each with a `name` and `regex`) and JSON field paths (`fields`, e.g. `transform.env`) to redact more, or remove the section to turn redaction off. " +
+			"Binary files, like profiles and binaries, that contain anything to redact are replaced by a note. What was redacted is listed in the dump's redactions.json.",
 		Example: "\t- {{alias}} dump.tgz \n" +
 			"\t- {{alias}} -t template.yaml out.tgz\n",
 		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
@@ -264,6 +317,115 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 		"launch a debug server on the given port. If unset, choose a free port automatically")
 	commands = append(commands, cmdutil.CreateAlias(analyze, "debug analyze"))
 
+	var rulePaths []string
+	var skipBuiltin, raw bool
+	var minSeverity, output string
+	diagnose := &cobra.Command{
+		Use:   "{{alias}} <dump>",
+		Short: "Find common problems in a debug dump.",
+		Long: "This command runs diagnostic rules against a debug dump, or a directory it's been extracted to, and prints the problems they find, most severe first. \n\n" +
+			"The built-in rules look for crashlooping and OOM-killed pods, image pull failures, stuck and failed commits, failed jobs and datums, common errors in user code logs, and version skew between pachd and workers. \n\n" +
+			"Rules are Starlark programs; to run your own, use the `--rules` flag with a .star file or a directory of them. " +
+			"Besides the usual Starlark modules, rules can call `files(glob)`, `read(path)`, `read_json(path)`, `pods()` and `report(severity, title, detail, files)`; see the built-in rules for examples.",
+		Example: "\t- {{alias}} dump.tgz \n" +
+			"\t- {{alias}} dump.tgz --min-severity error \n" +
+			"\t- {{alias}} dump.tgz --rules my-rules/ \n" +
+			"\t- {{alias}} dump.tgz --rules my-rule.star --skip-builtin \n" +
+			"\t- {{alias}} dump.tgz --raw \n",
+		Args: cobra.MatchAll(cobra.ExactArgs(1), cmdutil.FileMustExist(0)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			min, err := debugstar.ParseSeverity(minSeverity)
+			if err != nil {
+				return err
+			}
+			rules := make(map[string]string)
+			if !skipBuiltin {
+				for name, rule := range debugstar.BuiltinRules {
+					rules[name] = rule
+				}
+			}
+			for _, p := range rulePaths {
+				if err := readRules(p, rules); err != nil {
+					return err
+				}
+			}
+			if len(rules) == 0 {
+				return errors.New("no rules to run; use --rules or drop --skip-builtin")
+			}
+			findings, ruleErr := debugstar.Diagnose(cmd.Context(), args[0], rules)
+			if ruleErr != nil {
+				fmt.Fprintf(os.Stderr, "Some rules failed; their findings may be incomplete:\n%v\n", ruleErr)
+			}
+			var shown []*debugstar.Finding
+			for _, f := range findings {
+				if f.Severity >= min {
+					shown = append(shown, f)
+				}
+			}
+			if raw {
+				e := cmdutil.Encoder(output, os.Stdout)
+				for _, f := range shown {
+					if err := e.Encode(f); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			if len(shown) == 0 {
+				fmt.Println("No problems found.")
+				return nil
+			}
+			for _, f := range shown {
+				fmt.Printf("%-8s [%s] %s\n", strings.ToUpper(f.Severity.String()), f.Rule, f.Title)
+				if f.Detail != "" {
+					fmt.Printf("         %s\n", f.Detail)
+				}
+				for _, file := range f.Files {
+					fmt.Printf("         see %s\n", file)
+				}
+			}
+			return nil
+		},
+	}
+	diagnose.Flags().StringSliceVar(&rulePaths, "rules", nil, "Run the rules in a .star file, or a directory of them, as well as the built-in rules.")
+	diagnose.Flags().BoolVar(&skipBuiltin, "skip-builtin", false, "Don't run the built-in rules.")
+	diagnose.Flags().StringVar(&minSeverity, "min-severity", "info", "Only print findings at least this severe: one of info, warning, error or critical.")
+	diagnose.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	commands = append(commands, cmdutil.CreateAlias(diagnose, "debug diagnose"))
+
+	diff := &cobra.Command{
+		Use:   "{{alias}} <before> <after>",
+		Short: "Compare two debug dumps.",
+		Long: "This command compares two debug dumps of a cluster, or directories they've been extracted to, such as one taken before a regression and one taken after it. \n\n" +
+			"It reports pipelines that were added, removed or changed (version, state and spec), changes in each pipeline's job failure rate, error log signatures that only appear in the second dump, " +
+			"and changes to the cluster defaults, the pachd version and the Helm values of each release.",
+		Example: "\t- {{alias}} before.tgz after.tgz \n" +
+			"\t- {{alias}} before.tgz after.tgz --raw \n" +
+			"\t- {{alias}} before.tgz after.tgz --raw -o yaml \n",
+		Args: cobra.MatchAll(cobra.ExactArgs(2), cmdutil.FileMustExist(0), cmdutil.FileMustExist(1)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !pachctlCfg.Verbose {
+				// The dump servers log every request they serve.
+				log.SetLevel(log.ErrorLevel)
+			}
+			report, err := shell.Diff(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).Encode(report))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			printDiffReport(report)
+			return nil
+		},
+	}
+	diff.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	commands = append(commands, cmdutil.CreateAlias(diff, "debug diff"))
+
 	log := &cobra.Command{
 		Use:   "{{alias}} <level>",
 		Short: "Change the log level across Pachyderm.",
@@ -386,6 +548,62 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 	trace.Flags().DurationVarP(&traceDuration, "duration", "d", 30*time.Second, "how long to trace for")
 	commands = append(commands, cmdutil.CreateAlias(trace, "debug trace"))
 
+	var project = pachCtx.Project
+	var reproDir string
+	var runRepro bool
+	debugDatum := &cobra.Command{
+		Use:   "{{alias}} <pipeline>@<job> <datum>",
+		Short: "Set up a datum to be rerun locally.",
+		Long: "This command writes everything needed to rerun a datum outside of the cluster to a directory: the datum's inputs in the same `/pfs` layout the worker uses, " +
+			"the output the datum committed, the environment variables the worker set, and a `run.sh` script that runs the pipeline's image and command in a container with the `/pfs` directory mounted. \n \n" +
+			"The pipeline's auth token and secrets are not included.  Set CONTAINER_RUNTIME to run the script with a runtime other than docker. \n \n" +
+			"With --run, the script is run and its output is compared to the output the datum committed.",
+		Example: "\t- {{alias}} foo@5f93d03b65fa421996185e53f7f8b1e4 7f3cd988429894000bdad549dfe2d09b5ca7bfc5083b79fec0e6bda3db8cc705 --dir ./repro \n" +
+			"\t- {{alias}} foo@5f93d03b65fa421996185e53f7f8b1e4 7f3cd988429894000bdad549dfe2d09b5ca7bfc5083b79fec0e6bda3db8cc705 --dir ./repro --run \n",
+		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) (retErr error) {
+			if reproDir == "" {
+				return errors.New("must specify a directory with --dir")
+			}
+			job, err := cmdutil.ParseJob(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			if err := reproduceDatum(c, job, args[1], reproDir); err != nil {
+				return err
+			}
+			fmt.Printf("wrote datum to %s; run it with %s\n", reproDir, filepath.Join(reproDir, reproScript))
+			if !runRepro {
+				return nil
+			}
+			run := exec.CommandContext(cmd.Context(), "sh", filepath.Join(reproDir, reproScript))
+			run.Stdout, run.Stderr = os.Stdout, os.Stderr
+			if err := run.Run(); err != nil {
+				return errors.Wrap(err, "run datum")
+			}
+			diffs, err := diffDirs(filepath.Join(reproDir, reproCommittedDir), filepath.Join(reproDir, common.PFSPrefix, common.OutputPrefix), filepath.Join(reproDir, common.PFSPrefix))
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				fmt.Println("output matches the committed output")
+				return nil
+			}
+			for _, d := range diffs {
+				fmt.Println(d)
+			}
+			return errors.Errorf("output differs from the committed output in %d files", len(diffs))
+		}),
+	}
+	debugDatum.Flags().StringVar(&reproDir, "dir", "", "The directory to write the datum to.")
+	debugDatum.Flags().BoolVar(&runRepro, "run", false, "Run the datum and compare its output to the committed output.")
+	debugDatum.Flags().StringVar(&project, "project", project, "Project containing the job.")
+	commands = append(commands, cmdutil.CreateAlias(debugDatum, "debug datum"))
+
 	debug := &cobra.Command{
 		Short: "Debug commands for analyzing a running cluster.",
 		Long:  "Debug commands for analyzing a running cluster.",
@@ -430,3 +648,100 @@ func withFile(file string, cb func(*os.File) error) (retErr error) {
 	}()
 	return cb(f)
 }
+
+// readRules adds the diagnostic rules in path, a .star file or a directory of them, to rules.
+func readRules(path string, rules map[string]string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.star"))
+		if err != nil {
+			return errors.Wrapf(err, "list rules in %v", path)
+		}
+		if len(files) == 0 {
+			return errors.Errorf("no .star files in %v", path)
+		}
+	}
+	for _, f := range files {
+		rule, err := os.ReadFile(f)
+		if err != nil {
+			return errors.Wrap(err, "read rule")
+		}
+		rules[f] = string(rule)
+	}
+	return nil
+}
+
+// printDiffReport prints a debug dump diff for people.
+func printDiffReport(r *shell.DiffReport) {
+	if r.Empty() {
+		fmt.Println("The dumps don't differ.")
+		return
+	}
+	if r.Version != nil {
+		fmt.Printf("pachd version: %v -> %v\n", diffValue(r.Version.Before), diffValue(r.Version.After))
+	}
+	if len(r.Pipelines) > 0 {
+		fmt.Println("\nPipelines:")
+		for _, p := range r.Pipelines {
+			switch p.Kind {
+			case shell.PipelineAdded:
+				fmt.Printf("  + %v\n", p.Pipeline)
+			case shell.PipelineRemoved:
+				fmt.Printf("  - %v\n", p.Pipeline)
+			default:
+				fmt.Printf("  ~ %v\n", p.Pipeline)
+			}
+			if p.Version != nil {
+				fmt.Printf("      version: %v -> %v\n", diffValue(p.Version.Before), diffValue(p.Version.After))
+			}
+			if p.State != nil {
+				fmt.Printf("      state: %v -> %v\n", diffValue(p.State.Before), diffValue(p.State.After))
+			}
+			for _, c := range p.Spec {
+				fmt.Printf("      %v: %v -> %v\n", c.Path, diffValue(c.Before), diffValue(c.After))
+			}
+		}
+	}
+	if len(r.Jobs) > 0 {
+		fmt.Println("\nJob failure rates:")
+		for _, j := range r.Jobs {
+			fmt.Printf("  %v: %.0f%% (%d/%d) -> %.0f%% (%d/%d)\n", j.Pipeline,
+				100*j.Before.FailureRate, j.Before.Failed, j.Before.Finished,
+				100*j.After.FailureRate, j.After.Failed, j.After.Finished)
+		}
+	}
+	if len(r.LogSignatures) > 0 {
+		fmt.Println("\nNew error logs:")
+		for _, s := range r.LogSignatures {
+			fmt.Printf("  %v (%v, %dx)\n", s.Signature, s.Source, s.Count)
+		}
+	}
+	if len(r.ClusterDefaults) > 0 {
+		fmt.Println("\nCluster defaults:")
+		for _, c := range r.ClusterDefaults {
+			fmt.Printf("  %v: %v -> %v\n", c.Path, diffValue(c.Before), diffValue(c.After))
+		}
+	}
+	for _, h := range r.Helm {
+		fmt.Printf("\nHelm values of %v:\n", h.Release)
+		for _, c := range h.Changes {
+			fmt.Printf("  %v: %v -> %v\n", c.Path, diffValue(c.Before), diffValue(c.After))
+		}
+	}
+}
+
+// diffValue formats one side of a shell.Change.
+func diffValue(v any) string {
+	if v == nil {
+		return "(none)"
+	}
+	js, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(js)
+}
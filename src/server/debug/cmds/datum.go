@@ -0,0 +1,278 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+)
+
+const (
+	// reproScript is the name of the script that reruns a datum.
+	reproScript = "run.sh"
+	// reproEnvFile is the name of the env file passed to the container.
+	reproEnvFile = "env"
+	// reproStdinFile holds the pipeline's stdin.
+	reproStdinFile = "stdin"
+	// reproCommittedDir holds the output that the datum committed.
+	reproCommittedDir = "committed"
+)
+
+// reproduceDatum writes everything needed to rerun a datum outside of the
+// cluster to dir: the datum's /pfs directory, its committed output, the
+// environment the user code ran with, and a script that runs the pipeline's
+// image against them.
+func reproduceDatum(c *client.APIClient, job *pps.Job, datumID, dir string) error {
+	jobInfo, err := c.InspectJob(job.Pipeline.Project.GetName(), job.Pipeline.Name, job.Id, true)
+	if err != nil {
+		return err
+	}
+	datumInfo, err := c.InspectDatum(job.Pipeline.Project.GetName(), job.Pipeline.Name, job.Id, datumID)
+	if err != nil {
+		return err
+	}
+	meta, err := getDatumMeta(c, datumInfo.PfsState.Commit, datumInfo.Datum.Id)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := downloadDatum(c, meta, datumInfo.PfsState, dir); err != nil {
+		return err
+	}
+	transform := jobInfo.Details.Transform
+	if err := os.WriteFile(filepath.Join(dir, reproEnvFile), []byte(datumEnv(jobInfo, meta.Inputs)), 0666); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if len(transform.Stdin) > 0 {
+		if err := os.WriteFile(filepath.Join(dir, reproStdinFile), []byte(strings.Join(transform.Stdin, "\n")+"\n"), 0666); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	script := datumScript(jobInfo, datumInfo)
+	return errors.EnsureStack(os.WriteFile(filepath.Join(dir, reproScript), []byte(script), 0777))
+}
+
+// getDatumMeta reads the meta file of a datum, which records its inputs.
+func getDatumMeta(c *client.APIClient, metaCommit *pfs.Commit, datumID string) (*datum.Meta, error) {
+	var buf bytes.Buffer
+	if err := c.GetFile(metaCommit, common.MetaFilePath(datumID), &buf); err != nil {
+		return nil, errors.Wrap(err, "get datum meta file")
+	}
+	meta := &datum.Meta{}
+	if err := protojson.Unmarshal(buf.Bytes(), meta); err != nil {
+		return nil, errors.Wrap(err, "unmarshal datum meta")
+	}
+	return meta, nil
+}
+
+// downloadDatum lays out the datum's inputs under dir/pfs the way the worker
+// does, with an empty output directory, and downloads the datum's committed
+// output from its pfs state to dir/committed.  Lazy inputs are downloaded in
+// full.
+func downloadDatum(c *client.APIClient, meta *datum.Meta, pfsState *pfs.File, dir string) (retErr error) {
+	pfsDir := filepath.Join(dir, common.PFSPrefix)
+	committedDir := filepath.Join(dir, reproCommittedDir)
+	for _, d := range []string{pfsDir, committedDir} {
+		if err := os.RemoveAll(d); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(pfsDir, common.OutputPrefix), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	staging, err := os.MkdirTemp(dir, ".download-")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Invoke(&retErr, func() error { return errors.EnsureStack(os.RemoveAll(staging)) }, "remove download directory")
+	if err := c.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		cacheClient := pfssync.NewCacheClient(c.WithCtx(ctx), renewer)
+		return pfssync.WithDownloader(cacheClient, func(downloader pfssync.Downloader) error {
+			for _, input := range meta.Inputs {
+				inputDir := filepath.Join(pfsDir, input.Name)
+				if input.S3 {
+					if err := os.MkdirAll(inputDir, 0777); err != nil {
+						return errors.EnsureStack(err)
+					}
+					continue
+				}
+				var opts []pfssync.DownloadOption
+				if input.EmptyFiles {
+					opts = append(opts, pfssync.WithEmpty())
+				}
+				if err := downloader.Download(inputDir, input.FileInfo.File, opts...); err != nil {
+					return errors.Wrapf(err, "download input %q", input.Name)
+				}
+			}
+			output := &pfs.File{
+				Commit: pfsState.Commit,
+				Path:   pfsState.Path + "/" + common.OutputPrefix,
+			}
+			return errors.Wrap(downloader.Download(staging, output), "download committed output")
+		})
+	}); err != nil {
+		return err
+	}
+	committed := filepath.Join(staging, filepath.FromSlash(pfsState.Path), common.OutputPrefix)
+	if _, err := os.Stat(committed); errors.Is(err, fs.ErrNotExist) {
+		return errors.EnsureStack(os.MkdirAll(committedDir, 0777))
+	}
+	return errors.EnsureStack(os.Rename(committed, committedDir))
+}
+
+// datumEnv returns the contents of the env file for a datum.  It contains the
+// pipeline's env and the variables the worker sets for the datum; the
+// pipeline's auth token, the values of secrets and the job's S3 gateway are
+// not available, so variables set from secrets are listed as comments.
+func datumEnv(jobInfo *pps.JobInfo, inputs []*common.Input) string {
+	var env []string
+	for k, v := range jobInfo.Details.Transform.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	env = append(env,
+		client.PPSProjectNameEnv+"="+jobInfo.Job.Pipeline.Project.GetName(),
+		client.PPSPipelineNameEnv+"="+jobInfo.Job.Pipeline.Name,
+	)
+	env = append(env, common.UserCodeEnv(client.PPSInputPrefix, inputs, jobInfo.Job.Id, jobInfo.OutputCommit, "", "", "")...)
+	for _, secret := range jobInfo.Details.Transform.Secrets {
+		if secret.EnvVar != "" {
+			env = append(env, fmt.Sprintf("# %s is set from key %q of secret %q", secret.EnvVar, secret.Key, secret.Name))
+		}
+	}
+	return strings.Join(env, "\n") + "\n"
+}
+
+// datumScript returns a script that runs the pipeline's user code on the
+// datum in a container, with dir/pfs mounted at /pfs.
+func datumScript(jobInfo *pps.JobInfo, datumInfo *pps.DatumInfo) string {
+	transform := jobInfo.Details.Transform
+	image := transform.Image
+	// Prefer the digest of the image the datum actually ran with.
+	if id := datumInfo.ImageId; id != "" {
+		if _, digest, ok := strings.Cut(id, "://"); ok {
+			id = digest
+		}
+		image = id
+	}
+	args := []string{`"${CONTAINER_RUNTIME:-docker}"`, "run", "--rm", "-i",
+		"-v", `"$PWD/pfs:/pfs"`, "--env-file", reproEnvFile}
+	if transform.WorkingDir != "" {
+		args = append(args, "-w", shellQuote(transform.WorkingDir))
+	}
+	if transform.User != "" {
+		args = append(args, "-u", shellQuote(transform.User))
+	}
+	if len(transform.Cmd) > 0 {
+		args = append(args, "--entrypoint", shellQuote(transform.Cmd[0]), shellQuote(image))
+		for _, arg := range transform.Cmd[1:] {
+			args = append(args, shellQuote(arg))
+		}
+	} else {
+		args = append(args, shellQuote(image))
+	}
+	stdin := "/dev/null"
+	if len(transform.Stdin) > 0 {
+		stdin = reproStdinFile
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "#!/bin/sh\n")
+	fmt.Fprintf(&b, "# Reruns datum %s of job %s.\n", datumInfo.Datum.Id, jobInfo.Job)
+	fmt.Fprintf(&b, "# The datum's inputs are in ./pfs, and the output it committed is in ./%s.\n", reproCommittedDir)
+	fmt.Fprintf(&b, "set -e\n")
+	fmt.Fprintf(&b, "cd \"$(dirname \"$0\")\"\n")
+	fmt.Fprintf(&b, "rm -rf pfs/out\n")
+	fmt.Fprintf(&b, "mkdir pfs/out\n")
+	fmt.Fprintf(&b, "exec %s < %s\n", strings.Join(args, " "), stdin)
+	return b.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// diffDirs compares the files that a datum committed with the files that a
+// rerun of it produced, returning one line per difference: "+ path" for new
+// files, "- path" for missing files, and "M path" for files whose contents
+// differ.  Symlinks that the rerun created into /pfs are resolved against
+// pfsDir, since the worker uploads them as copies of their targets.
+func diffDirs(committedDir, outputDir, pfsDir string) ([]string, error) {
+	committed, err := readFiles(committedDir, "")
+	if err != nil {
+		return nil, err
+	}
+	output, err := readFiles(outputDir, pfsDir)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []string
+	for p, data := range committed {
+		newData, ok := output[p]
+		switch {
+		case !ok:
+			diffs = append(diffs, "- "+p)
+		case !bytes.Equal(data, newData):
+			diffs = append(diffs, "M "+p)
+		}
+	}
+	for p := range output {
+		if _, ok := committed[p]; !ok {
+			diffs = append(diffs, "+ "+p)
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i][2:] < diffs[j][2:] })
+	return diffs, nil
+}
+
+func readFiles(dir, pfsDir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		file := p
+		switch {
+		case d.Type()&fs.ModeSymlink != 0 && pfsDir != "":
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			if rel, ok := strings.CutPrefix(target, client.PPSInputPrefix+"/"); ok {
+				target = filepath.Join(pfsDir, rel)
+			} else if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(p), target)
+			}
+			file = target
+		case !d.Type().IsRegular():
+			return nil
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files["/"+filepath.ToSlash(rel)] = data
+		return nil
+	})
+	return files, errors.EnsureStack(err)
+}
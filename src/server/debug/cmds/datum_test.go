@@ -0,0 +1,87 @@
+package cmds
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func testJobInfo() *pps.JobInfo {
+	job := client.NewJob(pfs.DefaultProjectName, "edges", "5f93d03b65fa421996185e53f7f8b1e4")
+	return &pps.JobInfo{
+		Job:          job,
+		OutputCommit: client.NewCommit(pfs.DefaultProjectName, "edges", "master", job.Id),
+		Details: &pps.JobInfo_Details{
+			Transform: &pps.Transform{
+				Image:   "pachyderm/opencv:1.0",
+				Cmd:     []string{"python3", "/edges.py", "it's"},
+				Env:     map[string]string{"B": "2", "A": "1"},
+				Secrets: []*pps.SecretMount{{Name: "creds", Key: "token", EnvVar: "TOKEN"}},
+			},
+		},
+	}
+}
+
+func TestDatumEnv(t *testing.T) {
+	inputs := []*common.Input{{
+		Name: "images",
+		FileInfo: &pfs.FileInfo{
+			File: client.NewCommit(pfs.DefaultProjectName, "images", "master", "abc").NewFile("/a.png"),
+		},
+	}}
+	env := strings.Split(strings.TrimSpace(datumEnv(testJobInfo(), inputs)), "\n")
+	require.Equal(t, []string{
+		"A=1",
+		"B=2",
+		"PPS_PROJECT_NAME=default",
+		"PPS_PIPELINE_NAME=edges",
+		"images=/pfs/images/a.png",
+		"images_COMMIT=abc",
+		"PACH_DATUM_ID=" + common.DatumID(inputs),
+		"PACH_JOB_ID=5f93d03b65fa421996185e53f7f8b1e4",
+		"PACH_OUTPUT_COMMIT_ID=5f93d03b65fa421996185e53f7f8b1e4",
+		`# TOKEN is set from key "token" of secret "creds"`,
+	}, env)
+}
+
+func TestDatumScript(t *testing.T) {
+	jobInfo := testJobInfo()
+	datumInfo := &pps.DatumInfo{
+		Datum:   &pps.Datum{Job: jobInfo.Job, Id: "d1"},
+		ImageId: "docker-pullable://pachyderm/opencv@sha256:0123",
+	}
+	script := datumScript(jobInfo, datumInfo)
+	require.True(t, strings.Contains(script,
+		`exec "${CONTAINER_RUNTIME:-docker}" run --rm -i -v "$PWD/pfs:/pfs" --env-file env --entrypoint 'python3' 'pachyderm/opencv@sha256:0123' '/edges.py' 'it'\''s' < /dev/null`),
+		"unexpected script:\n%s", script)
+	jobInfo.Details.Transform.Stdin = []string{"echo hi"}
+	require.True(t, strings.HasSuffix(datumScript(jobInfo, datumInfo), "< stdin\n"))
+}
+
+func TestDiffDirs(t *testing.T) {
+	dir := t.TempDir()
+	write := func(p, data string) {
+		p = filepath.Join(dir, p)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+		require.NoError(t, os.WriteFile(p, []byte(data), 0666))
+	}
+	write("committed/same", "1")
+	write("committed/changed", "1")
+	write("committed/missing", "1")
+	write("committed/linked", "input")
+	write("pfs/in/file", "input")
+	write("pfs/out/same", "1")
+	write("pfs/out/changed", "2")
+	write("pfs/out/dir/new", "1")
+	require.NoError(t, os.Symlink("/pfs/in/file", filepath.Join(dir, "pfs/out/linked")))
+	diffs, err := diffDirs(filepath.Join(dir, "committed"), filepath.Join(dir, "pfs/out"), filepath.Join(dir, "pfs"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"M /changed", "+ /dir/new", "- /missing"}, diffs)
+}
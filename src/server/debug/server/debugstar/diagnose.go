@@ -0,0 +1,468 @@
+package debugstar
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	globlib "github.com/pachyderm/ohmyglob"
+	starjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	ourstar "github.com/pachyderm/pachyderm/v2/src/internal/starlark"
+)
+
+// BuiltinRules are the diagnostic rules loaded from rules/.
+var BuiltinRules = map[string]string{}
+
+//go:embed rules/*.star
+var builtinRules embed.FS
+
+func init() {
+	if err := fs.WalkDir(builtinRules, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errors.Wrap(err, "initial")
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rule, err := fs.ReadFile(builtinRules, path)
+		if err != nil {
+			return errors.Wrap(err, "read")
+		}
+		BuiltinRules[path] = string(rule)
+		return nil
+	}); err != nil {
+		panic(fmt.Sprintf("unable to load builtin rules; %v", err))
+	}
+}
+
+// Severity is how bad a Finding is.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+var severityNames = []string{"info", "warning", "error", "critical"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity parses the name of a severity.
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if strings.EqualFold(n, name) {
+			return Severity(i), nil
+		}
+	}
+	return 0, errors.Errorf("no severity %q; try one of %v", name, severityNames)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Severity) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(s.String())
+	return b, errors.EnsureStack(err)
+}
+
+// A Finding is a problem that a diagnostic rule found in a debug dump.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Title    string   `json:"title"`
+	Detail   string   `json:"detail,omitempty"`
+	// Files are the files in the dump that show the problem.
+	Files []string `json:"files,omitempty"`
+}
+
+// Diagnose runs diagnostic rules, a map from rule name to program text, against the debug dump at
+// dumpPath, which is either a dump archive or a directory it's been extracted to.  It returns the
+// findings of all rules, ranked from most to least severe.  A rule that fails doesn't stop the
+// others from running; the errors of failed rules are returned along with the findings of the
+// rest.
+//
+// Rules have these predefined, in addition to the usual Starlark modules:
+//
+//	files(pattern) -> list of the paths in the dump that match a glob, e.g. "**/describe.txt"
+//	read(path) -> the content of a file, as a string
+//	read_json(path) -> list of the JSON values in a file, e.g. the commits in commits.json
+//	pods() -> list of the pods described in the dump; see describePods
+//	report(severity, title, detail="", files=[]) -> records a finding; severity is one of
+//	    "info", "warning", "error" or "critical"
+func Diagnose(ctx context.Context, dumpPath string, rules map[string]string) (_ []*Finding, retErr error) {
+	dump, cleanup, err := openDump(dumpPath)
+	if err != nil {
+		return nil, err
+	}
+	defer errors.Invoke(&retErr, cleanup, "clean up extracted dump")
+	paths, err := dumpFiles(dump)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []*Finding
+	var errs error
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := runRule(ctx, dump, paths, name, rules[name], &findings); err != nil {
+			errors.JoinInto(&errs, errors.Wrapf(err, "run rule %q", name))
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Title < b.Title
+	})
+	return findings, errs
+}
+
+func runRule(rctx context.Context, dump fs.FS, paths []string, name, rule string, findings *[]*Finding) (retErr error) {
+	ctx, done := log.SpanContext(rctx, fmt.Sprintf("diagnose(%v)", name))
+	defer done(log.Errorp(&retErr))
+	defer func() {
+		if err := recover(); err != nil {
+			errors.JoinInto(&retErr, errors.Errorf("starlark evaluation panicked: %v", err))
+		}
+	}()
+	ruleName := strings.TrimSuffix(path.Base(name), ".star")
+	opts := ourstar.Options{
+		Predefined: starlark.StringDict{
+			"files": starlark.NewBuiltin("files", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var pattern string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "pattern", &pattern); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				g, err := globlib.Compile(pattern, '/')
+				if err != nil {
+					return nil, errors.Wrapf(err, "compile glob %q", pattern)
+				}
+				var result []starlark.Value
+				for _, p := range paths {
+					if g.Match(p) {
+						result = append(result, starlark.String(p))
+					}
+				}
+				return starlark.NewList(result), nil
+			}),
+			"read": starlark.NewBuiltin("read", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var name string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &name); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				b, err := fs.ReadFile(dump, name)
+				if err != nil {
+					return nil, errors.Wrapf(err, "read %v", name)
+				}
+				return starlark.String(b), nil
+			}),
+			"read_json": starlark.NewBuiltin("read_json", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var name string
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "path", &name); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				return readJSON(t, dump, name)
+			}),
+			"pods": starlark.NewBuiltin("pods", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				return describePods(dump, paths)
+			}),
+			"report": starlark.NewBuiltin("report", func(t *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var severity, title, detail string
+				var files *starlark.List
+				if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "severity", &severity, "title", &title, "detail?", &detail, "files?", &files); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				s, err := ParseSeverity(severity)
+				if err != nil {
+					return nil, err
+				}
+				f := &Finding{Rule: ruleName, Severity: s, Title: title, Detail: detail}
+				for i := 0; files != nil && i < files.Len(); i++ {
+					name, ok := starlark.AsString(files.Index(i))
+					if !ok {
+						return nil, errors.Errorf("files[%d] is a %v, not a string", i, files.Index(i).Type())
+					}
+					f.Files = append(f.Files, name)
+				}
+				*findings = append(*findings, f)
+				return starlark.None, nil
+			}),
+		},
+	}
+	if _, err := ourstar.RunScript(ctx, name, rule, opts); err != nil {
+		return errors.Wrap(err, "RunScript")
+	}
+	return nil
+}
+
+// readJSON decodes the stream of JSON values in a dump file, which is how the debug dump writes
+// lists of commits, jobs, etc.
+func readJSON(t *starlark.Thread, dump fs.FS, name string) (_ starlark.Value, retErr error) {
+	f, err := dump.Open(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %v", name)
+	}
+	defer errors.Close(&retErr, f, "close %v", name)
+	decode := starjson.Module.Members["decode"]
+	var result []starlark.Value
+	d := json.NewDecoder(f)
+	for {
+		var raw json.RawMessage
+		if err := d.Decode(&raw); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "decode JSON value %d of %v", len(result), name)
+		}
+		v, err := starlark.Call(t, decode, starlark.Tuple{starlark.String(raw)}, nil)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		result = append(result, v)
+	}
+	return starlark.NewList(result), nil
+}
+
+// describePods parses the `kubectl describe` output of each pod in the dump.  Each pod is a dict:
+//
+//	{
+//	    "name": "pipeline-default-edges-v1-x5z7q",
+//	    "owner": "pipeline default/edges",  # or "app pachd", etc.
+//	    "path": "pipelines/default/edges/pods/pipeline-default-edges-v1-x5z7q/describe.txt",
+//	    "containers": [{
+//	        "name": "user", "init": False, "image": "...", "restarts": 3,
+//	        "state": "Waiting", "reason": "CrashLoopBackOff",
+//	        "last_state": "Terminated", "last_reason": "OOMKilled", "exit_code": 137,
+//	    }],
+//	    "warnings": [...],  # the pod's Warning events
+//	}
+func describePods(dump fs.FS, paths []string) (starlark.Value, error) {
+	var pods []starlark.Value
+	for _, p := range paths {
+		parts := strings.Split(p, "/")
+		n := len(parts)
+		if n < 3 || parts[n-1] != "describe.txt" || parts[n-3] != "pods" {
+			continue
+		}
+		text, err := fs.ReadFile(dump, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %v", p)
+		}
+		owner := "app " + strings.Join(parts[:n-3], "/")
+		if n == 6 && parts[0] == "pipelines" {
+			owner = "pipeline " + parts[1] + "/" + parts[2]
+		}
+		containers, warnings := parseDescribe(string(text))
+		var cs []starlark.Value
+		for _, c := range containers {
+			cs = append(cs, c.value())
+		}
+		var ws []starlark.Value
+		for _, w := range warnings {
+			ws = append(ws, starlark.String(w))
+		}
+		pod := starlark.NewDict(5)
+		for k, v := range map[string]starlark.Value{
+			"name":       starlark.String(parts[n-2]),
+			"owner":      starlark.String(owner),
+			"path":       starlark.String(p),
+			"containers": starlark.NewList(cs),
+			"warnings":   starlark.NewList(ws),
+		} {
+			if err := pod.SetKey(starlark.String(k), v); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+		pods = append(pods, pod)
+	}
+	return starlark.NewList(pods), nil
+}
+
+// describedContainer is a container in `kubectl describe pod` output.
+type describedContainer struct {
+	name, image           string
+	init                  bool
+	state, reason         string
+	lastState, lastReason string
+	exitCode, restarts    int
+}
+
+func (c *describedContainer) value() starlark.Value {
+	d := starlark.NewDict(10)
+	for k, v := range map[string]starlark.Value{
+		"name":        starlark.String(c.name),
+		"init":        starlark.Bool(c.init),
+		"image":       starlark.String(c.image),
+		"state":       starlark.String(c.state),
+		"reason":      starlark.String(c.reason),
+		"last_state":  starlark.String(c.lastState),
+		"last_reason": starlark.String(c.lastReason),
+		"exit_code":   starlark.MakeInt(c.exitCode),
+		"restarts":    starlark.MakeInt(c.restarts),
+	} {
+		d.SetKey(starlark.String(k), v) //nolint:errcheck
+	}
+	return d
+}
+
+// parseDescribe extracts the containers and Warning events from `kubectl describe pod` output.
+func parseDescribe(text string) (containers []*describedContainer, warnings []string) {
+	var section string
+	var c *describedContainer
+	// reason is where the Reason of the current State or Last State goes.
+	var reason *string
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		key, value, _ := strings.Cut(trimmed, ":")
+		value = strings.TrimSpace(value)
+		switch {
+		case trimmed == "":
+		case indent == 0:
+			section, c = key, nil
+		case section == "Events":
+			if strings.HasPrefix(trimmed, "Warning") {
+				warnings = append(warnings, strings.Join(strings.Fields(trimmed), " "))
+			}
+		case section != "Containers" && section != "Init Containers":
+		case indent == 2 && value == "":
+			c = &describedContainer{name: key, init: section == "Init Containers"}
+			containers = append(containers, c)
+		case c == nil:
+		case indent == 4:
+			reason = nil
+			switch key {
+			case "Image":
+				c.image = value
+			case "State":
+				c.state, reason = value, &c.reason
+			case "Last State":
+				c.lastState, reason = value, &c.lastReason
+			case "Restart Count":
+				c.restarts, _ = strconv.Atoi(value)
+			}
+		case indent == 6 && key == "Reason" && reason != nil:
+			*reason = value
+		case indent == 6 && key == "Exit Code":
+			c.exitCode, _ = strconv.Atoi(value)
+		}
+	}
+	return containers, warnings
+}
+
+// openDump returns the files of a dump.  Archives are extracted to a temporary directory, which
+// cleanup removes.
+func openDump(dumpPath string) (_ fs.FS, cleanup func() error, retErr error) {
+	info, err := os.Stat(dumpPath)
+	if err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	if info.IsDir() {
+		return os.DirFS(dumpPath), func() error { return nil }, nil
+	}
+	dir, err := os.MkdirTemp("", "debug-diagnose-*")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "create tempdir")
+	}
+	cleanup = func() error { return errors.EnsureStack(os.RemoveAll(dir)) }
+	defer func() {
+		if retErr != nil {
+			errors.JoinInto(&retErr, cleanup())
+		}
+	}()
+	if err := extractDump(dumpPath, dir); err != nil {
+		return nil, nil, errors.Wrapf(err, "extract %v", dumpPath)
+	}
+	return os.DirFS(dir), cleanup, nil
+}
+
+func extractDump(dumpPath, dir string) (retErr error) {
+	fh, err := os.Open(dumpPath)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, fh, "close dump")
+	gzr, err := gzip.NewReader(fh)
+	if err != nil {
+		return errors.Wrap(err, "new gzip reader")
+	}
+	defer errors.Close(&retErr, gzr, "close gzip reader")
+	tr := tar.NewReader(gzr)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "read header")
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		// As in dump(), cleaning "/" + name keeps the file inside dir.
+		name := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(path.Clean("/"+h.Name), "/")))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return errors.Wrapf(err, "create parent directory for %v", h.Name)
+		}
+		if err := writeFile(name, tr); err != nil {
+			return errors.Wrapf(err, "extract %v", h.Name)
+		}
+	}
+}
+
+func writeFile(name string, r io.Reader) (retErr error) {
+	fh, err := os.Create(name)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, fh, "close %v", name)
+	_, err = io.Copy(fh, r)
+	return errors.EnsureStack(err)
+}
+
+// dumpFiles returns the sorted paths of the files in a dump.
+func dumpFiles(dump fs.FS) ([]string, error) {
+	var paths []string
+	if err := fs.WalkDir(dump, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			paths = append(paths, p)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "list dump files")
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,146 @@
+package debugstar
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+func TestDiagnose(t *testing.T) {
+	want := []*Finding{
+		{
+			Rule:     "crashloop",
+			Severity: SeverityCritical,
+			Title:    "pipeline default/edges is crashing",
+			Detail:   "user container is crashlooping",
+			Files:    []string{"pipelines/default/edges/spec.json"},
+		},
+		{
+			Rule:     "crashloop",
+			Severity: SeverityCritical,
+			Title:    "pipeline default/edges: container user of pod pipeline-default-edges-v1-x5z7q is crashlooping",
+			Detail:   "It has restarted 7 times; its last state was Terminated (OOMKilled, exit code 137).  Its logs from before the last restart show why it exited.",
+			Files:    []string{"pipelines/default/edges/pods/pipeline-default-edges-v1-x5z7q/describe.txt"},
+		},
+		{
+			Rule:     "failed_datums",
+			Severity: SeverityError,
+			Title:    "1 jobs of pipeline default/edges failed",
+			Detail:   "The latest failed because: datum 4f2e failed",
+			Files:    []string{"pipelines/default/edges/jobs.json"},
+		},
+		{
+			Rule:     "oom",
+			Severity: SeverityError,
+			Title:    "pipeline default/edges: container user of pod pipeline-default-edges-v1-x5z7q was OOM-killed",
+			Detail:   "Raise the pipeline's resource_limits.memory (and resource_requests.memory), or process less data per datum.",
+			Files:    []string{"pipelines/default/edges/pods/pipeline-default-edges-v1-x5z7q/describe.txt"},
+		},
+		{
+			Rule:     "stuck_commits",
+			Severity: SeverityError,
+			Title:    "commit default/images@9e8f failed",
+			Detail:   "validation failed",
+			Files:    []string{"source-repos/default/images/commits.json"},
+		},
+		{
+			Rule:     "failed_datums",
+			Severity: SeverityWarning,
+			Title:    "2 datums of pipeline default/edges failed",
+			Detail:   "Run `pachctl list datum` on the pipeline's jobs to see which, and `pachctl logs --datum` to see why.",
+			Files:    []string{"pipelines/default/edges/jobs.json"},
+		},
+		{
+			Rule:     "failed_datums",
+			Severity: SeverityWarning,
+			Title:    `pipeline default/edges logged "ModuleNotFoundError" 2 times`,
+			Detail:   "This usually means a Python module is missing from the pipeline's image.",
+			Files:    []string{"pipelines/default/edges/pods/pipeline-default-edges-v1-x5z7q/user/logs.txt"},
+		},
+		{
+			Rule:     "stuck_commits",
+			Severity: SeverityWarning,
+			Title:    "1 commits in default/images have been open for more than 1h0m0s",
+			Detail:   "The oldest, 0c1d, has been open for 2h0m0.5s.  Commits stay open while a pipeline is processing them, or until they're finished by the user who started them.",
+			Files:    []string{"source-repos/default/images/commits.json"},
+		},
+		{
+			Rule:     "version_skew",
+			Severity: SeverityWarning,
+			Title:    "pipeline default/edges: container storage of pod pipeline-default-edges-v1-x5z7q runs pachyderm/pachd:2.8.5, but pachd is version 2.9.0",
+			Detail:   "Workers are restarted with pachd's version when their pipelines are updated; mismatched images are usually pinned in the Helm values.",
+			Files:    []string{"pipelines/default/edges/pods/pipeline-default-edges-v1-x5z7q/describe.txt"},
+		},
+	}
+	dir := filepath.Join("testdata", "diagnose")
+	archive := filepath.Join(t.TempDir(), "dump.tgz")
+	writeArchive(t, dir, archive)
+	for _, dump := range []string{dir, archive} {
+		t.Run(filepath.Base(dump), func(t *testing.T) {
+			got, err := Diagnose(pctx.TestContext(t), dump, BuiltinRules)
+			if err != nil {
+				t.Fatalf("diagnose: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnoseRuleError(t *testing.T) {
+	rules := map[string]string{
+		"broken.star": `report("info", "before the error")
+read("no-such-file")`,
+		"ok.star": `report("warning", "%d pods" % len(pods()), files = ["pachd/pods/pachd-5c9f/describe.txt"])`,
+	}
+	got, err := Diagnose(pctx.TestContext(t), filepath.Join("testdata", "diagnose"), rules)
+	if err == nil || !strings.Contains(err.Error(), `run rule "broken.star"`) {
+		t.Errorf("diagnose should fail with the error of the broken rule; got %v", err)
+	}
+	want := []*Finding{
+		{Rule: "ok", Severity: SeverityWarning, Title: "2 pods", Files: []string{"pachd/pods/pachd-5c9f/describe.txt"}},
+		{Rule: "broken", Severity: SeverityInfo, Title: "before the error"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("findings (-want +got):\n%s", diff)
+	}
+}
+
+func writeArchive(t *testing.T, dir, archive string) {
+	t.Helper()
+	fh, err := os.Create(archive)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	gw := gzip.NewWriter(fh)
+	tw := tar.NewWriter(gw)
+	if err := fs.WalkDir(os.DirFS(dir), ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(filepath.Join(dir, p))
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: p, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	}); err != nil {
+		t.Fatalf("write archive: %v", err)
+	}
+	for _, c := range []interface{ Close() error }{tw, gw, fh} {
+		if err := c.Close(); err != nil {
+			t.Fatalf("close archive: %v", err)
+		}
+	}
+}
@@ -0,0 +1,433 @@
+package server
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/v2/src/debug"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+const redactionManifest = "redactions.json"
+
+// builtinRedactions are the detectors that run unless Redaction.DisableBuiltin is set.  As with
+// user-supplied patterns, only the text matched by capture groups is redacted, if there are any.
+var builtinRedactions = []*debug.RedactionPattern{
+	// The values of fields, environment variables, flags, etc. whose names look like they hold
+	// a credential.  Values starting with "<" are kubectl's placeholders, like "<set to the key
+	// 'password' in secret 'postgres'>", and aren't secret.
+	{Name: "credential", Regex: `(?i)(?:password|passwd|secret|token|credentials?|api[_-]?key|private[_-]?key)(?:[_-]?key)?["']?\s*[:=]\s*["']?([^\s"',;}<\\][^\s"',;}\\]*)`},
+	// The password in a URL, like a database connection string.
+	{Name: "url_password", Regex: `[a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@]+:([^/\s@]+)@`},
+	{Name: "jwt", Regex: `eyJ[A-Za-z0-9_-]{4,}\.eyJ[A-Za-z0-9_-]{4,}\.[A-Za-z0-9_-]+`},
+	{Name: "aws_access_key", Regex: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`},
+	// A PEM private key on one line, as in a JSON string.  Keys that span lines are handled
+	// by redactStream.
+	{Name: privateKeyDetector, Regex: `-----BEGIN [A-Z ]*PRIVATE KEY-----(.*?)-----END [A-Z ]*PRIVATE KEY-----`},
+}
+
+const privateKeyDetector = "private_key"
+
+var (
+	privateKeyBegin = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)
+	privateKeyEnd   = regexp.MustCompile(`-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+// maxRedactedLines limits the line numbers recorded for each file and detector in the manifest.
+const maxRedactedLines = 100
+
+type redactionPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// A redactor removes secrets from the files that dump tasks write, and records what it removed
+// in a manifest.  It's safe to use from multiple tasks at once.
+type redactor struct {
+	patterns   []*redactionPattern
+	fields     [][]string
+	privateKey bool
+
+	mu      sync.Mutex
+	records []*redactionRecord
+}
+
+// redactionRecord is an entry in the redaction manifest.  It never includes the redacted text.
+type redactionRecord struct {
+	File     string `json:"file"`
+	Detector string `json:"detector"`
+	Count    int    `json:"count"`
+	// Lines are the (1-based) lines that were redacted, for detectors that work on lines.
+	Lines []int `json:"lines,omitempty"`
+}
+
+// newRedactor returns a redactor configured by r, or nil if r is nil.
+func newRedactor(r *debug.Redaction) (*redactor, error) {
+	if r == nil {
+		return nil, nil
+	}
+	red := &redactor{}
+	var patterns []*debug.RedactionPattern
+	if !r.DisableBuiltin {
+		patterns = append(patterns, builtinRedactions...)
+		red.privateKey = true
+	}
+	patterns = append(patterns, r.Patterns...)
+	for i, p := range patterns {
+		if p.GetName() == "" {
+			return nil, errors.Errorf("redaction pattern %d has no name", i)
+		}
+		re, err := regexp.Compile(p.GetRegex())
+		if err != nil {
+			return nil, errors.Wrapf(err, "compile redaction pattern %q", p.GetName())
+		}
+		red.patterns = append(red.patterns, &redactionPattern{name: p.GetName(), re: re})
+	}
+	for _, f := range r.Fields {
+		if f == "" {
+			return nil, errors.New("empty redaction field path")
+		}
+		red.fields = append(red.fields, strings.Split(f, "."))
+	}
+	return red, nil
+}
+
+// redactDir redacts every text file in dir, the output directory of a dump task.  Binary files,
+// like profiles and binaries, can't be redacted without corrupting them, so those that contain
+// anything to redact are replaced by a note.
+func (r *redactor) redactDir(dir string) error {
+	return errors.Wrapf(filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errors.Wrapf(err, "walk path %q", path)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		return errors.Wrapf(r.redactFile(path, filepath.ToSlash(rel)), "redact %q", rel)
+	}), "redact directory %q", dir)
+}
+
+// redactFile redacts the file at path, which is called name in the dump, in place.
+func (r *redactor) redactFile(path, name string) (retErr error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer errors.Close(&retErr, in, "close input")
+	br := bufio.NewReaderSize(in, 8192)
+	if head, _ := br.Peek(8000); bytes.IndexByte(head, 0) >= 0 {
+		// Like git, treat files with NUL bytes as binary.
+		return r.redactBinaryFile(path, name, br)
+	}
+	var src io.Reader = br
+	var records []*redactionRecord
+	if len(r.fields) > 0 && strings.HasSuffix(name, ".json") {
+		content, err := io.ReadAll(br)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if redacted, fieldRecords, ok := r.redactFields(content); ok {
+			content, records = redacted, fieldRecords
+		}
+		src = bytes.NewReader(content)
+	}
+	out, err := os.CreateTemp(filepath.Dir(path), ".redact-*")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		// If the output was renamed over the input, this fails harmlessly.
+		os.Remove(out.Name()) //nolint:errcheck
+	}()
+	bw := bufio.NewWriter(out)
+	streamRecords, err := r.redactStream(src, bw)
+	if err != nil {
+		errors.JoinInto(&err, out.Close())
+		return err
+	}
+	records = append(records, streamRecords...)
+	if err := bw.Flush(); err != nil {
+		errors.JoinInto(&err, out.Close())
+		return errors.EnsureStack(err)
+	}
+	if err := out.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	if err := os.Rename(out.Name(), path); err != nil {
+		return errors.EnsureStack(err)
+	}
+	r.addRecords(name, records)
+	return nil
+}
+
+// redactBinaryFile replaces the binary file at path, whose content is read from br, with a note
+// if it contains anything to redact.  Gzipped files, like profiles, are scanned decompressed.
+func (r *redactor) redactBinaryFile(path, name string, br *bufio.Reader) (retErr error) {
+	var src io.Reader = br
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return errors.Wrap(err, "decompress")
+		}
+		defer errors.Close(&retErr, gz, "close decompressor")
+		src = gz
+	}
+	records, err := r.redactStream(src, io.Discard)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	var detectors []string
+	for _, rec := range records {
+		// Line numbers mean nothing in a binary file.
+		rec.Lines = nil
+		detectors = append(detectors, rec.Detector)
+	}
+	note := "This binary file was removed from the dump because it contains text matched by these redaction detectors: " +
+		strings.Join(detectors, ", ") + "\n"
+	if err := os.WriteFile(path, []byte(note), 0644); err != nil {
+		return errors.EnsureStack(err)
+	}
+	r.addRecords(name, records)
+	return nil
+}
+
+// addRecords adds the records of the redactions made in the file called name to the manifest.
+func (r *redactor) addRecords(name string, records []*redactionRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rec := range records {
+		rec.File = name
+		r.records = append(r.records, rec)
+	}
+}
+
+// redactStream copies src to dst line by line, redacting the matches of each pattern.
+func (r *redactor) redactStream(src io.Reader, dst io.Writer) ([]*redactionRecord, error) {
+	byDetector := make(map[string]*redactionRecord)
+	record := func(detector string, line, count int) {
+		rec, ok := byDetector[detector]
+		if !ok {
+			rec = &redactionRecord{Detector: detector}
+			byDetector[detector] = rec
+		}
+		rec.Count += count
+		if len(rec.Lines) < maxRedactedLines && (len(rec.Lines) == 0 || rec.Lines[len(rec.Lines)-1] != line) {
+			rec.Lines = append(rec.Lines, line)
+		}
+	}
+	br := bufio.NewReader(src)
+	var inKey bool
+	for n := 1; ; n++ {
+		line, err := br.ReadString('\n')
+		if line == "" && err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		body := strings.TrimRight(line, "\r\n")
+		eol := line[len(body):]
+		if inKey {
+			// Redact the lines of a multi-line private key, up to its END line.
+			if loc := privateKeyEnd.FindStringIndex(body); loc != nil {
+				body, inKey = redacted(privateKeyDetector)+body[loc[0]:], false
+			} else {
+				body = redacted(privateKeyDetector)
+			}
+			record(privateKeyDetector, n, 0)
+		} else {
+			for _, p := range r.patterns {
+				var count int
+				body, count = redactMatches(p, body)
+				if count > 0 {
+					record(p.name, n, count)
+				}
+			}
+			if r.privateKey {
+				if loc := privateKeyBegin.FindStringIndex(body); loc != nil && !privateKeyEnd.MatchString(body[loc[1]:]) {
+					body, inKey = body[:loc[1]], true
+					record(privateKeyDetector, n, 1)
+				}
+			}
+		}
+		if _, err := io.WriteString(dst, body+eol); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	var records []*redactionRecord
+	for _, rec := range byDetector {
+		records = append(records, rec)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Detector < records[j].Detector })
+	return records, nil
+}
+
+func redacted(detector string) string {
+	return "[REDACTED:" + detector + "]"
+}
+
+// redactMatches replaces the text matched by p's capture groups, or by all of p if it has none,
+// and returns the number of replacements.
+func redactMatches(p *redactionPattern, s string) (string, int) {
+	matches := p.re.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s, 0
+	}
+	var b strings.Builder
+	var last, count int
+	for _, m := range matches {
+		groups := m[2:]
+		if len(groups) == 0 {
+			groups = m[:2]
+		}
+		for i := 0; i < len(groups); i += 2 {
+			start, end := groups[i], groups[i+1]
+			if start < last || start == end {
+				// An unmatched or nested group.
+				continue
+			}
+			b.WriteString(s[last:start])
+			b.WriteString(redacted(p.name))
+			last = end
+			count++
+		}
+	}
+	b.WriteString(s[last:])
+	return b.String(), count
+}
+
+// redactFields replaces the values of the configured fields in content, a stream of JSON
+// values.  If content isn't JSON or has none of the fields, ok is false.
+func (r *redactor) redactFields(content []byte) (_ []byte, _ []*redactionRecord, ok bool) {
+	d := json.NewDecoder(bytes.NewReader(content))
+	d.UseNumber()
+	var values []any
+	for {
+		var v any
+		if err := d.Decode(&v); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, false
+		}
+		values = append(values, v)
+	}
+	counts := make(map[string]int)
+	for i, v := range values {
+		values[i] = r.walkFields(v, nil, counts)
+	}
+	if len(counts) == 0 {
+		return nil, nil, false
+	}
+	buf := new(bytes.Buffer)
+	e := json.NewEncoder(buf)
+	e.SetIndent("", "  ")
+	e.SetEscapeHTML(false)
+	for _, v := range values {
+		if err := e.Encode(v); err != nil {
+			return nil, nil, false
+		}
+	}
+	var records []*redactionRecord
+	for detector, count := range counts {
+		records = append(records, &redactionRecord{Detector: detector, Count: count})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Detector < records[j].Detector })
+	return buf.Bytes(), records, true
+}
+
+// walkFields redacts the configured fields in v, whose path is path.  Array elements have the
+// path of their array.
+func (r *redactor) walkFields(v any, path []string, counts map[string]int) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			childPath := append(path[:len(path):len(path)], k)
+			if f := r.matchField(childPath); f != "" {
+				detector := "field:" + f
+				v[k] = redacted(detector)
+				counts[detector]++
+				continue
+			}
+			v[k] = r.walkFields(child, childPath, counts)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = r.walkFields(child, path, counts)
+		}
+	}
+	return v
+}
+
+// matchField returns the configured field path that path ends with, if any.
+func (r *redactor) matchField(path []string) string {
+	for _, f := range r.fields {
+		if len(f) > len(path) {
+			continue
+		}
+		match := true
+		for i, key := range f {
+			if key != "*" && key != path[len(path)-len(f)+i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return strings.Join(f, ".")
+		}
+	}
+	return ""
+}
+
+// writeManifest adds the redaction manifest to a dump.
+func (r *redactor) writeManifest(tw *tar.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	records := append([]*redactionRecord{}, r.records...)
+	sort.SliceStable(records, func(i, j int) bool { return records[i].File < records[j].File })
+	var detectors []string
+	for _, p := range r.patterns {
+		detectors = append(detectors, p.name)
+	}
+	var fields []string
+	for _, f := range r.fields {
+		fields = append(fields, strings.Join(f, "."))
+	}
+	manifest, err := json.MarshalIndent(struct {
+		Detectors  []string           `json:"detectors"`
+		Fields     []string           `json:"fields,omitempty"`
+		Redactions []*redactionRecord `json:"redactions"`
+	}{detectors, fields, records}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal redaction manifest")
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name: redactionManifest,
+		Size: int64(len(manifest)),
+		Mode: 0777,
+	}); err != nil {
+		return errors.Wrapf(err, "write header for file %q", redactionManifest)
+	}
+	_, err = tw.Write(manifest)
+	return errors.Wrapf(err, "write tar file %q", redactionManifest)
+}
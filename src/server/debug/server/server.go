@@ -163,6 +163,9 @@ func (s *debugServer) GetDumpV2Template(ctx context.Context, request *debug.GetD
 				ClusterDefaults: true,
 			},
 			StarlarkScripts: scripts,
+			// Redact with just the built-in detectors by default; remove this to turn off
+			// redaction.
+			Redaction: &debug.Redaction{},
 		},
 	}, nil
 }
@@ -346,13 +349,17 @@ func (s *debugServer) DumpV2(request *debug.DumpV2Request, originalServer debug.
 	if err := s.fillApps(server.Context(), apps); err != nil {
 		return err
 	}
+	red, err := newRedactor(request.Redaction)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	c := s.env.GetPachClient(server.Context())
 	tasks := s.makeTasks(server.Context(), request, server)
 	var d time.Duration
 	if request.Timeout != nil {
 		d = request.Timeout.AsDuration()
 	}
-	return s.dump(c, server, tasks, d)
+	return s.dump(c, server, tasks, d, red)
 }
 
 func (s *debugServer) makeTasks(ctx context.Context, request *debug.DumpV2Request, server debug.Debug_DumpV2Server) []taskFunc {
@@ -669,7 +676,9 @@ func recordProgress(server debug.Debug_DumpV2Server, task string, total int) inc
 }
 
 // TODO: sharpen timeouts
-func (s *debugServer) dump(c *client.APIClient, server debug.Debug_DumpV2Server, tasks []taskFunc, timeout time.Duration) (retErr error) {
+//
+// If red is non-nil, it redacts the output of each task before the output is added to the dump.
+func (s *debugServer) dump(c *client.APIClient, server debug.Debug_DumpV2Server, tasks []taskFunc, timeout time.Duration, red *redactor) (retErr error) {
 	ctx := c.Ctx() // this context has authorization credentials we need
 	dumpRoot := filepath.Join(os.TempDir(), uuid.NewWithoutDashes())
 	if err := os.Mkdir(dumpRoot, 0744); err != nil {
@@ -722,6 +731,21 @@ func (s *debugServer) dump(c *client.APIClient, server debug.Debug_DumpV2Server,
 								log.Error(taskCtx, "write error", zap.Error(writeErr))
 							}
 						}
+						if red != nil {
+							if err := red.redactDir(taskDir); err != nil {
+								// Output that couldn't be redacted might contain secrets, so
+								// it's replaced with the error.
+								if err := os.RemoveAll(taskDir); err != nil {
+									return errors.Wrap(err, "remove unredacted task output")
+								}
+								if err := os.Mkdir(taskDir, 0744); err != nil {
+									return errors.Wrap(err, "create dump task sub-directory")
+								}
+								if writeErr := writeErrorFile(dfs, err, ""); writeErr != nil {
+									log.Error(taskCtx, "write error", zap.Error(writeErr))
+								}
+							}
+						}
 						mu.Lock()
 						defer mu.Unlock()
 						err := filepath.Walk(taskDir, func(path string, fi fs.FileInfo, err error) error {
@@ -738,7 +762,13 @@ func (s *debugServer) dump(c *client.APIClient, server debug.Debug_DumpV2Server,
 					})
 				}(f)
 			}
-			return errors.Wrap(eg.Wait(), "run dump tasks")
+			if err := eg.Wait(); err != nil {
+				return errors.Wrap(err, "run dump tasks")
+			}
+			if red != nil {
+				return red.writeManifest(tw)
+			}
+			return nil
 		})
 	})
 }
@@ -0,0 +1,430 @@
+package shell
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	ppsserver "github.com/pachyderm/pachyderm/v2/src/server/pps"
+)
+
+// A DiffReport is what changed between two debug dumps of a cluster, taken before and after a
+// regression.
+type DiffReport struct {
+	// Version is the change in pachd's version, if any.
+	Version *Change `json:"version,omitempty"`
+	// Pipelines are the pipelines that were added, removed or changed.
+	Pipelines []*PipelineDiff `json:"pipelines,omitempty"`
+	// Jobs are the pipelines whose job failure rates changed.
+	Jobs []*JobStatsDiff `json:"jobs,omitempty"`
+	// LogSignatures are the error log signatures that are only in the second dump.
+	LogSignatures []*LogSignature `json:"logSignatures,omitempty"`
+	// ClusterDefaults are the changes to the cluster defaults.
+	ClusterDefaults []*Change `json:"clusterDefaults,omitempty"`
+	// Helm are the changes to the values of Helm releases.
+	Helm []*HelmDiff `json:"helm,omitempty"`
+}
+
+// Empty returns true if the dumps don't differ.
+func (r *DiffReport) Empty() bool {
+	return r.Version == nil && len(r.Pipelines) == 0 && len(r.Jobs) == 0 && len(r.LogSignatures) == 0 &&
+		len(r.ClusterDefaults) == 0 && len(r.Helm) == 0
+}
+
+// A Change is a value that differs between the dumps.  Path is the value's dot-separated path in
+// a document, like a pipeline spec; Before or After is nil if the value was added or removed.
+type Change struct {
+	Path   string `json:"path,omitempty"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// The kinds of PipelineDiff.
+const (
+	PipelineAdded   = "added"
+	PipelineRemoved = "removed"
+	PipelineChanged = "changed"
+)
+
+// A PipelineDiff is a pipeline that was added, removed or changed.
+type PipelineDiff struct {
+	Pipeline string  `json:"pipeline"`
+	Kind     string  `json:"kind"`
+	Version  *Change `json:"version,omitempty"`
+	State    *Change `json:"state,omitempty"`
+	// Spec are the changes to the pipeline's details, other than its status.
+	Spec []*Change `json:"spec,omitempty"`
+}
+
+// JobStats summarize the finished jobs of a pipeline in a dump.
+type JobStats struct {
+	Finished    int     `json:"finished"`
+	Failed      int     `json:"failed"`
+	FailureRate float64 `json:"failureRate"`
+}
+
+// A JobStatsDiff is a pipeline whose job failure rate changed.
+type JobStatsDiff struct {
+	Pipeline string   `json:"pipeline"`
+	Before   JobStats `json:"before"`
+	After    JobStats `json:"after"`
+}
+
+// A LogSignature is a kind of error log line: the line, with numbers and IDs replaced.
+type LogSignature struct {
+	// Source is "pachd", or "pipeline <project>/<name>" for a pipeline's user code.
+	Source    string `json:"source"`
+	Signature string `json:"signature"`
+	Count     int    `json:"count"`
+}
+
+// A HelmDiff is the changes to the values of a Helm release between the latest revisions of it in
+// each dump.
+type HelmDiff struct {
+	Release string    `json:"release"`
+	Changes []*Change `json:"changes"`
+}
+
+// pipelineStatusFields are pipeline details that change as a pipeline runs, rather than when its
+// spec changes.
+var pipelineStatusFields = []string{
+	"createdAt", "updatedAt", "createdBy", "recentError", "workersRequested", "workersAvailable",
+	"reason", "unclaimedTasks", "workerRc", "workersStartedAt",
+}
+
+// dumpSnapshot is what Diff compares in each dump.
+type dumpSnapshot struct {
+	version         string
+	pipelines       map[string]*pps.PipelineInfo
+	jobs            map[string]JobStats
+	logSignatures   map[LogSignature]int
+	clusterDefaults any
+	// helm maps release names to the values of their latest revisions.
+	helm map[string]any
+}
+
+// Diff compares the debug dumps at before and after, each a dump archive or a directory it's
+// been extracted to.  Each dump is served by a dump server, as with `pachctl debug analyze`.
+func Diff(ctx context.Context, before, after string) (*DiffReport, error) {
+	a, err := snapshotDump(ctx, before)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %v", before)
+	}
+	b, err := snapshotDump(ctx, after)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %v", after)
+	}
+	r := &DiffReport{}
+	if a.version != b.version {
+		r.Version = &Change{Before: a.version, After: b.version}
+	}
+	for _, name := range sortedKeys(a.pipelines, b.pipelines) {
+		pa, pb := a.pipelines[name], b.pipelines[name]
+		switch {
+		case pa == nil:
+			r.Pipelines = append(r.Pipelines, &PipelineDiff{Pipeline: name, Kind: PipelineAdded})
+		case pb == nil:
+			r.Pipelines = append(r.Pipelines, &PipelineDiff{Pipeline: name, Kind: PipelineRemoved})
+		default:
+			d := &PipelineDiff{Pipeline: name, Kind: PipelineChanged}
+			if pa.Version != pb.Version {
+				d.Version = &Change{Before: pa.Version, After: pb.Version}
+			}
+			if pa.State != pb.State {
+				d.State = &Change{Before: pa.State.String(), After: pb.State.String()}
+			}
+			sa, err := pipelineSpec(pa)
+			if err != nil {
+				return nil, err
+			}
+			sb, err := pipelineSpec(pb)
+			if err != nil {
+				return nil, err
+			}
+			d.Spec = diffValues(sa, sb)
+			if d.Version != nil || d.State != nil || len(d.Spec) > 0 {
+				r.Pipelines = append(r.Pipelines, d)
+			}
+		}
+	}
+	for _, name := range sortedKeys(a.jobs, b.jobs) {
+		ja, jb := a.jobs[name], b.jobs[name]
+		if ja.FailureRate != jb.FailureRate {
+			r.Jobs = append(r.Jobs, &JobStatsDiff{Pipeline: name, Before: ja, After: jb})
+		}
+	}
+	for sig, count := range b.logSignatures {
+		if a.logSignatures[sig] == 0 {
+			sig.Count = count
+			r.LogSignatures = append(r.LogSignatures, &sig)
+		}
+	}
+	sort.Slice(r.LogSignatures, func(i, j int) bool {
+		si, sj := r.LogSignatures[i], r.LogSignatures[j]
+		if si.Count != sj.Count {
+			return si.Count > sj.Count
+		}
+		if si.Source != sj.Source {
+			return si.Source < sj.Source
+		}
+		return si.Signature < sj.Signature
+	})
+	r.ClusterDefaults = diffValues(a.clusterDefaults, b.clusterDefaults)
+	for _, release := range sortedKeys(a.helm, b.helm) {
+		if changes := diffValues(a.helm[release], b.helm[release]); len(changes) > 0 {
+			r.Helm = append(r.Helm, &HelmDiff{Release: release, Changes: changes})
+		}
+	}
+	return r, nil
+}
+
+func snapshotDump(ctx context.Context, dumpPath string) (_ *dumpSnapshot, retErr error) {
+	d := NewDumpServer(dumpPath, 0)
+	defer errors.Close(&retErr, d, "stop dump server")
+	c, err := client.NewFromURI(ctx, d.Address())
+	if err != nil {
+		return nil, errors.Wrap(err, "connect to dump server")
+	}
+	defer errors.Close(&retErr, c, "close client")
+	s := &dumpSnapshot{
+		pipelines:     make(map[string]*pps.PipelineInfo),
+		jobs:          make(map[string]JobStats),
+		logSignatures: make(map[LogSignature]int),
+		helm:          make(map[string]any),
+	}
+	// A dump without a version file reports an error, which is the same as an unknown version
+	// for our purposes.
+	s.version, _ = c.Version()
+	pipelines, err := c.ListPipeline()
+	if err != nil {
+		return nil, errors.Wrap(err, "list pipelines")
+	}
+	for _, pi := range pipelines {
+		name := pi.Pipeline.String()
+		s.pipelines[name] = pi
+		var stats JobStats
+		if err := c.ListJobF(pi.Pipeline.Project.GetName(), pi.Pipeline.Name, nil, -1, true, func(ji *pps.JobInfo) error {
+			if ji.Job.Pipeline.String() != name {
+				// The dump server doesn't distinguish pipelines with the same name in
+				// different projects.
+				return nil
+			}
+			switch ji.State {
+			case pps.JobState_JOB_FAILURE:
+				stats.Failed++
+				stats.Finished++
+			case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_KILLED, pps.JobState_JOB_UNRUNNABLE:
+				stats.Finished++
+			}
+			return nil
+		}); err != nil && !ppsserver.IsPipelineNotFoundErr(err) {
+			// Dumps taken with a job limit may have no jobs for a pipeline.
+			return nil, errors.Wrapf(err, "list jobs of %v", name)
+		}
+		if stats.Finished > 0 {
+			stats.FailureRate = float64(stats.Failed) / float64(stats.Finished)
+		}
+		s.jobs[name] = stats
+		if err := collectLogSignatures(c, pi.Pipeline, "pipeline "+name, s.logSignatures); err != nil {
+			return nil, err
+		}
+	}
+	if err := collectLogSignatures(c, nil, "pachd", s.logSignatures); err != nil {
+		return nil, err
+	}
+	resp, err := c.PpsAPIClient.GetClusterDefaults(c.Ctx(), &pps.GetClusterDefaultsRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "get cluster defaults")
+	}
+	if resp.ClusterDefaultsJson != "" {
+		if err := json.Unmarshal([]byte(resp.ClusterDefaultsJson), &s.clusterDefaults); err != nil {
+			return nil, errors.Wrap(err, "unmarshal cluster defaults")
+		}
+	}
+	if err := d.helmValues(s.helm); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+var (
+	// errorLogLine matches log lines that report errors.
+	errorLogLine = regexp.MustCompile(`(?i)(error|exception)s?\b|\b(panic|fatal|traceback|failed)\b`)
+	// The parts of a log line that vary between occurrences of the same error.
+	logIDs     = regexp.MustCompile(`\b[0-9a-fA-F-]{8,}\b`)
+	logNumbers = regexp.MustCompile(`\d+(\.\d+)?`)
+)
+
+// maxSignatureLength truncates long log lines, which are usually long because of their data.
+const maxSignatureLength = 200
+
+// collectLogSignatures counts the signatures of the error log lines of a pipeline's user code,
+// or of pachd if pipeline is nil.
+func collectLogSignatures(c *client.APIClient, pipeline *pps.Pipeline, source string, signatures map[LogSignature]int) error {
+	var iter *client.LogsIter
+	if pipeline == nil {
+		iter = c.GetLogs("", "", "", nil, "", false, false, 0)
+	} else {
+		iter = c.GetLogs(pipeline.Project.GetName(), pipeline.Name, "", nil, "", false, false, 0)
+	}
+	for iter.Next() {
+		if sig := logSignature(iter.Message().Message); sig != "" {
+			signatures[LogSignature{Source: source, Signature: sig}]++
+		}
+	}
+	return errors.Wrapf(iter.Err(), "get logs of %v", source)
+}
+
+// logSignature returns the signature of an error log line, or "" if the line isn't an error.
+// pachd's JSON log lines are reduced to their severity, message and error.
+func logSignature(line string) string {
+	if strings.HasPrefix(line, "{") {
+		var entry struct {
+			Severity string `json:"severity"`
+			Message  string `json:"message"`
+			Error    string `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err == nil && entry.Message != "" {
+			switch entry.Severity {
+			case "error", "dpanic", "panic", "fatal":
+			default:
+				return ""
+			}
+			line = entry.Message
+			if entry.Error != "" {
+				line += ": " + entry.Error
+			}
+		} else if !errorLogLine.MatchString(line) {
+			return ""
+		}
+	} else if !errorLogLine.MatchString(line) {
+		return ""
+	}
+	sig := logIDs.ReplaceAllString(line, "<id>")
+	sig = logNumbers.ReplaceAllString(sig, "<n>")
+	sig = strings.Join(strings.Fields(sig), " ")
+	if len(sig) > maxSignatureLength {
+		sig = sig[:maxSignatureLength] + "..."
+	}
+	return sig
+}
+
+// helmValues adds the values of the latest revision of each Helm release in the dump to values.
+func (d *debugDump) helmValues(values map[string]any) error {
+	revisions := make(map[string]int)
+	err := d.globTar("helm/*/values.yaml", func(name string, r io.Reader) error {
+		// Helm stores revision N of release R in secret sh.helm.release.v1.R.vN.
+		secret := path.Base(path.Dir(name))
+		i := strings.LastIndex(secret, ".v")
+		if i < 0 {
+			return nil
+		}
+		revision, err := strconv.Atoi(secret[i+2:])
+		if err != nil {
+			return nil
+		}
+		release := strings.TrimPrefix(secret[:i], "sh.helm.release.v1.")
+		if rev, ok := revisions[release]; ok && rev > revision {
+			return nil
+		}
+		var v any
+		if err := yaml.NewDecoder(r).Decode(&v); err != nil && !errors.Is(err, io.EOF) {
+			return errors.Wrapf(err, "decode %v", name)
+		}
+		revisions[release], values[release] = revision, v
+		return nil
+	})
+	return errors.Wrap(err, "read helm values")
+}
+
+// pipelineSpec returns the details of a pipeline other than its status, as JSON values.
+func pipelineSpec(pi *pps.PipelineInfo) (any, error) {
+	js, err := protojson.Marshal(pi.GetDetails())
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal details of %v", pi.Pipeline)
+	}
+	var spec map[string]any
+	if err := json.Unmarshal(js, &spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal details of %v", pi.Pipeline)
+	}
+	for _, f := range pipelineStatusFields {
+		delete(spec, f)
+	}
+	return spec, nil
+}
+
+// diffValues returns the changes between two JSON or YAML documents, sorted by path.
+func diffValues(a, b any) []*Change {
+	fa, fb := make(map[string]any), make(map[string]any)
+	flatten("", a, fa)
+	flatten("", b, fb)
+	var changes []*Change
+	for _, p := range sortedKeys(fa, fb) {
+		va, okA := fa[p]
+		vb, okB := fb[p]
+		if okA && okB && reflect.DeepEqual(va, vb) {
+			continue
+		}
+		changes = append(changes, &Change{Path: p, Before: va, After: vb})
+	}
+	return changes
+}
+
+// flatten adds the leaves of v to out, keyed by their paths.  Empty maps and lists are leaves.
+func flatten(prefix string, v any, out map[string]any) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			break
+		}
+		for k, child := range v {
+			flatten(join(k), child, out)
+		}
+		return
+	case []any:
+		if len(v) == 0 {
+			break
+		}
+		for i, child := range v {
+			flatten(fmt.Sprintf("%s[%d]", prefix, i), child, out)
+		}
+		return
+	case nil:
+		return
+	}
+	out[prefix] = v
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
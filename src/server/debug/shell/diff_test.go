@@ -0,0 +1,127 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+func writeDump(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %v: %v", name, err)
+		}
+	}
+	return dir
+}
+
+const edgesV1 = `{"pipeline": {"project": {"name": "default"}, "name": "edges"}, "version": "1", "state": "PIPELINE_RUNNING",
+  "details": {"transform": {"image": "edges:1", "cmd": ["python3", "/edges.py"]}, "workersAvailable": "1", "datumTries": "3"}}
+`
+
+const edgesV2 = `{"pipeline": {"project": {"name": "default"}, "name": "edges"}, "version": "2", "state": "PIPELINE_CRASHING",
+  "details": {"transform": {"image": "edges:2", "cmd": ["python3", "/edges.py"]}, "workersAvailable": "0", "datumTries": "3"}}
+`
+
+func jobs(states ...string) string {
+	var s string
+	for i, state := range states {
+		s += `{"job": {"pipeline": {"project": {"name": "default"}, "name": "edges"}, "id": "` + string(rune('a'+i)) + `"}, "state": "` + state + `"}` + "\n"
+	}
+	return s
+}
+
+func TestDiff(t *testing.T) {
+	before := writeDump(t, map[string]string{
+		"pachd/pachd-1/pachd/version.txt":                  "2.8.5\n",
+		"pipelines/default/edges/spec.json":                edgesV1,
+		"pipelines/default/edges/jobs.json":                jobs("JOB_SUCCESS", "JOB_SUCCESS", "JOB_FAILURE", "JOB_RUNNING"),
+		"pipelines/default/montage/spec.json":              `{"pipeline": {"project": {"name": "default"}, "name": "montage"}, "version": "1"}`,
+		"defaults/cluster-defaults.json":                   `{"createPipelineRequest": {"datumTries": 3}}`,
+		"helm/sh.helm.release.v1.pachyderm.v1/values.yaml": "pachd:\n  replicas: 1\n",
+		"helm/sh.helm.release.v1.pachyderm.v2/values.yaml": "pachd:\n  replicas: 1\n  resources:\n    memory: 2G\n",
+		"pachd/pods/pachd-1/pachd/logs.txt":                `{"severity":"error","message":"job 7f3a9c2e1b failed","error":"context deadline exceeded"}` + "\n",
+	})
+	after := writeDump(t, map[string]string{
+		"pachd/pachd-1/pachd/version.txt":                  "2.9.0\n",
+		"pipelines/default/edges/spec.json":                edgesV2,
+		"pipelines/default/edges/jobs.json":                jobs("JOB_FAILURE", "JOB_FAILURE", "JOB_SUCCESS", "JOB_FAILURE"),
+		"pipelines/images/resize/spec.json":                `{"pipeline": {"project": {"name": "images"}, "name": "resize"}, "version": "1"}`,
+		"defaults/cluster-defaults.json":                   `{"createPipelineRequest": {"datumTries": 5}}`,
+		"helm/sh.helm.release.v1.pachyderm.v3/values.yaml": "pachd:\n  replicas: 1\n  resources:\n    memory: 4G\n",
+		"pachd/pods/pachd-1/pachd/logs.txt": `{"severity":"error","message":"job 0b1c2d3e4f failed","error":"context deadline exceeded"}` + "\n" +
+			`{"severity":"info","message":"everything is fine"}` + "\n" +
+			`{"severity":"error","message":"cannot connect to postgres at 10.0.0.7:5432"}` + "\n",
+		"pipelines/default/edges/pods/pipeline-default-edges-v2-abcde/user/logs.txt": `{"projectName":"default","pipelineName":"edges","message":"ModuleNotFoundError: No module named 'cv2'"}` + "\n" +
+			`{"projectName":"default","pipelineName":"edges","message":"ModuleNotFoundError: No module named 'cv2'"}` + "\n" +
+			`{"projectName":"default","pipelineName":"edges","message":"processed 12 files"}` + "\n",
+	})
+
+	got, err := Diff(pctx.TestContext(t), before, after)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	want := &DiffReport{
+		Version: &Change{Before: "2.8.5", After: "2.9.0"},
+		Pipelines: []*PipelineDiff{
+			{
+				Pipeline: "default/edges",
+				Kind:     PipelineChanged,
+				Version:  &Change{Before: uint64(1), After: uint64(2)},
+				State:    &Change{Before: "PIPELINE_RUNNING", After: "PIPELINE_CRASHING"},
+				Spec:     []*Change{{Path: "transform.image", Before: "edges:1", After: "edges:2"}},
+			},
+			{Pipeline: "default/montage", Kind: PipelineRemoved},
+			{Pipeline: "images/resize", Kind: PipelineAdded},
+		},
+		Jobs: []*JobStatsDiff{{
+			Pipeline: "default/edges",
+			Before:   JobStats{Finished: 3, Failed: 1, FailureRate: 1.0 / 3},
+			After:    JobStats{Finished: 4, Failed: 3, FailureRate: 0.75},
+		}},
+		LogSignatures: []*LogSignature{
+			{Source: "pipeline default/edges", Signature: "ModuleNotFoundError: No module named 'cv<n>'", Count: 2},
+			{Source: "pachd", Signature: "cannot connect to postgres at <n>.<n>:<n>", Count: 1},
+		},
+		ClusterDefaults: []*Change{{Path: "createPipelineRequest.datumTries", Before: float64(3), After: float64(5)}},
+		Helm: []*HelmDiff{{
+			Release: "pachyderm",
+			Changes: []*Change{{Path: "pachd.resources.memory", Before: "2G", After: "4G"}},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("report (-want +got):\n%s", diff)
+	}
+
+	same, err := Diff(pctx.TestContext(t), after, after)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if !same.Empty() {
+		t.Errorf("a dump should not differ from itself; got %#v", same)
+	}
+}
+
+func TestLogSignature(t *testing.T) {
+	for line, want := range map[string]string{
+		"processed 12 files":                             "",
+		"Error: file 3 of 12":                            "Error: file <n> of <n>",
+		`{"severity":"info","message":"request failed"}`: "",
+		`{"severity":"error","message":"commit 4f2e9a1b7c finished","error":"x"}`: "commit <id> finished: x",
+		"panic: runtime error: index out of range [5] with length 5":              "panic: runtime error: index out of range [<n>] with length <n>",
+	} {
+		if got := logSignature(line); got != want {
+			t.Errorf("logSignature(%q):\n  got: %q\n want: %q", line, got, want)
+		}
+	}
+}
@@ -68,6 +68,7 @@ func NewDumpServer(filePath string, port uint16) *debugDump {
 	mock.PPS.InspectJobSet.Use(d.inspectJobSet)
 
 	mock.PPS.GetLogs.Use(d.getLogs)
+	mock.PPS.GetClusterDefaults.Use(d.getClusterDefaults)
 	mock.Version.GetVersion.Use(d.getVersion)
 
 	// report that enterprise and auth are disabled, to support console
@@ -94,6 +95,14 @@ func (d *debugDump) Address() string {
 	return d.mock.Addr.String()
 }
 
+// Close stops the dump server.
+func (d *debugDump) Close() error {
+	if err := d.mock.Close(); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
 func (d *debugDump) globTar(glob string, cb func(string, io.Reader) error) (retErr error) {
 	g := globlib.MustCompile(glob, '/')
 	info, err := os.Stat(d.path)
@@ -187,8 +196,10 @@ func (d *debugDump) globTarProtos(glob string, template proto.Message, onProto f
 }
 
 const commitPatternFormatString = `{{source,input}-repos,pipelines}/+(%s/)commits{,\.json}`
-const jobPatternFormatString = `pipelines/+(%s/)jobs{,\.json}`
-const pipelineSpecPatternFormatString = `pipelines/+(%s/)spec{,\.json}`
+
+// Pipelines are in pipelines/<project>/<name> in current dumps, and pipelines/<name> in older ones.
+const jobPatternFormatString = `pipelines/*(*/)%s/jobs{,\.json}`
+const pipelineSpecPatternFormatString = `pipelines/*(*/)%s/spec{,\.json}`
 
 func (d *debugDump) listCommit(req *pfs.ListCommitRequest, srv pfs.API_ListCommitServer) error {
 	glob := fmt.Sprintf(commitPatternFormatString, req.Repo.Name)
@@ -409,7 +420,7 @@ func (d *debugDump) getLogs(req *pps.GetLogsRequest, srv pps.API_GetLogsServer)
 	var plainText bool
 
 	if req.Pipeline == nil && req.Job == nil {
-		glob = "pachd/*/pachd/logs.txt"
+		glob = "pachd/{*,pods/*}/pachd/logs.txt"
 		plainText = true
 	} else {
 		name := req.Pipeline.GetName()
@@ -419,7 +430,7 @@ func (d *debugDump) getLogs(req *pps.GetLogsRequest, srv pps.API_GetLogsServer)
 		if name == "" {
 			return errors.New("must provide a pipeline name")
 		}
-		glob = fmt.Sprintf("pipelines/%s/pods/*/user/logs.txt", name)
+		glob = fmt.Sprintf("pipelines/*(*/)%s/pods/*/user/logs.txt", name)
 	}
 	return d.globTar(glob, func(_ string, r io.Reader) error {
 		return ppsutil.FilterLogLines(req, r, plainText, srv.Send)
@@ -553,6 +564,21 @@ func (d *debugDump) inspectJobSet(req *pps.InspectJobSetRequest, srv pps.API_Ins
 	return err
 }
 
+func (d *debugDump) getClusterDefaults(context.Context, *pps.GetClusterDefaultsRequest) (*pps.GetClusterDefaultsResponse, error) {
+	var defaults []byte
+	if err := d.globTar("defaults/cluster-defaults.json", func(_ string, r io.Reader) error {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		defaults = b
+		return errutil.ErrBreak
+	}); err != nil {
+		return nil, err
+	}
+	return &pps.GetClusterDefaultsResponse{ClusterDefaultsJson: string(defaults)}, nil
+}
+
 func (d *debugDump) getVersion(context.Context, *emptypb.Empty) (*versionpb.Version, error) {
 	var version *versionpb.Version
 	err := d.globTar("pachd/*/pachd/version.txt", func(_ string, r io.Reader) error {
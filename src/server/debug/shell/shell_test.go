@@ -39,3 +39,25 @@ func TestPathnameToRepo(t *testing.T) {
 		}
 	}
 }
+
+func TestPipelinePatternFormat(t *testing.T) {
+	var cases = []struct {
+		format, name, path string
+		match              bool
+	}{
+		{jobPatternFormatString, "*", "pipelines/edges/jobs.json", true},
+		{jobPatternFormatString, "*", "pipelines/default/edges/jobs.json", true},
+		{jobPatternFormatString, "edges", "pipelines/edges/jobs.json", true},
+		{jobPatternFormatString, "edges", "pipelines/default/edges/jobs", true},
+		{jobPatternFormatString, "edges", "pipelines/default/montage/jobs.json", false},
+		{pipelineSpecPatternFormatString, "*", "pipelines/default/edges/spec.json", true},
+		{pipelineSpecPatternFormatString, "edges", "pipelines/default/edges/spec.json", true},
+		{pipelineSpecPatternFormatString, "edges", "pipelines/default/edges/pods/spec.json", false},
+	}
+	for _, c := range cases {
+		g := globlib.MustCompile(fmt.Sprintf(c.format, c.name), '/')
+		if g.Match(c.path) != c.match {
+			t.Errorf("expected %q to be a %v match for %q", c.path, c.match, c.name)
+		}
+	}
+}
@@ -1,4 +1,5 @@
-// Package limits defines limits for the community edition.
+// Package limits defines limits for the community edition, and the per-project
+// quotas that administrators can set.
 package limits
 
 const (
@@ -0,0 +1,180 @@
+package limits
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// Usage is the amount of each resource limited by a pps.ProjectQuota that a
+// project, or some of its pipelines, are using.
+type Usage struct {
+	Pipelines      int64
+	Workers        int64
+	CPU            resource.Quantity
+	Memory         resource.Quantity
+	GPUs           int64
+	ConcurrentJobs int64
+}
+
+// WorkerUsage returns the usage of n workers that each request the resources
+// in requests.  Any resource named "<vendor>/gpu" is counted as a GPU.
+func WorkerUsage(n int64, requests v1.ResourceList) *Usage {
+	u := &Usage{Workers: n}
+	for name, q := range requests {
+		switch {
+		case name == v1.ResourceCPU:
+			u.CPU = *resource.NewMilliQuantity(q.MilliValue()*n, resource.DecimalSI)
+		case name == v1.ResourceMemory:
+			u.Memory = *resource.NewQuantity(q.Value()*n, resource.BinarySI)
+		case strings.HasSuffix(string(name), "/gpu"):
+			u.GPUs += q.Value() * n
+		}
+	}
+	return u
+}
+
+// Add adds o to u.
+func (u *Usage) Add(o *Usage) {
+	u.Pipelines += o.Pipelines
+	u.Workers += o.Workers
+	u.CPU.Add(o.CPU)
+	u.Memory.Add(o.Memory)
+	u.GPUs += o.GPUs
+	u.ConcurrentJobs += o.ConcurrentJobs
+}
+
+// ToProto converts u to a pps.ProjectQuotaUsage.
+func (u *Usage) ToProto() *pps.ProjectQuotaUsage {
+	return &pps.ProjectQuotaUsage{
+		Pipelines:      u.Pipelines,
+		Workers:        u.Workers,
+		Cpu:            u.CPU.String(),
+		Memory:         u.Memory.String(),
+		Gpus:           u.GPUs,
+		ConcurrentJobs: u.ConcurrentJobs,
+	}
+}
+
+// ValidateQuota returns an error if quota has negative limits or if its CPU
+// or memory limits are not valid Kubernetes quantities.
+func ValidateQuota(quota *pps.ProjectQuota) error {
+	for name, v := range map[string]int64{
+		"pipelines":       quota.GetPipelines(),
+		"workers":         quota.GetWorkers(),
+		"gpus":            quota.GetGpus(),
+		"concurrent_jobs": quota.GetConcurrentJobs(),
+	} {
+		if v < 0 {
+			return errors.Errorf("%s quota must not be negative", name)
+		}
+	}
+	for name, s := range map[string]string{
+		"cpu":    quota.GetCpu(),
+		"memory": quota.GetMemory(),
+	} {
+		if s == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(s)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s quota %q", name, s)
+		}
+		if q.Sign() < 0 {
+			return errors.Errorf("%s quota must not be negative", name)
+		}
+	}
+	return nil
+}
+
+// CheckQuota returns an ErrProjectQuotaExceeded listing each of the
+// resources for which usage is over the limit set by quota.  A nil quota
+// never fails.
+func CheckQuota(project *pfs.Project, quota *pps.ProjectQuota, usage *Usage) error {
+	if quota == nil {
+		return nil
+	}
+	var exceeded []string
+	checkInt := func(name string, limit, used int64) {
+		if limit > 0 && used > limit {
+			exceeded = append(exceeded, fmt.Sprintf("%s (%d > %d)", name, used, limit))
+		}
+	}
+	checkQuantity := func(name, limit string, used resource.Quantity) {
+		if limit == "" {
+			return
+		}
+		l, err := resource.ParseQuantity(limit)
+		if err != nil || l.IsZero() {
+			return
+		}
+		if used.Cmp(l) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s (%s > %s)", name, used.String(), l.String()))
+		}
+	}
+	checkInt("pipelines", quota.Pipelines, usage.Pipelines)
+	checkInt("workers", quota.Workers, usage.Workers)
+	checkQuantity("cpu", quota.Cpu, usage.CPU)
+	checkQuantity("memory", quota.Memory, usage.Memory)
+	checkInt("gpus", quota.Gpus, usage.GPUs)
+	checkInt("concurrent jobs", quota.ConcurrentJobs, usage.ConcurrentJobs)
+	if len(exceeded) > 0 {
+		return ErrProjectQuotaExceeded{Project: project, Exceeded: exceeded}
+	}
+	return nil
+}
+
+// MaxWorkers returns the largest number of workers, each requesting the
+// resources in requests, that can be added to usage without exceeding
+// quota.  It returns -1 if quota doesn't limit the number of workers.
+func MaxWorkers(quota *pps.ProjectQuota, usage *Usage, requests v1.ResourceList) int64 {
+	if quota == nil {
+		return -1
+	}
+	max := int64(-1)
+	limit := func(n int64) {
+		if n < 0 {
+			n = 0
+		}
+		if max < 0 || n < max {
+			max = n
+		}
+	}
+	per := WorkerUsage(1, requests)
+	if quota.Workers > 0 {
+		limit(quota.Workers - usage.Workers)
+	}
+	if quota.Gpus > 0 && per.GPUs > 0 {
+		limit((quota.Gpus - usage.GPUs) / per.GPUs)
+	}
+	if l, err := resource.ParseQuantity(quota.Cpu); err == nil && !l.IsZero() && !per.CPU.IsZero() {
+		limit((l.MilliValue() - usage.CPU.MilliValue()) / per.CPU.MilliValue())
+	}
+	if l, err := resource.ParseQuantity(quota.Memory); err == nil && !l.IsZero() && !per.Memory.IsZero() {
+		limit((l.Value() - usage.Memory.Value()) / per.Memory.Value())
+	}
+	return max
+}
+
+// ErrProjectQuotaExceeded is returned when an operation would take a project
+// over its quota.
+type ErrProjectQuotaExceeded struct {
+	Project  *pfs.Project
+	Exceeded []string
+}
+
+func (e ErrProjectQuotaExceeded) Error() string {
+	return fmt.Sprintf("project %q quota exceeded: %s", e.Project, strings.Join(e.Exceeded, ", "))
+}
+
+func (e ErrProjectQuotaExceeded) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
@@ -0,0 +1,70 @@
+package limits_test
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/enterprise/limits"
+)
+
+var project = &pfs.Project{Name: "test"}
+
+func requests(cpu, memory string, gpus int64) v1.ResourceList {
+	return v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpu),
+		v1.ResourceMemory: resource.MustParse(memory),
+		"nvidia.com/gpu":  *resource.NewQuantity(gpus, resource.DecimalSI),
+	}
+}
+
+func TestWorkerUsage(t *testing.T) {
+	u := limits.WorkerUsage(3, requests("500m", "1Gi", 1))
+	require.Equal(t, int64(3), u.Workers)
+	require.Equal(t, "1500m", u.CPU.String())
+	require.Equal(t, "3Gi", u.Memory.String())
+	require.Equal(t, int64(3), u.GPUs)
+	u.Add(limits.WorkerUsage(1, requests("1", "512Mi", 0)))
+	require.Equal(t, &pps.ProjectQuotaUsage{
+		Workers: 4,
+		Cpu:     "2500m",
+		Memory:  "3584Mi",
+		Gpus:    3,
+	}, u.ToProto())
+}
+
+func TestValidateQuota(t *testing.T) {
+	require.NoError(t, limits.ValidateQuota(nil))
+	require.NoError(t, limits.ValidateQuota(&pps.ProjectQuota{Workers: 4, Cpu: "2", Memory: "8Gi"}))
+	require.YesError(t, limits.ValidateQuota(&pps.ProjectQuota{Pipelines: -1}))
+	require.YesError(t, limits.ValidateQuota(&pps.ProjectQuota{Cpu: "two"}))
+	require.YesError(t, limits.ValidateQuota(&pps.ProjectQuota{Memory: "-1Gi"}))
+}
+
+func TestCheckQuota(t *testing.T) {
+	usage := limits.WorkerUsage(4, requests("1", "1Gi", 0))
+	usage.Pipelines = 2
+	require.NoError(t, limits.CheckQuota(project, nil, usage))
+	require.NoError(t, limits.CheckQuota(project, &pps.ProjectQuota{}, usage))
+	require.NoError(t, limits.CheckQuota(project, &pps.ProjectQuota{Pipelines: 2, Workers: 4, Cpu: "4", Memory: "4Gi"}, usage))
+	err := limits.CheckQuota(project, &pps.ProjectQuota{Pipelines: 1, Workers: 8, Cpu: "3500m"}, usage)
+	var quotaErr limits.ErrProjectQuotaExceeded
+	require.True(t, errors.As(err, &quotaErr))
+	require.Equal(t, []string{"pipelines (2 > 1)", "cpu (4 > 3500m)"}, quotaErr.Exceeded)
+}
+
+func TestMaxWorkers(t *testing.T) {
+	usage := limits.WorkerUsage(2, requests("1", "1Gi", 1))
+	per := requests("1", "2Gi", 1)
+	require.Equal(t, int64(-1), limits.MaxWorkers(nil, usage, per))
+	require.Equal(t, int64(-1), limits.MaxWorkers(&pps.ProjectQuota{Pipelines: 3}, usage, per))
+	require.Equal(t, int64(6), limits.MaxWorkers(&pps.ProjectQuota{Workers: 8}, usage, per))
+	require.Equal(t, int64(3), limits.MaxWorkers(&pps.ProjectQuota{Workers: 8, Memory: "8Gi"}, usage, per))
+	require.Equal(t, int64(1), limits.MaxWorkers(&pps.ProjectQuota{Workers: 8, Cpu: "3500m", Gpus: 4}, usage, per))
+	require.Equal(t, int64(0), limits.MaxWorkers(&pps.ProjectQuota{Gpus: 1}, usage, per))
+}
@@ -106,6 +106,13 @@ func CSRFWrapper(h http.Handler) http.HandlerFunc {
 			return
 		}
 		if origin != r.Host {
+			if !safeMethod(r.Method) {
+				// Without cookies, a cross-origin request can still change state on a
+				// cluster without auth, so don't let it.
+				log.Info(r.Context(), "csrf: origin/host mismatch on unsafe method; deny", zap.String("resolved_origin", origin), zap.String("method", r.Method), zap.String("host", r.Host))
+				http.Error(w, "cross-origin request denied", http.StatusForbidden)
+				return
+			}
 			log.Info(r.Context(), "csrf: origin/host mismatch; delete cookies", zap.String("resolved_origin", origin), zap.Strings("origin", r.Header.Values("origin")), zap.Strings("referer", r.Header.Values("referer")), zap.String("host", r.Host))
 			r.Header.Del("cookie")
 		}
@@ -114,6 +121,15 @@ func CSRFWrapper(h http.Handler) http.HandlerFunc {
 	}
 }
 
+// safeMethod returns true if an HTTP method only reads.
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, "PROPFIND":
+		return true
+	}
+	return false
+}
+
 // ListenAndServe begins serving the server, and returns when the context is canceled or the server
 // dies on its own.
 func (h *Server) ListenAndServe(ctx context.Context) error {
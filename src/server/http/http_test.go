@@ -11,8 +11,10 @@ import (
 func TestCSRFWrapper(t *testing.T) {
 	testData := []struct {
 		name        string
+		method      string
 		request     func(req *http.Request)
 		wantAllowed bool
+		wantDenied  bool
 	}{
 		{
 			name:        "empty",
@@ -59,6 +61,30 @@ func TestCSRFWrapper(t *testing.T) {
 			request:     func(req *http.Request) { req.Header.Add("referer", "http://example.com/index.html") },
 			wantAllowed: true,
 		},
+		{
+			name:        "put with no origin",
+			method:      "PUT",
+			request:     func(req *http.Request) {},
+			wantAllowed: true,
+		},
+		{
+			name:        "put with valid origin",
+			method:      "PUT",
+			request:     func(req *http.Request) { req.Header.Add("origin", "http://example.com") },
+			wantAllowed: true,
+		},
+		{
+			name:       "put with origin mismatch",
+			method:     "PUT",
+			request:    func(req *http.Request) { req.Header.Add("origin", "http://example.com:1234") },
+			wantDenied: true,
+		},
+		{
+			name:       "post with referer mismatch",
+			method:     "POST",
+			request:    func(req *http.Request) { req.Header.Add("referer", "http://example.com:1234/index.html") },
+			wantDenied: true,
+		},
 	}
 
 	for _, test := range testData {
@@ -75,7 +101,11 @@ func TestCSRFWrapper(t *testing.T) {
 			}))
 
 			ctx := pctx.TestContext(t)
-			req := httptest.NewRequest("GET", "http://example.com/foo", nil)
+			method := test.method
+			if method == "" {
+				method = "GET"
+			}
+			req := httptest.NewRequest(method, "http://example.com/foo", nil)
 			req = req.WithContext(ctx)
 			req.AddCookie(wantCookie)
 			test.request(req)
@@ -87,6 +117,9 @@ func TestCSRFWrapper(t *testing.T) {
 			if test.wantAllowed && len(gotCookies) == 0 {
 				t.Error("wanted cookies, but got none")
 			}
+			if got, want := w.Code == http.StatusForbidden, test.wantDenied; got != want {
+				t.Errorf("denied: got %v, want %v", got, want)
+			}
 		})
 	}
 }
@@ -350,6 +350,7 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	logsCmd.Flags().StringVar(&levelString, "level", "", "If set, return only logs greater than or equal to this severity; debug, info, error.")
 	logsCmd.Flags().BoolVar(&raw, "raw", false, "If set, print JSON log objects.")
 	commands = append(commands, logsCmd)
+	commands = append(commands, ruleCmds(pachCtx, pachctlCfg)...)
 	return commands
 }
 
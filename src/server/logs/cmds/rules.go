@@ -0,0 +1,166 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/logs"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+)
+
+const (
+	logRules   = "log-rules"
+	ruleHeader = "NAME\tPROJECT\tPIPELINE\tPATTERN\tLEVEL\tALERT\t\n"
+)
+
+func parseLevel(s string) (logs.LogLevel, error) {
+	switch s {
+	case "":
+		return logs.LogLevel_LOG_LEVEL_UNSET, nil
+	case "debug":
+		return logs.LogLevel_LOG_LEVEL_DEBUG, nil
+	case "info":
+		return logs.LogLevel_LOG_LEVEL_INFO, nil
+	case "error":
+		return logs.LogLevel_LOG_LEVEL_ERROR, nil
+	default:
+		return 0, errors.Errorf(`invalid log level %q; use "debug", "info", or "error"`, s)
+	}
+}
+
+func printRule(w *tabwriter.Writer, rule *logs.LogRule) {
+	pipeline, pattern, level := rule.GetPipeline(), rule.GetPattern(), "info"
+	if pipeline == "" {
+		pipeline = "*"
+	}
+	if pattern == "" {
+		pattern = "-"
+	}
+	switch rule.GetLevel() {
+	case logs.LogLevel_LOG_LEVEL_DEBUG:
+		level = "debug"
+	case logs.LogLevel_LOG_LEVEL_ERROR:
+		level = "error"
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t\n", rule.GetName(), rule.GetProject(), pipeline, pattern, level, rule.GetAlert())
+}
+
+// ruleCmds returns the commands that manage log rules.
+func ruleCmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	createOrUpdate := func(update bool) *cobra.Command {
+		var (
+			project  = pachCtx.Project
+			pipeline string
+			pattern  string
+			level    string
+			alert    bool
+		)
+		verb, short := "create", "Create a new log rule."
+		if update {
+			verb, short = "update", "Update an existing log rule."
+		}
+		cmd := &cobra.Command{
+			Use:   "{{alias}} <name>",
+			Short: short,
+			Long: "This command registers a log rule, which is evaluated continuously against the logs of a pipeline, or of every pipeline in a project.\n\n" +
+				"Each matching line increments the pachyderm_log_rule_matches_total metric.  If the pattern has a capture group, the first group is parsed as a number and exported as the pachyderm_log_rule_value metric.  " +
+				"With --alert, matching lines are also sent to the webhooks that accept log events, at most once a minute.",
+			Example: "\t- {{alias}} accuracy --pipeline train --pattern 'ACCURACY=([0-9.]+)'\n" +
+				"\t- {{alias}} errors --project images --level error --alert\n",
+			Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+				l, err := parseLevel(level)
+				if err != nil {
+					return err
+				}
+				c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+				if err != nil {
+					return err
+				}
+				defer errors.Close(&retErr, c, "close client")
+				_, err = c.LogsClient.CreateLogRule(c.Ctx(), &logs.CreateLogRuleRequest{
+					Rule: &logs.LogRule{
+						Name:     args[0],
+						Project:  project,
+						Pipeline: pipeline,
+						Pattern:  pattern,
+						Level:    l,
+						Alert:    alert,
+					},
+					Update: update,
+				})
+				return grpcutil.ScrubGRPC(err)
+			}),
+		}
+		cmd.Flags().StringVar(&project, "project", project, "The project whose logs are matched.")
+		cmd.Flags().StringVar(&pipeline, "pipeline", "", "The pipeline whose logs are matched; if unset, every pipeline in the project is matched.")
+		cmd.Flags().StringVar(&pattern, "pattern", "", "A regular expression that matching lines must match.")
+		cmd.Flags().StringVar(&level, "level", "", "Only match lines of at least this severity; debug, info (the default), or error.")
+		cmd.Flags().BoolVar(&alert, "alert", false, "Send matching lines to webhooks.")
+		return cmdutil.CreateAliases(cmd, verb+" log-rule", logRules)
+	}
+	commands = append(commands, createOrUpdate(false), createOrUpdate(true))
+
+	listRule := &cobra.Command{
+		Short: "Return all log rules.",
+		Long:  "This command returns the log rules that match logs you can read.",
+		Run: cmdutil.RunFixedArgs(0, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			client, err := c.LogsClient.ListLogRule(c.Ctx(), &logs.ListLogRuleRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *logs.ListLogRuleResponse) error {
+					return errors.Wrap(encoder.EncodeProto(res.Rule), "encode proto")
+				}))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, ruleHeader)
+			defer errors.Invoke(&retErr, writer.Flush, "flush output")
+			return grpcutil.ScrubGRPC(grpcutil.ForEach(client, func(res *logs.ListLogRuleResponse) error {
+				printRule(writer, res.Rule)
+				return nil
+			}))
+		}),
+	}
+	listRule.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(listRule, "list log-rule", logRules))
+
+	deleteRule := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a log rule.",
+		Long:  "This command deletes a log rule, and stops exporting its metrics.",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			_, err = c.LogsClient.DeleteLogRule(c.Ctx(), &logs.DeleteLogRuleRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteRule, "delete log-rule", logRules))
+
+	return commands
+}
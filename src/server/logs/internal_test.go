@@ -2,6 +2,8 @@ package logs
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/logs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	loki "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
@@ -273,3 +276,176 @@ func TestCompileRequest(t *testing.T) {
 		})
 	}
 }
+
+// fakeBackend is a Backend that serves entries forwards from the start time, and returns the
+// forward paging hint; it ignores the query and the end time.
+type fakeBackend struct {
+	mu      sync.Mutex
+	entries []loki.Entry
+}
+
+// add adds an entry, keeping the entries sorted by time.  Entries with the same time are kept in
+// the order they were added.
+func (b *fakeBackend) add(e loki.Entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries = append(b.entries, e)
+	sort.SliceStable(b.entries, func(i, j int) bool { return b.entries[i].Timestamp.Before(b.entries[j].Timestamp) })
+}
+
+func (b *fakeBackend) BatchedQueryRange(ctx context.Context, query string, start, end time.Time, offset uint, limit int, recv loki.RecvFunc) (time.Time, time.Time, uint, uint, error) {
+	b.mu.Lock()
+	entries := append([]loki.Entry(nil), b.entries...)
+	b.mu.Unlock()
+	next, nextOffset := start, offset
+	for _, e := range entries {
+		if e.Timestamp.Before(start) {
+			continue
+		}
+		if offset > 0 && e.Timestamp.Equal(start) {
+			offset--
+			continue
+		}
+		if _, err := recv(ctx, loki.LabelSet{"app": "test"}, &e); err != nil {
+			return time.Time{}, time.Time{}, 0, 0, err
+		}
+		if e.Timestamp.Equal(next) {
+			nextOffset++
+		} else {
+			next, nextOffset = e.Timestamp, 1
+		}
+	}
+	return time.Time{}, next, 0, nextOffset, nil
+}
+
+type collectingPublisher struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (p *collectingPublisher) Publish(ctx context.Context, resp *logs.GetLogsResponse) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if l := resp.GetLog(); l != nil {
+		p.messages = append(p.messages, l.GetPpsLogMessage().GetMessage())
+	}
+	return nil
+}
+
+func (p *collectingPublisher) get() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.messages...)
+}
+
+func TestGetLogs_store(t *testing.T) {
+	var (
+		ctx   = pctx.TestContext(t)
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		store = new(fakeBackend)
+		ls    = LogService{Store: store}
+		entry = func(d time.Duration, msg string) loki.Entry {
+			return loki.Entry{Timestamp: start.Add(d), Line: `{"severity":"info","message":"` + msg + `"}`}
+		}
+		request = func(regex *logs.RegexLogFilter, tail bool) *logs.GetLogsRequest {
+			return &logs.GetLogsRequest{
+				Query: &logs.LogQuery{QueryType: &logs.LogQuery_Admin{Admin: &logs.AdminLogQuery{AdminType: &logs.AdminLogQuery_App{App: "test"}}}},
+				Filter: &logs.LogFilter{
+					TimeRange: &logs.TimeRangeLogFilter{From: timestamppb.New(start)},
+					Regex:     regex,
+				},
+				Tail: tail,
+			}
+		}
+	)
+	store.add(entry(0, "processing datum 1"))
+	store.add(entry(time.Second, "processing datum 2"))
+	store.add(entry(time.Second, "error: datum 2 failed"))
+
+	p := new(collectingPublisher)
+	require.NoError(t, ls.GetLogs(ctx, request(&logs.RegexLogFilter{Pattern: `datum \d failed`}, false), p))
+	require.NoDiff(t, []string{"error: datum 2 failed"}, p.get(), nil, "regex filter")
+
+	p = new(collectingPublisher)
+	require.NoError(t, ls.GetLogs(ctx, request(&logs.RegexLogFilter{Pattern: "^error", Negate: true}, false), p))
+	require.NoDiff(t, []string{"processing datum 1", "processing datum 2", "error: datum 2 failed"}, p.get(), nil, "negated regex filter")
+
+	err := ls.GetLogs(ctx, request(&logs.RegexLogFilter{Pattern: "("}, false), new(collectingPublisher))
+	require.True(t, errors.Is(err, ErrBadRequest), "invalid regex should be a bad request; got %v", err)
+
+	tailInterval = 10 * time.Millisecond
+	t.Cleanup(func() { tailInterval = time.Second })
+	tctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p = new(collectingPublisher)
+	done := make(chan error)
+	go func() { done <- ls.GetLogs(tctx, request(nil, true), p) }()
+	waitFor := func(n int) {
+		t.Helper()
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			if got := p.get(); len(got) < n {
+				return errors.Errorf("got %d lines; want %d", len(got), n)
+			}
+			return nil
+		})
+	}
+	waitFor(3)
+	// A line logged in the same nanosecond as the last one already published must not be
+	// skipped, and no line may be published twice.
+	store.add(entry(time.Second, "processing datum 3"))
+	store.add(entry(2*time.Second, "processing datum 4"))
+	want := []string{"processing datum 1", "processing datum 2", "error: datum 2 failed", "processing datum 3", "processing datum 4"}
+	waitFor(len(want))
+	time.Sleep(5 * tailInterval)
+	cancel()
+	require.NoError(t, <-done)
+	require.NoDiff(t, want, p.get(), nil, "tailed lines")
+}
+
+func TestGetLogs_tailLateLines(t *testing.T) {
+	var (
+		ctx   = pctx.TestContext(t)
+		now   = time.Now()
+		store = new(fakeBackend)
+		ls    = LogService{Store: store}
+		entry = func(d time.Duration, msg string) loki.Entry {
+			return loki.Entry{Timestamp: now.Add(d), Line: `{"severity":"info","message":"` + msg + `"}`}
+		}
+	)
+	tailInterval = 10 * time.Millisecond
+	t.Cleanup(func() { tailInterval = time.Second })
+	store.add(entry(-3*time.Second, "pod 1: line 1"))
+
+	tctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p := new(collectingPublisher)
+	done := make(chan error)
+	go func() {
+		done <- ls.GetLogs(tctx, &logs.GetLogsRequest{
+			Query:  &logs.LogQuery{QueryType: &logs.LogQuery_Admin{Admin: &logs.AdminLogQuery{AdminType: &logs.AdminLogQuery_App{App: "test"}}}},
+			Filter: &logs.LogFilter{TimeRange: &logs.TimeRangeLogFilter{From: timestamppb.New(now.Add(-10 * time.Second))}},
+			Tail:   true,
+		}, p)
+	}()
+	waitFor := func(n int) {
+		t.Helper()
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			if got := p.get(); len(got) < n {
+				return errors.Errorf("got %d lines; want %d", len(got), n)
+			}
+			return nil
+		})
+	}
+	waitFor(1)
+	store.add(entry(-time.Second, "pod 1: line 2"))
+	waitFor(2)
+	// A line from another pod that reaches the backend after newer lines must still be
+	// published, and the lines already published must not be published again.
+	store.add(entry(-2*time.Second, "pod 2: line 1"))
+	want := []string{"pod 1: line 1", "pod 1: line 2", "pod 2: line 1"}
+	waitFor(len(want))
+	time.Sleep(5 * tailInterval)
+	cancel()
+	require.NoError(t, <-done)
+	require.NoDiff(t, want, p.get(), nil, "tailed lines")
+}
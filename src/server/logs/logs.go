@@ -6,6 +6,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -26,12 +29,28 @@ import (
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth"
 )
 
+// A Backend stores logs and runs LogQL queries over them.  *loki.Client is one; see
+// loki.Client.BatchedQueryRange for the semantics of its arguments and results.
+type Backend interface {
+	BatchedQueryRange(ctx context.Context, query string, start, end time.Time, offset uint, limit int, recv loki.RecvFunc) (prevPage, nextPage time.Time, prevOffset, nextOffset uint, retErr error)
+}
+
 // LogService implements the core logs functionality.
 type LogService struct {
 	GetLokiClient func() (*loki.Client, error)
-	AuthServer    authserver.APIServer
+	// Store, if set, is queried instead of Loki.
+	Store      Backend
+	AuthServer authserver.APIServer
 }
 
+var (
+	// tailInterval is how often a tailing GetLogs queries for new logs.
+	tailInterval = time.Second
+	// ingestionLag is how late a line may reach the backend, after newer lines from other
+	// pods, and still be published by a tailing GetLogs.
+	ingestionLag = time.Minute
+)
+
 var (
 	// ErrUnimplemented is returned whenever requested functionality is planned but unimplemented.
 	ErrUnimplemented = errors.New("unimplemented")
@@ -65,19 +84,28 @@ func (ls LogService) GetLogs(ctx context.Context, request *logs.GetLogsRequest,
 		return errors.Wrapf(ErrBadRequest, "limit %d > maxint", filter.Limit)
 	}
 
-	c, err := ls.GetLokiClient()
+	c, err := ls.backend()
 	if err != nil {
-		return errors.Wrap(err, "loki client error")
+		return err
 	}
 	var (
 		adapter = adapter{responsePublisher: publisher}
 		logQL   string
 	)
+	if request.Tail {
+		adapter.recent = make(map[recentEntry]struct{})
+	}
 	logQL, adapter.pass, err = ls.compileRequest(ctx, request)
 	if err != nil {
 		return errors.Wrap(err, "cannot convert request to LogQL")
 	}
 	adapter.addLevelFilter(filter.GetLevel())
+	if r := filter.GetRegex(); r.GetPattern() != "" {
+		if adapter.regex, err = regexp.Compile(r.Pattern); err != nil {
+			return errors.Wrapf(ErrBadRequest, "invalid regex %q: %v", r.Pattern, err)
+		}
+		adapter.negateRegex = r.Negate
+	}
 
 	var from, to time.Time
 	if t := filter.GetTimeRange().GetFrom(); t != nil {
@@ -86,6 +114,7 @@ func (ls LogService) GetLogs(ctx context.Context, request *logs.GetLogsRequest,
 	if t := filter.GetTimeRange().GetUntil(); t != nil {
 		to = t.AsTime()
 	}
+	tailFrom := time.Now()
 	prev, next, prevOffset, nextOffset, err := c.BatchedQueryRange(ctx, logQL, from, to, uint(filter.GetTimeRange().GetOffset()), int(filter.Limit), adapter.publish)
 	if err != nil {
 		return errors.Wrap(err, "BatchedQueryRange")
@@ -126,9 +155,62 @@ func (ls LogService) GetLogs(ctx context.Context, request *logs.GetLogsRequest,
 			return errors.Wrap(err, "send paging hint")
 		}
 	}
+	if request.Tail {
+		if next.IsZero() {
+			next, nextOffset = tailFrom, 0
+		}
+		return ls.tail(ctx, c, logQL, next, nextOffset, &adapter)
+	}
 	return nil
 }
 
+// backend returns the Backend to query.
+func (ls LogService) backend() (Backend, error) {
+	if ls.Store != nil {
+		return ls.Store, nil
+	}
+	c, err := ls.GetLokiClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "loki client error")
+	}
+	return c, nil
+}
+
+// tail publishes the logs that match logQL as they arrive, starting at from and offset, until ctx
+// is done.  Each query resumes where the backend says the previous one ended, but also looks back
+// over the last ingestionLag, so that lines that reached the backend after newer lines from other
+// pods aren't skipped; a.recent keeps lines from being published twice.
+func (ls LogService) tail(ctx context.Context, c Backend, logQL string, from time.Time, offset uint, a *adapter) error {
+	floor := from
+	t := time.NewTicker(tailInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+		start, startOffset := from, offset
+		if lagged := time.Now().Add(-ingestionLag); lagged.Before(start) {
+			start, startOffset = lagged, 0
+			if start.Before(floor) {
+				start = floor
+			}
+		}
+		a.forgetBefore(start)
+		_, next, _, nextOffset, err := c.BatchedQueryRange(ctx, logQL, start, time.Time{}, startOffset, 0, a.publish)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "BatchedQueryRange")
+		}
+		if !next.IsZero() {
+			from, offset = next, nextOffset
+		}
+	}
+}
+
 func (ls LogService) compileRequest(ctx context.Context, request *logs.GetLogsRequest) (string, passFunc, error) {
 	if request == nil {
 		return "", nil, errors.New("nil request")
@@ -518,6 +600,26 @@ func (ls LogService) authPipelineLogs(ctx context.Context, pipeline, project str
 	return pass
 }
 
+// AuthorizeRule returns an error if the caller may not see every log line that rule matches.  A
+// rule on one pipeline needs read access to its output repo; a rule on a whole project matches the
+// logs of pipelines that don't exist yet, so it needs permission to read all logs.
+func (ls LogService) AuthorizeRule(ctx context.Context, rule *logs.LogRule) error {
+	if ls.AuthServer == nil {
+		return nil
+	}
+	var err error
+	if rule.GetPipeline() == "" {
+		err = ls.AuthServer.CheckClusterIsAuthorized(ctx, auth.Permission_CLUSTER_GET_LOKI_LOGS)
+	} else {
+		repo := &pfs.Repo{Type: pfs.UserRepoType, Project: &pfs.Project{Name: rule.GetProject()}, Name: rule.GetPipeline()}
+		err = ls.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ)
+	}
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
 type ResponsePublisher interface {
 	// Publish publishes a single GetLogsResponse to the client.
 	Publish(context.Context, *logs.GetLogsResponse) error
@@ -534,6 +636,31 @@ type adapter struct {
 	level             logs.LogLevel
 	// If pass returns false, the message will not be published.
 	pass passFunc
+	// If regex is set, only lines that it matches (or, if negateRegex, doesn't match) are
+	// published.
+	regex       *regexp.Regexp
+	negateRegex bool
+
+	// recent, if set, holds the entries received within the last ingestionLag, published or
+	// not, so that a tailing query can look back over that window without publishing them
+	// again.
+	recent map[recentEntry]struct{}
+}
+
+// recentEntry identifies a log entry received by a tailing query.
+type recentEntry struct {
+	ts     int64
+	labels string
+	line   string
+}
+
+// forgetBefore forgets the recent entries from before t, which won't be received again.
+func (a *adapter) forgetBefore(t time.Time) {
+	for e := range a.recent {
+		if e.ts < t.UnixNano() {
+			delete(a.recent, e)
+		}
+	}
 }
 
 func (a *adapter) addLevelFilter(l logs.LogLevel) {
@@ -542,6 +669,20 @@ func (a *adapter) addLevelFilter(l logs.LogLevel) {
 	}
 }
 
+// labelsKey returns a string that identifies a set of labels.
+func labelsKey(labels loki.LabelSet) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%q=%q,", k, labels[k])
+	}
+	return b.String()
+}
+
 func stringAsLogLevel(s string) logs.LogLevel {
 	switch s {
 	case "debug":
@@ -558,6 +699,15 @@ func (a *adapter) publish(ctx context.Context, labels loki.LabelSet, entry *loki
 	if entry == nil {
 		return false, nil
 	}
+	// Entries from before the ingestion lag window are never received again, so there's no
+	// need to remember them.
+	if a.recent != nil && !entry.Timestamp.Before(time.Now().Add(-ingestionLag)) {
+		e := recentEntry{ts: entry.Timestamp.UnixNano(), labels: labelsKey(labels), line: entry.Line}
+		if _, ok := a.recent[e]; ok {
+			return false, nil
+		}
+		a.recent[e] = struct{}{}
+	}
 
 	// Fix some Loki configuration errors that would prevent parsing.
 	var obj map[string]any
@@ -649,6 +799,11 @@ func (a *adapter) publish(ctx context.Context, labels loki.LabelSet, entry *loki
 		return false, nil
 	}
 
+	// Filter by regex, if requested.
+	if a.regex != nil && a.regex.MatchString(entry.Line) == a.negateRegex {
+		return false, nil
+	}
+
 	// Run other filters.
 	if a.pass != nil && !a.pass(map[string]string(labels), msg) {
 		return false, nil
@@ -10,8 +10,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/logs"
 	logservice "github.com/pachyderm/pachyderm/v2/src/server/logs"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/logrules"
+	"github.com/pachyderm/pachyderm/v2/src/internal/logstore"
 	loki "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth"
 )
 
@@ -19,7 +24,11 @@ type APIServer = *apiServer
 
 type Env struct {
 	GetLokiClient func() (*loki.Client, error)
-	AuthServer    authserver.APIServer
+	// Store, if set, is queried instead of Loki.
+	Store      logservice.Backend
+	AuthServer authserver.APIServer
+	// DB stores log rules.
+	DB *pachsql.DB
 }
 
 type apiServer struct {
@@ -33,6 +42,7 @@ func NewAPIServer(env Env) (*apiServer, error) {
 		env: env,
 		service: logservice.LogService{
 			GetLokiClient: env.GetLokiClient,
+			Store:         env.Store,
 			AuthServer:    env.AuthServer,
 		},
 	}, nil
@@ -51,7 +61,7 @@ func (l *apiServer) GetLogs(request *logs.GetLogsRequest, apiGetLogsServer logs.
 		switch {
 		case errors.Is(err, logservice.ErrUnimplemented):
 			return status.Error(codes.Unimplemented, err.Error())
-		case errors.Is(err, logservice.ErrBadRequest):
+		case errors.Is(err, logservice.ErrBadRequest), errors.Is(err, logstore.ErrUnsupportedQuery):
 			return status.Error(codes.InvalidArgument, err.Error())
 		default:
 			// by definition, if we don’t understand the error then it’s an internal server error
@@ -61,3 +71,69 @@ func (l *apiServer) GetLogs(request *logs.GetLogsRequest, apiGetLogsServer logs.
 
 	return nil
 }
+
+func (l *apiServer) CreateLogRule(ctx context.Context, req *logs.CreateLogRuleRequest) (*logs.CreateLogRuleResponse, error) {
+	if err := logrules.Validate(req.GetRule()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := l.service.AuthorizeRule(ctx, req.Rule); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, l.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		if req.Update {
+			// Replacing a rule changes what it matched, too.
+			old, err := logrules.GetRule(ctx, tx, req.Rule.Name)
+			if err == nil {
+				if err := l.service.AuthorizeRule(ctx, old); err != nil {
+					return err
+				}
+			} else if !errors.As(err, new(*logrules.RuleNotFoundError)) {
+				return err
+			}
+		}
+		return logrules.CreateRule(ctx, tx, req.Rule, req.Update)
+	}); err != nil {
+		return nil, errors.Wrap(err, "create log rule")
+	}
+	return &logs.CreateLogRuleResponse{}, nil
+}
+
+// ListLogRule returns the rules that match logs the caller may see.
+func (l *apiServer) ListLogRule(req *logs.ListLogRuleRequest, srv logs.API_ListLogRuleServer) (retErr error) {
+	ctx, done := log.SpanContext(srv.Context(), "list log rule")
+	defer done(log.Errorp(&retErr))
+
+	var rules []*logs.LogRule
+	if err := dbutil.WithTx(ctx, l.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		rules, err = logrules.ListRules(ctx, tx)
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return errors.Wrap(err, "list log rules")
+	}
+	for _, rule := range rules {
+		if err := l.service.AuthorizeRule(ctx, rule); err != nil {
+			continue
+		}
+		if err := srv.Send(&logs.ListLogRuleResponse{Rule: rule}); err != nil {
+			return errors.Wrap(err, "send")
+		}
+	}
+	return nil
+}
+
+func (l *apiServer) DeleteLogRule(ctx context.Context, req *logs.DeleteLogRuleRequest) (*logs.DeleteLogRuleResponse, error) {
+	if err := dbutil.WithTx(ctx, l.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		rule, err := logrules.GetRule(ctx, tx, req.Name)
+		if err != nil {
+			return err
+		}
+		if err := l.service.AuthorizeRule(ctx, rule); err != nil {
+			return err
+		}
+		return logrules.DeleteRule(ctx, tx, req.Name)
+	}); err != nil {
+		return nil, errors.Wrap(err, "delete log rule")
+	}
+	return &logs.DeleteLogRuleResponse{}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"path"
 	"sort"
 	"strings"
 	"syscall"
@@ -146,12 +147,16 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 	// NOTE(jonathan): This can move out of misc when we add OAuth support to the download
 	// endpoint, and tell pachd what its externally-accessible URL is (so the link works when
 	// you click it).
+	var format string
 	generateURL := &cobra.Command{
 		Use:   "{{alias}} project/repo@branch_or_commit:/file_or_directory ...",
 		Short: "Generates the encoded part of an archive download URL.",
 		Long:  "Generates the encoded part of an archive download URL.",
 		Run: cmdutil.Run(func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if _, err := archiveserver.ArchiveFromURL(&url.URL{Path: "/archive/AQ." + format}); err != nil {
+				return errors.Wrap(err, "--format")
+			}
 			path, err := archiveserver.EncodeV1(args)
 			if err != nil {
 				return errors.Wrap(err, "encode")
@@ -168,7 +173,7 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 				defer errors.Close(&retErr, c, "close client")
 
 				info, _ := c.ClusterInfo()
-				fmt.Println(info.GetWebResources().GetArchiveDownloadBaseUrl() + path + ".zip")
+				fmt.Println(info.GetWebResources().GetArchiveDownloadBaseUrl() + path + "." + format)
 				return nil
 			}
 			if err := getPrefix(); err != nil {
@@ -179,6 +184,7 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 			return nil
 		}),
 	}
+	generateURL.Flags().StringVar(&format, "format", string(archiveserver.ArchiveFormatZip), "The format of the archive: zip, tar, tar.gz, or tar.zst.")
 	commands = append(commands, cmdutil.CreateAlias(generateURL, "misc generate-download-url"))
 
 	decodeURL := &cobra.Command{
@@ -193,7 +199,7 @@ func Cmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 			if !strings.HasPrefix(u.Path, "/archive/") {
 				u.Path = "/archive/" + u.Path
 			}
-			if !strings.HasSuffix(u.Path, ".zip") {
+			if !strings.Contains(path.Base(u.Path), ".") {
 				u.Path = u.Path + ".zip"
 			}
 			req, err := archiveserver.ArchiveFromURL(u)
@@ -6,6 +6,7 @@ import (
 	etcd "go.etcd.io/etcd/client/v3"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 )
 
@@ -15,6 +16,7 @@ type Env struct {
 	EtcdClient        *etcd.Client
 	PFSTaskService    task.Service
 	PPSTaskService    task.Service
+	NewDLock          func(prefix string) dlock.DLock
 	KubeClient        kubernetes.Interface
 	Namespace         string
 	MaxReplicas       int
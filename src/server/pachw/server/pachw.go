@@ -13,7 +13,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
@@ -42,7 +41,7 @@ func NewController(ctx context.Context, env *Env) {
 func (p *pachW) run(ctx context.Context) {
 	ctx = auth.AsInternalUser(ctx, "pachw-controller")
 	backoff.RetryUntilCancel(ctx, func() (retErr error) {
-		lock := dlock.NewDLock(p.env.EtcdClient, path.Join(p.env.EtcdPrefix, "pachw-controller-lock"))
+		lock := p.env.NewDLock(path.Join(p.env.EtcdPrefix, "pachw-controller-lock"))
 		ctx, err := lock.Lock(ctx)
 		if err != nil {
 			return errors.Wrap(err, "locking pachw-controller lock")
@@ -22,8 +22,10 @@ import (
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/pachyderm/pachyderm/v2/src/constants"
 	"github.com/pachyderm/pachyderm/v2/src/debug"
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -163,14 +165,17 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAliases(updateRepo, "update repo", repos))
 
+	var storage bool
 	inspectRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Return info about a repo.",
 		Long: "This command returns details of the repo such as: `Name`, `Description`, `Created`, and `Size of HEAD on Master`. By default, PachCTL checks for a matching repo in the project that is set to your active context (initially the `default` project).\n" +
 			"\n" +
-			"\t- To specify the project containing the repo you want to inspect, use the `--project` flag \n",
+			"\t- To specify the project containing the repo you want to inspect, use the `--project` flag \n" +
+			"\t- To show the object storage used by the repo and its branches after deduplication, use the `--storage` flag; unique bytes are roughly what deleting the repo or branch would free \n",
 		Example: "\t- {{alias}} foo  \n" +
-			"\t- {{alias}} foo --project myproject",
+			"\t- {{alias}} foo --project myproject \n" +
+			"\t- {{alias}} foo --storage",
 		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
 			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
 			if err != nil {
@@ -184,8 +189,30 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 			if repoInfo == nil {
 				return errors.Errorf("repo %s not found", args[0])
 			}
+			var usage []*pfs.StorageUsageResponse
+			if storage {
+				usageClient, err := c.PfsAPIClient.StorageUsage(c.Ctx(), &pfs.StorageUsageRequest{Repo: repoInfo.Repo})
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				if err := grpcutil.ForEach[*pfs.StorageUsageResponse](usageClient, func(resp *pfs.StorageUsageResponse) error {
+					usage = append(usage, resp)
+					return nil
+				}); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
 			if raw {
-				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(repoInfo))
+				e := cmdutil.Encoder(output, os.Stdout)
+				if err := e.EncodeProto(repoInfo); err != nil {
+					return errors.EnsureStack(err)
+				}
+				for _, resp := range usage {
+					if err := e.EncodeProto(resp); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
 			} else if output != "" {
 				return errors.New("cannot set --output (-o) without --raw")
 			}
@@ -193,12 +220,28 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 				RepoInfo:       repoInfo,
 				FullTimestamps: fullTimestamps,
 			}
-			return pretty.PrintDetailedRepoInfo(ri)
+			if err := pretty.PrintDetailedRepoInfo(ri); err != nil {
+				return err
+			}
+			if !storage {
+				return nil
+			}
+			if len(usage) == 0 {
+				fmt.Println("Storage usage: not computed yet")
+				return nil
+			}
+			fmt.Println("Storage usage:")
+			writer := tabwriter.NewWriter(os.Stdout, pretty.StorageUsageHeader)
+			for _, resp := range usage {
+				pretty.PrintStorageUsage(writer, resp, fullTimestamps)
+			}
+			return writer.Flush()
 		}),
 	}
 	inspectRepo.Flags().AddFlagSet(outputFlags)
 	inspectRepo.Flags().AddFlagSet(timestampFlags)
 	inspectRepo.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the repo is located.")
+	inspectRepo.Flags().BoolVar(&storage, "storage", false, "Show the object storage used by the repo and its branches after deduplication, as of the last accounting.")
 	shell.RegisterCompletionFunc(inspectRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAliases(inspectRepo, "inspect repo", repos))
 
@@ -2101,6 +2144,78 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	shell.RegisterCompletionFunc(deleteFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAliases(deleteFile, "delete file", files))
 
+	var shareTTL time.Duration
+	var maxDownloads int64
+	createShareLink := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/in/pfs>]",
+		Short: "Create a link that downloads a file without a Pachyderm account.",
+		Long: "This command creates a share link: a URL that anyone can use to download a file, or a directory as a ZIP archive, until the link expires. " +
+			"The link shares the commit that the branch points at when it is created, and works with the permissions of the user who created it. " +
+			"Only finished commits can be shared; leave out the path to share a whole commit. \n" +
+			"\t- To specify the project where the repo is located, use the --project flag \n",
+		Example: "\t- {{alias}} repo@master:/logs/log.txt \n" +
+			"\t- {{alias}} repo@master:/logs --expires 1h \n" +
+			"\t- {{alias}} repo@master --max-downloads 3 \n" +
+			"\t- {{alias}} repo@master:/logs/log.txt --project foo \n",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			file, err := cmdutil.ParseFile(project, args[0])
+			if err != nil {
+				return err
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			resp, err := c.PfsAPIClient.CreateShareLink(c.Ctx(), &pfs.CreateShareLinkRequest{
+				File:         file,
+				Ttl:          durationpb.New(shareTTL),
+				MaxDownloads: maxDownloads,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			// The archive server's base URL is the best guess at where the cluster's HTTP
+			// server can be reached from outside.
+			info, _ := c.ClusterInfo()
+			if base := info.GetWebResources().GetArchiveDownloadBaseUrl(); base != "" {
+				fmt.Println(strings.TrimSuffix(base, "/archive/") + resp.Path)
+				return nil
+			}
+			fmt.Println(resp.Path)
+			return nil
+		}),
+	}
+	createShareLink.Flags().DurationVar(&shareTTL, "expires", 24*time.Hour, "Set how long the link works for.")
+	createShareLink.Flags().Int64Var(&maxDownloads, "max-downloads", 0, "Set how many times the link can be downloaded; 0 means no limit.")
+	createShareLink.Flags().StringVar(&project, "project", project, "Specify the project (by name) where the file's repo is located.")
+	shell.RegisterCompletionFunc(createShareLink, shell.FileCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createShareLink, "create share-link", files))
+
+	deleteShareLink := &cobra.Command{
+		Use:   "{{alias}} <link-or-token>",
+		Short: "Revoke a share link.",
+		Long:  "This command deletes a share link before it expires, so that it can't be used again. It takes the link printed by 'create share-link', or just its token.",
+		Example: "\t- {{alias}} 'https://pachyderm.example.com/pfs/default/repo/44276ac4f0b14e0e9bb4bd1c9e1bba35/logs/log.txt?share=2b5b8fc3e4b64c9fb4df93a6bb30e6d0' \n" +
+			"\t- {{alias}} 2b5b8fc3e4b64c9fb4df93a6bb30e6d0 \n",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			token := args[0]
+			if u, err := url.Parse(args[0]); err == nil && u.Query().Has(constants.ShareLinkKey) {
+				token = u.Query().Get(constants.ShareLinkKey)
+			}
+			c, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, c, "close client")
+			if _, err := c.PfsAPIClient.RevokeShareLink(c.Ctx(), &pfs.RevokeShareLinkRequest{Token: token}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteShareLink, "delete share-link", files))
+
 	// OBJECT COMMANDS
 
 	objectDocs := &cobra.Command{
@@ -2243,6 +2358,8 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	runLoadTest.Flags().StringVar(&stateID, "state-id", "", "Set the ID of the base state to use for the load.")
 	commands = append(commands, cmdutil.CreateAlias(runLoadTest, "run pfs-load-test"))
 
+	commands = append(commands, replicationCmds(pachCtx, pachctlCfg)...)
+
 	// Add the mount commands (which aren't available on Windows, so they're in
 	// their own file)
 	commands = append(commands, mountCmds(pachctlCfg)...)
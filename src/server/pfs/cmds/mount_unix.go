@@ -11,6 +11,7 @@ import (
 	"strings"
 	"syscall"
 
+	units "github.com/docker/go-units"
 	"github.com/hanwen/go-fuse/v2/fs"
 	gofuse "github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/spf13/cobra"
@@ -63,6 +64,7 @@ func mountCmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 	var debug bool
 	var repoOpts cmdutil.RepeatedStringArg
 	var project string
+	var cacheDir, cacheSize string
 	mount := &cobra.Command{
 		Use:   "{{alias}} <path/to/mount/point>",
 		Short: "Mount pfs locally. This command blocks.",
@@ -78,6 +80,21 @@ func mountCmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 			if err != nil {
 				return err
 			}
+			var chunks *fuse.ChunkCache
+			if cacheDir != "" || cacheSize != "" {
+				size := int64(fuse.DefaultChunkCacheBytes)
+				if cacheSize != "" {
+					if size, err = units.RAMInBytes(cacheSize); err != nil {
+						return errors.Wrap(err, "--cache-size")
+					}
+				}
+				if cacheDir == "" {
+					cacheDir = fuse.DefaultChunkCacheDir()
+				}
+				if chunks, err = fuse.NewChunkCache(cacheDir, size); err != nil {
+					return err
+				}
+			}
 			opts := &fuse.Options{
 				Write: write,
 				Fuse: &fs.Options{
@@ -88,6 +105,7 @@ func mountCmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 					},
 				},
 				RepoOptions: repoOpts,
+				ChunkCache:  chunks,
 			}
 			// Prints a warning if we're on macOS
 			PrintWarning()
@@ -99,6 +117,8 @@ func mountCmds(pachctlCfg *pachctl.Config) []*cobra.Command {
 	mount.Flags().VarP(&repoOpts, "repos", "r", "Repos and branches / commits to mount, arguments should be of the form \"repo[@branch=commit][+w]\", where the trailing flag \"+w\" indicates write. You can omit the branch when specifying a commit unless the same commit ID is on multiple branches in the repo.")
 	mount.MarkFlagCustom("repos", "__pachctl_get_repo_branch")
 	mount.Flags().StringVar(&project, "project", pfs.DefaultProjectName, "Project in which repo is located.")
+	mount.Flags().StringVar(&cacheDir, "cache-dir", "", "Directory to cache the chunks of files read through the mount in; defaults to pachyderm/pfs-chunks in the user's cache directory.")
+	mount.Flags().StringVar(&cacheSize, "cache-size", "", "Maximum size of the chunk cache directory, across all the mounts that share it, e.g. 10GB; defaults to 10GiB.")
 	commands = append(commands, cmdutil.CreateAlias(mount, "mount"))
 
 	var all bool
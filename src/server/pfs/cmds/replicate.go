@@ -0,0 +1,123 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachctl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsreplicate"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
+)
+
+// replicationCmds returns the push and pull commands, which copy branches between clusters.  A
+// remote is the name of the pachctl context of the other cluster.
+func replicationCmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command {
+	var commands []*cobra.Command
+
+	project := pachCtx.Project
+	var to string
+	var follow bool
+	replicate := func(ctx context.Context, src, dst *client.APIClient, from *pfs.Branch) error {
+		dstBranch := from
+		if to != "" {
+			var err error
+			dstBranch, err = cmdutil.ParseBranch(from.Repo.Project.GetName(), to)
+			if err != nil {
+				return err
+			}
+		}
+		r := pfsreplicate.New(src, dst, from, dstBranch, func(stats *pfsreplicate.CommitStats) {
+			fmt.Printf("%s ➔ %s: %d files, copied %d chunks (%s)\n",
+				stats.Source.Id, stats.Destination.Id, stats.Files, stats.Chunks, pretty.Size(stats.Bytes))
+		})
+		if follow {
+			return r.Follow(ctx)
+		}
+		n, err := r.Replicate(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			fmt.Printf("%s is up to date\n", dstBranch)
+		}
+		return nil
+	}
+	withClients := func(ctx context.Context, remote string, cb func(local, remote *client.APIClient) error) (retErr error) {
+		local, err := pachctlCfg.NewOnUserMachine(ctx, false)
+		if err != nil {
+			return err
+		}
+		defer errors.Close(&retErr, local, "close client")
+		rc, err := pachctlCfg.NewOnUserMachineForContext(ctx, remote)
+		if err != nil {
+			return errors.Wrapf(err, "connect to remote %q", remote)
+		}
+		defer errors.Close(&retErr, rc, "close remote client")
+		return cb(local, rc)
+	}
+
+	push := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <remote>",
+		Short: "Copy the commits on a branch to another cluster.",
+		Long: "This command copies the commits on a branch to the same branch in another cluster, the remote, which is named by its pachctl context. " +
+			"Only the chunks of data that the remote doesn't already have are sent. \n\n" +
+			"Each commit is recreated on the remote with its description and metadata, and with the ID of the commit it was copied from in its `" + pfsreplicate.SourceCommitKey + "` metadata key. " +
+			"Pushing again copies only the commits made since the last push. " +
+			"The remote repo is created if it doesn't exist, and the remote branch must not have commits that weren't pushed to it. " +
+			"If auth is enabled, you need the `CLUSTER_REPLICATE` permission, which cluster admins have, on both clusters. \n" +
+			"\t- To push to a different repo or branch, use the `--to` flag. \n" +
+			"\t- To keep pushing commits as they're finished, use the `--follow` flag.",
+		Example: "\t- {{alias}} images@master cloud \n" +
+			"\t- {{alias}} images@master cloud --to images@onprem \n" +
+			"\t- {{alias}} images@master cloud --follow \n",
+		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) error {
+			branch, err := cmdutil.ParseBranch(project, args[0])
+			if err != nil {
+				return err
+			}
+			return withClients(cmd.Context(), args[1], func(local, remote *client.APIClient) error {
+				return replicate(cmd.Context(), local, remote, branch)
+			})
+		}),
+	}
+	push.Flags().StringVar(&to, "to", "", "Push to this repo and branch on the remote, rather than the same ones.")
+	push.Flags().BoolVar(&follow, "follow", false, "Keep pushing commits as they're finished on the branch.")
+	push.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the branch.")
+	shell.RegisterCompletionFunc(push, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(push, "push"))
+
+	pull := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <remote>",
+		Short: "Copy the commits on a branch of another cluster to this one.",
+		Long: "This command copies the commits on a branch of another cluster, the remote, which is named by its pachctl context, to the same branch in this cluster. " +
+			"It's the counterpart of `pachctl push`, and works the same way. \n" +
+			"\t- To pull to a different repo or branch, use the `--to` flag. \n" +
+			"\t- To keep pulling commits as they're finished, use the `--follow` flag.",
+		Example: "\t- {{alias}} images@master onprem \n" +
+			"\t- {{alias}} images@master onprem --to images@from-onprem \n" +
+			"\t- {{alias}} images@master onprem --follow \n",
+		Run: cmdutil.RunFixedArgs(2, func(cmd *cobra.Command, args []string) error {
+			branch, err := cmdutil.ParseBranch(project, args[0])
+			if err != nil {
+				return err
+			}
+			return withClients(cmd.Context(), args[1], func(local, remote *client.APIClient) error {
+				return replicate(cmd.Context(), remote, local, branch)
+			})
+		}),
+	}
+	pull.Flags().StringVar(&to, "to", "", "Pull to this repo and branch, rather than the same ones as on the remote.")
+	pull.Flags().BoolVar(&follow, "follow", false, "Keep pulling commits as they're finished on the remote branch.")
+	pull.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing the branch.")
+	commands = append(commands, cmdutil.CreateAlias(pull, "pull"))
+
+	return commands
+}
@@ -0,0 +1,276 @@
+package fuse
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	units "github.com/docker/go-units"
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+)
+
+const (
+	// prefetchBytes is how far ahead of a sequential reader chunks are fetched.
+	prefetchBytes = 32 * units.MiB
+	// DefaultChunkCacheBytes is the size of the chunk cache that mounts use by default.
+	DefaultChunkCacheBytes = 10 * units.GiB
+	// lockFile is the file in a chunk cache's directory that processes sharing the directory lock
+	// while they evict chunks.
+	lockFile = ".lock"
+	// touchInterval is how stale a chunk's modification time, which records when it was last
+	// used, may get before a read updates it.
+	touchInterval = time.Minute
+	// staleTmpAge is how old a temporary file must be for eviction to remove it; younger ones may
+	// still be being written.
+	staleTmpAge = time.Hour
+)
+
+// ChunkCache is a bounded on-disk cache of the chunks of PFS files, keyed by the content ranges
+// that PFS stores files in.  Files with the same content, or that share part of their content,
+// share chunks, whatever mount, commit or path they're read through.  One cache can be shared by
+// any number of mounts, and several processes can share a directory: chunks' modification times
+// record when they were last used, and whichever process finds the directory too big evicts the
+// least recently used chunks, holding a lock file so that evictions don't race.
+type ChunkCache struct {
+	dir      string
+	maxBytes int64
+	deduper  miscutil.WorkDeduper[string]
+
+	mu sync.Mutex
+	// size is the directory's size when it was last scanned plus the bytes written to it by this
+	// process since.  Other processes' writes are found by the next scan.
+	size int64
+	// written is the number of bytes written since the last scan.
+	written int64
+}
+
+// NewChunkCache returns a chunk cache that keeps at most maxBytes of chunks in dir, creating dir if
+// necessary.  Chunks already in dir are kept, and are the first to go when the cache fills up.
+func NewChunkCache(dir string, maxBytes int64) (*ChunkCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "create chunk cache directory")
+	}
+	cc := &ChunkCache{dir: dir, maxBytes: maxBytes}
+	if err := cc.evict(); err != nil {
+		return nil, err
+	}
+	return cc, nil
+}
+
+func (cc *ChunkCache) path(id string) string {
+	return filepath.Join(cc.dir, id)
+}
+
+// readAt reads the chunk with the given ID into p, starting at off, and returns the number of bytes
+// read, which is less than len(p) only at the end of the chunk.  It calls fetch to write the chunk
+// if it isn't cached; concurrent reads of the same chunk fetch it once.
+func (cc *ChunkCache) readAt(ctx context.Context, id string, p []byte, off int64, fetch func(w io.Writer) error) (int, error) {
+	if n, ok := cc.read(id, p, off); ok {
+		return n, nil
+	}
+	var data []byte
+	if err := cc.deduper.Do(ctx, id, func() error {
+		var err error
+		data, err = cc.fetch(id, fetch)
+		return err
+	}); err != nil {
+		return 0, errors.Wrap(err, "fetch chunk")
+	}
+	if data == nil {
+		// Fetched by whoever was already fetching it.
+		if n, ok := cc.read(id, p, off); ok {
+			return n, nil
+		}
+		// Evicted as soon as it arrived; the cache is much too small for its readers.
+		buf := &bytes.Buffer{}
+		if err := fetch(buf); err != nil {
+			return 0, errors.Wrap(err, "fetch chunk")
+		}
+		data = buf.Bytes()
+	}
+	if off >= int64(len(data)) {
+		return 0, nil
+	}
+	return copy(p, data[off:]), nil
+}
+
+// prefetch fetches the chunk with the given ID if it isn't cached.
+func (cc *ChunkCache) prefetch(ctx context.Context, id string, fetch func(w io.Writer) error) error {
+	if _, err := os.Stat(cc.path(id)); err == nil {
+		return nil
+	}
+	return errors.Wrap(cc.deduper.Do(ctx, id, func() error {
+		_, err := cc.fetch(id, fetch)
+		return err
+	}), "prefetch chunk")
+}
+
+// fetch fetches a chunk and adds it to the cache, unless it's already there, in which case it
+// returns nil.
+func (cc *ChunkCache) fetch(id string, fetch func(w io.Writer) error) ([]byte, error) {
+	if _, err := os.Stat(cc.path(id)); err == nil {
+		return nil, nil
+	}
+	buf := &bytes.Buffer{}
+	if err := fetch(buf); err != nil {
+		return nil, err
+	}
+	if err := cc.put(id, buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// read reads part of a cached chunk, if there is one, and notes that it was used.
+func (cc *ChunkCache) read(id string, p []byte, off int64) (int, bool) {
+	f, err := os.Open(cc.path(id))
+	if err != nil {
+		return 0, false
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err == nil && time.Since(info.ModTime()) > touchInterval {
+		now := time.Now()
+		os.Chtimes(cc.path(id), now, now) //nolint:errcheck
+	}
+	n, err := f.ReadAt(p, off)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, false
+	}
+	return n, true
+}
+
+// put adds a chunk to the cache, evicting others if the cache is too big.  The chunk is written to
+// a temporary file and renamed into place, so that readers never see part of a chunk.
+func (cc *ChunkCache) put(id string, data []byte) (retErr error) {
+	f, err := os.CreateTemp(cc.dir, id+"-*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "create chunk file")
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name()) //nolint:errcheck
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		f.Close() //nolint:errcheck
+		return errors.Wrap(err, "write chunk file")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close chunk file")
+	}
+	if err := os.Rename(f.Name(), cc.path(id)); err != nil {
+		return errors.Wrap(err, "rename chunk file")
+	}
+	cc.mu.Lock()
+	cc.size += int64(len(data))
+	cc.written += int64(len(data))
+	// Other processes may be filling the directory too, so it's rescanned after every
+	// sixteenth of its capacity that this process writes, even if it seems to have room.
+	full := cc.size > cc.maxBytes || cc.written > cc.maxBytes/16
+	cc.mu.Unlock()
+	if full {
+		if err := cc.evict(); err != nil {
+			log.Info(pctx.TODO(), "problem evicting chunks", zap.String("dir", cc.dir), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// evict scans the cache's directory, holding its lock file, and removes the least recently used
+// chunks until it holds at most maxBytes.  It also removes temporary files left behind by
+// processes that died while writing them.
+func (cc *ChunkCache) evict() (retErr error) {
+	lock, err := os.OpenFile(filepath.Join(cc.dir, lockFile), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open chunk cache lock file")
+	}
+	defer errors.Close(&retErr, lock, "close chunk cache lock file")
+	if err := syscall.Flock(int(lock.Fd()), syscall.LOCK_EX); err != nil {
+		return errors.Wrap(err, "lock chunk cache")
+	}
+	defer func() {
+		if err := syscall.Flock(int(lock.Fd()), syscall.LOCK_UN); err != nil && retErr == nil {
+			retErr = errors.Wrap(err, "unlock chunk cache")
+		}
+	}()
+	entries, err := os.ReadDir(cc.dir)
+	if err != nil {
+		return errors.Wrap(err, "read chunk cache directory")
+	}
+	type chunk struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var chunks []chunk
+	var size int64
+	for _, e := range entries {
+		if e.IsDir() || e.Name() == lockFile {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue // Removed since ReadDir.
+		}
+		if strings.HasSuffix(e.Name(), ".tmp") {
+			if time.Since(info.ModTime()) > staleTmpAge {
+				os.Remove(filepath.Join(cc.dir, e.Name())) //nolint:errcheck
+			}
+			continue
+		}
+		chunks = append(chunks, chunk{name: e.Name(), size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+	}
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i].modTime.Before(chunks[j].modTime) })
+	for _, c := range chunks {
+		if size <= cc.maxBytes {
+			break
+		}
+		if err := os.Remove(filepath.Join(cc.dir, c.name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Info(pctx.TODO(), "problem removing evicted chunk", zap.String("id", c.name), zap.Error(err))
+			continue
+		}
+		size -= c.size
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.size, cc.written = size, 0
+	return nil
+}
+
+var (
+	defaultChunkCacheOnce sync.Once
+	defaultChunkCache     *ChunkCache
+	defaultChunkCacheErr  error
+)
+
+// DefaultChunkCacheDir returns the directory of the default chunk cache, in the user's cache
+// directory.
+func DefaultChunkCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "pachyderm", "pfs-chunks")
+}
+
+// DefaultChunkCache returns the chunk cache shared by mounts that don't configure their own.  It
+// holds up to DefaultChunkCacheBytes in DefaultChunkCacheDir.
+func DefaultChunkCache() (*ChunkCache, error) {
+	defaultChunkCacheOnce.Do(func() {
+		defaultChunkCache, defaultChunkCacheErr = NewChunkCache(DefaultChunkCacheDir(), DefaultChunkCacheBytes)
+	})
+	return defaultChunkCache, defaultChunkCacheErr
+}
@@ -0,0 +1,76 @@
+package fuse
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestChunkCache(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	dir := t.TempDir()
+	cc, err := NewChunkCache(dir, 20)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	fetch := func(id string) func(w io.Writer) error {
+		return func(w io.Writer) error {
+			fetches.Add(1)
+			_, err := w.Write([]byte("chunk " + id))
+			return err
+		}
+	}
+	read := func(cc *ChunkCache, id string, off int64) string {
+		buf := make([]byte, 10)
+		n, err := cc.readAt(ctx, id, buf, off, fetch(id))
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	// Concurrent reads of one chunk fetch it once.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, "chunk a", read(cc, "a", 0))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), fetches.Load())
+	require.Equal(t, "a", read(cc, "a", 6))
+	require.Equal(t, int32(1), fetches.Load())
+
+	// A cache on the same directory shares its chunks, and counts them against its size.
+	cc2, err := NewChunkCache(dir, 20)
+	require.NoError(t, err)
+	require.Equal(t, "chunk a", read(cc2, "a", 0))
+	require.Equal(t, int32(1), fetches.Load())
+	require.Equal(t, "chunk b", read(cc2, "b", 0))
+	old := time.Now().Add(-2 * staleTmpAge)
+	require.NoError(t, os.Chtimes(cc.path("a"), old, old))
+	tmp := filepath.Join(dir, "c-123.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("chunk"), 0644))
+	require.NoError(t, os.Chtimes(tmp, old, old))
+
+	// Going over the size evicts the least recently used chunks, and stale temporary files.
+	require.Equal(t, "chunk c", read(cc2, "c", 0))
+	for _, p := range []string{cc.path("a"), tmp} {
+		_, err = os.Stat(p)
+		require.True(t, os.IsNotExist(err), "%s should have been removed", p)
+	}
+	for _, id := range []string{"b", "c"} {
+		_, err = os.Stat(cc.path(id))
+		require.NoError(t, err)
+	}
+	fetches.Store(0)
+	require.Equal(t, "chunk a", read(cc, "a", 0))
+	require.Equal(t, int32(1), fetches.Load())
+}
@@ -130,6 +130,54 @@ func TestSeek(t *testing.T) {
 	})
 }
 
+func TestStreamingRead(t *testing.T) {
+	pachClient := pachd.NewTestPachd(t)
+	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, "repo"))
+	random.SeedRand(123)
+	src := random.String(40*MB + 17)
+	err := pachClient.PutFile(client.NewCommit(pfs.DefaultProjectName, "repo", "master", ""), "file", strings.NewReader(src))
+	require.NoError(t, err)
+	cacheDir := t.TempDir()
+	chunks, err := NewChunkCache(cacheDir, DefaultChunkCacheBytes)
+	require.NoError(t, err)
+	cachedBytes := func() int64 {
+		entries, err := os.ReadDir(cacheDir)
+		require.NoError(t, err)
+		var n int64
+		for _, e := range entries {
+			info, err := e.Info()
+			require.NoError(t, err)
+			n += info.Size()
+		}
+		return n
+	}
+	withMount(t, pachClient, &Options{ChunkCache: chunks}, func(mountPoint string) {
+		f, err := os.Open(filepath.Join(mountPoint, "repo", "file"))
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, f.Close())
+		}()
+		fi, err := f.Stat()
+		require.NoError(t, err)
+		require.Equal(t, int64(len(src)), fi.Size())
+
+		// A read in the middle of the file fetches just the ranges it needs.
+		buf := make([]byte, 100)
+		off := int64(20*MB - 50)
+		_, err = f.ReadAt(buf, off)
+		require.NoError(t, err)
+		require.Equal(t, src[off:off+100], string(buf))
+		require.True(t, cachedBytes() > 0, "nothing was cached")
+		require.True(t, cachedBytes() < int64(len(src)), "cached the whole file")
+
+		// The end of the file can be read too.
+		buf = make([]byte, 17)
+		_, err = f.ReadAt(buf, 40*MB)
+		require.NoError(t, err)
+		require.Equal(t, src[40*MB:], string(buf))
+	})
+}
+
 func TestHeadlessBranch(t *testing.T) {
 	pachClient := pachd.NewTestPachd(t)
 	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, "repo"))
@@ -65,14 +65,16 @@ type loopbackRoot struct {
 
 	write bool
 
-	c *client.APIClient
+	c      *client.APIClient
+	chunks *ChunkCache
 
-	stateMap map[string]string       // key is mount name, value is 'mounted', etc
-	repoOpts map[string]*RepoOptions // key is mount name
-	branches map[string]string       // key is mount name
-	commits  map[string]string       // key is mount name
-	files    map[string]fileState    // key is {mount_name}/{path}
-	mu       sync.Mutex
+	stateMap  map[string]string        // key is mount name, value is 'mounted', etc
+	repoOpts  map[string]*RepoOptions  // key is mount name
+	branches  map[string]string        // key is mount name
+	commits   map[string]string        // key is mount name
+	files     map[string]fileState     // key is {mount_name}/{path}
+	fileInfos map[string]*pfs.FileInfo // key is {mount_name}/{path}
+	mu        sync.Mutex
 }
 
 type loopbackNode struct {
@@ -390,6 +392,18 @@ func (n *loopbackNode) Readlink(ctx context.Context) ([]byte, syscall.Errno) {
 
 func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle, fuseFlags uint32, errno syscall.Errno) {
 	p := n.path()
+	if !isWrite(flags) && !isCreate(flags) && n.getFileState(p) < full {
+		// Stream files that are only being read, rather than downloading
+		// them in full.
+		if err := n.download(ctx, p, meta); err != nil {
+			return nil, 0, fs.ToErrno(err)
+		}
+		if fi := n.getFileInfo(p); fi != nil {
+			// Mounted files come from a single commit, so they never change
+			// under the kernel's page cache.
+			return newStreamFile(n.c().Ctx(), n.c(), n.root().chunks, fi, p), fuse.FOPEN_KEEP_CACHE, 0
+		}
+	}
 	state := full
 	if isWrite(flags) {
 		if errno := n.checkWrite(p); errno != 0 {
@@ -540,16 +554,23 @@ func newLoopbackRoot(root, target string, c *client.APIClient, opts *Options) (*
 		return nil, errors.WithStack(err)
 	}
 
+	chunks, err := opts.getChunkCache()
+	if err != nil {
+		return nil, err
+	}
+
 	n := &loopbackRoot{
 		rootPath:   root,
 		rootDev:    uint64(st.Dev),
 		targetPath: target,
 		write:      opts.getWrite(),
 		c:          c,
+		chunks:     chunks,
 		repoOpts:   opts.getRepoOpts(),
 		branches:   opts.getBranches(),
 		commits:    make(map[string]string),
 		files:      make(map[string]fileState),
+		fileInfos:  make(map[string]*pfs.FileInfo),
 		stateMap:   make(map[string]string),
 	}
 	return n, nil
@@ -637,6 +658,7 @@ func (n *loopbackNode) download(ctx context.Context, origPath string, state file
 		defer func() {
 			if retErr == nil {
 				n.setFileState(p, state)
+				n.setFileInfo(p, fi)
 			}
 		}()
 		// Make sure the directory exists
@@ -783,6 +805,18 @@ func (n *loopbackNode) setFileState(path string, state fileState) {
 	n.root().files[n.trimPath(path)] = state
 }
 
+func (n *loopbackNode) getFileInfo(path string) *pfs.FileInfo {
+	n.root().mu.Lock()
+	defer n.root().mu.Unlock()
+	return n.root().fileInfos[n.trimPath(path)]
+}
+
+func (n *loopbackNode) setFileInfo(path string, fi *pfs.FileInfo) {
+	n.root().mu.Lock()
+	defer n.root().mu.Unlock()
+	n.root().fileInfos[n.trimPath(path)] = fi
+}
+
 func (n *loopbackNode) checkWrite(path string) syscall.Errno {
 	name := strings.Split(n.trimPath(path), "/")[0]
 	ros := n.root().repoOpts
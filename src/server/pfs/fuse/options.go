@@ -26,6 +26,10 @@ type Options struct {
 	// Unmount is a channel that will be closed when the filesystem has been
 	// unmounted. It can be nil in which case it's ignored.
 	Unmount chan struct{}
+
+	// ChunkCache caches the chunks of files that are read through the mount.
+	// If it's nil, the mount uses DefaultChunkCache.
+	ChunkCache *ChunkCache
 }
 
 // RepoOptions are the options associated with a mounted repo.
@@ -82,6 +86,13 @@ func (o *Options) getWrite() bool {
 	return o.Write
 }
 
+func (o *Options) getChunkCache() (*ChunkCache, error) {
+	if o == nil || o.ChunkCache == nil {
+		return DefaultChunkCache()
+	}
+	return o.ChunkCache, nil
+}
+
 func (o *Options) getUnmount() chan struct{} {
 	if o == nil {
 		return nil
@@ -0,0 +1,223 @@
+package fuse
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/storage"
+)
+
+// streamFile is a read-only FileHandle that reads a file from PFS a range at a time, instead of
+// downloading the whole file when it's opened.  The ranges are the ones that PFS stores the file's
+// content in, and are read through a ChunkCache, keyed by their IDs.  Reads that follow on from the
+// previous read prefetch the ranges after them.  If the file's ranges can't be listed, reads go
+// straight to PFS.
+type streamFile struct {
+	ctx   context.Context
+	c     *client.APIClient
+	cache *ChunkCache
+	fi    *pfs.FileInfo
+	// path is the file's path in the loopback directory, where it exists with the right size but
+	// no content.
+	path string
+
+	rangesOnce sync.Once
+	ranges     []*storage.ContentRange
+	// ends holds the offset in the file of the end of each range.
+	ends []int64
+
+	mu         sync.Mutex
+	next       int64 // the offset after the last read
+	prefetched int   // the index of the last range prefetched
+}
+
+var _ = (fs.FileHandle)((*streamFile)(nil))
+var _ = (fs.FileReader)((*streamFile)(nil))
+var _ = (fs.FileGetattrer)((*streamFile)(nil))
+var _ = (fs.FileReleaser)((*streamFile)(nil))
+
+func newStreamFile(ctx context.Context, c *client.APIClient, cache *ChunkCache, fi *pfs.FileInfo, path string) *streamFile {
+	return &streamFile{
+		ctx:        ctx,
+		c:          c,
+		cache:      cache,
+		fi:         fi,
+		path:       path,
+		prefetched: -1,
+	}
+}
+
+func (f *streamFile) Read(ctx context.Context, buf []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	end := off + int64(len(buf))
+	if end > f.fi.SizeBytes {
+		end = f.fi.SizeBytes
+	}
+	if off >= end {
+		return fuse.ReadResultData(nil), fs.OK
+	}
+	f.rangesOnce.Do(f.loadRanges)
+	if f.ranges == nil {
+		n, err := f.readDirect(ctx, buf[:end-off], off)
+		if err != nil {
+			log.Info(ctx, "problem reading file", zap.String("path", f.fi.File.Path), zap.Error(err))
+			return nil, syscall.EIO
+		}
+		return fuse.ReadResultData(buf[:n]), fs.OK
+	}
+	var n int64
+	for i := f.rangeAt(off); i < len(f.ranges) && off+n < end; i++ {
+		start := f.ends[i] - f.ranges[i].SizeBytes
+		m, err := f.cache.readAt(ctx, f.rangeID(i), buf[n:end-off], off+n-start, f.fetchRange(i))
+		if err != nil {
+			log.Info(ctx, "problem reading range", zap.String("path", f.fi.File.Path), zap.Int("range", i), zap.Error(err))
+			return nil, syscall.EIO
+		}
+		n += int64(m)
+		if off+n < f.ends[i] {
+			break // The range is shorter than PFS said.
+		}
+	}
+	if n > 0 {
+		f.prefetch(off, off+n)
+	}
+	return fuse.ReadResultData(buf[:n]), fs.OK
+}
+
+// loadRanges lists the ranges that the file's content is stored in.  If they can't be listed, or
+// don't add up to the file's size, ranges is left nil.
+func (f *streamFile) loadRanges() {
+	ranges, err := f.listRanges()
+	if err != nil {
+		log.Info(f.ctx, "problem listing file's ranges; reading it without the chunk cache", zap.String("path", f.fi.File.Path), zap.Error(err))
+		return
+	}
+	var ends []int64
+	var size int64
+	for _, r := range ranges {
+		size += r.SizeBytes
+		ends = append(ends, size)
+	}
+	if size != f.fi.SizeBytes {
+		log.Info(f.ctx, "file's ranges don't match its size; reading it without the chunk cache", zap.String("path", f.fi.File.Path), zap.Int64("rangeBytes", size), zap.Int64("sizeBytes", f.fi.SizeBytes))
+		return
+	}
+	f.ranges, f.ends = ranges, ends
+}
+
+func (f *streamFile) listRanges() ([]*storage.ContentRange, error) {
+	commit := f.fi.File.Commit
+	var branch string
+	if commit.Id == "" && commit.Branch != nil {
+		branch = commit.Branch.Name
+	}
+	filesetID, err := client.GetFileSet(f.ctx, f.c.PfsAPIClient, commit.Repo.Project.GetName(), commit.Repo.Name, branch, commit.Id)
+	if err != nil {
+		return nil, err
+	}
+	p := f.fi.File.Path
+	rfc, err := f.c.FilesetClient.ReadFilesetRanges(f.ctx, &storage.ReadFilesetRequest{
+		FilesetId: filesetID,
+		Filters: []*storage.FileFilter{{
+			Filter: &storage.FileFilter_PathRange{PathRange: &storage.PathRange{Lower: p, Upper: p + "\x00"}},
+		}},
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var ranges []*storage.ContentRange
+	for {
+		resp, err := rfc.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		// A file written by several datums is read as the concatenation of their content.
+		for _, r := range resp.Ranges {
+			if r.SizeBytes > 0 {
+				ranges = append(ranges, r)
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// rangeAt returns the index of the range that holds the given offset.
+func (f *streamFile) rangeAt(off int64) int {
+	return sort.Search(len(f.ends), func(i int) bool { return f.ends[i] > off })
+}
+
+func (f *streamFile) rangeID(i int) string {
+	return hex.EncodeToString(f.ranges[i].Id)
+}
+
+// fetchRange returns a function that reads the i'th range from PFS.  The range is fetched with the
+// file's context rather than a read's, as other reads may be waiting for it too.
+func (f *streamFile) fetchRange(i int) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		r := f.ranges[i]
+		return client.GetFile(f.ctx, f.c.PfsAPIClient, f.fi.File.Commit, f.fi.File.Path, w,
+			client.WithOffset(f.ends[i]-r.SizeBytes), client.WithSizeBytes(r.SizeBytes))
+	}
+}
+
+// readDirect reads part of the file from PFS, bypassing the cache.
+func (f *streamFile) readDirect(ctx context.Context, buf []byte, off int64) (int, error) {
+	w := bytes.NewBuffer(buf[:0])
+	if err := client.GetFile(ctx, f.c.PfsAPIClient, f.fi.File.Commit, f.fi.File.Path, w,
+		client.WithOffset(off), client.WithSizeBytes(int64(len(buf)))); err != nil {
+		return 0, err
+	}
+	return copy(buf, w.Bytes()), nil
+}
+
+// prefetch notes a read of [off, end), and if it follows on from the previous read, starts fetching
+// the ranges that hold the next prefetchBytes of the file in the background.
+func (f *streamFile) prefetch(off, end int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sequential := off == f.next
+	f.next = end
+	if !sequential {
+		return
+	}
+	first := f.rangeAt(end)
+	if first <= f.prefetched {
+		first = f.prefetched + 1
+	}
+	for i := first; i < len(f.ranges) && f.ends[i]-f.ranges[i].SizeBytes < end+prefetchBytes; i++ {
+		go func() {
+			if err := f.cache.prefetch(f.ctx, f.rangeID(i), f.fetchRange(i)); err != nil {
+				log.Debug(f.ctx, "problem prefetching range", zap.String("path", f.fi.File.Path), zap.Int("range", i), zap.Error(err))
+			}
+		}()
+		f.prefetched = i
+	}
+}
+
+func (f *streamFile) Getattr(ctx context.Context, out *fuse.AttrOut) syscall.Errno {
+	st := syscall.Stat_t{}
+	if err := syscall.Lstat(f.path, &st); err != nil {
+		return fs.ToErrno(err)
+	}
+	out.FromStat(&st)
+	return fs.OK
+}
+
+func (f *streamFile) Release(ctx context.Context) syscall.Errno {
+	return fs.OK
+}
@@ -42,6 +42,8 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// StorageUsageHeader is the header for storage usage.
+	StorageUsageHeader = "KIND\tNAME\tTOTAL\tUNIQUE\tSHARED\tCOMPUTED\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -150,6 +152,32 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintln(w)
 }
 
+// PrintStorageUsage pretty-prints the storage usage of a project, repo, branch, or commit.
+func PrintStorageUsage(w io.Writer, resp *pfs.StorageUsageResponse, fullTimestamps bool) {
+	switch e := resp.Entity.(type) {
+	case *pfs.StorageUsageResponse_Project:
+		fmt.Fprintf(w, "project\t%s\t", e.Project)
+	case *pfs.StorageUsageResponse_Repo:
+		fmt.Fprintf(w, "repo\t%s\t", e.Repo)
+	case *pfs.StorageUsageResponse_Branch:
+		fmt.Fprintf(w, "branch\t%s\t", e.Branch.Name)
+	case *pfs.StorageUsageResponse_Commit:
+		fmt.Fprintf(w, "commit\t%s\t", e.Commit.Id)
+	default:
+		fmt.Fprintf(w, "-\t-\t")
+	}
+	usage := resp.GetUsage()
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.GetTotalBytes())))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.GetUniqueBytes())))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(usage.GetSharedBytes())))
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", pretty.Timestamp(resp.ComputedAt))
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(resp.ComputedAt))
+	}
+	fmt.Fprintln(w)
+}
+
 // PrintProjectInfo pretty-prints a project.
 func PrintProjectInfo(w io.Writer, projectInfo *pfs.ProjectInfo, currentProject *pfs.Project) {
 	var line []string
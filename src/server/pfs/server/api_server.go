@@ -7,8 +7,10 @@ import (
 	"time"
 
 	etcd "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -23,8 +25,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
@@ -113,7 +117,14 @@ func (a *apiServer) ActivateAuthInTransaction(ctx context.Context, txnCtx *txnco
 	}
 	// Create role bindings for repos created before auth activation
 	if err := pfsdb.ForEachRepo(ctx, txnCtx.SqlTx, nil, nil, func(repo pfsdb.Repo) error {
-		err := a.env.Auth.CreateRoleBindingInTransaction(ctx, txnCtx, "", nil, repo.RepoInfo.Repo.AuthResource())
+		var principal string
+		var roleSlice []string
+		if repo.RepoInfo.Repo.Type == pfs.ProfilesRepoType {
+			// Let pachd, pachw and workers keep committing profiles.
+			principal = continuous.Principal
+			roleSlice = []string{auth.RepoWriterRole}
+		}
+		err := a.env.Auth.CreateRoleBindingInTransaction(ctx, txnCtx, principal, roleSlice, repo.RepoInfo.Repo.AuthResource())
 		if err != nil && !col.IsErrExists(err) {
 			return errors.EnsureStack(err)
 		}
@@ -580,10 +591,24 @@ func (a *apiServer) InspectProjectV2(ctx context.Context, request *pfs.InspectPr
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get project defaults for %q", request.Project.String())
 	}
-	return &pfs.InspectProjectV2Response{
+	result := &pfs.InspectProjectV2Response{
 		Info:         info,
 		DefaultsJson: resp.GetProjectDefaultsJson(),
-	}, nil
+	}
+	if request.IncludeQuota {
+		// The quota is informational; not being able to read it doesn't fail the inspection.
+		quotaResp, err := ppsServer.GetProjectQuota(ctx, &pps.GetProjectQuotaRequest{Project: request.GetProject()})
+		if err != nil {
+			log.Info(ctx, "could not get project quota", zap.String("project", request.Project.String()), zap.Error(err))
+			return result, nil
+		}
+		quotaJSON, err := protojson.Marshal(quotaResp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal project quota for %q", request.Project.String())
+		}
+		result.QuotaJson = string(quotaJSON)
+	}
+	return result, nil
 }
 
 // ListProject implements the protobuf pfs.ListProject RPC
@@ -663,7 +688,7 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
 			n = fileset.SizeFromIndex(file.Index())
 			return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
-				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset)))
+				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset), chunk.WithLengthBytes(request.SizeBytes)))
 			})
 		}); err != nil {
 			return 0, errors.EnsureStack(err)
@@ -16,7 +16,6 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cronutil"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
@@ -68,7 +67,7 @@ func (m *Master) Run(ctx context.Context) error {
 			log.Info(ctx, "Skipping Storage GC")
 		} else {
 			eg.Go(func() error {
-				lock := dlock.NewDLock(m.etcdClient, path.Join(m.prefix, masterLockPath, "storage-gc"))
+				lock := m.env.NewDLock(path.Join(m.prefix, masterLockPath, "storage-gc"))
 				log.Info(ctx, "Starting Storage GC", zap.Duration("period", trackerPeriod))
 				ctx, err := lock.Lock(ctx)
 				if err != nil {
@@ -88,7 +87,7 @@ func (m *Master) Run(ctx context.Context) error {
 			log.Info(ctx, "Skipping Chunk Storage GC")
 		} else {
 			eg.Go(func() error {
-				lock := dlock.NewDLock(m.etcdClient, path.Join(m.prefix, masterLockPath, "chunk-gc"))
+				lock := m.env.NewDLock(path.Join(m.prefix, masterLockPath, "chunk-gc"))
 				log.Info(ctx, "Starting Chunk Storage GC", zap.Duration("period", chunkPeriod))
 				ctx, err := lock.Lock(ctx)
 				if err != nil {
@@ -103,6 +102,40 @@ func (m *Master) Run(ctx context.Context) error {
 				return gc.RunForever(pctx.Child(ctx, "chunk-gc"))
 			})
 		}
+		usagePeriod := time.Second * time.Duration(m.env.StorageConfig.StorageUsagePeriod)
+		if usagePeriod <= 0 {
+			log.Info(ctx, "Skipping Storage Usage Accounting")
+		} else {
+			eg.Go(func() error {
+				lock := m.env.NewDLock(path.Join(m.prefix, masterLockPath, "storage-usage"))
+				log.Info(ctx, "Starting Storage Usage Accounting", zap.Duration("period", usagePeriod))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (storage usage)", zap.Error(err))
+					}
+				}()
+				return m.runStorageUsage(pctx.Child(ctx, "storage-usage"), usagePeriod)
+			})
+		}
+		if cleaner, ok := m.env.TaskService.(task.Cleaner); ok {
+			eg.Go(func() error {
+				lock := m.env.NewDLock(path.Join(m.prefix, masterLockPath, "task-cleanup"))
+				ctx, err := lock.Lock(ctx)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := lock.Unlock(ctx); err != nil {
+						log.Error(ctx, "error unlocking in pfs master (task cleanup)", zap.Error(err))
+					}
+				}()
+				return errors.EnsureStack(cleaner.RunCleanup(pctx.Child(ctx, "task-cleanup")))
+			})
+		}
 		eg.Go(func() error {
 			return m.watchRepos(ctx)
 		})
@@ -123,7 +156,7 @@ func (m *Master) watchRepos(ctx context.Context) error {
 		}
 	}()
 	ringPrefix := path.Join(randutil.UniqueString(m.prefix), masterLockPath, "ring")
-	return consistenthashing.WithRing(ctx, m.etcdClient, ringPrefix,
+	return m.env.WithRing(ctx, ringPrefix,
 		func(ctx context.Context, ring *consistenthashing.Ring) error {
 			return pfsdb.WatchRepos(ctx, m.env.DB, m.env.Listener,
 				func(id pfsdb.RepoID, repoInfo *pfs.RepoInfo) error {
@@ -22,6 +22,8 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/consistenthashing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachconfig"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
@@ -63,6 +65,8 @@ type Env struct {
 	EtcdPrefix  string
 	EtcdClient  *etcd.Client
 	TaskService task.Service
+	NewDLock    func(prefix string) dlock.DLock
+	WithRing    func(ctx context.Context, prefix string, cb func(context.Context, *consistenthashing.Ring) error) error
 	TxnEnv      *txnenv.TransactionEnv
 	Listener    col.PostgresListener
 
@@ -53,7 +53,7 @@ func (a *apiServer) createRepo(ctx context.Context, txnCtx *txncontext.Transacti
 			return errors.Wrapf(err, "error checking whether repo %q exists", repo)
 		}
 		// if this is a system repo, make sure the corresponding user repo already exists
-		if repo.Type != pfs.UserRepoType {
+		if repo.Type != pfs.UserRepoType && repo.Type != pfs.ProfilesRepoType {
 			baseRepo := &pfs.Repo{Project: &pfs.Project{Name: repo.Project.GetName()}, Name: repo.GetName(), Type: pfs.UserRepoType}
 			_, err := pfsdb.GetRepoByName(ctx, txnCtx.SqlTx, baseRepo.Project.Name, baseRepo.Name, baseRepo.Type)
 			if err != nil {
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/constants"
+	"github.com/pachyderm/pachyderm/v2/src/internal/archiveserver"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	internalauth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// shareLinkUser is the internal user that share link downloads run as.
+const shareLinkUser = "pfs-share-link"
+
+// CreateShareLink implements the protobuf pfs.CreateShareLink RPC
+func (a *apiServer) CreateShareLink(ctx context.Context, request *pfs.CreateShareLinkRequest) (*pfs.CreateShareLinkResponse, error) {
+	if t := request.File.Commit.Repo.Type; t != "" && t != pfs.UserRepoType {
+		return nil, errors.Errorf("only files in user repos can be shared, not files in %v repos", t)
+	}
+	// Share the commit that the branch points at now, so the link doesn't change what it
+	// downloads when the branch moves.
+	commitInfo, err := a.InspectCommit(ctx, &pfs.InspectCommitRequest{Commit: request.File.Commit})
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finished == nil {
+		return nil, errors.Errorf("commit %v is not finished; only finished commits can be shared", commitInfo.Commit.Id)
+	}
+	commit := &pfs.Commit{
+		Repo: &pfs.Repo{
+			Name:    commitInfo.Commit.Repo.Name,
+			Type:    pfs.UserRepoType,
+			Project: &pfs.Project{Name: commitInfo.Commit.Repo.Project.GetName()},
+		},
+		Id: commitInfo.Commit.Id,
+	}
+	fileInfo, err := a.InspectFile(ctx, &pfs.InspectFileRequest{File: commit.NewFile(request.File.Path)})
+	if err != nil {
+		return nil, err
+	}
+	var issuer string
+	if who, err := a.env.Auth.WhoAmI(ctx, &auth.WhoAmIRequest{}); err == nil {
+		issuer = who.Username
+	} else if !auth.IsErrNotActivated(err) {
+		return nil, errors.EnsureStack(err)
+	}
+
+	now := time.Now()
+	info := &pfs.ShareLinkInfo{
+		File:         commit.NewFile(pfsfile.CleanPath(request.File.Path)),
+		FileType:     fileInfo.FileType,
+		SizeBytes:    fileInfo.SizeBytes,
+		Issuer:       issuer,
+		Created:      timestamppb.New(now),
+		Expires:      timestamppb.New(now.Add(request.Ttl.AsDuration())),
+		MaxDownloads: request.MaxDownloads,
+	}
+	token := uuid.NewWithoutDashes()
+	linkPath, err := shareLinkPath(info, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		// Creating links is rare, so this is a fine time to clean up old ones.
+		if err := pfsdb.DeleteExpiredShareLinks(ctx, tx); err != nil {
+			return err
+		}
+		return pfsdb.CreateShareLink(ctx, tx, auth.HashToken(token), info)
+	}); err != nil {
+		return nil, errors.Wrap(err, "create share link")
+	}
+	return &pfs.CreateShareLinkResponse{
+		Token: token,
+		Path:  linkPath,
+		Info:  info,
+	}, nil
+}
+
+// shareLinkPath returns the path and query of the URL that downloads a share link from Pachyderm's
+// HTTP server.  Files are served by the file server, and directories as ZIP files by the archive
+// server.
+func shareLinkPath(info *pfs.ShareLinkInfo, token string) (string, error) {
+	file := info.GetFile()
+	repo := file.GetCommit().GetRepo()
+	query := url.Values{constants.ShareLinkKey: []string{token}}.Encode()
+	if info.GetFileType() == pfs.FileType_DIR {
+		encoded, err := archiveserver.EncodeV1([]string{
+			repo.GetProject().GetName() + "/" + repo.GetName() + "@" + file.GetCommit().GetId() + ":" + file.GetPath(),
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "encode archive URL")
+		}
+		return "/archive/" + encoded + ".zip?" + query, nil
+	}
+	u := &url.URL{
+		Path:     path.Join("/pfs", repo.GetProject().GetName(), repo.GetName(), file.GetCommit().GetId(), file.GetPath()),
+		RawQuery: query,
+	}
+	return u.String(), nil
+}
+
+// InspectShareLink implements the protobuf pfs.InspectShareLink RPC
+func (a *apiServer) InspectShareLink(ctx context.Context, request *pfs.InspectShareLinkRequest) (*pfs.ShareLinkInfo, error) {
+	var info *pfs.ShareLinkInfo
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		info, err = pfsdb.GetShareLink(ctx, tx, auth.HashToken(request.Token))
+		return err
+	}, dbutil.WithReadOnly()); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return info, nil
+}
+
+// GetShareLink implements the protobuf pfs.GetShareLink RPC
+func (a *apiServer) GetShareLink(request *pfs.GetShareLinkRequest, server pfs.API_GetShareLinkServer) error {
+	ctx := server.Context()
+	var info *pfs.ShareLinkInfo
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		info, err = pfsdb.UseShareLink(ctx, tx, auth.HashToken(request.Token))
+		return err
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The link carries the permissions its issuer had when it was created, which were checked
+	// then; the caller doesn't need any of their own.
+	return a.GetFileTAR(&pfs.GetFileRequest{File: info.File}, &shareLinkServer{
+		API_GetShareLinkServer: server,
+		ctx:                    internalauth.AsInternalUser(ctx, shareLinkUser),
+	})
+}
+
+// RevokeShareLink implements the protobuf pfs.RevokeShareLink RPC
+func (a *apiServer) RevokeShareLink(ctx context.Context, request *pfs.RevokeShareLinkRequest) (*pfs.RevokeShareLinkResponse, error) {
+	var info *pfs.ShareLinkInfo
+	if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+		var err error
+		info, err = pfsdb.DeleteShareLink(ctx, tx, auth.HashToken(request.Token))
+		return err
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &pfs.RevokeShareLinkResponse{Info: info}, nil
+}
+
+// shareLinkServer sends the GetFileTAR stream for a share link, as the share link user.
+type shareLinkServer struct {
+	pfs.API_GetShareLinkServer
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *shareLinkServer) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// storageUsageQuery computes the storage usage of every project, repo, branch, and commit.  Each
+// commit is charged for each distinct chunk that its filesets refer to, directly or through other
+// filesets and chunks; a commit's filesets are its total fileset and its diff filesets.  Filesets
+// are tracked as "fileset/<hex id>", and chunks as "chunk/<hex id>".  A branch is charged for the
+// chunks of its head commit, and a repo and a project for the chunks of all of their commits,
+// each chunk once.  A chunk's bytes count towards an entity's unique bytes if no other entity of
+// the same kind refers to the chunk.  The kinds are $1 to $4: project, repo, branch, and commit.
+const storageUsageQuery = `
+	WITH RECURSIVE filesets(commit_id, fileset_id) AS (
+		SELECT int_id, total_fileset_id
+		FROM pfs.commits
+		WHERE total_fileset_id IS NOT NULL
+	UNION
+		SELECT commit_int_id, fileset_id
+		FROM pfs.commit_diffs
+	), reachable(commit_id, int_id) AS (
+		SELECT filesets.commit_id, obj.int_id
+		FROM filesets
+			JOIN storage.tracker_objects obj ON obj.str_id = 'fileset/' || replace(filesets.fileset_id::TEXT, '-', '')
+	UNION
+		SELECT reachable.commit_id, refs.to_id
+		FROM reachable
+			JOIN storage.tracker_refs refs ON refs.from_id = reachable.int_id
+	), commit_chunks AS (
+		SELECT reachable.commit_id, commit.repo_id, repo.project_id, obj.int_id AS chunk, chunk_size.size
+		FROM reachable
+			JOIN pfs.commits commit ON commit.int_id = reachable.commit_id
+			JOIN pfs.repos repo ON repo.id = commit.repo_id
+			JOIN storage.tracker_objects obj ON obj.int_id = reachable.int_id
+			JOIN LATERAL (
+				SELECT MAX(size) AS size
+				FROM storage.chunk_objects
+				WHERE chunk_id = decode(substr(obj.str_id, 7), 'hex') AND NOT tombstone
+			) chunk_size ON chunk_size.size IS NOT NULL
+		WHERE obj.str_id LIKE 'chunk/%'
+	), uses(kind, id, chunk, size) AS (
+		SELECT $1::TEXT, project_id, chunk, size FROM commit_chunks
+	UNION
+		SELECT $2::TEXT, repo_id, chunk, size FROM commit_chunks
+	UNION
+		SELECT $3::TEXT, branch.id, chunk, size
+		FROM commit_chunks
+			JOIN pfs.branches branch ON branch.head = commit_chunks.commit_id
+	UNION
+		SELECT $4::TEXT, commit_id, chunk, size FROM commit_chunks
+	), owners AS (
+		SELECT kind, chunk, COUNT(*) AS n
+		FROM uses
+		GROUP BY kind, chunk
+	)
+	SELECT uses.kind, uses.id,
+		SUM(uses.size)::BIGINT AS total_bytes,
+		COALESCE(SUM(uses.size) FILTER (WHERE owners.n = 1), 0)::BIGINT AS unique_bytes
+	FROM uses
+		JOIN owners ON owners.kind = uses.kind AND owners.chunk = uses.chunk
+	GROUP BY uses.kind, uses.id
+	ORDER BY uses.kind, uses.id`
+
+// accountStorageUsage recomputes the storage usage of every project, repo, branch, and commit.
+// The usage is aggregated by postgres, so that pachd holds only the result, one row per entity,
+// rather than the chunks of every repo.
+func accountStorageUsage(ctx context.Context, db *pachsql.DB) (retErr error) {
+	ctx, end := log.SpanContext(ctx, "accountStorageUsage")
+	defer end(log.Errorp(&retErr))
+	var usage []pfsdb.StorageUsage
+	if err := dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) (retErr error) {
+		usage = nil
+		rows, err := tx.QueryContext(ctx, storageUsageQuery,
+			pfsdb.StorageUsageProject, pfsdb.StorageUsageRepo, pfsdb.StorageUsageBranch, pfsdb.StorageUsageCommit)
+		if err != nil {
+			return errors.Wrap(err, "query storage usage")
+		}
+		defer errors.Close(&retErr, rows, "close storage usage")
+		for rows.Next() {
+			var u pfsdb.StorageUsage
+			if err := rows.Scan(&u.Kind, &u.ID, &u.TotalBytes, &u.UniqueBytes); err != nil {
+				return errors.Wrap(err, "scan storage usage")
+			}
+			usage = append(usage, u)
+		}
+		return errors.Wrap(rows.Err(), "iterate storage usage")
+	}, dbutil.WithReadOnly()); err != nil {
+		return err
+	}
+	log.Info(ctx, "accounted storage usage", zap.Int("entities", len(usage)))
+	return dbutil.WithTx(ctx, db, func(ctx context.Context, tx *pachsql.Tx) error {
+		return pfsdb.ReplaceStorageUsage(ctx, tx, usage, time.Now())
+	})
+}
+
+// runStorageUsage accounts storage usage every period until the context is cancelled, logging any
+// errors.
+func (m *Master) runStorageUsage(ctx context.Context, period time.Duration) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := accountStorageUsage(ctx, m.env.DB); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Error(ctx, "error accounting storage usage", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+}
+
+// StorageUsage implements the protobuf pfs.StorageUsage RPC
+func (a *apiServer) StorageUsage(request *pfs.StorageUsageRequest, server pfs.API_StorageUsageServer) error {
+	ctx := server.Context()
+	var resps []*pfs.StorageUsageResponse
+	if request.Repo != nil {
+		if err := a.env.Auth.CheckRepoIsAuthorized(ctx, request.Repo, auth.Permission_REPO_READ); err != nil {
+			return errors.EnsureStack(err)
+		}
+		kinds := []string{pfsdb.StorageUsageRepo, pfsdb.StorageUsageBranch}
+		if request.Commits {
+			kinds = append(kinds, pfsdb.StorageUsageCommit)
+		}
+		if err := dbutil.WithTx(ctx, a.env.DB, func(ctx context.Context, tx *pachsql.Tx) error {
+			for _, kind := range kinds {
+				usage, err := pfsdb.ListStorageUsage(ctx, tx, kind, pfsdb.StorageUsageFilter{Repo: request.Repo})
+				if err != nil {
+					return err
+				}
+				resps = append(resps, usage...)
+			}
+			return nil
+		}, dbutil.WithReadOnly()); err != nil {
+			return errors.Wrap(err, "list storage usage")
+		}
+	} else {
+		var projects []string
+		for _, p := range request.Projects {
+			switch p.Picker.(type) {
+			case *pfs.ProjectPicker_Name:
+				projects = append(projects, p.GetName())
+			default:
+				return errors.Errorf("project picker is of an unknown type: %T", p.Picker)
+			}
+		}
+		if err := a.txnEnv.WithReadContext(ctx, func(ctx context.Context, txnCtx *txncontext.TransactionContext) error {
+			var err error
+			resps, err = a.listVisibleStorageUsage(ctx, txnCtx, projects)
+			return err
+		}); err != nil {
+			return errors.Wrap(err, "list storage usage")
+		}
+	}
+	for _, resp := range resps {
+		if err := server.Send(resp); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// listVisibleStorageUsage returns the usage of the given projects, or of every project if none are
+// given, followed by the usage of their repos.  Like ListRepo, it leaves out repos that the caller
+// can't list, and projects whose repos the caller can't list.
+func (a *apiServer) listVisibleStorageUsage(ctx context.Context, txnCtx *txncontext.TransactionContext, projects []string) ([]*pfs.StorageUsageResponse, error) {
+	var authActive bool
+	if _, err := txnCtx.WhoAmI(); err == nil {
+		authActive = true
+	} else if !errors.Is(err, auth.ErrNotActivated) {
+		return nil, errors.Wrap(err, "check if auth is active")
+	}
+	checkProjectAccess := miscutil.CacheFunc(func(project string) error {
+		return a.env.Auth.CheckProjectIsAuthorizedInTransaction(ctx, txnCtx, &pfs.Project{Name: project}, auth.Permission_PROJECT_LIST_REPO)
+	}, 100)
+	filter := pfsdb.StorageUsageFilter{Projects: projects}
+	var resps []*pfs.StorageUsageResponse
+	projectUsage, err := pfsdb.ListStorageUsage(ctx, txnCtx.SqlTx, pfsdb.StorageUsageProject, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, resp := range projectUsage {
+		if authActive {
+			if err := checkProjectAccess(resp.GetProject().GetName()); err != nil {
+				if !errors.As(err, &auth.ErrNotAuthorized{}) {
+					return nil, errors.Wrapf(err, "check access to project %s", resp.GetProject())
+				}
+				continue
+			}
+		}
+		resps = append(resps, resp)
+	}
+	repoUsage, err := pfsdb.ListStorageUsage(ctx, txnCtx.SqlTx, pfsdb.StorageUsageRepo, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, resp := range repoUsage {
+		if authActive {
+			hasAccess, err := a.hasProjectAccess(ctx, txnCtx, &pfs.RepoInfo{Repo: resp.GetRepo()}, checkProjectAccess)
+			if err != nil {
+				return nil, errors.Wrap(err, "checking project access")
+			}
+			if !hasAccess {
+				continue
+			}
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
+}
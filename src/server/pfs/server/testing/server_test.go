@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -7348,6 +7349,9 @@ func TestInspectProjectV2(t *testing.T) {
 	resp, err := c.PfsAPIClient.InspectProjectV2(ctx, &pfs.InspectProjectV2Request{Project: &pfs.Project{Name: "default"}})
 	require.NoError(t, err, "InspectProjectV2 must succeed with a real project")
 	require.Equal(t, "{}", resp.DefaultsJson)
+	require.Equal(t, "", resp.QuotaJson, "the quota is only returned on request")
+	_, err = c.PfsAPIClient.InspectProjectV2(ctx, &pfs.InspectProjectV2Request{Project: &pfs.Project{Name: "default"}, IncludeQuota: true})
+	require.NoError(t, err, "InspectProjectV2 must succeed when asked for the quota")
 
 	// change project defaults; the changed defaults should be then be reflected
 	_, err = c.PpsAPIClient.SetProjectDefaults(ctx, &pps.SetProjectDefaultsRequest{
@@ -7712,3 +7716,173 @@ func TestCommitDescription(t *testing.T) {
 	require.Equal(t, "test commit description in 'finish commit' that overwrites", commitInfo.Description)
 	require.NoError(t, pfspretty.PrintDetailedCommitInfo(os.Stdout, pfspretty.NewPrintableCommitInfo(commitInfo)))
 }
+
+func TestShareLink(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	t.Parallel()
+	c := pachd.NewTestPachd(t)
+	ctx := c.Ctx()
+
+	dataRepo := uuid.UniqueString("TestShareLink")
+	require.NoError(t, c.CreateRepo(pfs.DefaultProjectName, dataRepo))
+	commit, err := c.StartCommit(pfs.DefaultProjectName, dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "dir/file", strings.NewReader("foo")))
+
+	// Open commits can't be shared.
+	_, err = c.PfsAPIClient.CreateShareLink(ctx, &pfs.CreateShareLinkRequest{
+		File: client.NewFile(pfs.DefaultProjectName, dataRepo, "master", "", "/dir/file"),
+		Ttl:  durationpb.New(time.Hour),
+	})
+	require.YesError(t, err)
+	require.NoError(t, c.FinishCommit(pfs.DefaultProjectName, dataRepo, "master", ""))
+
+	resp, err := c.PfsAPIClient.CreateShareLink(ctx, &pfs.CreateShareLinkRequest{
+		File:         client.NewFile(pfs.DefaultProjectName, dataRepo, "master", "", "/dir/file"),
+		Ttl:          durationpb.New(time.Hour),
+		MaxDownloads: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commit.Id, resp.Info.File.Commit.Id)
+	require.Equal(t, "/pfs/default/"+dataRepo+"/"+commit.Id+"/dir/file?share="+resp.Token, resp.Path)
+
+	// Moving the branch doesn't change what the link shares.
+	require.NoError(t, c.PutFile(client.NewCommit(pfs.DefaultProjectName, dataRepo, "master", ""), "dir/file", strings.NewReader("bar")))
+
+	info, err := c.PfsAPIClient.InspectShareLink(ctx, &pfs.InspectShareLinkRequest{Token: resp.Token})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), info.SizeBytes)
+	require.Equal(t, int64(0), info.Downloads)
+
+	rc, err := client.GetShareLink(ctx, c.PfsAPIClient, resp.Token)
+	require.NoError(t, err)
+	tr := tar.NewReader(rc)
+	var content []byte
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if h.Typeflag == tar.TypeReg {
+			content, err = io.ReadAll(tr)
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, rc.Close())
+	require.Equal(t, "foo", string(content))
+
+	// The link allows only one download.
+	rc, err = client.GetShareLink(ctx, c.PfsAPIClient, resp.Token)
+	if err == nil {
+		_, err = io.ReadAll(rc)
+		rc.Close() //nolint:errcheck
+	}
+	require.YesError(t, err)
+	require.Matches(t, "already been downloaded 1 times", err.Error())
+
+	_, err = c.PfsAPIClient.InspectShareLink(ctx, &pfs.InspectShareLinkRequest{Token: "bogus"})
+	require.YesError(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// A revoked link can't be used.
+	resp, err = c.PfsAPIClient.CreateShareLink(ctx, &pfs.CreateShareLinkRequest{
+		File: client.NewFile(pfs.DefaultProjectName, dataRepo, "master", "", "/dir"),
+		Ttl:  durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+	revoked, err := c.PfsAPIClient.RevokeShareLink(ctx, &pfs.RevokeShareLinkRequest{Token: resp.Token})
+	require.NoError(t, err)
+	require.Equal(t, "/dir", revoked.Info.File.Path)
+	rc, err = client.GetShareLink(ctx, c.PfsAPIClient, resp.Token)
+	if err == nil {
+		_, err = io.ReadAll(rc)
+		rc.Close() //nolint:errcheck
+	}
+	require.YesError(t, err)
+	require.Matches(t, "share link not found", err.Error())
+	_, err = c.PfsAPIClient.RevokeShareLink(ctx, &pfs.RevokeShareLinkRequest{Token: resp.Token})
+	require.YesError(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestStorageUsage(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	env := realenv.NewRealEnv(ctx, t, dockertestenv.NewTestDBConfig(t).PachConfigOption, func(c *pachconfig.Configuration) {
+		c.PachdSpecificConfiguration.StorageUsagePeriod = 1
+	})
+	pachClient := env.PachClient
+
+	// Repo a has two commits, and repo b has one that writes the same content as a's first, so
+	// that the chunk holding it is shared between the repos.
+	shared := random.String(units.MB)
+	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, "a"))
+	require.NoError(t, pachClient.CreateRepo(pfs.DefaultProjectName, "b"))
+	commit1, err := pachClient.StartCommit(pfs.DefaultProjectName, "a", "master")
+	require.NoError(t, err)
+	require.NoError(t, pachClient.PutFile(commit1, "one", strings.NewReader(shared)))
+	require.NoError(t, finishCommit(pachClient, "a", "master", ""))
+	commit2, err := pachClient.StartCommit(pfs.DefaultProjectName, "a", "master")
+	require.NoError(t, err)
+	require.NoError(t, pachClient.PutFile(commit2, "two", strings.NewReader(random.String(units.MB))))
+	require.NoError(t, finishCommit(pachClient, "a", "master", ""))
+	commitB, err := pachClient.StartCommit(pfs.DefaultProjectName, "b", "master")
+	require.NoError(t, err)
+	require.NoError(t, pachClient.PutFile(commitB, "one", strings.NewReader(shared)))
+	require.NoError(t, finishCommit(pachClient, "b", "master", ""))
+
+	usage := func(repo string) (repoUsage, branchUsage *pfs.StorageUsage, commitUsage map[string]*pfs.StorageUsage, _ error) {
+		c, err := pachClient.PfsAPIClient.StorageUsage(pachClient.Ctx(), &pfs.StorageUsageRequest{
+			Repo:    client.NewRepo(pfs.DefaultProjectName, repo),
+			Commits: true,
+		})
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "StorageUsage")
+		}
+		resps, err := grpcutil.Collect[*pfs.StorageUsageResponse](c, 1000)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "collect")
+		}
+		commitUsage = make(map[string]*pfs.StorageUsage)
+		for _, resp := range resps {
+			switch {
+			case resp.GetRepo() != nil:
+				repoUsage = resp.Usage
+			case resp.GetBranch() != nil:
+				branchUsage = resp.Usage
+			case resp.GetCommit() != nil:
+				commitUsage[resp.GetCommit().Id] = resp.Usage
+			}
+		}
+		if repoUsage == nil || branchUsage == nil {
+			return nil, nil, nil, errors.Errorf("repo %s not accounted yet", repo)
+		}
+		return repoUsage, branchUsage, commitUsage, nil
+	}
+	var repoA, branchA, repoB *pfs.StorageUsage
+	var commitsA map[string]*pfs.StorageUsage
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		var err error
+		if repoA, branchA, commitsA, err = usage("a"); err != nil {
+			return err
+		}
+		if len(commitsA) != 2 {
+			return errors.Errorf("got usage of %d commits of repo a, want 2", len(commitsA))
+		}
+		repoB, _, _, err = usage("b")
+		return err
+	})
+
+	// Repo a's head commit refers to both files, and its first commit only to the shared one.
+	require.True(t, repoA.TotalBytes > 0)
+	require.Equal(t, repoA.TotalBytes, branchA.TotalBytes)
+	require.True(t, commitsA[commit1.Id].TotalBytes < commitsA[commit2.Id].TotalBytes)
+	// The shared content counts towards both repos but is unique to neither.
+	require.True(t, repoB.TotalBytes > 0)
+	require.True(t, repoB.UniqueBytes < repoB.TotalBytes)
+	require.True(t, repoA.UniqueBytes < repoA.TotalBytes)
+	require.Equal(t, repoA.TotalBytes-repoA.UniqueBytes, repoA.SharedBytes)
+}
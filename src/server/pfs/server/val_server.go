@@ -199,6 +199,17 @@ func (a *validatedAPIServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.
 	return a.apiServer.GetFileTAR(request, server)
 }
 
+// CreateShareLink implements the protobuf pfs.CreateShareLink RPC
+func (a *validatedAPIServer) CreateShareLink(ctx context.Context, request *pfs.CreateShareLinkRequest) (*pfs.CreateShareLinkResponse, error) {
+	if err := validateFile(request.File); err != nil {
+		return nil, err
+	}
+	if err := a.auth.CheckRepoIsAuthorized(ctx, request.File.Commit.Repo, auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return a.apiServer.CreateShareLink(ctx, request)
+}
+
 func (a *validatedAPIServer) CreateBranchInTransaction(ctx context.Context, txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
 	if request.Head != nil {
 		if err := checkCommit(request.Head); err != nil {
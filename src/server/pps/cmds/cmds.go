@@ -47,6 +47,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	"github.com/pachyderm/pachyderm/v2/src/server/pps/pretty"
 	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	workerapi "github.com/pachyderm/pachyderm/v2/src/worker"
 )
@@ -689,6 +690,7 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 		worker      bool
 		follow      bool
 		tail        int64
+		persisted   bool
 	)
 
 	// prettyLogsPrinter helps to print the logs recieved in different colours
@@ -727,12 +729,14 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 			"\t- To filter your logs by the worker process, use the `--worker` flag \n" +
 			"\t- To follow the logs as more are created, use the `--follow` flag \n" +
 			"\t- To set the number of lines to return, use the `--tail` flag \n" +
-			"\t- To return results starting from a certain amount of time before now, use the `--since` flag \n",
+			"\t- To return results starting from a certain amount of time before now, use the `--since` flag \n" +
+			"\t- To return the user code logs that were persisted with the job's output, use the `--persisted` flag with the `--job` flag; datums restored from the datum cache include the logs of the run that produced their output, if it persisted them \n",
 		Example: "\t- {{alias}} --pipeline foo \n" +
 			"\t- {{alias}} --job foo@5f93d03b65fa421996185e53f7f8b1e4 \n" +
 			"\t- {{alias}} --job foo@5f93d03b65fa421996185e53f7f8b1e4 --tail 10 \n" +
 			"\t- {{alias}} --job foo@5f93d03b65fa421996185e53f7f8b1e4 --follow \n" +
 			"\t- {{alias}} --job foo@5f93d03b65fa421996185e53f7f8b1e4 --datum 7f3c[...] \n" +
+			"\t- {{alias}} --job foo@5f93d03b65fa421996185e53f7f8b1e4 --persisted \n" +
 			"\t- {{alias}} --pipeline foo --datum 7f3c[...] --master \n" +
 			"\t- {{alias}} --pipeline foo --datum 7f3c[...] --worker  \n" +
 			"\t- {{alias}} --pipeline foo --datum 7f3c[...] --master --tail 10  \n" +
@@ -767,6 +771,16 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 			if pipelineName != "" && jobStr != "" {
 				return errors.Errorf("only one of pipeline or job should be specified")
 			}
+			if persisted {
+				if jobStr == "" {
+					return errors.Errorf("--persisted requires --job")
+				}
+				job, err := cmdutil.ParseJob(project, jobStr)
+				if err != nil {
+					return err
+				}
+				return printPersistedLogs(client, job, datumID, os.Stdout)
+			}
 
 			var jobID string
 			if jobStr != "" {
@@ -812,6 +826,7 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	getLogs.Flags().Int64VarP(&tail, "tail", "t", 0, "Set the number of lines to return of the most recent logs.")
 	getLogs.Flags().StringVar(&since, "since", "24h", "Specify results should return log messages more recent than \"since\".")
 	getLogs.Flags().StringVar(&project, "project", project, "Specify the project (by name) containing parent pipeline for the job.")
+	getLogs.Flags().BoolVar(&persisted, "persisted", false, "Return the user code logs stored in the job's meta commit, for pipelines that persist datum logs, instead of querying the log backend.")
 	shell.RegisterCompletionFunc(getLogs,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "--pipeline" || flag == "-p" {
@@ -1816,6 +1831,114 @@ func Cmds(pachCtx *config.Context, pachctlCfg *pachctl.Config) []*cobra.Command
 	updateDefaults.Flags().StringVar(&project, "project", project, "Update project defaults.")
 	commands = append(commands, cmdutil.CreateAliases(updateDefaults, "update defaults", "default"))
 
+	quotaDocs := &cobra.Command{
+		Short: "Docs for project quotas.",
+		Long: "Project quotas limit the number of pipelines in a project, the number of workers and " +
+			"the CPU, memory and GPUs they request, and the number of jobs that may run at once. " +
+			"Creating a pipeline that doesn't fit in its project's quota fails, and pipelines that " +
+			"autoscale only scale up as far as the quota allows.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(quotaDocs, "quota", " quota$", "quotas"))
+
+	inspectQuota := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Return a project's quota and usage.",
+		Long:  "This command returns a project's quota and how much of each resource the project is using.",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, client, "close client")
+			resp, err := client.PpsAPIClient.GetProjectQuota(client.Ctx(), &pps.GetProjectQuotaRequest{Project: &pfs.Project{Name: args[0]}})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ProjectQuotaHeader)
+			pretty.PrintProjectQuota(writer, resp)
+			return writer.Flush()
+		}),
+	}
+	inspectQuota.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAliases(inspectQuota, "inspect quota", "quotas"))
+
+	var quota pps.ProjectQuota
+	updateQuota := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Update a project's quota.",
+		Long: "This command updates the limits of a project's quota that are passed as flags, leaving the " +
+			"others unchanged.  A limit of 0 (or \"\" for --cpu and --memory) removes that limit.",
+		Example: "\t- {{alias}} foo --pipelines 10 --workers 20\n" +
+			"\t- {{alias}} foo --cpu 16 --memory 64Gi --gpus 2\n" +
+			"\t- {{alias}} foo --jobs 0",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, client, "close client")
+			project := &pfs.Project{Name: args[0]}
+			resp, err := client.PpsAPIClient.GetProjectQuota(client.Ctx(), &pps.GetProjectQuotaRequest{Project: project})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			newQuota := resp.GetQuota()
+			if newQuota == nil {
+				newQuota = &pps.ProjectQuota{}
+			}
+			flags := cmd.Flags()
+			if flags.Changed("pipelines") {
+				newQuota.Pipelines = quota.Pipelines
+			}
+			if flags.Changed("workers") {
+				newQuota.Workers = quota.Workers
+			}
+			if flags.Changed("cpu") {
+				newQuota.Cpu = quota.Cpu
+			}
+			if flags.Changed("memory") {
+				newQuota.Memory = quota.Memory
+			}
+			if flags.Changed("gpus") {
+				newQuota.Gpus = quota.Gpus
+			}
+			if flags.Changed("jobs") {
+				newQuota.ConcurrentJobs = quota.ConcurrentJobs
+			}
+			_, err = client.PpsAPIClient.SetProjectQuota(client.Ctx(), &pps.SetProjectQuotaRequest{
+				Project: project,
+				Quota:   newQuota,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateQuota.Flags().Int64Var(&quota.Pipelines, "pipelines", 0, "The maximum number of pipelines in the project.")
+	updateQuota.Flags().Int64Var(&quota.Workers, "workers", 0, "The maximum number of workers across the project's pipelines.")
+	updateQuota.Flags().StringVar(&quota.Cpu, "cpu", "", "The maximum number of CPUs requested by the project's workers (e.g. 4 or 500m).")
+	updateQuota.Flags().StringVar(&quota.Memory, "memory", "", "The maximum amount of memory requested by the project's workers (e.g. 16Gi).")
+	updateQuota.Flags().Int64Var(&quota.Gpus, "gpus", 0, "The maximum number of GPUs requested by the project's workers.")
+	updateQuota.Flags().Int64Var(&quota.ConcurrentJobs, "jobs", 0, "The maximum number of jobs that may run at once in the project.")
+	commands = append(commands, cmdutil.CreateAliases(updateQuota, "update quota", "quotas"))
+
+	deleteQuota := &cobra.Command{
+		Use:   "{{alias}} <project>",
+		Short: "Delete a project's quota.",
+		Long:  "This command removes all of the limits of a project's quota.",
+		Run: cmdutil.RunFixedArgs(1, func(cmd *cobra.Command, args []string) (retErr error) {
+			client, err := pachctlCfg.NewOnUserMachine(cmd.Context(), false)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, client, "close client")
+			_, err = client.PpsAPIClient.SetProjectQuota(client.Ctx(), &pps.SetProjectQuotaRequest{Project: &pfs.Project{Name: args[0]}})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteQuota, "delete quota", "quotas"))
+
 	return commands
 }
 
@@ -1935,6 +2058,36 @@ func fileIndicatorToReadCloser(indicator string) (io.ReadCloser, error) {
 	return f, nil
 }
 
+// printPersistedLogs writes the user code logs of a job's datums, which the pipeline stored in the
+// job's meta commit, to w.  If datumID isn't set, each line is prefixed with the ID of its datum.
+func printPersistedLogs(c *pachdclient.APIClient, job *pps.Job, datumID string, w io.Writer) error {
+	jobInfo, err := c.InspectJob(job.Pipeline.Project.GetName(), job.Pipeline.Name, job.Id, false)
+	if err != nil {
+		return errors.Wrap(err, "inspect job")
+	}
+	metaCommit := ppsutil.MetaCommit(jobInfo.OutputCommit)
+	if datumID != "" {
+		return errors.Wrapf(c.GetFile(metaCommit, common.LogsFilePath(datumID), w), "get logs of datum %s", datumID)
+	}
+	return errors.Wrap(c.GlobFile(metaCommit, common.LogsFilePath("*"), func(fi *pfs.FileInfo) error {
+		// The logs of datum <id> are at /meta/<id>/logs.
+		id := filepath.Base(filepath.Dir(fi.File.Path))
+		var buf bytes.Buffer
+		if err := c.GetFile(metaCommit, fi.File.Path, &buf); err != nil {
+			return errors.Wrapf(err, "get logs of datum %s", id)
+		}
+		// Lines aren't limited in length, so they're split by hand rather than
+		// with a bufio.Scanner.
+		for buf.Len() > 0 {
+			line, _ := buf.ReadString('\n') // the only possible error is io.EOF
+			if _, err := fmt.Fprintf(w, "%s %s\n", id, strings.TrimSuffix(line, "\n")); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}), "glob datum logs")
+}
+
 func evaluateJsonnetTemplate(client *pachdclient.APIClient, jsonnetPath string, jsonnetArgs []string) (_ []byte, retErr error) {
 	r, err := fileIndicatorToReadCloser(jsonnetPath)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"strings"
 	"text/template"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
@@ -40,6 +41,8 @@ const (
 	KubeEventsHeader = "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\t\n"
 	// CheckStatusHeader is the header for pipeline check statuse calls
 	CheckStatusHeader = "PROJECT\tPIPELINE\tALERT\t\n"
+	// ProjectQuotaHeader is the header for project quotas
+	ProjectQuotaHeader = "RESOURCE\tUSAGE\tQUOTA\t\n"
 )
 
 func safeTrim(s string, l int) string {
@@ -286,6 +289,14 @@ Data Uploaded: {{prettySize .Stats.UploadBytes}}
 Download Time: {{prettyDuration .Stats.DownloadTime}}
 Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
+CPU Time: {{prettyDuration .Stats.CpuTime}}
+Peak Memory: {{prettySize .Stats.PeakMemoryBytes}}
+Data Read: {{prettySize .Stats.ReadBytes}}
+Data Written: {{prettySize .Stats.WriteBytes}}
+{{- if .AggregateStats }}
+Datum Process Time: {{aggregateDuration .AggregateStats.ProcessTime}}
+Datum CPU Time: {{aggregateDuration .AggregateStats.CpuTime}}
+Datum Peak Memory: {{aggregateSize .AggregateStats.PeakMemoryBytes}} {{end}}
 Datum Timeout: {{.Details.DatumTimeout}}
 Job Timeout: {{.Details.JobTimeout}}
 Worker Status:
@@ -456,12 +467,23 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	fmt.Fprintf(w, "Download Time\t%s\n", datumInfo.Stats.DownloadTime.AsDuration())
 	fmt.Fprintf(w, "Process Time\t%s\n", datumInfo.Stats.ProcessTime.AsDuration())
 	fmt.Fprintf(w, "Upload Time\t%s\n", datumInfo.Stats.UploadTime.AsDuration())
+	fmt.Fprintf(w, "CPU Time\t%s\n", datumInfo.Stats.CpuTime.AsDuration())
+	fmt.Fprintf(w, "Peak Memory\t%s\n", pretty.Size(datumInfo.Stats.PeakMemoryBytes))
+	fmt.Fprintf(w, "Data Read\t%s\n", pretty.Size(datumInfo.Stats.ReadBytes))
+	fmt.Fprintf(w, "Data Written\t%s\n", pretty.Size(datumInfo.Stats.WriteBytes))
 
 	fmt.Fprintf(w, "PFS State:\n")
 	tw := ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
 	PrintFileHeader(tw)
 	PrintFile(tw, datumInfo.PfsState)
 	tw.Flush()
+	if datumInfo.Logs != nil {
+		fmt.Fprintf(w, "Logs:\n")
+		tw = ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
+		PrintFileHeader(tw)
+		PrintFile(tw, datumInfo.Logs)
+		tw.Flush()
+	}
 	fmt.Fprintf(w, "Inputs:\n")
 	tw = ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
 	PrintFileHeader(tw)
@@ -476,6 +498,30 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintProjectQuota pretty-prints a project's quota and its usage of it.
+// Resources without a limit are shown as unlimited.
+func PrintProjectQuota(w io.Writer, resp *ppsclient.GetProjectQuotaResponse) {
+	quota, usage := resp.GetQuota(), resp.GetUsage()
+	limit := func(n int64) string {
+		if n <= 0 {
+			return "unlimited"
+		}
+		return fmt.Sprint(n)
+	}
+	quantity := func(q string) string {
+		if q == "" {
+			return "unlimited"
+		}
+		return q
+	}
+	fmt.Fprintf(w, "pipelines\t%d\t%s\t\n", usage.GetPipelines(), limit(quota.GetPipelines()))
+	fmt.Fprintf(w, "workers\t%d\t%s\t\n", usage.GetWorkers(), limit(quota.GetWorkers()))
+	fmt.Fprintf(w, "cpu\t%s\t%s\t\n", usage.GetCpu(), quantity(quota.GetCpu()))
+	fmt.Fprintf(w, "memory\t%s\t%s\t\n", usage.GetMemory(), quantity(quota.GetMemory()))
+	fmt.Fprintf(w, "gpus\t%d\t%s\t\n", usage.GetGpus(), limit(quota.GetGpus()))
+	fmt.Fprintf(w, "concurrent jobs\t%d\t%s\t\n", usage.GetConcurrentJobs(), limit(quota.GetConcurrentJobs()))
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
@@ -653,6 +699,17 @@ func js(prefix, indent, s string) (string, error) {
 	return buf.String(), nil
 }
 
+func aggregateDuration(a *ppsclient.Aggregate) string {
+	seconds := func(s float64) time.Duration {
+		return time.Duration(s * float64(time.Second)).Round(time.Millisecond)
+	}
+	return fmt.Sprintf("mean %s, 95th percentile %s", seconds(a.GetMean()), seconds(a.GetNinetyFifthPercentile()))
+}
+
+func aggregateSize(a *ppsclient.Aggregate) string {
+	return fmt.Sprintf("mean %s, 95th percentile %s", pretty.Size(int64(a.GetMean())), pretty.Size(int64(a.GetNinetyFifthPercentile())))
+}
+
 var funcMap = template.FuncMap{
 	"pipelineState":        pipelineState,
 	"jobState":             JobState,
@@ -660,6 +717,8 @@ var funcMap = template.FuncMap{
 	"workerStatus":         workerStatus,
 	"pipelineInput":        pipelineInput,
 	"jobInput":             jobInput,
+	"aggregateDuration":    aggregateDuration,
+	"aggregateSize":        aggregateSize,
 	"prettyAgo":            pretty.Ago,
 	"prettyDuration":       pretty.Duration,
 	"prettySize":           pretty.Size,
@@ -135,6 +135,7 @@ type apiServer struct {
 	jobs            col.PostgresCollection
 	clusterDefaults col.PostgresCollection
 	projectDefaults col.PostgresCollection
+	projectQuotas   col.PostgresCollection
 }
 
 func (a *apiServer) validateInput(pipeline *pps.Pipeline, input *pps.Input) error {
@@ -452,6 +453,7 @@ func (a *apiServer) UpdateJobStateInTransaction(ctx context.Context, txnCtx *txn
 	jobInfo.DataRecovered = request.DataRecovered
 	jobInfo.DataTotal = request.DataTotal
 	jobInfo.Stats = request.Stats
+	jobInfo.AggregateStats = request.AggregateStats
 
 	return ppsutil.UpdateJobState(ctx, a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, request.State, request.Reason)
 }
@@ -1051,6 +1053,15 @@ func (a *apiServer) InspectDatum(ctx context.Context, request *pps.InspectDatumR
 	if response == nil {
 		return nil, errors.Errorf("datum %s not found in job %s", request.Datum.Id, request.Datum.Job)
 	}
+	logs := &pfs.File{
+		Commit: response.PfsState.Commit,
+		Path:   "/" + common.LogsFilePath(request.Datum.Id),
+	}
+	if _, err := a.env.GetPachClient(ctx).InspectFile(logs.Commit, logs.Path); err == nil {
+		response.Logs = logs
+	} else if !errutil.IsNotFoundError(err) {
+		return nil, errors.Wrap(err, "inspect datum logs")
+	}
 	return response, nil
 }
 
@@ -1882,6 +1893,19 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.Transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
 	}
+	if request.Transform.DatumCache {
+		switch {
+		case request.Transform.DatumBatching:
+			return errors.New("the datum cache is not supported with datum batching")
+		case request.S3Out:
+			return errors.New("the datum cache is not supported with s3 output")
+		case request.Service != nil || request.Spout != nil:
+			return errors.New("the datum cache is not supported in spouts or services")
+		}
+	}
+	if request.PersistDatumLogs && request.Transform.DatumBatching {
+		return errors.New("persisting datum logs is not supported with datum batching")
+	}
 	if request.ReprocessSpec != "" &&
 		request.ReprocessSpec != client.ReprocessSpecUntilSuccess &&
 		request.ReprocessSpec != client.ReprocessSpecEveryJob {
@@ -2375,6 +2399,9 @@ func (a *apiServer) createPipeline(ctx context.Context, req *pps.CreatePipelineV
 	if err := a.validateEnterpriseChecks(ctx, effectiveSpec); err != nil {
 		return "", err
 	}
+	if err := a.validateProjectQuota(ctx, effectiveSpec); err != nil {
+		return "", err
+	}
 
 	if err := a.validateSecret(ctx, effectiveSpec); err != nil {
 		return "", err
@@ -2492,6 +2519,7 @@ func (a *apiServer) initializePipelineInfo(txn *pps.CreatePipelineTransaction, o
 			Tolerations:             request.Tolerations,
 			Determined:              request.Determined,
 			MaximumExpectedUptime:   request.MaximumExpectedUptime,
+			PersistDatumLogs:        request.PersistDatumLogs,
 		},
 		UserSpecJson:      txn.UserJson,
 		EffectiveSpecJson: txn.EffectiveJson,
@@ -4230,6 +4258,7 @@ func (a *apiServer) PipelinesSummary(ctx context.Context, req *pps.PipelinesSumm
 		projectsMap[p.String()] = struct{}{}
 	}
 	summaries := make(map[string]*pps.PipelinesSummary)
+	pipelines := make(map[string][]*pps.Pipeline)
 	resp := &pps.PipelinesSummaryResponse{}
 	if err := ppsutil.ListPipelineInfo(ctx, a.pipelines, nil, 0, func(pi *pps.PipelineInfo) error {
 		project := pi.Pipeline.Project
@@ -4243,6 +4272,7 @@ func (a *apiServer) PipelinesSummary(ctx context.Context, req *pps.PipelinesSumm
 				return nil
 			}
 		}
+		pipelines[project.String()] = append(pipelines[project.String()], pi.Pipeline)
 		if pi.State == pps.PipelineState_PIPELINE_FAILURE || pi.State == pps.PipelineState_PIPELINE_CRASHING {
 			summary.FailedPipelines++
 		} else if pi.State == pps.PipelineState_PIPELINE_PAUSED {
@@ -4262,10 +4292,34 @@ func (a *apiServer) PipelinesSummary(ctx context.Context, req *pps.PipelinesSumm
 	}); err != nil {
 		return nil, err
 	}
+	// Quota usage is counted from the pipelines listed above.  Problems reading quotas, jobs or
+	// workers are logged rather than failing the summary.
+	var rcs *v1.ReplicationControllerList
 	for _, p := range projects {
-		if summary, ok := summaries[p.String()]; ok {
-			resp.Summaries = append(resp.Summaries, summary)
+		summary, ok := summaries[p.String()]
+		if !ok {
+			continue
+		}
+		resp.Summaries = append(resp.Summaries, summary)
+		quota, err := getProjectQuota(ctx, a.projectQuotas, p)
+		if err != nil {
+			log.Info(ctx, "could not get project quota for pipeline summary", zap.String("project", p.String()), zap.Error(err))
+			continue
+		}
+		usage := &enterpriselimits.Usage{Pipelines: int64(len(pipelines[p.String()]))}
+		if usage.ConcurrentJobs, err = countRunningJobs(ctx, a.jobs, pipelines[p.String()]); err != nil {
+			log.Info(ctx, "could not count running jobs for pipeline summary", zap.String("project", p.String()), zap.Error(err))
+			continue
+		}
+		if rcs == nil {
+			if rcs, err = newKubeDriver(a.env.KubeClient, a.env.Config).ListReplicationControllers(ctx); err != nil {
+				log.Info(ctx, "could not list workers for pipeline summary", zap.Error(err))
+				rcs = &v1.ReplicationControllerList{}
+			}
 		}
+		usage.Add(rcWorkerUsage(rcs, p, nil))
+		summary.Quota = quota
+		summary.QuotaUsage = usage.ToProto()
 	}
 	return resp, nil
 }
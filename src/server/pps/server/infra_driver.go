@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -56,7 +57,7 @@ func newMockInfraDriver() *mockInfraDriver {
 
 func (d *mockInfraDriver) CreatePipelineResources(ctx context.Context, pi *pps.PipelineInfo) error {
 	key := toKey(pi.Pipeline)
-	d.rcs[key] = *d.makeRC(pi)
+	d.rcs[key] = *d.makeRC(ctx, pi)
 	d.incCall(key, mockInfraOp_CREATE)
 	if _, ok := d.scaleHistory[key]; !ok {
 		d.scaleHistory[key] = make([]int32, 0)
@@ -122,7 +123,20 @@ func (d *mockInfraDriver) resetRCs() {
 	d.rcs = make(map[pipelineKey]v1.ReplicationController)
 }
 
-func (d *mockInfraDriver) makeRC(pi *pps.PipelineInfo) *v1.ReplicationController {
+func (d *mockInfraDriver) makeRC(ctx context.Context, pi *pps.PipelineInfo) *v1.ReplicationController {
+	// Like the kube driver, the user container gets the pipeline's resource
+	// requests and limits, so that quotas can count them.
+	user := v1.Container{Name: client.PPSWorkerUserContainerName}
+	if r := pi.Details.GetResourceRequests(); r != nil {
+		if rl, err := ppsutil.GetLimitsResourceList(ctx, r); err == nil {
+			user.Resources.Requests = *rl
+		}
+	}
+	if l := pi.Details.GetResourceLimits(); l != nil {
+		if rl, err := ppsutil.GetLimitsResourceList(ctx, l); err == nil {
+			user.Resources.Limits = *rl
+		}
+	}
 	return &v1.ReplicationController{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: ppsutil.PipelineRcName(pi),
@@ -134,6 +148,11 @@ func (d *mockInfraDriver) makeRC(pi *pps.PipelineInfo) *v1.ReplicationController
 			},
 			Labels: pipelineLabels(pi.Pipeline.Project.GetName(), pi.Pipeline.Name, pi.Version),
 		},
+		Spec: v1.ReplicationControllerSpec{
+			Template: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{user}},
+			},
+		},
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pctx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	enterpriselimits "github.com/pachyderm/pachyderm/v2/src/server/enterprise/limits"
 )
 
 func TestAPIServer_validatePipelineRequest(t *testing.T) {
@@ -35,6 +36,35 @@ func TestAPIServer_validatePipelineRequest(t *testing.T) {
 	err = a.validatePipelineRequest(request)
 	require.YesError(t, err)
 	require.ErrorContains(t, err, fmt.Sprintf("is %d characters longer than the %d max", len(k8sName)-dnsLabelLimit, dnsLabelLimit))
+
+	request.Pipeline.Name = "logs"
+	request.Transform = &pps.Transform{DatumBatching: true}
+	request.PersistDatumLogs = true
+	err = a.validatePipelineRequest(request)
+	require.YesError(t, err)
+	require.ErrorContains(t, err, "persisting datum logs is not supported with datum batching")
+	request.Transform.DatumBatching = false
+	require.NoError(t, a.validatePipelineRequest(request))
+}
+
+func TestWorkerRequestsCountsLimits(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	a := new(apiServer)
+	// A pipeline that only sets limits requests its limits.
+	requests, err := a.workerRequests(ctx, &pps.CreatePipelineRequest{
+		ResourceRequests: &pps.ResourceSpec{Cpu: 1},
+		ResourceLimits: &pps.ResourceSpec{
+			Cpu:    2,
+			Memory: "1G",
+			Gpu:    &pps.GPUSpec{Type: "nvidia.com/gpu", Number: 1},
+		},
+	})
+	require.NoError(t, err)
+	usage := enterpriselimits.WorkerUsage(3, requests)
+	require.Equal(t, int64(3000), usage.CPU.MilliValue())
+	require.Equal(t, int64(3e9), usage.Memory.Value())
+	require.Equal(t, int64(3), usage.GPUs)
+	require.YesError(t, enterpriselimits.CheckQuota(&pfs.Project{Name: pfs.DefaultProjectName}, &pps.ProjectQuota{Gpus: 2}, usage))
 }
 
 func TestNewMessageFilterFunc(t *testing.T) {
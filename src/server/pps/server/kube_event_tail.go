@@ -11,7 +11,6 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
-	etcd "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	v1 "k8s.io/api/core/v1"
@@ -20,7 +19,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
-func kubeEventTail(ctx context.Context, coreV1 corev1.CoreV1Interface, namespace string, etcdPrefix string, etcdClient *etcd.Client) {
+func kubeEventTail(ctx context.Context, coreV1 corev1.CoreV1Interface, namespace string, etcdPrefix string, newDLock func(string) dlock.DLock) {
 	// kubernetes's fake ClientSet used in our unit tests doesn't support the RESTClient.
 	// in unit tests we don't run kube event tail.
 	if coreV1.RESTClient() == nil || reflect.ValueOf(coreV1.RESTClient()).IsNil() {
@@ -28,7 +27,7 @@ func kubeEventTail(ctx context.Context, coreV1 corev1.CoreV1Interface, namespace
 		return
 	}
 	backoff.RetryUntilCancel(ctx, func() (retErr error) {
-		lock := dlock.NewDLock(etcdClient, path.Join(etcdPrefix, "pachd-kube-events"))
+		lock := newDLock(path.Join(etcdPrefix, "pachd-kube-events"))
 		ctx, err := lock.Lock(ctx)
 		if err != nil {
 			return errors.Wrap(err, "locking pachd-kube-events lock")
@@ -13,7 +13,6 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/collection"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	middleware_auth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
@@ -138,7 +137,7 @@ func newMaster(ctx context.Context, env Env, etcdPrefix string, kd InfraDriver,
 // The master process is responsible for creating/deleting workers as
 // pipelines are created/removed.
 func (a *apiServer) master(ctx context.Context) {
-	masterLock := dlock.NewDLock(a.env.EtcdClient, path.Join(a.etcdPrefix, masterLockPath))
+	masterLock := a.env.NewDLock(path.Join(a.etcdPrefix, masterLockPath))
 	backoff.RetryUntilCancel(ctx, func() (retErr error) {
 		ctx, cancel := pctx.WithCancel(pctx.Child(ctx, "master", pctx.WithServerID()))
 		// set internal auth for basic operations
@@ -151,7 +150,7 @@ func (a *apiServer) master(ctx context.Context) {
 		defer errors.Invoke1(&retErr, masterLock.Unlock, ctx, "unlock master lock")
 		log.Info(ctx, "PPS master: launching master process")
 		kd := newKubeDriver(a.env.KubeClient, a.env.Config)
-		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.txnEnv, a.env.PFSServer)
+		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.jobs, a.projectQuotas, a.txnEnv, a.env.PFSServer)
 		go gcDetUsers(ctx, a.getDetConfig(), time.Minute, sd, a.env.KubeClient.CoreV1().Secrets(a.namespace))
 		m := newMaster(ctx, a.env, a.etcdPrefix, kd, sd)
 		m.run()
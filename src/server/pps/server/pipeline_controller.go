@@ -604,6 +604,17 @@ func (pc *pipelineController) scaleUpPipeline(ctx context.Context, pi *pps.Pipel
 	if pi.Details.ParallelismSpec != nil && pi.Details.ParallelismSpec.Constant > 0 {
 		maxScale = int32(pi.Details.ParallelismSpec.Constant)
 	}
+	var oldScale int32
+	if oldRC.Spec.Replicas != nil && *oldRC.Spec.Replicas > 0 {
+		oldScale = *oldRC.Spec.Replicas
+	}
+	// Limit the scale to what the project's quota allows.  If the quota can't
+	// be read, leave the pipeline unlimited rather than stalling it; new
+	// pipelines are still checked against the quota when they're created.
+	quotaScale, err := pc.quotaScale(ctx, pi, oldRC, oldScale, maxScale)
+	if err != nil {
+		log.Info(ctx, "could not check project quota", zap.Error(err))
+	}
 	// update pipeline RC
 	return errors.EnsureStack(pc.iDriver.UpdateReplicationController(ctx, oldRC, func(rc *v1.ReplicationController) bool {
 		var curScale int32
@@ -640,6 +651,13 @@ func (pc *pipelineController) scaleUpPipeline(ctx context.Context, pi *pps.Pipel
 				return maxScale
 			}
 		}()
+		// The quota never causes running workers to be removed.
+		if targetScale > quotaScale && targetScale > curScale {
+			targetScale = quotaScale
+			if targetScale < curScale {
+				targetScale = curScale
+			}
+		}
 		if targetScale < maxScale {
 			// schedule another step in scaleUpInterval, to check the tasks again
 			go func() {
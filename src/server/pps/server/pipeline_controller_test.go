@@ -244,6 +244,91 @@ func TestAutoscalingBasic(t *testing.T) {
 	require.Equal(t, 1, infraDriver.calls[toKey(pipeline)][mockInfraOp_CREATE])
 }
 
+func waitForScale(t *testing.T, infraDriver *mockInfraDriver, pipeline *pps.Pipeline, scale int32) {
+	require.NoErrorWithinT(t, 10*time.Second, func() error {
+		return backoff.Retry(func() error {
+			history := infraDriver.scaleHistory[toKey(pipeline)]
+			if len(history) > 0 && history[len(history)-1] == scale {
+				return nil
+			}
+			return errors.Errorf("waiting for pipeline %v to scale to %d (history: %v)", pipeline, scale, history)
+		}, backoff.NewTestingBackOff())
+	})
+}
+
+func TestProjectQuotaLimitsScale(t *testing.T) {
+	stateDriver, infraDriver, _ := ppsMasterHandles(t, 0)
+	stateDriver.quotas[pfs.DefaultProjectName] = &pps.ProjectQuota{Workers: 5}
+	first := client.NewPipeline(pfs.DefaultProjectName, uuid.UniqueString(t.Name()))
+	stateDriver.upsertPipeline(&pps.PipelineInfo{
+		Pipeline: first,
+		State:    pps.PipelineState_PIPELINE_STARTING,
+		Details: &pps.PipelineInfo_Details{
+			ParallelismSpec: &pps.ParallelismSpec{Constant: 3},
+		},
+		Version: 1,
+	})
+	waitForScale(t, infraDriver, first, 3)
+	second := client.NewPipeline(pfs.DefaultProjectName, uuid.UniqueString(t.Name()))
+	stateDriver.upsertPipeline(&pps.PipelineInfo{
+		Pipeline: second,
+		State:    pps.PipelineState_PIPELINE_STARTING,
+		Details: &pps.PipelineInfo_Details{
+			ParallelismSpec: &pps.ParallelismSpec{Constant: 4},
+		},
+		Version: 1,
+	})
+	// Only two of the second pipeline's workers fit in the quota.
+	waitForScale(t, infraDriver, second, 2)
+}
+
+func TestProjectQuotaCountsLimits(t *testing.T) {
+	stateDriver, infraDriver, _ := ppsMasterHandles(t, 0)
+	stateDriver.quotas[pfs.DefaultProjectName] = &pps.ProjectQuota{Gpus: 2}
+	pipeline := client.NewPipeline(pfs.DefaultProjectName, uuid.UniqueString(t.Name()))
+	stateDriver.upsertPipeline(&pps.PipelineInfo{
+		Pipeline: pipeline,
+		State:    pps.PipelineState_PIPELINE_STARTING,
+		Details: &pps.PipelineInfo_Details{
+			ParallelismSpec: &pps.ParallelismSpec{Constant: 3},
+			// Only limits are set, so each worker requests a GPU at its limit.
+			ResourceLimits: &pps.ResourceSpec{Gpu: &pps.GPUSpec{Type: "nvidia.com/gpu", Number: 1}},
+		},
+		Version: 1,
+	})
+	waitForScale(t, infraDriver, pipeline, 2)
+}
+
+func TestProjectQuotaLimitsConcurrentJobs(t *testing.T) {
+	stateDriver, infraDriver, _ := ppsMasterHandles(t, 0)
+	stateDriver.quotas[pfs.DefaultProjectName] = &pps.ProjectQuota{ConcurrentJobs: 1}
+	// The project's other pipelines are already running as many jobs as the quota allows.
+	stateDriver.runningJobs.Store(1)
+	pipeline := client.NewPipeline(pfs.DefaultProjectName, uuid.UniqueString(t.Name()))
+	stateDriver.upsertPipeline(&pps.PipelineInfo{
+		Pipeline: pipeline,
+		State:    pps.PipelineState_PIPELINE_STARTING,
+		Details: &pps.PipelineInfo_Details{
+			ParallelismSpec: &pps.ParallelismSpec{Constant: 3},
+		},
+		Version: 1,
+	})
+	require.NoErrorWithinT(t, 10*time.Second, func() error {
+		return backoff.Retry(func() error {
+			if stateDriver.jobCounts.Load() < 2 {
+				return errors.New("waiting for the pipeline to check the project's running jobs twice")
+			}
+			return nil
+		}, backoff.NewTestingBackOff())
+	})
+	for _, scale := range infraDriver.scaleHistory[toKey(pipeline)] {
+		require.Equal(t, int32(0), scale, "pipeline must not start workers while its project is at its job quota")
+	}
+	// Once the other jobs finish, the pipeline starts its workers.
+	stateDriver.runningJobs.Store(0)
+	waitForScale(t, infraDriver, pipeline, 3)
+}
+
 func TestAutoscalingManyCommits(t *testing.T) {
 	stateDriver, infraDriver, mockPachd := ppsMasterHandles(t, 1)
 	pipelineName := uuid.UniqueString(t.Name())
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -43,25 +44,35 @@ type PipelineStateDriver interface {
 	// list all PipelineInfos
 	ListPipelineInfo(ctx context.Context, f func(*pps.PipelineInfo) error) error
 	GetPipelineInfo(ctx context.Context, pipeline *pps.Pipeline, version int) (*pps.PipelineInfo, error)
+	// returns the quota of a project, or nil if it doesn't have one
+	GetProjectQuota(ctx context.Context, project *pfs.Project) (*pps.ProjectQuota, error)
+	// counts the running jobs of a project's pipelines, other than exclude
+	CountRunningJobs(ctx context.Context, project *pfs.Project, exclude *pps.Pipeline) (int64, error)
 }
 
 type stateDriver struct {
-	db        *pachsql.DB
-	pipelines collection.PostgresCollection
-	txEnv     *txnenv.TransactionEnv
-	pfsApi    pfsserver.APIServer
+	db            *pachsql.DB
+	pipelines     collection.PostgresCollection
+	jobs          collection.PostgresCollection
+	projectQuotas collection.PostgresCollection
+	txEnv         *txnenv.TransactionEnv
+	pfsApi        pfsserver.APIServer
 }
 
 func newPipelineStateDriver(
 	db *pachsql.DB,
 	pipelines collection.PostgresCollection,
+	jobs collection.PostgresCollection,
+	projectQuotas collection.PostgresCollection,
 	txEnv *txnenv.TransactionEnv,
 	pfsApi pfsserver.APIServer) PipelineStateDriver {
 	return &stateDriver{
-		db:        db,
-		pipelines: pipelines,
-		txEnv:     txEnv,
-		pfsApi:    pfsApi,
+		db:            db,
+		pipelines:     pipelines,
+		jobs:          jobs,
+		projectQuotas: projectQuotas,
+		txEnv:         txEnv,
+		pfsApi:        pfsApi,
 	}
 }
 
@@ -133,6 +144,18 @@ func (sd *stateDriver) GetPipelineInfo(ctx context.Context, pipeline *pps.Pipeli
 	return &pipelineInfo, nil
 }
 
+func (sd *stateDriver) GetProjectQuota(ctx context.Context, project *pfs.Project) (*pps.ProjectQuota, error) {
+	return getProjectQuota(ctx, sd.projectQuotas, project)
+}
+
+func (sd *stateDriver) CountRunningJobs(ctx context.Context, project *pfs.Project, exclude *pps.Pipeline) (int64, error) {
+	usage, err := projectPipelineUsage(ctx, sd.pipelines, sd.jobs, project, exclude)
+	if err != nil {
+		return 0, err
+	}
+	return usage.ConcurrentJobs, nil
+}
+
 func (sd *stateDriver) tryLoadLatestPipelineInfo(ctx context.Context, pipeline *pps.Pipeline) (*pps.PipelineInfo, error) {
 	pi := &pps.PipelineInfo{}
 	errCnt := 0
@@ -169,6 +192,9 @@ type mockStateDriver struct {
 	pipelines   map[pipelineKey]string              // maps pipeline names to their latest spec commit IDs
 	specCommits map[string]*pps.PipelineInfo        // maps spec commit IDs to their pipeline Infos
 	states      map[pipelineKey][]pps.PipelineState // tracks all of the
+	quotas      map[string]*pps.ProjectQuota        // maps project names to their quotas
+	runningJobs atomic.Int64                        // returned by CountRunningJobs
+	jobCounts   atomic.Int64                        // counts calls to CountRunningJobs
 	eChan       chan *watch.Event
 	closeEChan  chan struct{} // supports closing eChan
 }
@@ -264,6 +290,15 @@ func (d *mockStateDriver) GetPipelineInfo(ctx context.Context, pipeline *pps.Pip
 	return nil, errors.New("not found")
 }
 
+func (d *mockStateDriver) GetProjectQuota(ctx context.Context, project *pfs.Project) (*pps.ProjectQuota, error) {
+	return d.quotas[project.GetName()], nil
+}
+
+func (d *mockStateDriver) CountRunningJobs(ctx context.Context, project *pfs.Project, exclude *pps.Pipeline) (int64, error) {
+	d.jobCounts.Add(1)
+	return d.runningJobs.Load(), nil
+}
+
 func (d *mockStateDriver) upsertPipeline(pi *pps.PipelineInfo) *pfs.Commit {
 	mockSpecCommit := client.NewCommit(pi.Pipeline.Project.GetName(), pi.Pipeline.Name, "master", uuid.NewWithoutDashes())
 	pi.SpecCommit = mockSpecCommit
@@ -293,6 +328,7 @@ func (d *mockStateDriver) reset() {
 	d.pipelines = make(map[pipelineKey]string)
 	d.specCommits = make(map[string]*pps.PipelineInfo)
 	d.states = make(map[pipelineKey][]pps.PipelineState)
+	d.quotas = make(map[string]*pps.ProjectQuota)
 }
 
 func (d *mockStateDriver) currentPipelineInfo(key pipelineKey) *pps.PipelineInfo {
@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	txncontext "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	enterpriselimits "github.com/pachyderm/pachyderm/v2/src/server/enterprise/limits"
+)
+
+// GetProjectQuota returns the quota of a project and its current usage.
+func (a *apiServer) GetProjectQuota(ctx context.Context, req *pps.GetProjectQuotaRequest) (*pps.GetProjectQuotaResponse, error) {
+	if req.Project == nil || req.Project.Name == "" {
+		req.Project = &pfs.Project{Name: pfs.DefaultProjectName}
+	}
+	// note: do not convert this to InspectProjectV2; it calls GetProjectQuota
+	if _, err := a.env.PFSServer.InspectProject(ctx, &pfs.InspectProjectRequest{Project: req.Project}); err != nil {
+		return nil, err
+	}
+	quota, err := getProjectQuota(ctx, a.projectQuotas, req.Project)
+	if err != nil {
+		return nil, unknownError(ctx, "could not read project quota", err)
+	}
+	rcs, err := newKubeDriver(a.env.KubeClient, a.env.Config).ListReplicationControllers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := projectPipelineUsage(ctx, a.pipelines, a.jobs, req.Project, nil)
+	if err != nil {
+		return nil, err
+	}
+	usage.Add(rcWorkerUsage(rcs, req.Project, nil))
+	return &pps.GetProjectQuotaResponse{
+		Quota: quota,
+		Usage: usage.ToProto(),
+	}, nil
+}
+
+// SetProjectQuota sets the quota of a project, or removes it if the quota in
+// the request is unset.  Setting a quota below a project's current usage
+// doesn't affect its existing pipelines or workers; it only stops new ones
+// from being created.
+func (a *apiServer) SetProjectQuota(ctx context.Context, req *pps.SetProjectQuotaRequest) (*pps.SetProjectQuotaResponse, error) {
+	if req.Project == nil || req.Project.Name == "" {
+		req.Project = &pfs.Project{Name: pfs.DefaultProjectName}
+	}
+	if err := enterpriselimits.ValidateQuota(req.Quota); err != nil {
+		return nil, badRequest(ctx, err.Error(), []*errdetails.BadRequest_FieldViolation{
+			{Field: "quota", Description: err.Error()},
+		})
+	}
+	if _, err := a.env.PFSServer.InspectProject(ctx, &pfs.InspectProjectRequest{Project: req.Project}); err != nil {
+		return nil, err
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(ctx context.Context, txnCtx *txncontext.TransactionContext) error {
+		quotas := a.projectQuotas.ReadWrite(txnCtx.SqlTx)
+		if req.Quota == nil {
+			if err := quotas.Delete(ctx, req.Project.String()); err != nil && !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			return nil
+		}
+		return errors.EnsureStack(quotas.Put(ctx, req.Project.String(), req.Quota))
+	}); err != nil {
+		return nil, err
+	}
+	return &pps.SetProjectQuotaResponse{}, nil
+}
+
+// validateProjectQuota checks that creating or updating the pipeline in req
+// would keep its project within its quota.  Autoscaling pipelines only need
+// to fit in the quota on their own, since the pipeline controller scales
+// them up as far as the quota allows; other pipelines run at full
+// parallelism, so they must also fit alongside the project's other workers.
+// Concurrent jobs are limited when pipelines scale up, not here.
+func (a *apiServer) validateProjectQuota(ctx context.Context, req *pps.CreatePipelineRequest) error {
+	project := req.Pipeline.Project
+	quota, err := getProjectQuota(ctx, a.projectQuotas, project)
+	if err != nil {
+		return errors.Wrap(err, "could not read project quota")
+	}
+	if quota == nil {
+		return nil
+	}
+	usage, err := projectPipelineUsage(ctx, a.pipelines, a.jobs, project, req.Pipeline)
+	if err != nil {
+		return err
+	}
+	usage.ConcurrentJobs = 0
+	if _, err := a.inspectPipeline(ctx, req.Pipeline, false); err == nil {
+		// Updating a pipeline doesn't change the number of pipelines, so
+		// people can update their pipelines even if they're over the quota.
+		usage.Pipelines = 0
+	} else if errutil.IsNotFoundError(err) {
+		usage.Pipelines++
+	} else {
+		return err
+	}
+	if !req.Autoscaling {
+		rcs, err := newKubeDriver(a.env.KubeClient, a.env.Config).ListReplicationControllers(ctx)
+		if err != nil {
+			return err
+		}
+		usage.Add(rcWorkerUsage(rcs, project, req.Pipeline))
+	}
+	requests, err := a.workerRequests(ctx, req)
+	if err != nil {
+		return err
+	}
+	workers := int64(1)
+	if req.ParallelismSpec.GetConstant() > 0 {
+		workers = int64(req.ParallelismSpec.Constant)
+	}
+	usage.Add(enterpriselimits.WorkerUsage(workers, requests))
+	return enterpriselimits.CheckQuota(project, quota, usage)
+}
+
+// workerRequests returns the resources that the user container of each of
+// the pipeline's workers will request, including the defaults that are
+// applied if the pipeline doesn't set any, and the limits of the resources it
+// doesn't request.
+func (a *apiServer) workerRequests(ctx context.Context, req *pps.CreatePipelineRequest) (v1.ResourceList, error) {
+	if req.ResourceRequests == nil && req.ResourceLimits == nil {
+		return v1.ResourceList{
+			v1.ResourceCPU:    a.env.Config.PipelineDefaultCPURequest,
+			v1.ResourceMemory: a.env.Config.PipelineDefaultMemoryRequest,
+		}, nil
+	}
+	var requests, limits v1.ResourceList
+	if req.ResourceRequests != nil {
+		rl, err := ppsutil.GetLimitsResourceList(ctx, req.ResourceRequests)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine resource request")
+		}
+		requests = *rl
+	}
+	if req.ResourceLimits != nil {
+		rl, err := ppsutil.GetLimitsResourceList(ctx, req.ResourceLimits)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine resource limit")
+		}
+		limits = *rl
+	}
+	return effectiveRequests(requests, limits), nil
+}
+
+// effectiveRequests returns the resources that a container with requests and
+// limits is counted as requesting.  As in Kubernetes, a resource that has a
+// limit but no request is requested at its limit.
+func effectiveRequests(requests, limits v1.ResourceList) v1.ResourceList {
+	if len(limits) == 0 {
+		return requests
+	}
+	result := make(v1.ResourceList, len(requests)+len(limits))
+	for name, q := range requests {
+		result[name] = q
+	}
+	for name, q := range limits {
+		if _, ok := result[name]; !ok {
+			result[name] = q
+		}
+	}
+	return result
+}
+
+// getProjectQuota returns the quota of a project, or nil if it doesn't have
+// one.
+func getProjectQuota(ctx context.Context, quotas col.PostgresCollection, project *pfs.Project) (*pps.ProjectQuota, error) {
+	quota := &pps.ProjectQuota{}
+	if err := quotas.ReadOnly().Get(ctx, project.String(), quota); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return quota, nil
+}
+
+// projectPipelineUsage counts the pipelines in a project and their running
+// jobs, leaving out the pipeline exclude, if it's set.
+func projectPipelineUsage(ctx context.Context, pipelines, jobs col.PostgresCollection, project *pfs.Project, exclude *pps.Pipeline) (*enterpriselimits.Usage, error) {
+	usage := &enterpriselimits.Usage{}
+	var toCount []*pps.Pipeline
+	if err := ppsutil.ListPipelineInfo(ctx, pipelines, nil, 0, func(pi *pps.PipelineInfo) error {
+		if pi.Pipeline.Project.String() != project.String() {
+			return nil
+		}
+		if exclude != nil && pi.Pipeline.String() == exclude.String() {
+			return nil
+		}
+		usage.Pipelines++
+		toCount = append(toCount, pi.Pipeline)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "list pipelines")
+	}
+	n, err := countRunningJobs(ctx, jobs, toCount)
+	if err != nil {
+		return nil, err
+	}
+	usage.ConcurrentJobs = n
+	return usage, nil
+}
+
+// countRunningJobs counts the running jobs of pipelines.
+func countRunningJobs(ctx context.Context, jobs col.PostgresCollection, pipelines []*pps.Pipeline) (int64, error) {
+	var n int64
+	jobInfo := &pps.JobInfo{}
+	for _, p := range pipelines {
+		if err := jobs.ReadOnly().GetByIndex(ctx, ppsdb.JobsTerminalIndex, ppsdb.JobsTerminalKey(p, false), jobInfo, col.DefaultOptions(), func(string) error {
+			// Jobs that are waiting for their pipeline's workers don't count.
+			if jobInfo.State != pps.JobState_JOB_CREATED {
+				n++
+			}
+			return nil
+		}); err != nil {
+			return 0, errors.Wrapf(err, "list running jobs of pipeline %q", p)
+		}
+	}
+	return n, nil
+}
+
+// rcWorkerUsage sums the workers of a project's pipelines, and the resources
+// their user containers request, leaving out the pipeline exclude, if it's
+// set.
+func rcWorkerUsage(rcs *v1.ReplicationControllerList, project *pfs.Project, exclude *pps.Pipeline) *enterpriselimits.Usage {
+	usage := &enterpriselimits.Usage{}
+	for i := range rcs.Items {
+		rc := &rcs.Items[i]
+		if rc.Labels[pipelineProjectLabel] != project.GetName() {
+			continue
+		}
+		if exclude != nil && rc.Labels[pipelineNameLabel] == exclude.Name {
+			continue
+		}
+		var replicas int64
+		if rc.Spec.Replicas != nil {
+			replicas = int64(*rc.Spec.Replicas)
+		}
+		usage.Add(enterpriselimits.WorkerUsage(replicas, rcRequests(rc)))
+	}
+	return usage
+}
+
+// rcRequests returns the resources requested by the user container of an
+// RC's workers, counting the limits of the resources it doesn't request.
+func rcRequests(rc *v1.ReplicationController) v1.ResourceList {
+	if rc.Spec.Template == nil {
+		return nil
+	}
+	for _, c := range rc.Spec.Template.Spec.Containers {
+		if c.Name == client.PPSWorkerUserContainerName {
+			return effectiveRequests(c.Resources.Requests, c.Resources.Limits)
+		}
+	}
+	return nil
+}
+
+// quotaScale returns the number of workers, up to maxScale, that a pipeline
+// may scale up to without taking its project over its quota.  A pipeline
+// whose workers aren't running may not start them while the project's other
+// pipelines are running as many jobs as the quota allows.  Workers that are
+// already running are never counted against the pipeline, so quotas don't
+// cause pipelines to scale down.
+func (pc *pipelineController) quotaScale(ctx context.Context, pi *pps.PipelineInfo, rc *v1.ReplicationController, curScale, maxScale int32) (int32, error) {
+	project := pi.Pipeline.Project
+	quota, err := pc.psDriver.GetProjectQuota(ctx, project)
+	if err != nil || quota == nil {
+		return maxScale, err
+	}
+	if quota.ConcurrentJobs > 0 && curScale == 0 {
+		n, err := pc.psDriver.CountRunningJobs(ctx, project, pi.Pipeline)
+		if err != nil {
+			return maxScale, err
+		}
+		if n >= quota.ConcurrentJobs {
+			log.Info(ctx, "project is running its quota of concurrent jobs; not starting workers", zap.Int64("runningJobs", n), zap.Int64("quota", quota.ConcurrentJobs))
+			return curScale, nil
+		}
+	}
+	rcs, err := pc.iDriver.ListReplicationControllers(ctx)
+	if err != nil {
+		return maxScale, errors.EnsureStack(err)
+	}
+	n := enterpriselimits.MaxWorkers(quota, rcWorkerUsage(rcs, project, pi.Pipeline), rcRequests(rc))
+	switch {
+	case n < 0 || n >= int64(maxScale):
+		return maxScale, nil
+	case n <= int64(curScale):
+		log.Info(ctx, "project quota doesn't allow more workers", zap.Int32("currentScale", curScale), zap.Int32("maxScale", maxScale))
+		return curScale, nil
+	default:
+		log.Info(ctx, "limiting workers to project quota", zap.Int64("quotaScale", n), zap.Int32("maxScale", maxScale))
+		return int32(n), nil
+	}
+}
@@ -19,7 +19,6 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
-	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
@@ -128,7 +127,7 @@ func (s *sidecarS3G) createK8sServices(ctx context.Context) {
 	// is created per pachyderm job running sidecar s3 gateway
 	var projectName = s.pipelineInfo.Pipeline.Project.GetName()
 	backoff.RetryNotify(func() error {
-		masterLock := dlock.NewDLock(s.apiServer.env.EtcdClient,
+		masterLock := s.apiServer.env.NewDLock(
 			path.Join(s.apiServer.etcdPrefix,
 				s3gSidecarLockPath,
 				projectName,
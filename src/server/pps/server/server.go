@@ -8,6 +8,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	loki "github.com/pachyderm/pachyderm/v2/src/internal/lokiutil/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
@@ -33,6 +34,7 @@ type Env struct {
 	EtcdClient  *etcd.Client
 	EtcdPrefix  string
 	TaskService task.Service
+	NewDLock    func(prefix string) dlock.DLock
 	// TODO: make this just a *loki.Client
 	// This is not a circular dependency
 	GetLokiClient func() (*loki.Client, error)
@@ -63,7 +65,7 @@ func NewAPIServer(env Env) (ppsiface.APIServer, error) {
 		log.Error(env.BackgroundContext, "Preflight checks are disabled. This is not recommended.")
 	}
 	go apiServer.master(env.BackgroundContext)
-	go kubeEventTail(pctx.Background("pps-kube-events"), env.KubeClient.CoreV1(), env.Config.Namespace, env.EtcdPrefix, env.EtcdClient)
+	go kubeEventTail(pctx.Background("pps-kube-events"), env.KubeClient.CoreV1(), env.Config.Namespace, env.EtcdPrefix, env.NewDLock)
 	return apiServer, nil
 }
 
@@ -89,6 +91,7 @@ func NewAPIServerNoMaster(env Env) (ppsiface.APIServer, error) {
 		jobs:                  ppsdb.Jobs(env.DB, env.Listener),
 		clusterDefaults:       ppsdb.ClusterDefaults(env.DB, env.Listener),
 		projectDefaults:       ppsdb.ProjectDefaults(env.DB, env.Listener),
+		projectQuotas:         ppsdb.ProjectQuotas(env.DB, env.Listener),
 		workerGrpcPort:        config.PPSWorkerPort,
 		port:                  config.Port,
 		peerPort:              config.PeerPort,
@@ -117,6 +120,7 @@ func NewSidecarAPIServer(
 		jobs:            ppsdb.Jobs(env.DB, env.Listener),
 		clusterDefaults: ppsdb.ClusterDefaults(env.DB, env.Listener),
 		projectDefaults: ppsdb.ProjectDefaults(env.DB, env.Listener),
+		projectQuotas:   ppsdb.ProjectQuotas(env.DB, env.Listener),
 		workerGrpcPort:  workerGrpcPort,
 		peerPort:        peerPort,
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil/continuous"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	workerstats "github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
@@ -288,6 +289,26 @@ func (kd *kubeDriver) workerPodSpec(ctx context.Context, options *workerOptions,
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "GOOGLE_CLOUD_PROFILER_PROJECT", Value: p})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "GOOGLE_CLOUD_PROFILER_PROJECT", Value: p})
 	}
+	if i := kd.config.ContinuousProfilingIntervalSeconds; i > 0 {
+		workerEnv = append(workerEnv,
+			v1.EnvVar{Name: "CONTINUOUS_PROFILING_INTERVAL_SECONDS", Value: strconv.Itoa(i)},
+			v1.EnvVar{Name: "CONTINUOUS_PROFILING_CPU_SECONDS", Value: strconv.Itoa(kd.config.ContinuousProfilingCPUSeconds)},
+			v1.EnvVar{Name: "CONTINUOUS_PROFILING_AUTH_TOKEN", ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: continuous.SecretName},
+					Key:                  continuous.SecretKey,
+					Optional:             ptr.To(true),
+				},
+			}},
+		)
+	}
+	if s := kd.config.LogStore; s != "" {
+		workerEnv = append(workerEnv, v1.EnvVar{Name: "LOG_STORE", Value: s})
+	}
+	if b := kd.config.CoordinationBackend; b != "" {
+		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "COORDINATION_BACKEND", Value: b})
+		workerEnv = append(workerEnv, v1.EnvVar{Name: "COORDINATION_BACKEND", Value: b})
+	}
 	if pipelineInfo.Details.Determined != nil {
 		workerEnv = append(workerEnv, kd.getDeterminedEnvVars(pipelineInfo)...)
 	}
@@ -8,7 +8,9 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"path"
+	"path/filepath"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
@@ -21,6 +23,9 @@ const (
 	MetaPrefix = "meta"
 	// MetaFileName is the name of the meta file.
 	MetaFileName = "meta"
+	// LogsFileName is the name of the file that holds a datum's user code logs, next to its
+	// meta file.
+	LogsFileName = "logs"
 	// PFSPrefix is the prefix for the pfs path.
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
@@ -36,6 +41,11 @@ func MetaFilePath(id string) string {
 	return path.Join(MetaPrefix, id, MetaFileName)
 }
 
+// LogsFilePath returns the path of a datum's user code logs in the meta commit.
+func LogsFilePath(id string) string {
+	return path.Join(MetaPrefix, id, LogsFileName)
+}
+
 // DatumID computes the ID of a datum.
 func DatumID(inputs []*Input) string {
 	hash := pfs.NewHash()
@@ -53,6 +63,64 @@ func DatumID(inputs []*Input) string {
 	return hex.EncodeToString(hash.Sum(nil))
 }
 
+// DatumEnv returns the environment variables that describe a datum's inputs
+// to the user code, given the directory the inputs are downloaded to.
+func DatumEnv(inputDir string, inputs []*Input) []string {
+	if len(inputs) == 0 {
+		return nil
+	}
+	var result []string
+	for _, input := range inputs {
+		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(inputDir, input.Name, input.FileInfo.File.Path)))
+		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.Id))
+		if input.JoinOn != "" {
+			result = append(result, fmt.Sprintf("PACH_DATUM_%s_JOIN_ON=%s", input.Name, input.JoinOn))
+		}
+		if input.GroupBy != "" {
+			result = append(result, fmt.Sprintf("PACH_DATUM_%s_GROUP_BY=%s", input.Name, input.GroupBy))
+		}
+	}
+	return append(result, fmt.Sprintf("%s=%s", client.DatumIDEnv, DatumID(inputs)))
+}
+
+// UserCodeEnv returns the environment variables that the worker sets for the
+// user code on top of its own environment: those of DatumEnv, and those that
+// describe the job.  s3Endpoint is the address of the job's S3 gateway
+// sidecar, or empty if the pipeline doesn't use one.
+func UserCodeEnv(inputDir string, inputs []*Input, jobID string, outputCommit *pfs.Commit, pachToken, filesetID, s3Endpoint string) []string {
+	result := DatumEnv(inputDir, inputs)
+	if jobID != "" {
+		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
+		if s3Endpoint != "" {
+			result = append(result, "S3_ENDPOINT="+s3Endpoint)
+			// Set AWS_... creds vars in addition to PACH_PIPELINE_TOKEN so that any
+			// S3 clients running in the user code use these and successfully connect
+			// by default
+			if pachToken != "" {
+				result = append(result, "AWS_ACCESS_KEY_ID="+pachToken)
+				result = append(result, "AWS_SECRET_ACCESS_KEY="+pachToken)
+			} else {
+				// If auth is off, clients can use any creds with Pachyderm's S3
+				// gateway, as long as the ID and secret match. However, many clients
+				// (e.g. the AWS cli) require _some_ nonempty creds; this default value
+				// allows those clients to work in pipelines if Pachyderm auth is off.
+				result = append(result, "AWS_ACCESS_KEY_ID=default")
+				result = append(result, "AWS_SECRET_ACCESS_KEY=default")
+			}
+		}
+	}
+	if pachToken != "" {
+		result = append(result, "PACH_TOKEN="+pachToken)
+	}
+	if outputCommit != nil {
+		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.Id))
+	}
+	if filesetID != "" {
+		result = append(result, fmt.Sprintf("%s=%s", client.FilesetIDEnv, filesetID))
+	}
+	return result
+}
+
 // HashDatum computes the hash of a datum.
 func HashDatum(pipelineSalt string, inputs []*Input) string {
 	hash := pfs.NewHash()
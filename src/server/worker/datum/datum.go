@@ -133,7 +133,10 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
-
+	if d.logs != nil {
+		// The logs are closed when the datum finishes, unless it fails before it gets to run.
+		defer d.logs.close() //nolint:errcheck
+	}
 	var err error
 	for i := 0; i <= d.numRetries; i++ {
 		err = d.withData(func() (retErr error) {
@@ -167,6 +170,7 @@ type Datum struct {
 	timeout          time.Duration
 	IDPrefix         string
 	env              []string
+	logs             *Logs
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -185,6 +189,11 @@ func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
 	return d
 }
 
+// Stats returns the process stats of the datum.
+func (d *Datum) Stats() *pps.ProcessStats {
+	return d.meta.Stats
+}
+
 // PFSStorageRoot returns the pfs storage root.
 func (d *Datum) PFSStorageRoot() string {
 	return path.Join(d.storageRoot, common.PFSPrefix, d.ID)
@@ -195,8 +204,87 @@ func (d *Datum) MetaStorageRoot() string {
 	return path.Join(d.storageRoot, common.MetaPrefix, d.ID)
 }
 
+// Logs returns the persisted logs of the datum's user code, or nil if the datum doesn't persist
+// its logs.  The logs of every attempt are uploaded to the meta output with the datum's meta file.
+func (d *Datum) Logs() *Logs {
+	return d.logs
+}
+
+// Logs is a datum's logs file.  It's opened by the first write and closed when the datum finishes,
+// before it's uploaded.
+type Logs struct {
+	mu     sync.Mutex
+	path   string
+	f      *os.File
+	size   int64
+	closed bool
+}
+
+func (l *Logs) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, errors.EnsureStack(os.ErrClosed)
+	}
+	if l.f == nil {
+		if err := os.MkdirAll(path.Dir(l.path), 0777); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		l.f = f
+	}
+	n, err := l.f.Write(p)
+	l.size += int64(n)
+	return n, errors.EnsureStack(err)
+}
+
+// Size returns the number of bytes written to the logs.
+func (l *Logs) Size() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size
+}
+
+// Open returns a reader of the logs written since the given offset.
+func (l *Logs) Open(off int64) (io.ReadCloser, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.Open(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && off == 0 {
+			return io.NopCloser(&bytes.Reader{}), nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if _, err := f.Seek(off, io.SeekStart); err != nil {
+		f.Close() //nolint:errcheck
+		return nil, errors.EnsureStack(err)
+	}
+	return f, nil
+}
+
+func (l *Logs) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return errors.EnsureStack(err)
+}
+
 func (d *Datum) finish(err error) (retErr error) {
 	defer MergeProcessStats(d.set.stats.ProcessStats, d.meta.Stats)
+	if d.logs != nil {
+		if err := d.logs.close(); err != nil {
+			return errors.Wrap(err, "close logs")
+		}
+	}
 	if err != nil {
 		d.handleFailed(err)
 		return d.uploadMetaOutput()
@@ -501,6 +589,10 @@ func NewDeleter(metaFileWalker fileWalkerFunc, metaOutputClient, pfsOutputClient
 		if err := metaOutputClient.DeleteFile(common.MetaFilePath(ID), tagOption); err != nil {
 			return errors.EnsureStack(err)
 		}
+		// And its logs, if they were persisted.
+		if err := metaOutputClient.DeleteFile(common.LogsFilePath(ID), tagOption); err != nil {
+			return errors.EnsureStack(err)
+		}
 		pfsDir := "/" + path.Join(common.PFSPrefix, ID)
 		outDir := path.Join(pfsDir, common.OutputPrefix)
 		files, err := metaFileWalker(pfsDir)
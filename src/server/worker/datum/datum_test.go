@@ -1,14 +1,20 @@
 package datum
 
 import (
+	"archive/tar"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"testing"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 )
 
 func TestUploadOutput(t *testing.T) {
@@ -63,3 +69,71 @@ func TestUploadOutput(t *testing.T) {
 		})
 	}
 }
+
+// recordingModifyFile records the files put with PutFile and PutFileTAR.
+type recordingModifyFile struct {
+	client.ModifyFile
+	files map[string]string
+}
+
+func (mf *recordingModifyFile) PutFile(path string, r io.Reader, opts ...client.PutFileOption) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	mf.files[path] += string(content)
+	return nil
+}
+
+func (mf *recordingModifyFile) PutFileTAR(r io.Reader, opts ...client.PutFileOption) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := mf.PutFile(hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func TestUploadLogs(t *testing.T) {
+	mf := &recordingModifyFile{ModifyFile: client.NewNoOpModifyFileClient(), files: make(map[string]string)}
+	s := &Set{
+		storageRoot:      t.TempDir(),
+		metaOutputClient: mf,
+		stats:            &Stats{ProcessStats: &pps.ProcessStats{}},
+	}
+	meta := &Meta{
+		Job:    client.NewJob(pfs.DefaultProjectName, "test", "id"),
+		Inputs: []*common.Input{{Name: "in", FileInfo: &pfs.FileInfo{File: client.NewFile(pfs.DefaultProjectName, "in", "master", "", "/a")}}},
+	}
+	d := newDatum(s, meta, WithLogs())
+	// Each attempt appends to the logs, which are uploaded when the datum finishes.
+	for attempt := 1; attempt <= 2; attempt++ {
+		_, err := fmt.Fprintf(d.Logs(), "attempt %d\n", attempt)
+		require.NoError(t, err)
+	}
+	// A reader of the logs can start part way through them.
+	r, err := d.Logs().Open(int64(len("attempt 1\n")))
+	require.NoError(t, err)
+	since, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "attempt 2\n", string(since))
+	require.Equal(t, int64(len("attempt 1\nattempt 2\n")), d.Logs().Size())
+	require.NoError(t, d.finish(errors.New("user code failed")))
+	require.Equal(t, "attempt 1\nattempt 2\n", mf.files[common.LogsFilePath(d.ID)], "logs of every attempt are uploaded next to the meta file")
+	require.NotEqual(t, "", mf.files[common.MetaFilePath(d.ID)])
+	_, err = os.Stat(d.MetaStorageRoot())
+	require.True(t, os.IsNotExist(err), "logs are removed after they're uploaded")
+	_, err = fmt.Fprintf(d.Logs(), "too late\n")
+	require.YesError(t, err, "logs are closed when the datum finishes")
+
+	d = newDatum(s, meta)
+	require.Nil(t, d.Logs(), "logs are only persisted with WithLogs")
+}
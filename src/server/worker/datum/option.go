@@ -3,9 +3,11 @@ package datum
 import (
 	"context"
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 )
 
 // SetOption configures a set.
@@ -69,3 +71,10 @@ func WithEnv(env []string) Option {
 		d.env = env
 	}
 }
+
+// WithLogs persists the logs of the user code in the meta output, next to the datum's meta file.
+func WithLogs() Option {
+	return func(d *Datum) {
+		d.logs = &Logs{path: path.Join(d.MetaStorageRoot(), common.LogsFileName)}
+	}
+}
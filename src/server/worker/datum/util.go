@@ -2,9 +2,11 @@ package datum
 
 import (
 	"context"
+	"math"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
@@ -35,6 +37,78 @@ func MergeProcessStats(x, y *pps.ProcessStats) {
 	x.UploadTime = durationpb.New(x.UploadTime.AsDuration() + y.UploadTime.AsDuration())
 	x.DownloadBytes += y.DownloadBytes
 	x.UploadBytes += y.UploadBytes
+	x.CpuTime = durationpb.New(x.CpuTime.AsDuration() + y.CpuTime.AsDuration())
+	x.PeakMemoryBytes = max(x.PeakMemoryBytes, y.PeakMemoryBytes)
+	x.ReadBytes += y.ReadBytes
+	x.WriteBytes += y.WriteBytes
+}
+
+// StatsAggregator collects the process stats of individual datums and computes
+// their distribution.
+type StatsAggregator struct {
+	downloadTime, processTime, uploadTime           []float64
+	downloadBytes, uploadBytes                      []float64
+	cpuTime, peakMemoryBytes, readBytes, writeBytes []float64
+}
+
+// Add adds the process stats of a datum.
+func (a *StatsAggregator) Add(s *pps.ProcessStats) {
+	a.downloadTime = append(a.downloadTime, s.GetDownloadTime().AsDuration().Seconds())
+	a.processTime = append(a.processTime, s.GetProcessTime().AsDuration().Seconds())
+	a.uploadTime = append(a.uploadTime, s.GetUploadTime().AsDuration().Seconds())
+	a.downloadBytes = append(a.downloadBytes, float64(s.GetDownloadBytes()))
+	a.uploadBytes = append(a.uploadBytes, float64(s.GetUploadBytes()))
+	a.cpuTime = append(a.cpuTime, s.GetCpuTime().AsDuration().Seconds())
+	a.peakMemoryBytes = append(a.peakMemoryBytes, float64(s.GetPeakMemoryBytes()))
+	a.readBytes = append(a.readBytes, float64(s.GetReadBytes()))
+	a.writeBytes = append(a.writeBytes, float64(s.GetWriteBytes()))
+}
+
+// Aggregate returns the distribution of the stats added so far, or nil if no
+// stats have been added.
+func (a *StatsAggregator) Aggregate() *pps.AggregateProcessStats {
+	if len(a.processTime) == 0 {
+		return nil
+	}
+	return &pps.AggregateProcessStats{
+		DownloadTime:    aggregate(a.downloadTime),
+		ProcessTime:     aggregate(a.processTime),
+		UploadTime:      aggregate(a.uploadTime),
+		DownloadBytes:   aggregate(a.downloadBytes),
+		UploadBytes:     aggregate(a.uploadBytes),
+		CpuTime:         aggregate(a.cpuTime),
+		PeakMemoryBytes: aggregate(a.peakMemoryBytes),
+		ReadBytes:       aggregate(a.readBytes),
+		WriteBytes:      aggregate(a.writeBytes),
+	}
+}
+
+func aggregate(xs []float64) *pps.Aggregate {
+	sorted := slices.Clone(xs)
+	slices.Sort(sorted)
+	var sum float64
+	for _, x := range sorted {
+		sum += x
+	}
+	n := float64(len(sorted))
+	mean := sum / n
+	var sqDiff float64
+	for _, x := range sorted {
+		sqDiff += (x - mean) * (x - mean)
+	}
+	return &pps.Aggregate{
+		Count:                 int64(len(sorted)),
+		Mean:                  mean,
+		Stddev:                math.Sqrt(sqDiff / n),
+		FifthPercentile:       percentile(sorted, 5),
+		NinetyFifthPercentile: percentile(sorted, 95),
+	}
+}
+
+// percentile returns the nearest-rank percentile of a sorted, non-empty slice.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank-1, 0), len(sorted)-1)]
 }
 
 func WithCreateFileSet(ctx context.Context, c pfs.APIClient, name string, cb func(*Set) error) (string, error) {
@@ -4,10 +4,13 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestCartesianProduct(t *testing.T) {
@@ -48,3 +51,30 @@ func TestCartesianProduct(t *testing.T) {
 	}
 	require.NoDiff(t, expected, permutations, []cmp.Option{op})
 }
+
+func TestStatsAggregator(t *testing.T) {
+	var a StatsAggregator
+	require.Nil(t, a.Aggregate())
+	for i := 1; i <= 20; i++ {
+		a.Add(&pps.ProcessStats{
+			ProcessTime:     durationpb.New(time.Duration(i) * time.Second),
+			PeakMemoryBytes: int64(i) * 1024,
+		})
+	}
+	agg := a.Aggregate()
+	require.Equal(t, int64(20), agg.ProcessTime.Count)
+	require.Equal(t, 10.5, agg.ProcessTime.Mean)
+	require.Equal(t, 1.0, agg.ProcessTime.FifthPercentile)
+	require.Equal(t, 19.0, agg.ProcessTime.NinetyFifthPercentile)
+	require.Equal(t, 10.5*1024, agg.PeakMemoryBytes.Mean)
+	require.Equal(t, 0.0, agg.CpuTime.Stddev)
+}
+
+func TestMergeProcessStats(t *testing.T) {
+	x := &pps.ProcessStats{CpuTime: durationpb.New(time.Second), PeakMemoryBytes: 10, ReadBytes: 1}
+	MergeProcessStats(x, &pps.ProcessStats{CpuTime: durationpb.New(2 * time.Second), PeakMemoryBytes: 5, ReadBytes: 2, WriteBytes: 3})
+	require.Equal(t, 3*time.Second, x.CpuTime.AsDuration())
+	require.Equal(t, int64(10), x.PeakMemoryBytes)
+	require.Equal(t, int64(3), x.ReadBytes)
+	require.Equal(t, int64(3), x.WriteBytes)
+}
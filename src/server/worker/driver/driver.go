@@ -98,7 +98,10 @@ type Driver interface {
 	// launching the configured user process.
 	UserCodeEnv(string, *pfs.Commit, []*common.Input, string, string) []string
 
-	RunUserCode(context.Context, logs.TaggedLogger, []string) error
+	// RunUserCode runs the configured user process.  If the provided
+	// ProcessStats is non-nil, the CPU time, peak memory and I/O of the user
+	// process tree are recorded in it.
+	RunUserCode(context.Context, logs.TaggedLogger, []string, *pps.ProcessStats) error
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
@@ -349,6 +352,7 @@ func (d *driver) RunUserCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
 	environ []string,
+	stats *pps.ProcessStats,
 ) (retErr error) {
 	logger.Logf("beginning to run user code")
 	defer func(start time.Time) {
@@ -388,8 +392,15 @@ func (d *driver) RunUserCode(
 	}
 	monctx, endMonitoring := context.WithCancelCause(ctx)
 	go proc.MonitorProcessGroup(logger.Context(monctx), cmd.Process.Pid)
+	usage := newProcessGroupUsage(cmd.Process.Pid)
+	go usage.monitor(logger.Context(monctx))
 	killChildren := makeProcessGroupKiller(ctx, logger, cmd.Process.Pid)
 	if ok, err := blockUntilWaitable(cmd.Process.Pid); ok {
+		// cmd.Process.Pid has not been reaped yet, so its counters still include
+		// everything it ran.
+		if err := usage.sample(); err != nil {
+			logger.Logf("warning: unable to sample resource usage of user code: %v", err)
+		}
 		// Since cmd.Process.Pid is dead, we can kill its children now.
 		killChildren()
 	} else if err != nil {
@@ -408,6 +419,7 @@ func (d *driver) RunUserCode(
 
 	// Print final rusage metrics.
 	printRusage(logger.Context(ctx), cmd.ProcessState)
+	usage.record(stats, cmd.ProcessState)
 
 	// We ignore broken pipe errors, these occur very occasionally if a user
 	// specifies Stdin but their process doesn't actually read everything from
@@ -556,26 +568,13 @@ func (d *driver) UserCodeEnv(
 ) []string {
 	var result []string
 	for _, e := range os.Environ() {
-		if strings.HasPrefix(e, "POSTGRES_PASSWORD=") {
+		if strings.HasPrefix(e, "POSTGRES_PASSWORD=") || strings.HasPrefix(e, "CONTINUOUS_PROFILING_AUTH_TOKEN=") {
 			continue
 		}
 		result = append(result, e)
 	}
-	if len(inputs) > 0 {
-		for _, input := range inputs {
-			result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
-			result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.Id))
-			if input.JoinOn != "" {
-				result = append(result, fmt.Sprintf("PACH_DATUM_%s_JOIN_ON=%s", input.Name, input.JoinOn))
-			}
-			if input.GroupBy != "" {
-				result = append(result, fmt.Sprintf("PACH_DATUM_%s_GROUP_BY=%s", input.Name, input.GroupBy))
-			}
-		}
-		result = append(result, fmt.Sprintf("%s=%s", client.DatumIDEnv, common.DatumID(inputs)))
-	}
+	var s3Endpoint string
 	if jobID != "" {
-		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
 		pipeline := &pps.Pipeline{
 			Project: outputCommit.Repo.Project,
 			Name:    outputCommit.Repo.Name,
@@ -589,40 +588,14 @@ func (d *driver) UserCodeEnv(
 			// mock a ServiceEnv. Once we can create mock ServiceEnvs, we should store
 			// a ServiceEnv in worker.APIServer, rewrite newTestAPIServer and
 			// NewAPIServer, and then change this code.
-			result = append(
-				result,
-				fmt.Sprintf("S3_ENDPOINT=http://%s.%s:%s",
-					ppsutil.SidecarS3GatewayService(pipeline, jobID),
-					d.Namespace(),
-					os.Getenv("S3GATEWAY_PORT"),
-				),
+			s3Endpoint = fmt.Sprintf("http://%s.%s:%s",
+				ppsutil.SidecarS3GatewayService(pipeline, jobID),
+				d.Namespace(),
+				os.Getenv("S3GATEWAY_PORT"),
 			)
-
-			// Set AWS_... creds vars in addition to PACH_PIPELINE_TOKEN so that any
-			// S3 clients running in the user code use these and successfully connect
-			// by default
-			if pachToken != "" {
-				result = append(result, "AWS_ACCESS_KEY_ID="+pachToken)
-				result = append(result, "AWS_SECRET_ACCESS_KEY="+pachToken)
-			} else {
-				// If auth is off, clients can use any creds with Pachyderm's S3
-				// gateway, as long as the ID and secret match. However, many clients
-				// (e.g. the AWS cli) require _some_ nonempty creds; this default value
-				// allows those clients to work in pipelines if Pachyderm auth is off.
-				result = append(result, "AWS_ACCESS_KEY_ID=default")
-				result = append(result, "AWS_SECRET_ACCESS_KEY=default")
-			}
 		}
 	}
-	if pachToken != "" {
-		result = append(result, "PACH_TOKEN="+pachToken)
-	}
-	if outputCommit != nil {
-		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.Id))
-	}
-	if filesetId != "" {
-		result = append(result, fmt.Sprintf("%s=%s", client.FilesetIDEnv, filesetId))
-	}
+	result = append(result, common.UserCodeEnv(d.InputDir(), inputs, jobID, outputCommit, pachToken, filesetId, s3Endpoint)...)
 	return result
 }
 
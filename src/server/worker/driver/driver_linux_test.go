@@ -30,7 +30,7 @@ func TestLogUnexpectedSubprocesses(t *testing.T) {
 		},
 	}
 	logger := logs.NewTest(ctx)
-	if err := d.RunUserCode(ctx, logger, nil); err != nil {
+	if err := d.RunUserCode(ctx, logger, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 	if diff := cmp.Diff(logger.Errors, ([]string)(nil), cmpopts.EquateEmpty()); diff != "" {
@@ -51,3 +51,36 @@ func TestLogUnexpectedSubprocesses(t *testing.T) {
 		t.Errorf("logs (-got +want):\n%s", diff)
 	}
 }
+
+func TestRunUserCodeRecordsUsage(t *testing.T) {
+	ctx := pctx.TestContext(t)
+	d := &driver{
+		pipelineInfo: &pps.PipelineInfo{
+			Details: &pps.PipelineInfo_Details{
+				Transform: &pps.Transform{
+					Cmd: []string{
+						"sh",
+						"-c",
+						"head -c 4194304 /dev/zero | cat > /dev/null",
+					},
+				},
+			},
+		},
+	}
+	stats := &pps.ProcessStats{}
+	if err := d.RunUserCode(ctx, logs.NewTest(ctx), nil, stats); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stats.ReadBytes, int64(2*4194304); got < want {
+		t.Errorf("read bytes: got %v, want at least %v", got, want)
+	}
+	if got, want := stats.WriteBytes, int64(2*4194304); got < want {
+		t.Errorf("write bytes: got %v, want at least %v", got, want)
+	}
+	if stats.PeakMemoryBytes <= 0 {
+		t.Errorf("peak memory: got %v, want > 0", stats.PeakMemoryBytes)
+	}
+	if stats.CpuTime == nil {
+		t.Error("cpu time was not recorded")
+	}
+}
@@ -3,10 +3,20 @@
 package driver
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 	"github.com/prometheus/procfs"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // logRunningProcesses will print the cmdline of processes that we'd kill if we killed the provided
@@ -44,3 +54,107 @@ func logRunningProcesses(l logs.TaggedLogger, pgid int) {
 		l.Logf("note: about to kill unexpectedly-remaining subprocess of the user code: pid=%v comm=%v cmdline=%s", p.PID, comm, cmdline)
 	}
 }
+
+// userHZ is the unit of the child CPU times in /proc/<pid>/stat; see proc(5).  procfs uses the
+// same value for ProcStat.CPUTime.
+const userHZ = 100
+
+// usageSampleInterval is how often the user code's process group is sampled for resource usage.
+const usageSampleInterval = time.Second
+
+// processGroupUsage tracks the resource usage of the process tree that the user code runs in.
+//
+// Counters read from /proc are cumulative for a process and any children it has reaped, so the
+// totals from a sample taken while the group leader is waitable (but not yet reaped) cover
+// everything the user code ran, apart from orphans reaped by someone else.  Peak memory is the
+// largest total RSS of the process group seen in any sample.
+type processGroupUsage struct {
+	pgid int
+
+	mu                    sync.Mutex
+	cpuTime               float64
+	readBytes, writeBytes uint64
+	peakRSS               int64
+}
+
+func newProcessGroupUsage(pgid int) *processGroupUsage {
+	return &processGroupUsage{pgid: pgid}
+}
+
+// monitor samples the process group until the context is done.
+func (u *processGroupUsage) monitor(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(usageSampleInterval):
+		case <-ctx.Done():
+			return
+		}
+		if err := u.sample(); err != nil {
+			log.Debug(ctx, "problem sampling user code resource usage", zap.Error(err))
+		}
+	}
+}
+
+// sample walks /proc and records the current usage of the process group.
+func (u *processGroupUsage) sample() error {
+	fs, err := procfs.NewDefaultFS()
+	if err != nil {
+		return errors.Wrap(err, "NewDefaultFS")
+	}
+	pp, err := fs.AllProcs()
+	if err != nil {
+		return errors.Wrap(err, "AllProcs")
+	}
+	var cpu float64
+	var rchar, wchar uint64
+	var rss int64
+	var found bool
+	for _, p := range pp {
+		stat, err := p.Stat()
+		if err != nil || stat.PGRP != u.pgid {
+			// Processes can exit while we're walking /proc; skip any we can't read.
+			continue
+		}
+		found = true
+		cpu += stat.CPUTime() + float64(stat.CUTime+stat.CSTime)/userHZ
+		rss += int64(stat.ResidentMemory())
+		if io, err := p.IO(); err == nil {
+			rchar += io.RChar
+			wchar += io.WChar
+		}
+	}
+	if !found {
+		return nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	// Counters only decrease when a process exits without being reaped by another member of
+	// the group; keep the larger of the two so that we never under-report what we've seen.
+	u.cpuTime = max(u.cpuTime, cpu)
+	u.readBytes = max(u.readBytes, rchar)
+	u.writeBytes = max(u.writeBytes, wchar)
+	u.peakRSS = max(u.peakRSS, rss)
+	return nil
+}
+
+// record fills in the resource usage fields of stats, folding in the rusage of the reaped group
+// leader.
+func (u *processGroupUsage) record(stats *pps.ProcessStats, state *os.ProcessState) {
+	if stats == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	cpuTime, peakRSS := u.cpuTime, u.peakRSS
+	if state != nil {
+		cpuTime = max(cpuTime, state.UserTime().Seconds()+state.SystemTime().Seconds())
+		if rusage, ok := state.SysUsage().(*syscall.Rusage); ok {
+			// Maxrss is in KiB; see getrusage(2).
+			peakRSS = max(peakRSS, rusage.Maxrss*1024)
+		}
+	}
+	stats.CpuTime = durationpb.New(time.Duration(cpuTime * float64(time.Second)))
+	stats.PeakMemoryBytes = peakRSS
+	stats.ReadBytes = int64(u.readBytes)
+	stats.WriteBytes = int64(u.writeBytes)
+}
@@ -2,8 +2,34 @@
 
 package driver
 
-import "github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
 
 func logRunningProcesses(l logs.TaggedLogger, pgid int) {
 	l.Logf("warning: listing processes on this OS is not supported; you won't see debug information about which child processes we're about to kill")
 }
+
+// processGroupUsage only reports the CPU time of the reaped user code on this OS.
+type processGroupUsage struct{}
+
+func newProcessGroupUsage(pgid int) *processGroupUsage {
+	return &processGroupUsage{}
+}
+
+func (u *processGroupUsage) monitor(ctx context.Context) {}
+
+func (u *processGroupUsage) sample() error { return nil }
+
+func (u *processGroupUsage) record(stats *pps.ProcessStats, state *os.ProcessState) {
+	if stats == nil || state == nil {
+		return
+	}
+	stats.CpuTime = durationpb.New(state.UserTime() + state.SystemTime())
+}
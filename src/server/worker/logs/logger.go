@@ -151,6 +151,37 @@ func (logger *taggedLogger) Writer(stream string) io.WriteCloser {
 	return log.WriterAt(pctx.Child(logger.ctx, "", pctx.WithFields(zap.String("stream", stream)), pctx.WithoutRatelimit()), log.InfoLevel)
 }
 
+// Tee returns a TaggedLogger that also copies everything written to its Writers, like the stdout
+// and stderr of the user code, to w.
+func Tee(logger TaggedLogger, w io.Writer) TaggedLogger {
+	return &teeLogger{TaggedLogger: logger, w: w}
+}
+
+type teeLogger struct {
+	TaggedLogger
+	w io.Writer
+}
+
+func (l *teeLogger) WithJob(jobID string) TaggedLogger {
+	return Tee(l.TaggedLogger.WithJob(jobID), l.w)
+}
+
+func (l *teeLogger) WithData(data []*common.Input) TaggedLogger {
+	return Tee(l.TaggedLogger.WithData(data), l.w)
+}
+
+func (l *teeLogger) WithUserCode() TaggedLogger {
+	return Tee(l.TaggedLogger.WithUserCode(), l.w)
+}
+
+func (l *teeLogger) Writer(stream string) io.WriteCloser {
+	w := l.TaggedLogger.Writer(stream)
+	return struct {
+		io.Writer
+		io.Closer
+	}{io.MultiWriter(w, l.w), w}
+}
+
 // TestTaggedLogger is a taggedLogger that captures (some) logs for testing.  It is not safe for
 // concurrent use.
 type TestTaggedLogger struct {
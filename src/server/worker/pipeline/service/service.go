@@ -76,7 +76,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 				return s.WithDatum(meta, func(d *datum.Datum) error {
 					err := driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 						return d.Run(ctx, func(runCtx context.Context) error {
-							return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env))
+							return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env, nil))
 						})
 					})
 					return errors.EnsureStack(err)
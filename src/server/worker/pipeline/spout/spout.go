@@ -12,5 +12,5 @@ import (
 // Run will run a spout pipeline until the driver is canceled.
 func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	logger = logger.WithJob("spout")
-	return errors.EnsureStack(driver.RunUserCode(driver.PachClient().Ctx(), logger, nil))
+	return errors.EnsureStack(driver.RunUserCode(driver.PachClient().Ctx(), logger, nil, nil))
 }
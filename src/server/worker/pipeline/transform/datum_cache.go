@@ -0,0 +1,288 @@
+package transform
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+const (
+	datumCacheKeyPrefix = "datum-cache/"
+	// datumCacheTag is shared by every pipeline, so that clearing the cache of
+	// a job or a deleted pipeline leaves the datum cache intact.
+	datumCacheTag = "datum-cache"
+	// datumCacheLogsPath is the path of the logs in a cached datum's logs file
+	// set.
+	datumCacheLogsPath = "/logs"
+)
+
+// datumCache is a content-addressed cache of datum outputs, shared by the
+// pipelines in a project that opt into it.  Entries are keyed by the project,
+// the user code (image digest, cmd, stdin, env, secret values, etc.) and the
+// datum's inputs, and are stored in the PFS cache, which keeps the output file
+// sets alive until they are evicted.  Keying by project keeps a pipeline from
+// reusing outputs computed from data that its project can't read.
+type datumCache struct {
+	pachClient *client.APIClient
+	codeHash   []byte
+}
+
+// newDatumCache returns nil if the pipeline does not use the datum cache.
+// The cache is also disabled if the user image digest is unknown, since the
+// user code can't be identified without it.
+func newDatumCache(pachClient *client.APIClient, project *pfs.Project, transform *pps.Transform, imageID string) (*datumCache, error) {
+	if !transform.GetDatumCache() || imageID == "" {
+		return nil, nil
+	}
+	codeHash, err := hashTransform(project, transform, imageID)
+	if err != nil {
+		return nil, err
+	}
+	return &datumCache{
+		pachClient: pachClient,
+		codeHash:   codeHash,
+	}, nil
+}
+
+// hashTransform hashes the project and the parts of a transform that
+// determine the output of the user code.  The image is identified by its
+// digest rather than its tag, and secrets by their values rather than their
+// names.
+func hashTransform(project *pfs.Project, transform *pps.Transform, imageID string) ([]byte, error) {
+	t := &pps.Transform{
+		Image:            imageID,
+		Cmd:              transform.Cmd,
+		Env:              transform.Env,
+		Secrets:          transform.Secrets,
+		Stdin:            transform.Stdin,
+		AcceptReturnCode: transform.AcceptReturnCode,
+		User:             transform.User,
+		WorkingDir:       transform.WorkingDir,
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(t)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal transform")
+	}
+	hash := pfs.NewHash()
+	writeField(hash, []byte(project.GetName()))
+	writeField(hash, data)
+	if err := hashSecrets(hash, transform.Secrets); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+// hashSecrets hashes the values of secrets as the user code sees them: the
+// environment variables and the files in the directories that they're loaded
+// into.  A secret's value can change without the pipeline changing.
+func hashSecrets(hash io.Writer, secrets []*pps.SecretMount) error {
+	for _, secret := range secrets {
+		if secret.EnvVar != "" {
+			writeField(hash, []byte(os.Getenv(secret.EnvVar)))
+		}
+		if secret.MountPath == "" {
+			continue
+		}
+		entries, err := os.ReadDir(secret.MountPath)
+		if err != nil {
+			return errors.Wrapf(err, "read secret %q", secret.Name)
+		}
+		for _, e := range entries {
+			// Kubernetes keeps the current version of the secret in "..data" and
+			// links each key to it.
+			if strings.HasPrefix(e.Name(), "..") {
+				continue
+			}
+			value, err := os.ReadFile(filepath.Join(secret.MountPath, e.Name()))
+			if err != nil {
+				return errors.Wrapf(err, "read secret %q", secret.Name)
+			}
+			writeField(hash, []byte(e.Name()))
+			writeField(hash, value)
+		}
+	}
+	return nil
+}
+
+// writeField writes a length-prefixed field to hash, so that adjacent fields
+// can't run into each other.
+func writeField(hash io.Writer, field []byte) {
+	_, _ = hash.Write(field)
+	_ = binary.Write(hash, binary.BigEndian, int64(len(field)))
+}
+
+// key computes the cache key of a datum.  Unlike the datum hash, the key
+// doesn't depend on the pipeline or the input repos, only on the project, the
+// user code and the paths and contents of the files it sees.
+func (dc *datumCache) key(inputs []*common.Input) string {
+	hash := pfs.NewHash()
+	hash.Write(dc.codeHash)
+	for _, input := range inputs {
+		for _, s := range []string{input.Name, input.FileInfo.File.Path, string(input.FileInfo.Hash)} {
+			writeField(hash, []byte(s))
+		}
+		_ = binary.Write(hash, binary.BigEndian, []bool{input.Lazy, input.EmptyFiles, input.S3, input.Reference})
+	}
+	return datumCacheKeyPrefix + hex.EncodeToString(hash.Sum(nil))
+}
+
+// run restores the output of a datum from the cache if possible, and
+// otherwise runs the user code and adds its output to the cache.  If the datum
+// persists its logs, the logs of the run that produced the output are cached
+// and restored with it.  Failures to read or write the cache are logged rather
+// than failing the datum.
+func (dc *datumCache) run(ctx context.Context, logger logs.TaggedLogger, inputs []*common.Input, outputDir string, datumLogs *datum.Logs, cb func(context.Context) error) error {
+	key := dc.key(inputs)
+	if entry, ok := dc.get(ctx, key); ok {
+		err := dc.restore(ctx, entry.OutputFileSetId, outputDir)
+		if err == nil {
+			logger.Logf("restored datum output from the datum cache")
+			if datumLogs != nil {
+				dc.restoreLogs(ctx, logger, entry.LogsFileSetId, datumLogs)
+			}
+			return nil
+		}
+		logger.Logf("could not restore datum output from the datum cache: %v", err)
+		if err := os.RemoveAll(outputDir); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := os.MkdirAll(outputDir, 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	var logsStart int64
+	if datumLogs != nil {
+		logsStart = datumLogs.Size()
+	}
+	if err := cb(ctx); err != nil {
+		return err
+	}
+	if err := dc.put(ctx, key, outputDir, datumLogs, logsStart); err != nil {
+		logger.Logf("could not add datum output to the datum cache: %v", err)
+	}
+	return nil
+}
+
+// get returns the cache entry of a datum.  It returns false if the datum is
+// not in the cache.
+func (dc *datumCache) get(ctx context.Context, key string) (*DatumCacheEntry, bool) {
+	resp, err := dc.pachClient.PfsAPIClient.GetCache(ctx, &pfs.GetCacheRequest{Key: key})
+	if err != nil {
+		return nil, false
+	}
+	entry := &DatumCacheEntry{}
+	if err := resp.Value.UnmarshalTo(entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+// restore writes the output of a cached datum to outputDir.
+func (dc *datumCache) restore(ctx context.Context, fileSetID, outputDir string) (retErr error) {
+	commit := client.NewRepo(pfs.DefaultProjectName, client.FileSetsRepoName).NewCommit("", fileSetID)
+	r, err := dc.pachClient.WithCtx(ctx).GetFileTAR(commit, "/")
+	if err != nil {
+		return errors.Wrap(err, "get cached output")
+	}
+	defer errors.Close(&retErr, r, "close cached output")
+	return errors.Wrap(tarutil.Import(outputDir, r), "import cached output")
+}
+
+// restoreLogs appends the logs of the run that produced a cached datum's output
+// to the datum's logs.  Outputs cached by runs that didn't persist their logs
+// have none, which is noted in the logs instead.
+func (dc *datumCache) restoreLogs(ctx context.Context, logger logs.TaggedLogger, fileSetID string, datumLogs *datum.Logs) {
+	if fileSetID == "" {
+		logger.Logf("the datum cache has no logs for the restored output, because the run that produced it didn't persist its logs")
+		return
+	}
+	logger.Logf("logs of the run that produced the restored output follow")
+	commit := client.NewRepo(pfs.DefaultProjectName, client.FileSetsRepoName).NewCommit("", fileSetID)
+	if err := dc.pachClient.WithCtx(ctx).GetFile(commit, datumCacheLogsPath, datumLogs); err != nil {
+		logger.Logf("could not restore datum logs from the datum cache: %v", err)
+	}
+}
+
+// put adds the output of a successful datum to the cache, along with the logs
+// written since logsStart if the datum persists its logs.  Outputs that
+// contain symlinks are not cached, since symlinks into the inputs are uploaded
+// as copies of the input files rather than from the output directory.
+func (dc *datumCache) put(ctx context.Context, key, outputDir string, datumLogs *datum.Logs, logsStart int64) error {
+	if hasSymlinks, err := containsSymlinks(outputDir); err != nil || hasSymlinks {
+		return err
+	}
+	pachClient := dc.pachClient.WithCtx(ctx)
+	resp, err := pachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		return miscutil.WithPipe(func(w io.Writer) error {
+			return tarutil.Export(outputDir, w)
+		}, func(r io.Reader) error {
+			return errors.EnsureStack(mf.PutFileTAR(r))
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "create output file set")
+	}
+	entry := &DatumCacheEntry{OutputFileSetId: resp.FileSetId}
+	fileSetIDs := []string{resp.FileSetId}
+	if datumLogs != nil {
+		resp, err := pachClient.WithCreateFileSetClient(func(mf client.ModifyFile) (retErr error) {
+			r, err := datumLogs.Open(logsStart)
+			if err != nil {
+				return err
+			}
+			defer errors.Close(&retErr, r, "close datum logs")
+			return errors.EnsureStack(mf.PutFile(datumCacheLogsPath, r))
+		})
+		if err != nil {
+			return errors.Wrap(err, "create logs file set")
+		}
+		entry.LogsFileSetId = resp.FileSetId
+		fileSetIDs = append(fileSetIDs, resp.FileSetId)
+	}
+	value, err := anypb.New(entry)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := pachClient.PfsAPIClient.PutCache(ctx, &pfs.PutCacheRequest{
+		Key:        key,
+		Value:      value,
+		FileSetIds: fileSetIDs,
+		Tag:        datumCacheTag,
+	}); err != nil {
+		return errors.Wrap(err, "put cache")
+	}
+	return nil
+}
+
+func containsSymlinks(dir string) (bool, error) {
+	var found bool
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&os.ModeSymlink != 0 {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found, errors.EnsureStack(err)
+}
@@ -0,0 +1,103 @@
+package transform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestDatumCacheKey(t *testing.T) {
+	transform := &pps.Transform{
+		Image:      "ubuntu:latest",
+		Cmd:        []string{"bash"},
+		Stdin:      []string{"cp /pfs/in/* /pfs/out/"},
+		Env:        map[string]string{"A": "1", "B": "2"},
+		DatumCache: true,
+	}
+	const imageID = "docker.io/library/ubuntu@sha256:0123"
+	project := &pfs.Project{Name: pfs.DefaultProjectName}
+	newCache := func(transform *pps.Transform, imageID string) *datumCache {
+		dc, err := newDatumCache(nil, project, transform, imageID)
+		require.NoError(t, err)
+		require.NotNil(t, dc)
+		return dc
+	}
+	input := func(repo, path, hash string) []*common.Input {
+		return []*common.Input{{
+			Name: "in",
+			FileInfo: &pfs.FileInfo{
+				File: client.NewCommit(pfs.DefaultProjectName, repo, "master", "").NewFile(path),
+				Hash: []byte(hash),
+			},
+		}}
+	}
+	dc := newCache(transform, imageID)
+	key := dc.key(input("images", "/a", "hash1"))
+	// The key doesn't depend on the input repo, or on fields of the transform
+	// that don't affect the output.
+	require.Equal(t, key, dc.key(input("other", "/a", "hash1")))
+	other := proto.Clone(transform).(*pps.Transform)
+	other.Image = "ubuntu:22.04"
+	other.Debug = true
+	require.Equal(t, key, newCache(other, imageID).key(input("images", "/a", "hash1")))
+	// It does depend on the contents and paths of the inputs, and on the code.
+	require.NotEqual(t, key, dc.key(input("images", "/a", "hash2")))
+	require.NotEqual(t, key, dc.key(input("images", "/b", "hash1")))
+	require.NotEqual(t, key, newCache(transform, "docker.io/library/ubuntu@sha256:4567").key(input("images", "/a", "hash1")))
+	other = proto.Clone(transform).(*pps.Transform)
+	other.Env["B"] = "3"
+	require.NotEqual(t, key, newCache(other, imageID).key(input("images", "/a", "hash1")))
+	other = proto.Clone(transform).(*pps.Transform)
+	other.Stdin = []string{"cp -r /pfs/in/* /pfs/out/"}
+	require.NotEqual(t, key, newCache(other, imageID).key(input("images", "/a", "hash1")))
+	// Pipelines in other projects don't share the cache.
+	dc, err := newDatumCache(nil, &pfs.Project{Name: "other"}, transform, imageID)
+	require.NoError(t, err)
+	require.NotEqual(t, key, dc.key(input("images", "/a", "hash1")))
+}
+
+func TestDatumCacheKeySecrets(t *testing.T) {
+	dir := t.TempDir()
+	transform := &pps.Transform{
+		Image: "ubuntu:latest",
+		Secrets: []*pps.SecretMount{
+			{Name: "token", Key: "token", EnvVar: "DATUM_CACHE_TEST_TOKEN"},
+			{Name: "creds", MountPath: dir},
+		},
+		DatumCache: true,
+	}
+	project := &pfs.Project{Name: pfs.DefaultProjectName}
+	key := func() string {
+		dc, err := newDatumCache(nil, project, transform, "docker.io/library/ubuntu@sha256:0123")
+		require.NoError(t, err)
+		return dc.key(nil)
+	}
+	t.Setenv("DATUM_CACHE_TEST_TOKEN", "1")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password"), []byte("a"), 0600))
+	first := key()
+	require.Equal(t, first, key())
+	// Changing the value of either secret changes the key.
+	t.Setenv("DATUM_CACHE_TEST_TOKEN", "2")
+	second := key()
+	require.NotEqual(t, first, second)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password"), []byte("b"), 0600))
+	require.NotEqual(t, second, key())
+}
+
+func TestDatumCacheDisabled(t *testing.T) {
+	project := &pfs.Project{Name: pfs.DefaultProjectName}
+	dc, err := newDatumCache(nil, project, &pps.Transform{Image: "ubuntu"}, "docker.io/library/ubuntu@sha256:0123")
+	require.NoError(t, err)
+	require.Nil(t, dc)
+	dc, err = newDatumCache(nil, project, &pps.Transform{Image: "ubuntu", DatumCache: true}, "")
+	require.NoError(t, err)
+	require.Nil(t, dc)
+}
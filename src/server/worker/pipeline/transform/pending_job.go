@@ -29,6 +29,7 @@ type pendingJob struct {
 	baseMetaCommit             *pfs.Commit
 	noSkip                     bool
 	cache                      *cache
+	datumStats                 datum.StatsAggregator
 }
 
 func (pj *pendingJob) writeJobInfo() error {
@@ -50,6 +51,19 @@ func (pj *pendingJob) saveJobStats(stats *datum.Stats) {
 	pj.ji.DataRecovered += stats.Recovered
 }
 
+// saveDatumStats collects the process stats of the datums in a datum set's
+// meta file set, for computing the job's aggregate stats.
+func (pj *pendingJob) saveDatumStats(ctx context.Context, metaFileSetID string) error {
+	pachClient := pj.driver.PachClient()
+	di := datum.NewFileSetIterator(ctx, pachClient.PfsAPIClient, metaFileSetID, nil)
+	return errors.EnsureStack(di.Iterate(func(meta *datum.Meta) error {
+		if meta.Stats != nil {
+			pj.datumStats.Add(meta.Stats)
+		}
+		return nil
+	}))
+}
+
 func (pj *pendingJob) load() error {
 	pachClient := pj.driver.PachClient()
 	var err error
@@ -139,6 +153,8 @@ func (pj *pendingJob) load() error {
 
 func (pj *pendingJob) clearJobStats() {
 	pj.ji.Stats = &pps.ProcessStats{}
+	pj.ji.AggregateStats = nil
+	pj.datumStats = datum.StatsAggregator{}
 	pj.ji.DataProcessed = 0
 	pj.ji.DataSkipped = 0
 	pj.ji.DataFailed = 0
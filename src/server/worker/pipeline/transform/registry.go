@@ -372,12 +372,19 @@ func (reg *registry) processDatums(pachClient *client.APIClient, pj *pendingJob,
 		); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if err := pj.saveDatumStats(ctx, result.MetaFileSetId); err != nil {
+			return err
+		}
 		datum.MergeStats(stats, result.Stats)
 		pj.saveJobStats(result.Stats)
 		return pj.writeJobInfo()
 	}); err != nil {
 		return err
 	}
+	// Computing the aggregate stats requires sorting the stats of every datum,
+	// so it is only done once all of the datum sets have been processed; the
+	// job info is written with them when the job is finished or failed.
+	pj.ji.AggregateStats = pj.datumStats.Aggregate()
 	if stats.FailedId != "" {
 		if err := reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedId)); err != nil {
 			return err
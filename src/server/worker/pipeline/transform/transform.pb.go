@@ -583,6 +583,63 @@ func (x *DatumSetTaskResult) GetStats() *datum.Stats {
 	return nil
 }
 
+type DatumCacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputFileSetId string `protobuf:"bytes,1,opt,name=output_file_set_id,json=outputFileSetId,proto3" json:"output_file_set_id,omitempty"`
+	// logs_file_set_id holds the persisted logs of the run that produced the
+	// output, if the run persisted its logs.
+	LogsFileSetId string `protobuf:"bytes,2,opt,name=logs_file_set_id,json=logsFileSetId,proto3" json:"logs_file_set_id,omitempty"`
+}
+
+func (x *DatumCacheEntry) Reset() {
+	*x = DatumCacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatumCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatumCacheEntry) ProtoMessage() {}
+
+func (x *DatumCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_server_worker_pipeline_transform_transform_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatumCacheEntry.ProtoReflect.Descriptor instead.
+func (*DatumCacheEntry) Descriptor() ([]byte, []int) {
+	return file_server_worker_pipeline_transform_transform_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DatumCacheEntry) GetOutputFileSetId() string {
+	if x != nil {
+		return x.OutputFileSetId
+	}
+	return ""
+}
+
+func (x *DatumCacheEntry) GetLogsFileSetId() string {
+	if x != nil {
+		return x.LogsFileSetId
+	}
+	return ""
+}
+
 var File_server_worker_pipeline_transform_transform_proto protoreflect.FileDescriptor
 
 var file_server_worker_pipeline_transform_transform_proto_rawDesc = []byte{
@@ -683,12 +740,19 @@ var file_server_worker_pipeline_transform_transform_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x53,
 	0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d,
-	0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72,
-	0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x75,
+	0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x12, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x6f, 0x67, 0x73,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64,
+	0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -703,7 +767,7 @@ func file_server_worker_pipeline_transform_transform_proto_rawDescGZIP() []byte
 	return file_server_worker_pipeline_transform_transform_proto_rawDescData
 }
 
-var file_server_worker_pipeline_transform_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_server_worker_pipeline_transform_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_server_worker_pipeline_transform_transform_proto_goTypes = []interface{}{
 	(*CreateParallelDatumsTask)(nil),       // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask
 	(*CreateParallelDatumsTaskResult)(nil), // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult
@@ -713,27 +777,28 @@ var file_server_worker_pipeline_transform_transform_proto_goTypes = []interface{
 	(*CreateDatumSetsTaskResult)(nil),      // 5: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult
 	(*DatumSetTask)(nil),                   // 6: pachyderm.worker.pipeline.transform.DatumSetTask
 	(*DatumSetTaskResult)(nil),             // 7: pachyderm.worker.pipeline.transform.DatumSetTaskResult
-	(*pps.Job)(nil),                        // 8: pps_v2.Job
-	(*pfs.PathRange)(nil),                  // 9: pfs_v2.PathRange
-	(*datum.Stats)(nil),                    // 10: datum.Stats
-	(*pfs.Commit)(nil),                     // 11: pfs_v2.Commit
-	(*datum.SetSpec)(nil),                  // 12: datum.SetSpec
+	(*DatumCacheEntry)(nil),                // 8: pachyderm.worker.pipeline.transform.DatumCacheEntry
+	(*pps.Job)(nil),                        // 9: pps_v2.Job
+	(*pfs.PathRange)(nil),                  // 10: pfs_v2.PathRange
+	(*datum.Stats)(nil),                    // 11: datum.Stats
+	(*pfs.Commit)(nil),                     // 12: pfs_v2.Commit
+	(*datum.SetSpec)(nil),                  // 13: datum.SetSpec
 }
 var file_server_worker_pipeline_transform_transform_proto_depIdxs = []int32{
-	8,  // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.job:type_name -> pps_v2.Job
-	9,  // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.path_range:type_name -> pfs_v2.PathRange
-	10, // 2: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult.stats:type_name -> datum.Stats
-	8,  // 3: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.job:type_name -> pps_v2.Job
-	11, // 4: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.base_meta_commit:type_name -> pfs_v2.Commit
-	9,  // 5: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.path_range:type_name -> pfs_v2.PathRange
-	10, // 6: pachyderm.worker.pipeline.transform.CreateSerialDatumsTaskResult.stats:type_name -> datum.Stats
-	9,  // 7: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.path_range:type_name -> pfs_v2.PathRange
-	12, // 8: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.set_spec:type_name -> datum.SetSpec
-	9,  // 9: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult.datum_sets:type_name -> pfs_v2.PathRange
-	8,  // 10: pachyderm.worker.pipeline.transform.DatumSetTask.job:type_name -> pps_v2.Job
-	9,  // 11: pachyderm.worker.pipeline.transform.DatumSetTask.path_range:type_name -> pfs_v2.PathRange
-	11, // 12: pachyderm.worker.pipeline.transform.DatumSetTask.output_commit:type_name -> pfs_v2.Commit
-	10, // 13: pachyderm.worker.pipeline.transform.DatumSetTaskResult.stats:type_name -> datum.Stats
+	9,  // 0: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.job:type_name -> pps_v2.Job
+	10, // 1: pachyderm.worker.pipeline.transform.CreateParallelDatumsTask.path_range:type_name -> pfs_v2.PathRange
+	11, // 2: pachyderm.worker.pipeline.transform.CreateParallelDatumsTaskResult.stats:type_name -> datum.Stats
+	9,  // 3: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.job:type_name -> pps_v2.Job
+	12, // 4: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.base_meta_commit:type_name -> pfs_v2.Commit
+	10, // 5: pachyderm.worker.pipeline.transform.CreateSerialDatumsTask.path_range:type_name -> pfs_v2.PathRange
+	11, // 6: pachyderm.worker.pipeline.transform.CreateSerialDatumsTaskResult.stats:type_name -> datum.Stats
+	10, // 7: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.path_range:type_name -> pfs_v2.PathRange
+	13, // 8: pachyderm.worker.pipeline.transform.CreateDatumSetsTask.set_spec:type_name -> datum.SetSpec
+	10, // 9: pachyderm.worker.pipeline.transform.CreateDatumSetsTaskResult.datum_sets:type_name -> pfs_v2.PathRange
+	9,  // 10: pachyderm.worker.pipeline.transform.DatumSetTask.job:type_name -> pps_v2.Job
+	10, // 11: pachyderm.worker.pipeline.transform.DatumSetTask.path_range:type_name -> pfs_v2.PathRange
+	12, // 12: pachyderm.worker.pipeline.transform.DatumSetTask.output_commit:type_name -> pfs_v2.Commit
+	11, // 13: pachyderm.worker.pipeline.transform.DatumSetTaskResult.stats:type_name -> datum.Stats
 	14, // [14:14] is the sub-list for method output_type
 	14, // [14:14] is the sub-list for method input_type
 	14, // [14:14] is the sub-list for extension type_name
@@ -843,6 +908,18 @@ func file_server_worker_pipeline_transform_transform_proto_init() {
 				return nil
 			}
 		}
+		file_server_worker_pipeline_transform_transform_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatumCacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -850,7 +927,7 @@ func file_server_worker_pipeline_transform_transform_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server_worker_pipeline_transform_transform_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
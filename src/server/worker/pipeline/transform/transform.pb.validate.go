@@ -1294,3 +1294,107 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DatumSetTaskResultValidationError{}
+
+// Validate checks the field values on DatumCacheEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DatumCacheEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatumCacheEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DatumCacheEntryMultiError, or nil if none found.
+func (m *DatumCacheEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatumCacheEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OutputFileSetId
+
+	// no validation rules for LogsFileSetId
+
+	if len(errors) > 0 {
+		return DatumCacheEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatumCacheEntryMultiError is an error wrapping multiple validation errors
+// returned by DatumCacheEntry.ValidateAll() if the designated constraints
+// aren't met.
+type DatumCacheEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatumCacheEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatumCacheEntryMultiError) AllErrors() []error { return m }
+
+// DatumCacheEntryValidationError is the validation error returned by
+// DatumCacheEntry.Validate if the designated constraints aren't met.
+type DatumCacheEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatumCacheEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatumCacheEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatumCacheEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatumCacheEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatumCacheEntryValidationError) ErrorName() string { return "DatumCacheEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatumCacheEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatumCacheEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatumCacheEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatumCacheEntryValidationError{}
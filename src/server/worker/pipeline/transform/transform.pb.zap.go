@@ -100,3 +100,12 @@ func (x *DatumSetTaskResult) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddObject("stats", x.Stats)
 	return nil
 }
+
+func (x *DatumCacheEntry) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("output_file_set_id", x.OutputFileSetId)
+	enc.AddString("logs_file_set_id", x.LogsFileSetId)
+	return nil
+}
@@ -301,8 +301,8 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, task *DatumS
 				if driver.PipelineInfo().Details.Transform.DatumBatching {
 					return handleDatumSetBatching(ctx, driver, logger, task, status, cacheClient, di, opts)
 				}
-				return forEachDatum(ctx, driver, logger, task, status, cacheClient, di, filesetId, opts, func(ctx context.Context, logger logs.TaggedLogger, env []string) error {
-					return errors.EnsureStack(driver.RunUserCode(ctx, logger, env))
+				return forEachDatum(ctx, driver, logger, task, status, cacheClient, di, filesetId, opts, func(ctx context.Context, logger logs.TaggedLogger, env []string, stats *pps.ProcessStats) error {
+					return errors.EnsureStack(driver.RunUserCode(ctx, logger, env, stats))
 				})
 			})
 			if err != nil {
@@ -348,7 +348,9 @@ func handleDatumSetBatching(ctx context.Context, driver driver.Driver, logger lo
 			cancelCtx, cancel = pctx.WithCancel(ctx)
 			errChan = make(chan error, 1)
 			go func() {
-				err := driver.RunUserCode(cancelCtx, logger, env)
+				// The user code runs across all datums in the batch, so its
+				// resource usage can't be attributed to individual datums.
+				err := driver.RunUserCode(cancelCtx, logger, env, nil)
 				if err == nil {
 					err = errors.New("user code exited prematurely")
 				}
@@ -368,7 +370,7 @@ func handleDatumSetBatching(ctx context.Context, driver driver.Driver, logger lo
 		if err := start(); err != nil {
 			return errors.Wrap(err, "error starting user code")
 		}
-		return forEachDatum(ctx, driver, logger, task, status, cacheClient, di, "", setOpts, func(ctx context.Context, logger logs.TaggedLogger, env []string) (retErr error) {
+		return forEachDatum(ctx, driver, logger, task, status, cacheClient, di, "", setOpts, func(ctx context.Context, logger logs.TaggedLogger, env []string, _ *pps.ProcessStats) (retErr error) {
 			defer func() {
 				// Restart the user code if an error occurred.
 				if retErr != nil {
@@ -395,7 +397,7 @@ func handleDatumSetBatching(ctx context.Context, driver driver.Driver, logger lo
 	})
 }
 
-type datumCallback = func(ctx context.Context, logger logs.TaggedLogger, env []string) error
+type datumCallback = func(ctx context.Context, logger logs.TaggedLogger, env []string, stats *pps.ProcessStats) error
 
 // TODO: There are way too many parameters here. Consider grouping them into a reasonable struct or storing some of these in the driver.
 func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.TaggedLogger, task *DatumSetTask, status *Status, cacheClient *pfssync.CacheClient, di datum.Iterator, filesetId string, setOpts []datum.SetOption, cb datumCallback) error {
@@ -410,6 +412,13 @@ func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.Tag
 	if err != nil {
 		return errors.Wrap(err, "could not get user image ID")
 	}
+	var dc *datumCache
+	if !driver.PipelineInfo().Details.Transform.DatumBatching {
+		dc, err = newDatumCache(driver.PachClient(), driver.PipelineInfo().Pipeline.Project, driver.PipelineInfo().Details.Transform, userImageID)
+		if err != nil {
+			return err
+		}
+	}
 	// Setup datum set for processing.
 	return datum.WithSet(cacheClient, storageRoot, func(s *datum.Set) error {
 		// Process each datum in the datum set.
@@ -428,18 +437,34 @@ func forEachDatum(ctx context.Context, driver driver.Driver, baseLogger logs.Tag
 			if driver.PipelineInfo().Details.DatumTries > 0 {
 				opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 			}
+			// The datum's logger is replaced by one that also writes to its
+			// persisted logs, if it has them, once the datum is set up.
+			datumLogger := logger
 			if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 				opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-					return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, logger, env))
+					return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, datumLogger, env))
 				}))
 			}
+			if driver.PipelineInfo().Details.PersistDatumLogs {
+				opts = append(opts, datum.WithLogs())
+			}
 			return s.WithDatum(meta, func(d *datum.Datum) error {
+				if l := d.Logs(); l != nil {
+					datumLogger = logs.Tee(logger, l)
+				}
+				logger := datumLogger
 				cancelCtx, cancel := pctx.WithCancel(ctx)
 				defer cancel()
 				err := status.withDatum(inputs, cancel, func() error {
 					err := driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 						err := d.Run(cancelCtx, func(runCtx context.Context) error {
-							return cb(runCtx, logger, env)
+							if dc != nil {
+								outputDir := filepath.Join(d.PFSStorageRoot(), common.OutputPrefix)
+								return dc.run(runCtx, logger, inputs, outputDir, d.Logs(), func(runCtx context.Context) error {
+									return cb(runCtx, logger, env, d.Stats())
+								})
+							}
+							return cb(runCtx, logger, env, d.Stats())
 						})
 						return errors.EnsureStack(err)
 					})
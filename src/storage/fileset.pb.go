@@ -611,6 +611,484 @@ func (x *ReadFilesetCDRResponse) GetRef() *cdr.Ref {
 	return nil
 }
 
+// ContentRange is a range of a file's content.  Ranges are identified by where
+// their content is stored, so files that share content share ranges.
+type ContentRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the range's content: ranges with the same id have the same
+	// content.
+	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *ContentRange) Reset() {
+	*x = ContentRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentRange) ProtoMessage() {}
+
+func (x *ContentRange) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentRange.ProtoReflect.Descriptor instead.
+func (*ContentRange) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ContentRange) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ContentRange) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ReadFilesetRangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// ranges are the ranges that make up the file's content, in order.
+	Ranges []*ContentRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *ReadFilesetRangesResponse) Reset() {
+	*x = ReadFilesetRangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFilesetRangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFilesetRangesResponse) ProtoMessage() {}
+
+func (x *ReadFilesetRangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFilesetRangesResponse.ProtoReflect.Descriptor instead.
+func (*ReadFilesetRangesResponse) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadFilesetRangesResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadFilesetRangesResponse) GetRanges() []*ContentRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// FileRefs describes where a file's content is stored, rather than the
+// content itself.  It is used to copy filesets between clusters, sending only
+// the chunks that the destination doesn't already have.
+type FileRefs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	// data_refs are the file's serialized data references, in order.  They are
+	// opaque outside of the storage layer.
+	DataRefs [][]byte `protobuf:"bytes,3,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	// chunk_ids are the IDs of the chunks that data_refs refer to.
+	ChunkIds [][]byte `protobuf:"bytes,4,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
+}
+
+func (x *FileRefs) Reset() {
+	*x = FileRefs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileRefs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileRefs) ProtoMessage() {}
+
+func (x *FileRefs) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileRefs.ProtoReflect.Descriptor instead.
+func (*FileRefs) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FileRefs) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileRefs) GetDatum() string {
+	if x != nil {
+		return x.Datum
+	}
+	return ""
+}
+
+func (x *FileRefs) GetDataRefs() [][]byte {
+	if x != nil {
+		return x.DataRefs
+	}
+	return nil
+}
+
+func (x *FileRefs) GetChunkIds() [][]byte {
+	if x != nil {
+		return x.ChunkIds
+	}
+	return nil
+}
+
+type FindMissingChunksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkIds [][]byte `protobuf:"bytes,1,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
+}
+
+func (x *FindMissingChunksRequest) Reset() {
+	*x = FindMissingChunksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMissingChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMissingChunksRequest) ProtoMessage() {}
+
+func (x *FindMissingChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMissingChunksRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingChunksRequest) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindMissingChunksRequest) GetChunkIds() [][]byte {
+	if x != nil {
+		return x.ChunkIds
+	}
+	return nil
+}
+
+type FindMissingChunksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chunk_ids are the requested chunks that are not in storage.
+	ChunkIds [][]byte `protobuf:"bytes,1,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
+}
+
+func (x *FindMissingChunksResponse) Reset() {
+	*x = FindMissingChunksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMissingChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMissingChunksResponse) ProtoMessage() {}
+
+func (x *FindMissingChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMissingChunksResponse.ProtoReflect.Descriptor instead.
+func (*FindMissingChunksResponse) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FindMissingChunksResponse) GetChunkIds() [][]byte {
+	if x != nil {
+		return x.ChunkIds
+	}
+	return nil
+}
+
+type ReadChunkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId []byte `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+}
+
+func (x *ReadChunkRequest) Reset() {
+	*x = ReadChunkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadChunkRequest) ProtoMessage() {}
+
+func (x *ReadChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadChunkRequest.ProtoReflect.Descriptor instead.
+func (*ReadChunkRequest) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReadChunkRequest) GetChunkId() []byte {
+	if x != nil {
+		return x.ChunkId
+	}
+	return nil
+}
+
+// A ReadChunkResponse is part of a chunk, as it is stored: compressed and
+// encrypted.  Chunks may be spread across multiple messages.
+type ReadChunkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReadChunkResponse) Reset() {
+	*x = ReadChunkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadChunkResponse) ProtoMessage() {}
+
+func (x *ReadChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadChunkResponse.ProtoReflect.Descriptor instead.
+func (*ReadChunkResponse) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReadChunkResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A WriteChunkRequest is part of a chunk, as returned by ReadChunk.
+type WriteChunkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *WriteChunkRequest) Reset() {
+	*x = WriteChunkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteChunkRequest) ProtoMessage() {}
+
+func (x *WriteChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteChunkRequest.ProtoReflect.Descriptor instead.
+func (*WriteChunkRequest) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WriteChunkRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteChunkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId []byte `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+}
+
+func (x *WriteChunkResponse) Reset() {
+	*x = WriteChunkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_fileset_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteChunkResponse) ProtoMessage() {}
+
+func (x *WriteChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_fileset_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteChunkResponse.ProtoReflect.Descriptor instead.
+func (*WriteChunkResponse) Descriptor() ([]byte, []int) {
+	return file_storage_fileset_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WriteChunkResponse) GetChunkId() []byte {
+	if x != nil {
+		return x.ChunkId
+	}
+	return nil
+}
+
 type RenewFilesetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -624,7 +1102,7 @@ type RenewFilesetRequest struct {
 func (x *RenewFilesetRequest) Reset() {
 	*x = RenewFilesetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[9]
+		mi := &file_storage_fileset_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +1115,7 @@ func (x *RenewFilesetRequest) String() string {
 func (*RenewFilesetRequest) ProtoMessage() {}
 
 func (x *RenewFilesetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[9]
+	mi := &file_storage_fileset_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +1128,7 @@ func (x *RenewFilesetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenewFilesetRequest.ProtoReflect.Descriptor instead.
 func (*RenewFilesetRequest) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{9}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RenewFilesetRequest) GetFilesetId() string {
@@ -680,7 +1158,7 @@ type ComposeFilesetRequest struct {
 func (x *ComposeFilesetRequest) Reset() {
 	*x = ComposeFilesetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[10]
+		mi := &file_storage_fileset_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +1171,7 @@ func (x *ComposeFilesetRequest) String() string {
 func (*ComposeFilesetRequest) ProtoMessage() {}
 
 func (x *ComposeFilesetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[10]
+	mi := &file_storage_fileset_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +1184,7 @@ func (x *ComposeFilesetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeFilesetRequest.ProtoReflect.Descriptor instead.
 func (*ComposeFilesetRequest) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{10}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ComposeFilesetRequest) GetFilesetIds() []string {
@@ -734,7 +1212,7 @@ type ComposeFilesetResponse struct {
 func (x *ComposeFilesetResponse) Reset() {
 	*x = ComposeFilesetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[11]
+		mi := &file_storage_fileset_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -747,7 +1225,7 @@ func (x *ComposeFilesetResponse) String() string {
 func (*ComposeFilesetResponse) ProtoMessage() {}
 
 func (x *ComposeFilesetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[11]
+	mi := &file_storage_fileset_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +1238,7 @@ func (x *ComposeFilesetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeFilesetResponse.ProtoReflect.Descriptor instead.
 func (*ComposeFilesetResponse) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{11}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ComposeFilesetResponse) GetFilesetId() string {
@@ -789,7 +1267,7 @@ type ShardFilesetRequest struct {
 func (x *ShardFilesetRequest) Reset() {
 	*x = ShardFilesetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[12]
+		mi := &file_storage_fileset_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -802,7 +1280,7 @@ func (x *ShardFilesetRequest) String() string {
 func (*ShardFilesetRequest) ProtoMessage() {}
 
 func (x *ShardFilesetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[12]
+	mi := &file_storage_fileset_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1293,7 @@ func (x *ShardFilesetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardFilesetRequest.ProtoReflect.Descriptor instead.
 func (*ShardFilesetRequest) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{12}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ShardFilesetRequest) GetFilesetId() string {
@@ -853,7 +1331,7 @@ type PathRange struct {
 func (x *PathRange) Reset() {
 	*x = PathRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[13]
+		mi := &file_storage_fileset_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -866,7 +1344,7 @@ func (x *PathRange) String() string {
 func (*PathRange) ProtoMessage() {}
 
 func (x *PathRange) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[13]
+	mi := &file_storage_fileset_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1357,7 @@ func (x *PathRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathRange.ProtoReflect.Descriptor instead.
 func (*PathRange) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{13}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PathRange) GetLower() string {
@@ -907,7 +1385,7 @@ type ShardFilesetResponse struct {
 func (x *ShardFilesetResponse) Reset() {
 	*x = ShardFilesetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_fileset_proto_msgTypes[14]
+		mi := &file_storage_fileset_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1398,7 @@ func (x *ShardFilesetResponse) String() string {
 func (*ShardFilesetResponse) ProtoMessage() {}
 
 func (x *ShardFilesetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_fileset_proto_msgTypes[14]
+	mi := &file_storage_fileset_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1411,7 @@ func (x *ShardFilesetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardFilesetResponse.ProtoReflect.Descriptor instead.
 func (*ShardFilesetResponse) Descriptor() ([]byte, []int) {
-	return file_storage_fileset_proto_rawDescGZIP(), []int{14}
+	return file_storage_fileset_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ShardFilesetResponse) GetShards() []*PathRange {
@@ -1006,71 +1484,137 @@ var file_storage_fileset_proto_rawDesc = []byte{
 	0x65, 0x74, 0x43, 0x44, 0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x12, 0x1a, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x63, 0x64, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x55, 0x0a,
-	0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
-	0x37, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72,
-	0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x09, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x70,
-	0x70, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73,
-	0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x32, 0xeb, 0x03, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x65, 0x74, 0x43, 0x44, 0x52, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x43, 0x44, 0x52, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0c, 0x52, 0x65, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53,
-	0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61,
-	0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x63, 0x64, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x3d, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x19,
+	0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74,
+	0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x66, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x18,
+	0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x22,
+	0x2d, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0x27,
+	0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x2f, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49,
+	0x64, 0x22, 0x55, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x13,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x37,
+	0x0a, 0x09, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x32, 0xcd, 0x07, 0x0a, 0x07,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x52,
+	0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0e, 0x52, 0x65, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x43, 0x44, 0x52, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x43, 0x44,
+	0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a,
+	0x11, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
+	0x4e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x66, 0x73, 0x12, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73, 0x1a, 0x1e, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
+	0x46, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64,
+	0x65, 0x72, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x68, 0x79, 0x64, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x32,
+	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1085,54 +1629,76 @@ func file_storage_fileset_proto_rawDescGZIP() []byte {
 	return file_storage_fileset_proto_rawDescData
 }
 
-var file_storage_fileset_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_storage_fileset_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_storage_fileset_proto_goTypes = []interface{}{
-	(*AppendFile)(nil),             // 0: storage.AppendFile
-	(*DeleteFile)(nil),             // 1: storage.DeleteFile
-	(*CopyFile)(nil),               // 2: storage.CopyFile
-	(*CreateFilesetRequest)(nil),   // 3: storage.CreateFilesetRequest
-	(*CreateFilesetResponse)(nil),  // 4: storage.CreateFilesetResponse
-	(*FileFilter)(nil),             // 5: storage.FileFilter
-	(*ReadFilesetRequest)(nil),     // 6: storage.ReadFilesetRequest
-	(*ReadFilesetResponse)(nil),    // 7: storage.ReadFilesetResponse
-	(*ReadFilesetCDRResponse)(nil), // 8: storage.ReadFilesetCDRResponse
-	(*RenewFilesetRequest)(nil),    // 9: storage.RenewFilesetRequest
-	(*ComposeFilesetRequest)(nil),  // 10: storage.ComposeFilesetRequest
-	(*ComposeFilesetResponse)(nil), // 11: storage.ComposeFilesetResponse
-	(*ShardFilesetRequest)(nil),    // 12: storage.ShardFilesetRequest
-	(*PathRange)(nil),              // 13: storage.PathRange
-	(*ShardFilesetResponse)(nil),   // 14: storage.ShardFilesetResponse
-	(*wrapperspb.BytesValue)(nil),  // 15: google.protobuf.BytesValue
-	(*cdr.Ref)(nil),                // 16: cdr.Ref
-	(*emptypb.Empty)(nil),          // 17: google.protobuf.Empty
+	(*AppendFile)(nil),                // 0: storage.AppendFile
+	(*DeleteFile)(nil),                // 1: storage.DeleteFile
+	(*CopyFile)(nil),                  // 2: storage.CopyFile
+	(*CreateFilesetRequest)(nil),      // 3: storage.CreateFilesetRequest
+	(*CreateFilesetResponse)(nil),     // 4: storage.CreateFilesetResponse
+	(*FileFilter)(nil),                // 5: storage.FileFilter
+	(*ReadFilesetRequest)(nil),        // 6: storage.ReadFilesetRequest
+	(*ReadFilesetResponse)(nil),       // 7: storage.ReadFilesetResponse
+	(*ReadFilesetCDRResponse)(nil),    // 8: storage.ReadFilesetCDRResponse
+	(*ContentRange)(nil),              // 9: storage.ContentRange
+	(*ReadFilesetRangesResponse)(nil), // 10: storage.ReadFilesetRangesResponse
+	(*FileRefs)(nil),                  // 11: storage.FileRefs
+	(*FindMissingChunksRequest)(nil),  // 12: storage.FindMissingChunksRequest
+	(*FindMissingChunksResponse)(nil), // 13: storage.FindMissingChunksResponse
+	(*ReadChunkRequest)(nil),          // 14: storage.ReadChunkRequest
+	(*ReadChunkResponse)(nil),         // 15: storage.ReadChunkResponse
+	(*WriteChunkRequest)(nil),         // 16: storage.WriteChunkRequest
+	(*WriteChunkResponse)(nil),        // 17: storage.WriteChunkResponse
+	(*RenewFilesetRequest)(nil),       // 18: storage.RenewFilesetRequest
+	(*ComposeFilesetRequest)(nil),     // 19: storage.ComposeFilesetRequest
+	(*ComposeFilesetResponse)(nil),    // 20: storage.ComposeFilesetResponse
+	(*ShardFilesetRequest)(nil),       // 21: storage.ShardFilesetRequest
+	(*PathRange)(nil),                 // 22: storage.PathRange
+	(*ShardFilesetResponse)(nil),      // 23: storage.ShardFilesetResponse
+	(*wrapperspb.BytesValue)(nil),     // 24: google.protobuf.BytesValue
+	(*cdr.Ref)(nil),                   // 25: cdr.Ref
+	(*emptypb.Empty)(nil),             // 26: google.protobuf.Empty
 }
 var file_storage_fileset_proto_depIdxs = []int32{
-	15, // 0: storage.AppendFile.data:type_name -> google.protobuf.BytesValue
+	24, // 0: storage.AppendFile.data:type_name -> google.protobuf.BytesValue
 	0,  // 1: storage.CreateFilesetRequest.append_file:type_name -> storage.AppendFile
 	1,  // 2: storage.CreateFilesetRequest.delete_file:type_name -> storage.DeleteFile
 	2,  // 3: storage.CreateFilesetRequest.copy_file:type_name -> storage.CopyFile
-	13, // 4: storage.FileFilter.path_range:type_name -> storage.PathRange
+	22, // 4: storage.FileFilter.path_range:type_name -> storage.PathRange
 	5,  // 5: storage.ReadFilesetRequest.filters:type_name -> storage.FileFilter
-	15, // 6: storage.ReadFilesetResponse.data:type_name -> google.protobuf.BytesValue
-	16, // 7: storage.ReadFilesetCDRResponse.ref:type_name -> cdr.Ref
-	13, // 8: storage.ShardFilesetResponse.shards:type_name -> storage.PathRange
-	3,  // 9: storage.Fileset.CreateFileset:input_type -> storage.CreateFilesetRequest
-	6,  // 10: storage.Fileset.ReadFileset:input_type -> storage.ReadFilesetRequest
-	6,  // 11: storage.Fileset.ReadFilesetCDR:input_type -> storage.ReadFilesetRequest
-	9,  // 12: storage.Fileset.RenewFileset:input_type -> storage.RenewFilesetRequest
-	10, // 13: storage.Fileset.ComposeFileset:input_type -> storage.ComposeFilesetRequest
-	12, // 14: storage.Fileset.ShardFileset:input_type -> storage.ShardFilesetRequest
-	4,  // 15: storage.Fileset.CreateFileset:output_type -> storage.CreateFilesetResponse
-	7,  // 16: storage.Fileset.ReadFileset:output_type -> storage.ReadFilesetResponse
-	8,  // 17: storage.Fileset.ReadFilesetCDR:output_type -> storage.ReadFilesetCDRResponse
-	17, // 18: storage.Fileset.RenewFileset:output_type -> google.protobuf.Empty
-	11, // 19: storage.Fileset.ComposeFileset:output_type -> storage.ComposeFilesetResponse
-	14, // 20: storage.Fileset.ShardFileset:output_type -> storage.ShardFilesetResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	24, // 6: storage.ReadFilesetResponse.data:type_name -> google.protobuf.BytesValue
+	25, // 7: storage.ReadFilesetCDRResponse.ref:type_name -> cdr.Ref
+	9,  // 8: storage.ReadFilesetRangesResponse.ranges:type_name -> storage.ContentRange
+	22, // 9: storage.ShardFilesetResponse.shards:type_name -> storage.PathRange
+	3,  // 10: storage.Fileset.CreateFileset:input_type -> storage.CreateFilesetRequest
+	6,  // 11: storage.Fileset.ReadFileset:input_type -> storage.ReadFilesetRequest
+	6,  // 12: storage.Fileset.ReadFilesetCDR:input_type -> storage.ReadFilesetRequest
+	6,  // 13: storage.Fileset.ReadFilesetRanges:input_type -> storage.ReadFilesetRequest
+	6,  // 14: storage.Fileset.ReadFilesetRefs:input_type -> storage.ReadFilesetRequest
+	12, // 15: storage.Fileset.FindMissingChunks:input_type -> storage.FindMissingChunksRequest
+	14, // 16: storage.Fileset.ReadChunk:input_type -> storage.ReadChunkRequest
+	16, // 17: storage.Fileset.WriteChunk:input_type -> storage.WriteChunkRequest
+	11, // 18: storage.Fileset.CreateFilesetFromRefs:input_type -> storage.FileRefs
+	18, // 19: storage.Fileset.RenewFileset:input_type -> storage.RenewFilesetRequest
+	19, // 20: storage.Fileset.ComposeFileset:input_type -> storage.ComposeFilesetRequest
+	21, // 21: storage.Fileset.ShardFileset:input_type -> storage.ShardFilesetRequest
+	4,  // 22: storage.Fileset.CreateFileset:output_type -> storage.CreateFilesetResponse
+	7,  // 23: storage.Fileset.ReadFileset:output_type -> storage.ReadFilesetResponse
+	8,  // 24: storage.Fileset.ReadFilesetCDR:output_type -> storage.ReadFilesetCDRResponse
+	10, // 25: storage.Fileset.ReadFilesetRanges:output_type -> storage.ReadFilesetRangesResponse
+	11, // 26: storage.Fileset.ReadFilesetRefs:output_type -> storage.FileRefs
+	13, // 27: storage.Fileset.FindMissingChunks:output_type -> storage.FindMissingChunksResponse
+	15, // 28: storage.Fileset.ReadChunk:output_type -> storage.ReadChunkResponse
+	17, // 29: storage.Fileset.WriteChunk:output_type -> storage.WriteChunkResponse
+	4,  // 30: storage.Fileset.CreateFilesetFromRefs:output_type -> storage.CreateFilesetResponse
+	26, // 31: storage.Fileset.RenewFileset:output_type -> google.protobuf.Empty
+	20, // 32: storage.Fileset.ComposeFileset:output_type -> storage.ComposeFilesetResponse
+	23, // 33: storage.Fileset.ShardFileset:output_type -> storage.ShardFilesetResponse
+	22, // [22:34] is the sub-list for method output_type
+	10, // [10:22] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_storage_fileset_proto_init() }
@@ -1250,7 +1816,7 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewFilesetRequest); i {
+			switch v := v.(*ContentRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1262,7 +1828,7 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComposeFilesetRequest); i {
+			switch v := v.(*ReadFilesetRangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1274,7 +1840,7 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComposeFilesetResponse); i {
+			switch v := v.(*FileRefs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1286,7 +1852,7 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardFilesetRequest); i {
+			switch v := v.(*FindMissingChunksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1298,7 +1864,7 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathRange); i {
+			switch v := v.(*FindMissingChunksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1310,6 +1876,114 @@ func file_storage_fileset_proto_init() {
 			}
 		}
 		file_storage_fileset_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadChunkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadChunkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteChunkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteChunkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewFilesetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposeFilesetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposeFilesetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardFilesetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_fileset_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShardFilesetResponse); i {
 			case 0:
 				return &v.state
@@ -1337,7 +2011,7 @@ func file_storage_fileset_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_storage_fileset_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
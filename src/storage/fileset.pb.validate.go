@@ -1268,6 +1268,968 @@ var _ interface {
 	ErrorName() string
 } = ReadFilesetCDRResponseValidationError{}
 
+// Validate checks the field values on ContentRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentRange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentRangeMultiError, or
+// nil if none found.
+func (m *ContentRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return ContentRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentRangeMultiError is an error wrapping multiple validation errors
+// returned by ContentRange.ValidateAll() if the designated constraints aren't met.
+type ContentRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentRangeMultiError) AllErrors() []error { return m }
+
+// ContentRangeValidationError is the validation error returned by
+// ContentRange.Validate if the designated constraints aren't met.
+type ContentRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentRangeValidationError) ErrorName() string { return "ContentRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentRangeValidationError{}
+
+// Validate checks the field values on ReadFilesetRangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadFilesetRangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadFilesetRangesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadFilesetRangesResponseMultiError, or nil if none found.
+func (m *ReadFilesetRangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadFilesetRangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	for idx, item := range m.GetRanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadFilesetRangesResponseValidationError{
+						field:  fmt.Sprintf("Ranges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadFilesetRangesResponseValidationError{
+						field:  fmt.Sprintf("Ranges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadFilesetRangesResponseValidationError{
+					field:  fmt.Sprintf("Ranges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadFilesetRangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadFilesetRangesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReadFilesetRangesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReadFilesetRangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadFilesetRangesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadFilesetRangesResponseMultiError) AllErrors() []error { return m }
+
+// ReadFilesetRangesResponseValidationError is the validation error returned by
+// ReadFilesetRangesResponse.Validate if the designated constraints aren't met.
+type ReadFilesetRangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadFilesetRangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadFilesetRangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadFilesetRangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadFilesetRangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadFilesetRangesResponseValidationError) ErrorName() string {
+	return "ReadFilesetRangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadFilesetRangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadFilesetRangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadFilesetRangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadFilesetRangesResponseValidationError{}
+
+// Validate checks the field values on FileRefs with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileRefs) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileRefs with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileRefsMultiError, or nil
+// if none found.
+func (m *FileRefs) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileRefs) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Datum
+
+	if len(errors) > 0 {
+		return FileRefsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileRefsMultiError is an error wrapping multiple validation errors returned
+// by FileRefs.ValidateAll() if the designated constraints aren't met.
+type FileRefsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileRefsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileRefsMultiError) AllErrors() []error { return m }
+
+// FileRefsValidationError is the validation error returned by
+// FileRefs.Validate if the designated constraints aren't met.
+type FileRefsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileRefsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileRefsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileRefsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileRefsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileRefsValidationError) ErrorName() string { return "FileRefsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileRefsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileRefs.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileRefsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileRefsValidationError{}
+
+// Validate checks the field values on FindMissingChunksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindMissingChunksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindMissingChunksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindMissingChunksRequestMultiError, or nil if none found.
+func (m *FindMissingChunksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindMissingChunksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FindMissingChunksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindMissingChunksRequestMultiError is an error wrapping multiple validation
+// errors returned by FindMissingChunksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FindMissingChunksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindMissingChunksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindMissingChunksRequestMultiError) AllErrors() []error { return m }
+
+// FindMissingChunksRequestValidationError is the validation error returned by
+// FindMissingChunksRequest.Validate if the designated constraints aren't met.
+type FindMissingChunksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindMissingChunksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindMissingChunksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindMissingChunksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindMissingChunksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindMissingChunksRequestValidationError) ErrorName() string {
+	return "FindMissingChunksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindMissingChunksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindMissingChunksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindMissingChunksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindMissingChunksRequestValidationError{}
+
+// Validate checks the field values on FindMissingChunksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindMissingChunksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindMissingChunksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindMissingChunksResponseMultiError, or nil if none found.
+func (m *FindMissingChunksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindMissingChunksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FindMissingChunksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindMissingChunksResponseMultiError is an error wrapping multiple validation
+// errors returned by FindMissingChunksResponse.ValidateAll() if the
+// designated constraints aren't met.
+type FindMissingChunksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindMissingChunksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindMissingChunksResponseMultiError) AllErrors() []error { return m }
+
+// FindMissingChunksResponseValidationError is the validation error returned by
+// FindMissingChunksResponse.Validate if the designated constraints aren't met.
+type FindMissingChunksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindMissingChunksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindMissingChunksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindMissingChunksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindMissingChunksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindMissingChunksResponseValidationError) ErrorName() string {
+	return "FindMissingChunksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindMissingChunksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindMissingChunksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindMissingChunksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindMissingChunksResponseValidationError{}
+
+// Validate checks the field values on ReadChunkRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadChunkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadChunkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadChunkRequestMultiError, or nil if none found.
+func (m *ReadChunkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadChunkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChunkId
+
+	if len(errors) > 0 {
+		return ReadChunkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadChunkRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadChunkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadChunkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadChunkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadChunkRequestMultiError) AllErrors() []error { return m }
+
+// ReadChunkRequestValidationError is the validation error returned by
+// ReadChunkRequest.Validate if the designated constraints aren't met.
+type ReadChunkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadChunkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadChunkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadChunkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadChunkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadChunkRequestValidationError) ErrorName() string { return "ReadChunkRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadChunkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadChunkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadChunkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadChunkRequestValidationError{}
+
+// Validate checks the field values on ReadChunkResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadChunkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadChunkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadChunkResponseMultiError, or nil if none found.
+func (m *ReadChunkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadChunkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ReadChunkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadChunkResponseMultiError is an error wrapping multiple validation errors
+// returned by ReadChunkResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReadChunkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadChunkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadChunkResponseMultiError) AllErrors() []error { return m }
+
+// ReadChunkResponseValidationError is the validation error returned by
+// ReadChunkResponse.Validate if the designated constraints aren't met.
+type ReadChunkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadChunkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadChunkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadChunkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadChunkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadChunkResponseValidationError) ErrorName() string {
+	return "ReadChunkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadChunkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadChunkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadChunkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadChunkResponseValidationError{}
+
+// Validate checks the field values on WriteChunkRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WriteChunkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteChunkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteChunkRequestMultiError, or nil if none found.
+func (m *WriteChunkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteChunkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return WriteChunkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteChunkRequestMultiError is an error wrapping multiple validation errors
+// returned by WriteChunkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WriteChunkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteChunkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteChunkRequestMultiError) AllErrors() []error { return m }
+
+// WriteChunkRequestValidationError is the validation error returned by
+// WriteChunkRequest.Validate if the designated constraints aren't met.
+type WriteChunkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteChunkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteChunkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteChunkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteChunkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteChunkRequestValidationError) ErrorName() string {
+	return "WriteChunkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteChunkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteChunkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteChunkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteChunkRequestValidationError{}
+
+// Validate checks the field values on WriteChunkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WriteChunkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteChunkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteChunkResponseMultiError, or nil if none found.
+func (m *WriteChunkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteChunkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChunkId
+
+	if len(errors) > 0 {
+		return WriteChunkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteChunkResponseMultiError is an error wrapping multiple validation errors
+// returned by WriteChunkResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WriteChunkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteChunkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteChunkResponseMultiError) AllErrors() []error { return m }
+
+// WriteChunkResponseValidationError is the validation error returned by
+// WriteChunkResponse.Validate if the designated constraints aren't met.
+type WriteChunkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteChunkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteChunkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteChunkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteChunkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteChunkResponseValidationError) ErrorName() string {
+	return "WriteChunkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteChunkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteChunkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteChunkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteChunkResponseValidationError{}
+
 // Validate checks the field values on RenewFilesetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -121,6 +121,117 @@ func (x *ReadFilesetCDRResponse) MarshalLogObject(enc zapcore.ObjectEncoder) err
 	return nil
 }
 
+func (x *ContentRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "id", x.Id)
+	enc.AddInt64("size_bytes", x.SizeBytes)
+	return nil
+}
+
+func (x *ReadFilesetRangesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("path", x.Path)
+	rangesArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.Ranges {
+			if obj, ok := interface{}(v).(zapcore.ObjectMarshaler); ok {
+				enc.AppendObject(obj)
+			} else {
+				enc.AppendReflected(v)
+			}
+		}
+		return nil
+	}
+	enc.AddArray("ranges", zapcore.ArrayMarshalerFunc(rangesArrMarshaller))
+	return nil
+}
+
+func (x *FileRefs) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	enc.AddString("path", x.Path)
+	enc.AddString("datum", x.Datum)
+	data_refsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.DataRefs {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("data_refs", zapcore.ArrayMarshalerFunc(data_refsArrMarshaller))
+	chunk_idsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.ChunkIds {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("chunk_ids", zapcore.ArrayMarshalerFunc(chunk_idsArrMarshaller))
+	return nil
+}
+
+func (x *FindMissingChunksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	chunk_idsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.ChunkIds {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("chunk_ids", zapcore.ArrayMarshalerFunc(chunk_idsArrMarshaller))
+	return nil
+}
+
+func (x *FindMissingChunksResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	chunk_idsArrMarshaller := func(enc zapcore.ArrayEncoder) error {
+		for _, v := range x.ChunkIds {
+			enc.AppendByteString(v)
+		}
+		return nil
+	}
+	enc.AddArray("chunk_ids", zapcore.ArrayMarshalerFunc(chunk_idsArrMarshaller))
+	return nil
+}
+
+func (x *ReadChunkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "chunk_id", x.ChunkId)
+	return nil
+}
+
+func (x *ReadChunkResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "data", x.Data)
+	return nil
+}
+
+func (x *WriteChunkRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "data", x.Data)
+	return nil
+}
+
+func (x *WriteChunkResponse) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if x == nil {
+		return nil
+	}
+	protoextensions.AddBytes(enc, "chunk_id", x.ChunkId)
+	return nil
+}
+
 func (x *RenewFilesetRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if x == nil {
 		return nil
@@ -20,12 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Fileset_CreateFileset_FullMethodName  = "/storage.Fileset/CreateFileset"
-	Fileset_ReadFileset_FullMethodName    = "/storage.Fileset/ReadFileset"
-	Fileset_ReadFilesetCDR_FullMethodName = "/storage.Fileset/ReadFilesetCDR"
-	Fileset_RenewFileset_FullMethodName   = "/storage.Fileset/RenewFileset"
-	Fileset_ComposeFileset_FullMethodName = "/storage.Fileset/ComposeFileset"
-	Fileset_ShardFileset_FullMethodName   = "/storage.Fileset/ShardFileset"
+	Fileset_CreateFileset_FullMethodName         = "/storage.Fileset/CreateFileset"
+	Fileset_ReadFileset_FullMethodName           = "/storage.Fileset/ReadFileset"
+	Fileset_ReadFilesetCDR_FullMethodName        = "/storage.Fileset/ReadFilesetCDR"
+	Fileset_ReadFilesetRanges_FullMethodName     = "/storage.Fileset/ReadFilesetRanges"
+	Fileset_ReadFilesetRefs_FullMethodName       = "/storage.Fileset/ReadFilesetRefs"
+	Fileset_FindMissingChunks_FullMethodName     = "/storage.Fileset/FindMissingChunks"
+	Fileset_ReadChunk_FullMethodName             = "/storage.Fileset/ReadChunk"
+	Fileset_WriteChunk_FullMethodName            = "/storage.Fileset/WriteChunk"
+	Fileset_CreateFilesetFromRefs_FullMethodName = "/storage.Fileset/CreateFilesetFromRefs"
+	Fileset_RenewFileset_FullMethodName          = "/storage.Fileset/RenewFileset"
+	Fileset_ComposeFileset_FullMethodName        = "/storage.Fileset/ComposeFileset"
+	Fileset_ShardFileset_FullMethodName          = "/storage.Fileset/ShardFileset"
 )
 
 // FilesetClient is the client API for Fileset service.
@@ -40,6 +46,26 @@ type FilesetClient interface {
 	// ReadFileset reads a fileset.
 	ReadFileset(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetClient, error)
 	ReadFilesetCDR(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetCDRClient, error)
+	// ReadFilesetRanges reads the ranges that the content of each file in a
+	// fileset is stored in, so that clients can cache file content by range.
+	ReadFilesetRanges(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetRangesClient, error)
+	// ReadFilesetRefs reads the data references of the files in a fileset.
+	// It, and the chunk RPCs below, require the CLUSTER_REPLICATE permission.
+	ReadFilesetRefs(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetRefsClient, error)
+	// FindMissingChunks returns the chunks, out of those requested, that are
+	// not in storage.  The chunks that are in storage are kept for at least 30
+	// minutes, like those written with WriteChunk.
+	FindMissingChunks(ctx context.Context, in *FindMissingChunksRequest, opts ...grpc.CallOption) (*FindMissingChunksResponse, error)
+	// ReadChunk reads a chunk as it is stored.
+	ReadChunk(ctx context.Context, in *ReadChunkRequest, opts ...grpc.CallOption) (Fileset_ReadChunkClient, error)
+	// WriteChunk writes a chunk that was read with ReadChunk, possibly from
+	// another cluster.  The chunk is kept for at least 30 minutes, during which
+	// it should be referenced by a fileset.
+	WriteChunk(ctx context.Context, opts ...grpc.CallOption) (Fileset_WriteChunkClient, error)
+	// CreateFilesetFromRefs creates a fileset from the data references of its
+	// files, as read by ReadFilesetRefs.  The referenced chunks must be in
+	// storage.
+	CreateFilesetFromRefs(ctx context.Context, opts ...grpc.CallOption) (Fileset_CreateFilesetFromRefsClient, error)
 	// RenewFileset renews a fileset.
 	RenewFileset(ctx context.Context, in *RenewFilesetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ComposeFileset composes a fileset.
@@ -158,6 +184,179 @@ func (x *filesetReadFilesetCDRClient) Recv() (*ReadFilesetCDRResponse, error) {
 	return m, nil
 }
 
+func (c *filesetClient) ReadFilesetRanges(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetRangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileset_ServiceDesc.Streams[3], Fileset_ReadFilesetRanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesetReadFilesetRangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileset_ReadFilesetRangesClient interface {
+	Recv() (*ReadFilesetRangesResponse, error)
+	grpc.ClientStream
+}
+
+type filesetReadFilesetRangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesetReadFilesetRangesClient) Recv() (*ReadFilesetRangesResponse, error) {
+	m := new(ReadFilesetRangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *filesetClient) ReadFilesetRefs(ctx context.Context, in *ReadFilesetRequest, opts ...grpc.CallOption) (Fileset_ReadFilesetRefsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileset_ServiceDesc.Streams[4], Fileset_ReadFilesetRefs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesetReadFilesetRefsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileset_ReadFilesetRefsClient interface {
+	Recv() (*FileRefs, error)
+	grpc.ClientStream
+}
+
+type filesetReadFilesetRefsClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesetReadFilesetRefsClient) Recv() (*FileRefs, error) {
+	m := new(FileRefs)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *filesetClient) FindMissingChunks(ctx context.Context, in *FindMissingChunksRequest, opts ...grpc.CallOption) (*FindMissingChunksResponse, error) {
+	out := new(FindMissingChunksResponse)
+	err := c.cc.Invoke(ctx, Fileset_FindMissingChunks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesetClient) ReadChunk(ctx context.Context, in *ReadChunkRequest, opts ...grpc.CallOption) (Fileset_ReadChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileset_ServiceDesc.Streams[5], Fileset_ReadChunk_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesetReadChunkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileset_ReadChunkClient interface {
+	Recv() (*ReadChunkResponse, error)
+	grpc.ClientStream
+}
+
+type filesetReadChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesetReadChunkClient) Recv() (*ReadChunkResponse, error) {
+	m := new(ReadChunkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *filesetClient) WriteChunk(ctx context.Context, opts ...grpc.CallOption) (Fileset_WriteChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileset_ServiceDesc.Streams[6], Fileset_WriteChunk_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesetWriteChunkClient{stream}
+	return x, nil
+}
+
+type Fileset_WriteChunkClient interface {
+	Send(*WriteChunkRequest) error
+	CloseAndRecv() (*WriteChunkResponse, error)
+	grpc.ClientStream
+}
+
+type filesetWriteChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesetWriteChunkClient) Send(m *WriteChunkRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *filesetWriteChunkClient) CloseAndRecv() (*WriteChunkResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteChunkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *filesetClient) CreateFilesetFromRefs(ctx context.Context, opts ...grpc.CallOption) (Fileset_CreateFilesetFromRefsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileset_ServiceDesc.Streams[7], Fileset_CreateFilesetFromRefs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesetCreateFilesetFromRefsClient{stream}
+	return x, nil
+}
+
+type Fileset_CreateFilesetFromRefsClient interface {
+	Send(*FileRefs) error
+	CloseAndRecv() (*CreateFilesetResponse, error)
+	grpc.ClientStream
+}
+
+type filesetCreateFilesetFromRefsClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesetCreateFilesetFromRefsClient) Send(m *FileRefs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *filesetCreateFilesetFromRefsClient) CloseAndRecv() (*CreateFilesetResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateFilesetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *filesetClient) RenewFileset(ctx context.Context, in *RenewFilesetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Fileset_RenewFileset_FullMethodName, in, out, opts...)
@@ -197,6 +396,26 @@ type FilesetServer interface {
 	// ReadFileset reads a fileset.
 	ReadFileset(*ReadFilesetRequest, Fileset_ReadFilesetServer) error
 	ReadFilesetCDR(*ReadFilesetRequest, Fileset_ReadFilesetCDRServer) error
+	// ReadFilesetRanges reads the ranges that the content of each file in a
+	// fileset is stored in, so that clients can cache file content by range.
+	ReadFilesetRanges(*ReadFilesetRequest, Fileset_ReadFilesetRangesServer) error
+	// ReadFilesetRefs reads the data references of the files in a fileset.
+	// It, and the chunk RPCs below, require the CLUSTER_REPLICATE permission.
+	ReadFilesetRefs(*ReadFilesetRequest, Fileset_ReadFilesetRefsServer) error
+	// FindMissingChunks returns the chunks, out of those requested, that are
+	// not in storage.  The chunks that are in storage are kept for at least 30
+	// minutes, like those written with WriteChunk.
+	FindMissingChunks(context.Context, *FindMissingChunksRequest) (*FindMissingChunksResponse, error)
+	// ReadChunk reads a chunk as it is stored.
+	ReadChunk(*ReadChunkRequest, Fileset_ReadChunkServer) error
+	// WriteChunk writes a chunk that was read with ReadChunk, possibly from
+	// another cluster.  The chunk is kept for at least 30 minutes, during which
+	// it should be referenced by a fileset.
+	WriteChunk(Fileset_WriteChunkServer) error
+	// CreateFilesetFromRefs creates a fileset from the data references of its
+	// files, as read by ReadFilesetRefs.  The referenced chunks must be in
+	// storage.
+	CreateFilesetFromRefs(Fileset_CreateFilesetFromRefsServer) error
 	// RenewFileset renews a fileset.
 	RenewFileset(context.Context, *RenewFilesetRequest) (*emptypb.Empty, error)
 	// ComposeFileset composes a fileset.
@@ -223,6 +442,24 @@ func (UnimplementedFilesetServer) ReadFileset(*ReadFilesetRequest, Fileset_ReadF
 func (UnimplementedFilesetServer) ReadFilesetCDR(*ReadFilesetRequest, Fileset_ReadFilesetCDRServer) error {
 	return status.Errorf(codes.Unimplemented, "method ReadFilesetCDR not implemented")
 }
+func (UnimplementedFilesetServer) ReadFilesetRanges(*ReadFilesetRequest, Fileset_ReadFilesetRangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFilesetRanges not implemented")
+}
+func (UnimplementedFilesetServer) ReadFilesetRefs(*ReadFilesetRequest, Fileset_ReadFilesetRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFilesetRefs not implemented")
+}
+func (UnimplementedFilesetServer) FindMissingChunks(context.Context, *FindMissingChunksRequest) (*FindMissingChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingChunks not implemented")
+}
+func (UnimplementedFilesetServer) ReadChunk(*ReadChunkRequest, Fileset_ReadChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadChunk not implemented")
+}
+func (UnimplementedFilesetServer) WriteChunk(Fileset_WriteChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteChunk not implemented")
+}
+func (UnimplementedFilesetServer) CreateFilesetFromRefs(Fileset_CreateFilesetFromRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFilesetFromRefs not implemented")
+}
 func (UnimplementedFilesetServer) RenewFileset(context.Context, *RenewFilesetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenewFileset not implemented")
 }
@@ -313,6 +550,139 @@ func (x *filesetReadFilesetCDRServer) Send(m *ReadFilesetCDRResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Fileset_ReadFilesetRanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFilesetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FilesetServer).ReadFilesetRanges(m, &filesetReadFilesetRangesServer{stream})
+}
+
+type Fileset_ReadFilesetRangesServer interface {
+	Send(*ReadFilesetRangesResponse) error
+	grpc.ServerStream
+}
+
+type filesetReadFilesetRangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesetReadFilesetRangesServer) Send(m *ReadFilesetRangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Fileset_ReadFilesetRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFilesetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FilesetServer).ReadFilesetRefs(m, &filesetReadFilesetRefsServer{stream})
+}
+
+type Fileset_ReadFilesetRefsServer interface {
+	Send(*FileRefs) error
+	grpc.ServerStream
+}
+
+type filesetReadFilesetRefsServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesetReadFilesetRefsServer) Send(m *FileRefs) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Fileset_FindMissingChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesetServer).FindMissingChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fileset_FindMissingChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesetServer).FindMissingChunks(ctx, req.(*FindMissingChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileset_ReadChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadChunkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FilesetServer).ReadChunk(m, &filesetReadChunkServer{stream})
+}
+
+type Fileset_ReadChunkServer interface {
+	Send(*ReadChunkResponse) error
+	grpc.ServerStream
+}
+
+type filesetReadChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesetReadChunkServer) Send(m *ReadChunkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Fileset_WriteChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FilesetServer).WriteChunk(&filesetWriteChunkServer{stream})
+}
+
+type Fileset_WriteChunkServer interface {
+	SendAndClose(*WriteChunkResponse) error
+	Recv() (*WriteChunkRequest, error)
+	grpc.ServerStream
+}
+
+type filesetWriteChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesetWriteChunkServer) SendAndClose(m *WriteChunkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *filesetWriteChunkServer) Recv() (*WriteChunkRequest, error) {
+	m := new(WriteChunkRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Fileset_CreateFilesetFromRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FilesetServer).CreateFilesetFromRefs(&filesetCreateFilesetFromRefsServer{stream})
+}
+
+type Fileset_CreateFilesetFromRefsServer interface {
+	SendAndClose(*CreateFilesetResponse) error
+	Recv() (*FileRefs, error)
+	grpc.ServerStream
+}
+
+type filesetCreateFilesetFromRefsServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesetCreateFilesetFromRefsServer) SendAndClose(m *CreateFilesetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *filesetCreateFilesetFromRefsServer) Recv() (*FileRefs, error) {
+	m := new(FileRefs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Fileset_RenewFileset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenewFilesetRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +744,10 @@ var Fileset_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "storage.Fileset",
 	HandlerType: (*FilesetServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindMissingChunks",
+			Handler:    _Fileset_FindMissingChunks_Handler,
+		},
 		{
 			MethodName: "RenewFileset",
 			Handler:    _Fileset_RenewFileset_Handler,
@@ -403,6 +777,31 @@ var Fileset_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Fileset_ReadFilesetCDR_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReadFilesetRanges",
+			Handler:       _Fileset_ReadFilesetRanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadFilesetRefs",
+			Handler:       _Fileset_ReadFilesetRefs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadChunk",
+			Handler:       _Fileset_ReadChunk_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteChunk",
+			Handler:       _Fileset_WriteChunk_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CreateFilesetFromRefs",
+			Handler:       _Fileset_CreateFilesetFromRefs_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "storage/fileset.proto",
 }
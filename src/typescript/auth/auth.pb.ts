@@ -5,6 +5,7 @@
 */
 
 import * as fm from "../fetch.pb"
+import * as GoogleProtobufDuration from "../google/protobuf/duration.pb"
 import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
 
 export enum Permission {
@@ -27,6 +28,8 @@ export enum Permission {
   CLUSTER_AUTH_DELETE_EXPIRED_TOKENS = "CLUSTER_AUTH_DELETE_EXPIRED_TOKENS",
   CLUSTER_AUTH_REVOKE_USER_TOKENS = "CLUSTER_AUTH_REVOKE_USER_TOKENS",
   CLUSTER_AUTH_ROTATE_ROOT_TOKEN = "CLUSTER_AUTH_ROTATE_ROOT_TOKEN",
+  CLUSTER_AUTH_MODIFY_ROLES = "CLUSTER_AUTH_MODIFY_ROLES",
+  CLUSTER_AUTH_LIST_AUDIT_EVENTS = "CLUSTER_AUTH_LIST_AUDIT_EVENTS",
   CLUSTER_ENTERPRISE_ACTIVATE = "CLUSTER_ENTERPRISE_ACTIVATE",
   CLUSTER_ENTERPRISE_HEARTBEAT = "CLUSTER_ENTERPRISE_HEARTBEAT",
   CLUSTER_ENTERPRISE_GET_CODE = "CLUSTER_ENTERPRISE_GET_CODE",
@@ -59,6 +62,8 @@ export enum Permission {
   CLUSTER_DELETE_ALL = "CLUSTER_DELETE_ALL",
   CLUSTER_SNAPSHOTTER = "CLUSTER_SNAPSHOTTER",
   CLUSTER_RESTART_PACHYDERM = "CLUSTER_RESTART_PACHYDERM",
+  CLUSTER_MANAGE_WEBHOOKS = "CLUSTER_MANAGE_WEBHOOKS",
+  CLUSTER_REPLICATE = "CLUSTER_REPLICATE",
   REPO_READ = "REPO_READ",
   REPO_WRITE = "REPO_WRITE",
   REPO_MODIFY_BINDINGS = "REPO_MODIFY_BINDINGS",
@@ -77,6 +82,7 @@ export enum Permission {
   PIPELINE_LIST_JOB = "PIPELINE_LIST_JOB",
   CLUSTER_SET_DEFAULTS = "CLUSTER_SET_DEFAULTS",
   PROJECT_SET_DEFAULTS = "PROJECT_SET_DEFAULTS",
+  CLUSTER_SET_PROJECT_QUOTA = "CLUSTER_SET_PROJECT_QUOTA",
   PROJECT_CREATE = "PROJECT_CREATE",
   PROJECT_DELETE = "PROJECT_DELETE",
   PROJECT_LIST_REPO = "PROJECT_LIST_REPO",
@@ -145,11 +151,19 @@ export type TokenInfo = {
   subject?: string
   expiration?: GoogleProtobufTimestamp.Timestamp
   hashedToken?: string
+  scope?: TokenScope
+}
+
+export type TokenScope = {
+  permissions?: Permission[]
+  resources?: Resource[]
+  readOnly?: boolean
 }
 
 export type AuthenticateRequest = {
   oidcState?: string
   idToken?: string
+  scope?: TokenScope
 }
 
 export type AuthenticateResponse = {
@@ -162,6 +176,7 @@ export type WhoAmIRequest = {
 export type WhoAmIResponse = {
   username?: string
   expiration?: GoogleProtobufTimestamp.Timestamp
+  scope?: TokenScope
 }
 
 export type GetRolesForPermissionRequest = {
@@ -172,8 +187,58 @@ export type GetRolesForPermissionResponse = {
   roles?: Role[]
 }
 
+export type CreateRoleRequest = {
+  role?: Role
+}
+
+export type CreateRoleResponse = {
+}
+
+export type UpdateRoleRequest = {
+  role?: Role
+}
+
+export type UpdateRoleResponse = {
+}
+
+export type DeleteRoleRequest = {
+  name?: string
+}
+
+export type DeleteRoleResponse = {
+}
+
+export type ListRolesRequest = {
+}
+
+export type ListRolesResponse = {
+  roles?: Role[]
+}
+
+export type AuditEvent = {
+  id?: string
+  time?: GoogleProtobufTimestamp.Timestamp
+  principal?: string
+  method?: string
+  resource?: string
+  request?: string
+  code?: string
+  error?: string
+  duration?: GoogleProtobufDuration.Duration
+}
+
+export type ListAuditEventsRequest = {
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
+  principal?: string
+  method?: string
+  resource?: string
+  limit?: string
+}
+
 export type Roles = {
   roles?: {[key: string]: boolean}
+  expiration?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type RoleBinding = {
@@ -198,6 +263,7 @@ export type Role = {
   permissions?: Permission[]
   canBeBoundTo?: ResourceType[]
   returnedFor?: ResourceType[]
+  custom?: boolean
 }
 
 export type AuthorizeRequest = {
@@ -226,10 +292,39 @@ export type GetPermissionsResponse = {
   roles?: string[]
 }
 
+export type ExplainAuthorizationRequest = {
+  resource?: Resource
+  principal?: string
+  permissions?: Permission[]
+}
+
+export type RoleGrant = {
+  resource?: Resource
+  subject?: string
+  role?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  expired?: boolean
+}
+
+export type PermissionExplanation = {
+  permission?: Permission
+  granted?: boolean
+  grants?: RoleGrant[]
+  roles?: string[]
+  deniedByScope?: boolean
+}
+
+export type ExplainAuthorizationResponse = {
+  principal?: string
+  groups?: string[]
+  permissions?: PermissionExplanation[]
+}
+
 export type ModifyRoleBindingRequest = {
   resource?: Resource
   principal?: string
   roles?: string[]
+  expiration?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type ModifyRoleBindingResponse = {
@@ -260,6 +355,7 @@ export type GetOIDCLoginResponse = {
 export type GetRobotTokenRequest = {
   robot?: string
   ttl?: string
+  scope?: TokenScope
 }
 
 export type GetRobotTokenResponse = {
@@ -369,6 +465,24 @@ export class API {
   static GetRolesForPermission(req: GetRolesForPermissionRequest, initReq?: fm.InitReq): Promise<GetRolesForPermissionResponse> {
     return fm.fetchReq<GetRolesForPermissionRequest, GetRolesForPermissionResponse>(`/auth_v2.API/GetRolesForPermission`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static ExplainAuthorization(req: ExplainAuthorizationRequest, initReq?: fm.InitReq): Promise<ExplainAuthorizationResponse> {
+    return fm.fetchReq<ExplainAuthorizationRequest, ExplainAuthorizationResponse>(`/auth_v2.API/ExplainAuthorization`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static CreateRole(req: CreateRoleRequest, initReq?: fm.InitReq): Promise<CreateRoleResponse> {
+    return fm.fetchReq<CreateRoleRequest, CreateRoleResponse>(`/auth_v2.API/CreateRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static UpdateRole(req: UpdateRoleRequest, initReq?: fm.InitReq): Promise<UpdateRoleResponse> {
+    return fm.fetchReq<UpdateRoleRequest, UpdateRoleResponse>(`/auth_v2.API/UpdateRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteRole(req: DeleteRoleRequest, initReq?: fm.InitReq): Promise<DeleteRoleResponse> {
+    return fm.fetchReq<DeleteRoleRequest, DeleteRoleResponse>(`/auth_v2.API/DeleteRole`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListRoles(req: ListRolesRequest, initReq?: fm.InitReq): Promise<ListRolesResponse> {
+    return fm.fetchReq<ListRolesRequest, ListRolesResponse>(`/auth_v2.API/ListRoles`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListAuditEvents(req: ListAuditEventsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<AuditEvent>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListAuditEventsRequest, AuditEvent>(`/auth_v2.API/ListAuditEvents`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ModifyRoleBinding(req: ModifyRoleBindingRequest, initReq?: fm.InitReq): Promise<ModifyRoleBindingResponse> {
     return fm.fetchReq<ModifyRoleBindingRequest, ModifyRoleBindingResponse>(`/auth_v2.API/ModifyRoleBinding`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
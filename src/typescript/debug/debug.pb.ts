@@ -135,6 +135,17 @@ type BaseStarlark = {
 export type Starlark = BaseStarlark
   & OneOf<{ builtin: string; literal: StarlarkLiteral }>
 
+export type RedactionPattern = {
+  name?: string
+  regex?: string
+}
+
+export type Redaction = {
+  disableBuiltin?: boolean
+  patterns?: RedactionPattern[]
+  fields?: string[]
+}
+
 export type DumpV2RequestDefaults = {
   clusterDefaults?: boolean
 }
@@ -146,6 +157,7 @@ export type DumpV2Request = {
   timeout?: GoogleProtobufDuration.Duration
   defaults?: DumpV2RequestDefaults
   starlarkScripts?: Starlark[]
+  redaction?: Redaction
 }
 
 export type DumpContent = {
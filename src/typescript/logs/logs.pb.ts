@@ -121,8 +121,48 @@ export type LogMessage = {
   ppsLogMessage?: Pps_v2Pps.LogMessage
 }
 
+export type LogRule = {
+  name?: string
+  project?: string
+  pipeline?: string
+  pattern?: string
+  level?: LogLevel
+  alert?: boolean
+}
+
+export type CreateLogRuleRequest = {
+  rule?: LogRule
+  update?: boolean
+}
+
+export type CreateLogRuleResponse = {
+}
+
+export type ListLogRuleRequest = {
+}
+
+export type ListLogRuleResponse = {
+  rule?: LogRule
+}
+
+export type DeleteLogRuleRequest = {
+  name?: string
+}
+
+export type DeleteLogRuleResponse = {
+}
+
 export class API {
   static GetLogs(req: GetLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GetLogsResponse>, initReq?: fm.InitReq): Promise<void> {
     return fm.fetchStreamingRequest<GetLogsRequest, GetLogsResponse>(`/logs.API/GetLogs`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateLogRule(req: CreateLogRuleRequest, initReq?: fm.InitReq): Promise<CreateLogRuleResponse> {
+    return fm.fetchReq<CreateLogRuleRequest, CreateLogRuleResponse>(`/logs.API/CreateLogRule`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListLogRule(req: ListLogRuleRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ListLogRuleResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListLogRuleRequest, ListLogRuleResponse>(`/logs.API/ListLogRule`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteLogRule(req: DeleteLogRuleRequest, initReq?: fm.InitReq): Promise<DeleteLogRuleResponse> {
+    return fm.fetchReq<DeleteLogRuleRequest, DeleteLogRuleResponse>(`/logs.API/DeleteLogRule`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
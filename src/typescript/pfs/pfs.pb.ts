@@ -445,11 +445,13 @@ export type InspectProjectRequest = {
 
 export type InspectProjectV2Request = {
   project?: Project
+  includeQuota?: boolean
 }
 
 export type InspectProjectV2Response = {
   info?: ProjectInfo
   defaultsJson?: string
+  quotaJson?: string
 }
 
 export type ListProjectRequest = {
@@ -499,6 +501,7 @@ export type GetFileRequest = {
   uRL?: string
   offset?: string
   pathRange?: PathRange
+  sizeBytes?: string
 }
 
 export type InspectFileRequest = {
@@ -681,6 +684,27 @@ export type ReposSummaryResponse = {
   summaries?: ReposSummary[]
 }
 
+export type StorageUsage = {
+  totalBytes?: string
+  uniqueBytes?: string
+  sharedBytes?: string
+}
+
+export type StorageUsageRequest = {
+  repo?: Repo
+  commits?: boolean
+  projects?: ProjectPicker[]
+}
+
+
+type BaseStorageUsageResponse = {
+  usage?: StorageUsage
+  computedAt?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type StorageUsageResponse = BaseStorageUsageResponse
+  & OneOf<{ project: Project; repo: Repo; branch: Branch; commit: Commit }>
+
 export type ForgetCommitRequest = {
   commit?: CommitPicker
 }
@@ -688,6 +712,45 @@ export type ForgetCommitRequest = {
 export type ForgetCommitResponse = {
 }
 
+export type ShareLinkInfo = {
+  file?: File
+  fileType?: FileType
+  sizeBytes?: string
+  issuer?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  expires?: GoogleProtobufTimestamp.Timestamp
+  maxDownloads?: string
+  downloads?: string
+}
+
+export type CreateShareLinkRequest = {
+  file?: File
+  ttl?: GoogleProtobufDuration.Duration
+  maxDownloads?: string
+}
+
+export type CreateShareLinkResponse = {
+  token?: string
+  path?: string
+  info?: ShareLinkInfo
+}
+
+export type InspectShareLinkRequest = {
+  token?: string
+}
+
+export type GetShareLinkRequest = {
+  token?: string
+}
+
+export type RevokeShareLinkRequest = {
+  token?: string
+}
+
+export type RevokeShareLinkResponse = {
+  info?: ShareLinkInfo
+}
+
 export class API {
   static CreateRepo(req: CreateRepoRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<CreateRepoRequest, GoogleProtobufEmpty.Empty>(`/pfs_v2.API/CreateRepo`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
@@ -848,7 +911,22 @@ export class API {
   static ReposSummary(req: ReposSummaryRequest, initReq?: fm.InitReq): Promise<ReposSummaryResponse> {
     return fm.fetchReq<ReposSummaryRequest, ReposSummaryResponse>(`/pfs_v2.API/ReposSummary`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static StorageUsage(req: StorageUsageRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StorageUsageResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<StorageUsageRequest, StorageUsageResponse>(`/pfs_v2.API/StorageUsage`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ForgetCommit(req: ForgetCommitRequest, initReq?: fm.InitReq): Promise<ForgetCommitResponse> {
     return fm.fetchReq<ForgetCommitRequest, ForgetCommitResponse>(`/pfs_v2.API/ForgetCommit`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static CreateShareLink(req: CreateShareLinkRequest, initReq?: fm.InitReq): Promise<CreateShareLinkResponse> {
+    return fm.fetchReq<CreateShareLinkRequest, CreateShareLinkResponse>(`/pfs_v2.API/CreateShareLink`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectShareLink(req: InspectShareLinkRequest, initReq?: fm.InitReq): Promise<ShareLinkInfo> {
+    return fm.fetchReq<InspectShareLinkRequest, ShareLinkInfo>(`/pfs_v2.API/InspectShareLink`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static GetShareLink(req: GetShareLinkRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GoogleProtobufWrappers.BytesValue>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<GetShareLinkRequest, GoogleProtobufWrappers.BytesValue>(`/pfs_v2.API/GetShareLink`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static RevokeShareLink(req: RevokeShareLinkRequest, initReq?: fm.InitReq): Promise<RevokeShareLinkResponse> {
+    return fm.fetchReq<RevokeShareLinkRequest, RevokeShareLinkResponse>(`/pfs_v2.API/RevokeShareLink`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
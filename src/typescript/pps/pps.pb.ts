@@ -108,6 +108,7 @@ export type Transform = {
   dockerfile?: string
   memoryVolume?: boolean
   datumBatching?: boolean
+  datumCache?: boolean
 }
 
 export type TFJob = {
@@ -213,6 +214,7 @@ export type DatumInfo = {
   pfsState?: Pfs_v2Pfs.File
   data?: Pfs_v2Pfs.FileInfo[]
   imageId?: string
+  logs?: Pfs_v2Pfs.File
 }
 
 export type Aggregate = {
@@ -229,6 +231,10 @@ export type ProcessStats = {
   uploadTime?: GoogleProtobufDuration.Duration
   downloadBytes?: string
   uploadBytes?: string
+  cpuTime?: GoogleProtobufDuration.Duration
+  peakMemoryBytes?: string
+  readBytes?: string
+  writeBytes?: string
 }
 
 export type AggregateProcessStats = {
@@ -237,6 +243,10 @@ export type AggregateProcessStats = {
   uploadTime?: Aggregate
   downloadBytes?: Aggregate
   uploadBytes?: Aggregate
+  cpuTime?: Aggregate
+  peakMemoryBytes?: Aggregate
+  readBytes?: Aggregate
+  writeBytes?: Aggregate
 }
 
 export type WorkerStatus = {
@@ -307,6 +317,7 @@ export type JobInfo = {
   finished?: GoogleProtobufTimestamp.Timestamp
   details?: JobInfoDetails
   authToken?: string
+  aggregateStats?: AggregateProcessStats
 }
 
 export type Worker = {
@@ -366,6 +377,7 @@ export type PipelineInfoDetails = {
   determined?: Determined
   maximumExpectedUptime?: GoogleProtobufDuration.Duration
   workersStartedAt?: GoogleProtobufTimestamp.Timestamp
+  persistDatumLogs?: boolean
 }
 
 export type PipelineInfo = {
@@ -451,6 +463,7 @@ export type UpdateJobStateRequest = {
   dataRecovered?: string
   dataTotal?: string
   stats?: ProcessStats
+  aggregateStats?: AggregateProcessStats
 }
 
 export type GetLogsRequest = {
@@ -566,6 +579,7 @@ export type CreatePipelineRequest = {
   dryRun?: boolean
   determined?: Determined
   maximumExpectedUptime?: GoogleProtobufDuration.Duration
+  persistDatumLogs?: boolean
 }
 
 export type CreatePipelineV2Request = {
@@ -785,6 +799,43 @@ export type PipelinesSummary = {
   pausedPipelines?: string
   failedPipelines?: string
   unhealthyPipelines?: string
+  quota?: ProjectQuota
+  quotaUsage?: ProjectQuotaUsage
+}
+
+export type ProjectQuota = {
+  pipelines?: string
+  workers?: string
+  cpu?: string
+  memory?: string
+  gpus?: string
+  concurrentJobs?: string
+}
+
+export type ProjectQuotaUsage = {
+  pipelines?: string
+  workers?: string
+  cpu?: string
+  memory?: string
+  gpus?: string
+  concurrentJobs?: string
+}
+
+export type GetProjectQuotaRequest = {
+  project?: Pfs_v2Pfs.Project
+}
+
+export type GetProjectQuotaResponse = {
+  quota?: ProjectQuota
+  usage?: ProjectQuotaUsage
+}
+
+export type SetProjectQuotaRequest = {
+  project?: Pfs_v2Pfs.Project
+  quota?: ProjectQuota
+}
+
+export type SetProjectQuotaResponse = {
 }
 
 export type PipelinePickerPipelineName = {
@@ -923,4 +974,10 @@ export class API {
   static PipelinesSummary(req: PipelinesSummaryRequest, initReq?: fm.InitReq): Promise<PipelinesSummaryResponse> {
     return fm.fetchReq<PipelinesSummaryRequest, PipelinesSummaryResponse>(`/pps_v2.API/PipelinesSummary`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static GetProjectQuota(req: GetProjectQuotaRequest, initReq?: fm.InitReq): Promise<GetProjectQuotaResponse> {
+    return fm.fetchReq<GetProjectQuotaRequest, GetProjectQuotaResponse>(`/pps_v2.API/GetProjectQuota`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static SetProjectQuota(req: SetProjectQuotaRequest, initReq?: fm.InitReq): Promise<SetProjectQuotaResponse> {
+    return fm.fetchReq<SetProjectQuotaRequest, SetProjectQuotaResponse>(`/pps_v2.API/SetProjectQuota`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
@@ -60,4 +60,8 @@ export type DatumSetTaskResult = {
   outputFileSetId?: string
   metaFileSetId?: string
   stats?: DatumDatum.Stats
-}
\ No newline at end of file
+}
+export type DatumCacheEntry = {
+  outputFileSetId?: string
+  logsFileSetId?: string
+}
@@ -66,6 +66,47 @@ export type ReadFilesetCDRResponse = {
   ref?: CdrCdr.Ref
 }
 
+export type ContentRange = {
+  id?: Uint8Array
+  sizeBytes?: string
+}
+
+export type ReadFilesetRangesResponse = {
+  path?: string
+  ranges?: ContentRange[]
+}
+
+export type FileRefs = {
+  path?: string
+  datum?: string
+  dataRefs?: Uint8Array[]
+  chunkIds?: Uint8Array[]
+}
+
+export type FindMissingChunksRequest = {
+  chunkIds?: Uint8Array[]
+}
+
+export type FindMissingChunksResponse = {
+  chunkIds?: Uint8Array[]
+}
+
+export type ReadChunkRequest = {
+  chunkId?: Uint8Array
+}
+
+export type ReadChunkResponse = {
+  data?: Uint8Array
+}
+
+export type WriteChunkRequest = {
+  data?: Uint8Array
+}
+
+export type WriteChunkResponse = {
+  chunkId?: Uint8Array
+}
+
 export type RenewFilesetRequest = {
   filesetId?: string
   ttlSeconds?: string
@@ -102,6 +143,18 @@ export class Fileset {
   static ReadFilesetCDR(req: ReadFilesetRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReadFilesetCDRResponse>, initReq?: fm.InitReq): Promise<void> {
     return fm.fetchStreamingRequest<ReadFilesetRequest, ReadFilesetCDRResponse>(`/storage.Fileset/ReadFilesetCDR`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static ReadFilesetRanges(req: ReadFilesetRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReadFilesetRangesResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ReadFilesetRequest, ReadFilesetRangesResponse>(`/storage.Fileset/ReadFilesetRanges`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ReadFilesetRefs(req: ReadFilesetRequest, entityNotifier?: fm.NotifyStreamEntityArrival<FileRefs>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ReadFilesetRequest, FileRefs>(`/storage.Fileset/ReadFilesetRefs`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static FindMissingChunks(req: FindMissingChunksRequest, initReq?: fm.InitReq): Promise<FindMissingChunksResponse> {
+    return fm.fetchReq<FindMissingChunksRequest, FindMissingChunksResponse>(`/storage.Fileset/FindMissingChunks`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ReadChunk(req: ReadChunkRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ReadChunkResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ReadChunkRequest, ReadChunkResponse>(`/storage.Fileset/ReadChunk`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static RenewFileset(req: RenewFilesetRequest, initReq?: fm.InitReq): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<RenewFilesetRequest, GoogleProtobufEmpty.Empty>(`/storage.Fileset/RenewFileset`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -0,0 +1,132 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+
+import * as fm from "../fetch.pb"
+import * as GoogleProtobufTimestamp from "../google/protobuf/timestamp.pb"
+import * as Pfs_v2Pfs from "../pfs/pfs.pb"
+import * as Pps_v2Pps from "../pps/pps.pb"
+
+export enum EventType {
+  EVENT_TYPE_UNKNOWN = "EVENT_TYPE_UNKNOWN",
+  JOB = "JOB",
+  PIPELINE = "PIPELINE",
+  COMMIT = "COMMIT",
+  LOG = "LOG",
+}
+
+export enum DeliveryState {
+  DELIVERY_STATE_UNKNOWN = "DELIVERY_STATE_UNKNOWN",
+  PENDING = "PENDING",
+  DELIVERED = "DELIVERED",
+  FAILED = "FAILED",
+}
+
+export type Filter = {
+  types?: EventType[]
+  projects?: string[]
+  pipelines?: string[]
+  repos?: string[]
+  branches?: string[]
+  jobStates?: Pps_v2Pps.JobState[]
+  pipelineStates?: Pps_v2Pps.PipelineState[]
+  commitStates?: Pfs_v2Pfs.CommitState[]
+}
+
+export type WebhookInfo = {
+  name?: string
+  url?: string
+  filter?: Filter
+  hasSecret?: boolean
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+  updatedAt?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type Event = {
+  type?: EventType
+  time?: GoogleProtobufTimestamp.Timestamp
+  project?: string
+  pipeline?: string
+  repo?: string
+  branch?: string
+  id?: string
+  state?: string
+  reason?: string
+  rule?: string
+  message?: string
+}
+
+export type DeliveryInfo = {
+  id?: string
+  webhook?: string
+  event?: Event
+  state?: DeliveryState
+  attempts?: number
+  lastStatusCode?: number
+  lastError?: string
+  createdAt?: GoogleProtobufTimestamp.Timestamp
+  lastAttemptAt?: GoogleProtobufTimestamp.Timestamp
+  nextAttemptAt?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type CreateWebhookRequest = {
+  name?: string
+  url?: string
+  secret?: string
+  filter?: Filter
+  update?: boolean
+}
+
+export type CreateWebhookResponse = {
+}
+
+export type InspectWebhookRequest = {
+  name?: string
+}
+
+export type InspectWebhookResponse = {
+  info?: WebhookInfo
+}
+
+export type ListWebhookRequest = {
+}
+
+export type ListWebhookResponse = {
+  info?: WebhookInfo
+}
+
+export type DeleteWebhookRequest = {
+  name?: string
+}
+
+export type DeleteWebhookResponse = {
+}
+
+export type ListDeliveryRequest = {
+  webhook?: string
+  limit?: number
+}
+
+export type ListDeliveryResponse = {
+  info?: DeliveryInfo
+}
+
+export class API {
+  static CreateWebhook(req: CreateWebhookRequest, initReq?: fm.InitReq): Promise<CreateWebhookResponse> {
+    return fm.fetchReq<CreateWebhookRequest, CreateWebhookResponse>(`/webhook.API/CreateWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static InspectWebhook(req: InspectWebhookRequest, initReq?: fm.InitReq): Promise<InspectWebhookResponse> {
+    return fm.fetchReq<InspectWebhookRequest, InspectWebhookResponse>(`/webhook.API/InspectWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListWebhook(req: ListWebhookRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ListWebhookResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListWebhookRequest, ListWebhookResponse>(`/webhook.API/ListWebhook`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static DeleteWebhook(req: DeleteWebhookRequest, initReq?: fm.InitReq): Promise<DeleteWebhookResponse> {
+    return fm.fetchReq<DeleteWebhookRequest, DeleteWebhookResponse>(`/webhook.API/DeleteWebhook`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListDelivery(req: ListDeliveryRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ListDeliveryResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<ListDeliveryRequest, ListDeliveryResponse>(`/webhook.API/ListDelivery`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+}
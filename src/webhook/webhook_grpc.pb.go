@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: webhook/webhook.proto
+
+package webhook
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	API_CreateWebhook_FullMethodName  = "/webhook.API/CreateWebhook"
+	API_InspectWebhook_FullMethodName = "/webhook.API/InspectWebhook"
+	API_ListWebhook_FullMethodName    = "/webhook.API/ListWebhook"
+	API_DeleteWebhook_FullMethodName  = "/webhook.API/DeleteWebhook"
+	API_ListDelivery_FullMethodName   = "/webhook.API/ListDelivery"
+)
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APIClient interface {
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	InspectWebhook(ctx context.Context, in *InspectWebhookRequest, opts ...grpc.CallOption) (*InspectWebhookResponse, error)
+	ListWebhook(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (API_ListWebhookClient, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListDelivery(ctx context.Context, in *ListDeliveryRequest, opts ...grpc.CallOption) (API_ListDeliveryClient, error)
+}
+
+type aPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, API_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectWebhook(ctx context.Context, in *InspectWebhookRequest, opts ...grpc.CallOption) (*InspectWebhookResponse, error) {
+	out := new(InspectWebhookResponse)
+	err := c.cc.Invoke(ctx, API_InspectWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWebhook(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (API_ListWebhookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], API_ListWebhook_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListWebhookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListWebhookClient interface {
+	Recv() (*ListWebhookResponse, error)
+	grpc.ClientStream
+}
+
+type aPIListWebhookClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListWebhookClient) Recv() (*ListWebhookResponse, error) {
+	m := new(ListWebhookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, API_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListDelivery(ctx context.Context, in *ListDeliveryRequest, opts ...grpc.CallOption) (API_ListDeliveryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[1], API_ListDelivery_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListDeliveryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListDeliveryClient interface {
+	Recv() (*ListDeliveryResponse, error)
+	grpc.ClientStream
+}
+
+type aPIListDeliveryClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListDeliveryClient) Recv() (*ListDeliveryResponse, error) {
+	m := new(ListDeliveryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServer is the server API for API service.
+// All implementations must embed UnimplementedAPIServer
+// for forward compatibility
+type APIServer interface {
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	InspectWebhook(context.Context, *InspectWebhookRequest) (*InspectWebhookResponse, error)
+	ListWebhook(*ListWebhookRequest, API_ListWebhookServer) error
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListDelivery(*ListDeliveryRequest, API_ListDeliveryServer) error
+	mustEmbedUnimplementedAPIServer()
+}
+
+// UnimplementedAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (UnimplementedAPIServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedAPIServer) InspectWebhook(context.Context, *InspectWebhookRequest) (*InspectWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectWebhook not implemented")
+}
+func (UnimplementedAPIServer) ListWebhook(*ListWebhookRequest, API_ListWebhookServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListWebhook not implemented")
+}
+func (UnimplementedAPIServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedAPIServer) ListDelivery(*ListDeliveryRequest, API_ListDeliveryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDelivery not implemented")
+}
+func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
+
+// UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIServer will
+// result in compilation errors.
+type UnsafeAPIServer interface {
+	mustEmbedUnimplementedAPIServer()
+}
+
+func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
+	s.RegisterService(&API_ServiceDesc, srv)
+}
+
+func _API_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_InspectWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectWebhook(ctx, req.(*InspectWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListWebhook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListWebhookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListWebhook(m, &aPIListWebhookServer{stream})
+}
+
+type API_ListWebhookServer interface {
+	Send(*ListWebhookResponse) error
+	grpc.ServerStream
+}
+
+type aPIListWebhookServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListWebhookServer) Send(m *ListWebhookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListDelivery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDeliveryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListDelivery(m, &aPIListDeliveryServer{stream})
+}
+
+type API_ListDeliveryServer interface {
+	Send(*ListDeliveryResponse) error
+	grpc.ServerStream
+}
+
+type aPIListDeliveryServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListDeliveryServer) Send(m *ListDeliveryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// API_ServiceDesc is the grpc.ServiceDesc for API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webhook.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _API_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "InspectWebhook",
+			Handler:    _API_InspectWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _API_DeleteWebhook_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListWebhook",
+			Handler:       _API_ListWebhook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDelivery",
+			Handler:       _API_ListDelivery_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "webhook/webhook.proto",
+}